@@ -50,8 +50,53 @@ type AssessmentProfileSpec struct {
 	// DisabledChecks lists specific check IDs to skip across all validators.
 	// +optional
 	DisabledChecks []string `json:"disabledChecks,omitempty"`
+
+	// Parameters sets validator-specific tunables, keyed by validator name.
+	// Each validator declares the parameters it accepts, their types and
+	// defaults in the validator catalog; unset parameters keep the default.
+	// +optional
+	Parameters map[string]ValidatorParameters `json:"parameters,omitempty"`
+
+	// Scoring selects and tunes the model used to compute the assessment score.
+	// +optional
+	Scoring *ScoringSpec `json:"scoring,omitempty"`
+}
+
+// ScoringSpec configures the scoring model.
+type ScoringSpec struct {
+	// Model is the scoring model. "simple" averages fixed status weights over all
+	// findings; "weighted" scores each category separately and combines the
+	// category scores using CategoryWeights. Defaults to "simple".
+	// +kubebuilder:validation:Enum=simple;weighted
+	// +optional
+	Model string `json:"model,omitempty"`
+
+	// CategoryWeights sets the relative weight of each category in the overall
+	// score. Categories not listed have weight 1. Only used by the weighted model.
+	// +optional
+	CategoryWeights map[string]int `json:"categoryWeights,omitempty"`
+
+	// CheckCriticality sets the relative weight of individual checks within their
+	// category, keyed by check ID. A trailing "*" matches a family of IDs.
+	// Checks not listed have weight 1. Only used by the weighted model.
+	// +optional
+	CheckCriticality map[string]int `json:"checkCriticality,omitempty"`
+
+	// SeverityWeights sets the weight of checks not listed in CheckCriticality by
+	// finding severity (low, medium, high, critical). Defaults to low=1, medium=2,
+	// high=4, critical=8. Only used by the weighted model.
+	// +optional
+	SeverityWeights map[string]int `json:"severityWeights,omitempty"`
+
+	// ExcludeInfo leaves INFO findings out of the score. Only used by the weighted model.
+	// +optional
+	ExcludeInfo bool `json:"excludeInfo,omitempty"`
 }
 
+// ValidatorParameters maps parameter names to values for a single validator.
+// Values are strings and are parsed according to the parameter's declared type.
+type ValidatorParameters map[string]string
+
 // ThresholdOverrides allows overriding individual threshold values from the base profile.
 // All fields are pointers: nil means "inherit from base profile".
 type ThresholdOverrides struct {
@@ -0,0 +1,226 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+)
+
+// RunTrigger identifies what started an assessment run.
+// +kubebuilder:validation:Enum=Created;Schedule;Manual;ProfileChange;Event
+type RunTrigger string
+
+const (
+	// RunTriggerCreated is the first run of a one-time assessment.
+	RunTriggerCreated RunTrigger = "Created"
+
+	// RunTriggerSchedule is a run started by the assessment's cron schedule.
+	RunTriggerSchedule RunTrigger = "Schedule"
+
+	// RunTriggerManual is a run requested by a user.
+	RunTriggerManual RunTrigger = "Manual"
+
+	// RunTriggerProfileChange is a run started because the assessment's profile changed.
+	RunTriggerProfileChange RunTrigger = "ProfileChange"
+
+	// RunTriggerEvent is a run started by a change in the cluster.
+	RunTriggerEvent RunTrigger = "Event"
+)
+
+// AssessmentRunSpec identifies the assessment to run and why.
+type AssessmentRunSpec struct {
+	// AssessmentName is the name of the ClusterAssessment to run.
+	// +kubebuilder:validation:MinLength=1
+	// +kubebuilder:validation:XValidation:rule="self == oldSelf",message="assessmentName is immutable"
+	AssessmentName string `json:"assessmentName"`
+
+	// Trigger records what requested the run. Runs created by users default to Manual.
+	// +kubebuilder:default=Manual
+	// +optional
+	Trigger RunTrigger `json:"trigger,omitempty"`
+
+	// Reason is a free-form note explaining why the run was requested.
+	// +optional
+	Reason string `json:"reason,omitempty"`
+
+	// Validators limits the run to the listed validators instead of the
+	// assessment's validators. Findings of the other validators are kept from
+	// the previous run.
+	// +optional
+	Validators []string `json:"validators,omitempty"`
+}
+
+// ValidatorOutcome is the result of running a single validator.
+// +kubebuilder:validation:Enum=Succeeded;Failed;Skipped;TimedOut
+type ValidatorOutcome string
+
+const (
+	// ValidatorSucceeded means the validator completed and its findings are scored.
+	ValidatorSucceeded ValidatorOutcome = "Succeeded"
+
+	// ValidatorFailed means the validator returned an error.
+	ValidatorFailed ValidatorOutcome = "Failed"
+
+	// ValidatorSkipped means the validator was not run.
+	ValidatorSkipped ValidatorOutcome = "Skipped"
+
+	// ValidatorTimedOut means the validator exceeded its deadline.
+	ValidatorTimedOut ValidatorOutcome = "TimedOut"
+)
+
+// ValidatorRunStatus describes how a single validator ran.
+type ValidatorRunStatus struct {
+	// Name is the validator name.
+	Name string `json:"name"`
+
+	// Outcome is the result of the validator.
+	// +optional
+	Outcome ValidatorOutcome `json:"outcome,omitempty"`
+
+	// Duration is how long the validator ran.
+	Duration metav1.Duration `json:"duration"`
+
+	// FindingCount is the number of findings the validator returned.
+	FindingCount int `json:"findingCount"`
+
+	// PassCount, WarnCount, FailCount, InfoCount, NotApplicableCount,
+	// ErrorCount and SkippedCount break the findings down by status.
+	// +optional
+	PassCount int `json:"passCount,omitempty"`
+	// +optional
+	WarnCount int `json:"warnCount,omitempty"`
+	// +optional
+	FailCount int `json:"failCount,omitempty"`
+	// +optional
+	InfoCount int `json:"infoCount,omitempty"`
+	// +optional
+	NotApplicableCount int `json:"notApplicableCount,omitempty"`
+	// +optional
+	ErrorCount int `json:"errorCount,omitempty"`
+	// +optional
+	SkippedCount int `json:"skippedCount,omitempty"`
+
+	// Error is set when the validator failed, timed out or was skipped.
+	// +optional
+	Error string `json:"error,omitempty"`
+
+	// MissingPermissions lists the permissions the operator lacks that the
+	// validator needs, e.g. "list secrets". Set when the validator was
+	// skipped because of them.
+	// +optional
+	MissingPermissions []string `json:"missingPermissions,omitempty"`
+}
+
+// Ran reports whether the validator completed, so its findings describe the
+// cluster. Statuses recorded before outcomes existed are judged by their error.
+func (s ValidatorRunStatus) Ran() bool {
+	if s.Outcome == "" {
+		return s.Error == ""
+	}
+	return s.Outcome == ValidatorSucceeded
+}
+
+// AssessmentRunStatus records the execution of a run.
+type AssessmentRunStatus struct {
+	// Phase is the run's current phase.
+	// +kubebuilder:validation:Enum=Pending;Running;Completed;Failed
+	// +optional
+	Phase string `json:"phase,omitempty"`
+
+	// StartTime is when the run started.
+	// +optional
+	StartTime *metav1.Time `json:"startTime,omitempty"`
+
+	// CompletionTime is when the run completed or failed.
+	// +optional
+	CompletionTime *metav1.Time `json:"completionTime,omitempty"`
+
+	// Profile is the name of the profile the run used.
+	// +optional
+	Profile string `json:"profile,omitempty"`
+
+	// ResolvedProfile is the full profile the run used, after resolving
+	// AssessmentProfile inheritance and overrides.
+	// +kubebuilder:pruning:PreserveUnknownFields
+	// +optional
+	ResolvedProfile *runtime.RawExtension `json:"resolvedProfile,omitempty"`
+
+	// Validators reports the outcome, duration, error and finding counts of
+	// each validator, sorted by name.
+	// +optional
+	Validators []ValidatorRunStatus `json:"validators,omitempty"`
+
+	// Summary is the result summary of the run.
+	// +optional
+	Summary *AssessmentSummary `json:"summary,omitempty"`
+
+	// ReportConfigMap is the name of the ConfigMap holding the run's report.
+	// +optional
+	ReportConfigMap string `json:"reportConfigMap,omitempty"`
+
+	// SnapshotName is the name of the AssessmentSnapshot recorded for the run.
+	// +optional
+	SnapshotName string `json:"snapshotName,omitempty"`
+
+	// JobName is the name of the Job that executed the run in Job mode.
+	// +optional
+	JobName string `json:"jobName,omitempty"`
+
+	// Message provides details about the run's phase.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// Finished reports whether the run has completed or failed.
+func (s *AssessmentRunStatus) Finished() bool {
+	return s.Phase == PhaseCompleted || s.Phase == PhaseFailed
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,shortName=arun
+// +kubebuilder:printcolumn:name="Assessment",type=string,JSONPath=`.spec.assessmentName`
+// +kubebuilder:printcolumn:name="Trigger",type=string,JSONPath=`.spec.trigger`
+// +kubebuilder:printcolumn:name="Phase",type=string,JSONPath=`.status.phase`
+// +kubebuilder:printcolumn:name="Score",type=integer,JSONPath=`.status.summary.score`
+// +kubebuilder:printcolumn:name="Started",type=date,JSONPath=`.status.startTime`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// AssessmentRun records a single execution of a ClusterAssessment. The
+// operator creates one for every scheduled or initial run, and users can
+// create one to request a run of an existing assessment.
+type AssessmentRun struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   AssessmentRunSpec   `json:"spec,omitempty"`
+	Status AssessmentRunStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// AssessmentRunList contains a list of AssessmentRun
+type AssessmentRunList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []AssessmentRun `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&AssessmentRun{}, &AssessmentRunList{})
+}
@@ -42,9 +42,13 @@ type FindingSnapshot struct {
 	Category string `json:"category"`
 
 	// Status indicates the finding severity.
-	// +kubebuilder:validation:Enum=PASS;WARN;FAIL;INFO
+	// +kubebuilder:validation:Enum=PASS;WARN;FAIL;INFO;SKIPPED;NOT_APPLICABLE;ERROR
 	Status FindingStatus `json:"status"`
 
+	// Severity is the risk level of the finding.
+	// +optional
+	Severity FindingSeverity `json:"severity,omitempty"`
+
 	// Title is a short, human-readable title.
 	Title string `json:"title"`
 
@@ -55,6 +59,16 @@ type FindingSnapshot struct {
 	// Namespace is the namespace of the resource, if applicable.
 	// +optional
 	Namespace string `json:"namespace,omitempty"`
+
+	// AffectedResources lists the resources this finding applies to, up to
+	// the first 50.
+	// +optional
+	AffectedResources []AffectedResource `json:"affectedResources,omitempty"`
+
+	// AffectedResourceCount is the number of resources this finding applies
+	// to, including those not listed in AffectedResources.
+	// +optional
+	AffectedResourceCount int `json:"affectedResourceCount,omitempty"`
 }
 
 // DeltaSummary summarizes changes from the previous assessment snapshot.
@@ -75,6 +89,16 @@ type DeltaSummary struct {
 	// +optional
 	ImprovedFindings []string `json:"improvedFindings,omitempty"`
 
+	// NewAffectedResources are resources newly affected by a finding that was
+	// already present, formatted as "<finding-id>: <kind>/<namespace>/<name>".
+	// +optional
+	NewAffectedResources []string `json:"newAffectedResources,omitempty"`
+
+	// ResolvedAffectedResources are resources no longer affected by a finding,
+	// formatted like NewAffectedResources.
+	// +optional
+	ResolvedAffectedResources []string `json:"resolvedAffectedResources,omitempty"`
+
 	// ScoreDelta is the score change from the previous run (positive = improved).
 	// +optional
 	ScoreDelta *int `json:"scoreDelta,omitempty"`
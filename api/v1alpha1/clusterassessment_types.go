@@ -17,6 +17,7 @@ limitations under the License.
 package v1alpha1
 
 import (
+	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 )
 
@@ -30,6 +31,37 @@ type ClusterAssessmentSpec struct {
 	// +optional
 	Schedule string `json:"schedule,omitempty"`
 
+	// TimeZone is the IANA time zone name the schedule and blackout windows are
+	// evaluated in, such as "Europe/Berlin". Defaults to the operator's local time.
+	// +optional
+	TimeZone string `json:"timeZone,omitempty"`
+
+	// StartingDeadlineSeconds is how late a scheduled run may start. A run missed
+	// by more than this, for example while the operator was down, is skipped and
+	// the assessment waits for the next scheduled time. By default a missed run
+	// starts as soon as the operator notices it.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	StartingDeadlineSeconds *int64 `json:"startingDeadlineSeconds,omitempty"`
+
+	// ConcurrencyPolicy decides what happens when a scheduled run is due while
+	// the previous run is still executing in a Job. Forbid skips the new run;
+	// Replace stops the running Job and starts the new run.
+	// +kubebuilder:default=Forbid
+	// +optional
+	ConcurrencyPolicy ConcurrencyPolicy `json:"concurrencyPolicy,omitempty"`
+
+	// Jitter delays each scheduled run by a random amount up to this duration,
+	// so assessments sharing a schedule do not all start at once. The delay is
+	// stable for a given assessment and scheduled time.
+	// +optional
+	Jitter *metav1.Duration `json:"jitter,omitempty"`
+
+	// BlackoutWindows are periods in which scheduled runs are skipped, such as
+	// cluster upgrade windows. Requested AssessmentRuns are not affected.
+	// +optional
+	BlackoutWindows []BlackoutWindow `json:"blackoutWindows,omitempty"`
+
 	// Profile specifies the baseline profile to use for assessment.
 	// Can be a built-in profile name ("production", "development") or
 	// the name of a custom AssessmentProfile CR.
@@ -51,9 +83,11 @@ type ClusterAssessmentSpec struct {
 	ReportStorage ReportStorageSpec `json:"reportStorage,omitempty"`
 
 	// MinSeverity filters findings to only include this severity level and above.
-	// Valid values are: "INFO", "PASS", "WARN", "FAIL"
+	// Status values ("INFO", "PASS", "WARN", "FAIL") filter by finding status.
+	// Severity values ("low", "medium", "high", "critical") keep only findings
+	// that are not PASS and whose severity is at least the given level.
 	// Leave empty to include all findings.
-	// +kubebuilder:validation:Enum=INFO;PASS;WARN;FAIL
+	// +kubebuilder:validation:Enum=INFO;PASS;WARN;FAIL;low;medium;high;critical
 	// +optional
 	MinSeverity string `json:"minSeverity,omitempty"`
 
@@ -64,11 +98,228 @@ type ClusterAssessmentSpec struct {
 	// +optional
 	HistoryLimit *int `json:"historyLimit,omitempty"`
 
-	// Suppressions lists finding IDs to suppress from scoring.
+	// Suppressions lists rules selecting findings to suppress from scoring.
 	// Suppressed findings are still collected and visible in reports
 	// but marked as suppressed and excluded from score calculation.
+	// Approved FindingException resources are applied in addition to these rules.
 	// +optional
 	Suppressions []SuppressionRule `json:"suppressions,omitempty"`
+
+	// Execution configures where the validators run.
+	// Defaults to running inside the operator pod.
+	// +optional
+	Execution *ExecutionSpec `json:"execution,omitempty"`
+
+	// Triggers re-run the assessment when the cluster changes between
+	// scheduled runs, such as after an upgrade.
+	// +optional
+	Triggers *TriggerSpec `json:"triggers,omitempty"`
+
+	// FindingStorage configures where the full findings are stored. Large
+	// clusters should store them in ConfigMaps to keep the assessment below the
+	// etcd object size limit.
+	// +optional
+	FindingStorage *FindingStorageSpec `json:"findingStorage,omitempty"`
+
+	// DeletionPolicy selects whether the report ConfigMaps, findings ConfigMaps
+	// and snapshots of the assessment are deleted along with it. Defaults to Delete.
+	// +kubebuilder:default=Delete
+	// +optional
+	DeletionPolicy DeletionPolicy `json:"deletionPolicy,omitempty"`
+}
+
+// DeletionPolicy describes what happens to the stored reports and history of
+// an assessment when it is deleted.
+// +kubebuilder:validation:Enum=Delete;Retain
+type DeletionPolicy string
+
+const (
+	// DeletionPolicyDelete deletes the reports, findings and snapshots of the
+	// assessment before it is removed.
+	DeletionPolicyDelete DeletionPolicy = "Delete"
+
+	// DeletionPolicyRetain keeps them; they stay labeled with the assessment name.
+	DeletionPolicyRetain DeletionPolicy = "Retain"
+)
+
+// FindingStorageMode selects where the full findings of an assessment are stored.
+// +kubebuilder:validation:Enum=Status;ConfigMap
+type FindingStorageMode string
+
+const (
+	// FindingStorageStatus stores every finding in the assessment status.
+	FindingStorageStatus FindingStorageMode = "Status"
+
+	// FindingStorageConfigMap stores the findings as gzip-compressed JSON split
+	// across ConfigMaps in the operator namespace. The status keeps only the
+	// most severe findings and a reference to the ConfigMaps.
+	FindingStorageConfigMap FindingStorageMode = "ConfigMap"
+)
+
+// FindingStorageSpec configures where the findings of an assessment are stored.
+type FindingStorageSpec struct {
+	// Mode selects whether findings are stored in the status or in ConfigMaps.
+	// +kubebuilder:default=Status
+	// +optional
+	Mode FindingStorageMode `json:"mode,omitempty"`
+
+	// StatusLimit is the number of findings kept in the status in ConfigMap
+	// mode, failing findings first, by severity. Defaults to 20.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	StatusLimit *int `json:"statusLimit,omitempty"`
+}
+
+// FindingsReference locates findings stored outside the assessment status.
+type FindingsReference struct {
+	// Namespace is the namespace of the ConfigMaps.
+	Namespace string `json:"namespace"`
+
+	// ConfigMaps are the names of the ConfigMaps holding the findings, in
+	// order. Their concatenated data is the gzip-compressed JSON array of findings.
+	ConfigMaps []string `json:"configMaps"`
+
+	// Count is the total number of findings.
+	Count int `json:"count"`
+
+	// Checksum is the hex-encoded SHA-256 of the compressed data.
+	Checksum string `json:"checksum"`
+}
+
+// ConcurrencyPolicy describes how a scheduled run is handled while the previous
+// run is still executing.
+// +kubebuilder:validation:Enum=Forbid;Replace
+type ConcurrencyPolicy string
+
+const (
+	// ConcurrencyPolicyForbid skips a scheduled run while the previous run is executing.
+	ConcurrencyPolicyForbid ConcurrencyPolicy = "Forbid"
+
+	// ConcurrencyPolicyReplace stops the executing run and starts the scheduled run.
+	ConcurrencyPolicyReplace ConcurrencyPolicy = "Replace"
+)
+
+// BlackoutWindow is a period in which scheduled runs are skipped. A window is
+// either recurring, starting on a cron schedule and lasting Duration, or a
+// one-off window between Start and End.
+// +kubebuilder:validation:XValidation:rule="has(self.schedule) ? (has(self.duration) && !has(self.start) && !has(self.end)) : (has(self.start) && has(self.end) && !has(self.duration))",message="a blackout window sets either schedule and duration, or start and end"
+type BlackoutWindow struct {
+	// Name identifies the window in status messages.
+	// +optional
+	Name string `json:"name,omitempty"`
+
+	// Schedule is a cron expression for the start of a recurring window,
+	// evaluated in the assessment's time zone.
+	// +optional
+	Schedule string `json:"schedule,omitempty"`
+
+	// Duration is the length of a recurring window.
+	// +optional
+	Duration *metav1.Duration `json:"duration,omitempty"`
+
+	// Start is the beginning of a one-off window.
+	// +optional
+	Start *metav1.Time `json:"start,omitempty"`
+
+	// End is the end of a one-off window.
+	// +optional
+	End *metav1.Time `json:"end,omitempty"`
+}
+
+// TriggerEventType is a kind of cluster change that can trigger a run.
+// +kubebuilder:validation:Enum=ClusterUpgrade;MachineConfigPoolUpdate;NamespaceCreated;ProfileChange
+type TriggerEventType string
+
+const (
+	// TriggerClusterUpgrade fires when the ClusterVersion history gains a new
+	// Completed entry.
+	TriggerClusterUpgrade TriggerEventType = "ClusterUpgrade"
+
+	// TriggerMachineConfigPoolUpdate fires when a MachineConfigPool finishes
+	// rolling out a configuration.
+	TriggerMachineConfigPoolUpdate TriggerEventType = "MachineConfigPoolUpdate"
+
+	// TriggerNamespaceCreated fires when a namespace is created.
+	TriggerNamespaceCreated TriggerEventType = "NamespaceCreated"
+
+	// TriggerProfileChange fires when the AssessmentProfile used by the
+	// assessment changes.
+	TriggerProfileChange TriggerEventType = "ProfileChange"
+)
+
+// TriggerSpec configures the cluster events that re-run an assessment.
+type TriggerSpec struct {
+	// Events lists the cluster changes that trigger a run.
+	// +kubebuilder:validation:MinItems=1
+	// +listType=map
+	// +listMapKey=type
+	Events []EventTrigger `json:"events"`
+
+	// Debounce is how long the cluster must be quiet after an event before the
+	// triggered run starts, so a burst of changes causes a single run. A steady
+	// stream of events delays the run by at most five times this period.
+	// Defaults to 2m.
+	// +optional
+	Debounce *metav1.Duration `json:"debounce,omitempty"`
+
+	// MinInterval is the minimum time between the end of the assessment's last
+	// run and the start of a triggered run. Defaults to 15m.
+	// +optional
+	MinInterval *metav1.Duration `json:"minInterval,omitempty"`
+}
+
+// EventTrigger selects a cluster change that triggers a run.
+type EventTrigger struct {
+	// Type is the kind of cluster change.
+	Type TriggerEventType `json:"type"`
+
+	// Validators limits runs triggered by this event to the listed validators.
+	// Findings of the other validators are kept from the previous run.
+	// Leave empty to run all of the assessment's validators.
+	// +optional
+	Validators []string `json:"validators,omitempty"`
+}
+
+// ExecutionMode selects where an assessment's validators run.
+// +kubebuilder:validation:Enum=InProcess;Job
+type ExecutionMode string
+
+const (
+	// ExecutionModeInProcess runs the validators inside the operator pod.
+	ExecutionModeInProcess ExecutionMode = "InProcess"
+
+	// ExecutionModeJob runs the validators in a Job in the operator namespace,
+	// isolating the run's memory and failures from the controller.
+	ExecutionModeJob ExecutionMode = "Job"
+)
+
+// ExecutionSpec configures how an assessment is executed.
+type ExecutionSpec struct {
+	// Mode selects whether validators run in the operator pod or in a Job.
+	// +kubebuilder:default=InProcess
+	// +optional
+	Mode ExecutionMode `json:"mode,omitempty"`
+
+	// ServiceAccountName is the service account the Job runs as.
+	// It needs read access to the cluster and permission to create ConfigMaps
+	// in the operator namespace. Defaults to the operator's service account.
+	// +optional
+	ServiceAccountName string `json:"serviceAccountName,omitempty"`
+
+	// Resources sets the compute resources of the Job's container.
+	// +optional
+	Resources corev1.ResourceRequirements `json:"resources,omitempty"`
+
+	// ActiveDeadlineSeconds bounds the runtime of the Job. Defaults to the
+	// worst-case runtime of all validators.
+	// +kubebuilder:validation:Minimum=1
+	// +optional
+	ActiveDeadlineSeconds *int64 `json:"activeDeadlineSeconds,omitempty"`
+}
+
+// JobMode reports whether the assessment runs its validators in a Job.
+func (s *ClusterAssessmentSpec) JobMode() bool {
+	return s.Execution != nil && s.Execution.Mode == ExecutionModeJob
 }
 
 // ReportStorageSpec configures report storage options
@@ -102,6 +353,26 @@ type ConfigMapStorageSpec struct {
 	// Defaults to "json"
 	// +optional
 	Format string `json:"format,omitempty"`
+
+	// Retain limits the report ConfigMaps kept for the assessment. Every run
+	// creates a new ConfigMap; older ones are deleted once they exceed the
+	// policy. Without it every report is kept.
+	// +optional
+	Retain *RetentionPolicy `json:"retain,omitempty"`
+}
+
+// RetentionPolicy limits how many report ConfigMaps are kept, and for how long.
+// The most recent report is always kept.
+type RetentionPolicy struct {
+	// Count is the maximum number of report ConfigMaps to keep. 0, the
+	// default, keeps all of them.
+	// +kubebuilder:validation:Minimum=0
+	// +optional
+	Count *int `json:"count,omitempty"`
+
+	// MaxAge deletes report ConfigMaps older than this duration.
+	// +optional
+	MaxAge *metav1.Duration `json:"maxAge,omitempty"`
 }
 
 // GitStorageSpec configures Git repository export
@@ -148,6 +419,11 @@ type ClusterAssessmentStatus struct {
 	// +optional
 	NextRunTime *metav1.Time `json:"nextRunTime,omitempty"`
 
+	// LastScheduleTime is the scheduled time of the last run started or skipped
+	// by the schedule. The next scheduled time is computed from it.
+	// +optional
+	LastScheduleTime *metav1.Time `json:"lastScheduleTime,omitempty"`
+
 	// ClusterInfo contains metadata about the assessed cluster.
 	// +optional
 	ClusterInfo ClusterInfo `json:"clusterInfo,omitempty"`
@@ -156,10 +432,16 @@ type ClusterAssessmentStatus struct {
 	// +optional
 	Summary AssessmentSummary `json:"summary,omitempty"`
 
-	// Findings is the list of all assessment findings.
+	// Findings is the list of all assessment findings. When FindingsRef is
+	// set, it only holds the most severe findings.
 	// +optional
 	Findings []Finding `json:"findings,omitempty"`
 
+	// FindingsRef references the full findings when the assessment stores them
+	// in ConfigMaps.
+	// +optional
+	FindingsRef *FindingsReference `json:"findingsRef,omitempty"`
+
 	// ReportConfigMap is the name of the ConfigMap containing the full report.
 	// +optional
 	ReportConfigMap string `json:"reportConfigMap,omitempty"`
@@ -179,6 +461,60 @@ type ClusterAssessmentStatus struct {
 	// SnapshotCount is the number of historical snapshots retained for this assessment.
 	// +optional
 	SnapshotCount int `json:"snapshotCount,omitempty"`
+
+	// Suppressions reports how each suppression rule and FindingException was
+	// applied in the last run, including rules that matched nothing or are
+	// about to expire.
+	// +optional
+	Suppressions []SuppressionStatus `json:"suppressions,omitempty"`
+
+	// Collection reports how many objects were listed from the API server for
+	// the last run and how long it took.
+	// +optional
+	Collection *CollectionStats `json:"collection,omitempty"`
+
+	// JobName is the name of the Job executing the current or last run,
+	// when the assessment runs in Job mode.
+	// +optional
+	JobName string `json:"jobName,omitempty"`
+
+	// LastRunName is the name of the AssessmentRun recording the current or
+	// last run.
+	// +optional
+	LastRunName string `json:"lastRunName,omitempty"`
+
+	// Validators reports how each validator ran, in name order. Partial runs
+	// keep the entries of the validators they did not run.
+	// +optional
+	Validators []ValidatorRunStatus `json:"validators,omitempty"`
+}
+
+// CollectionStats summarizes the cluster state listed during an assessment run.
+type CollectionStats struct {
+	// ObjectsScanned is the total number of objects listed.
+	ObjectsScanned int `json:"objectsScanned"`
+
+	// Duration is the total time spent listing objects.
+	Duration metav1.Duration `json:"duration"`
+
+	// Resources breaks the collection down by resource type.
+	// +optional
+	Resources []ResourceCollectionStats `json:"resources,omitempty"`
+}
+
+// ResourceCollectionStats describes how a single resource type was listed.
+type ResourceCollectionStats struct {
+	// Resource is the plural resource name, e.g. "pods".
+	Resource string `json:"resource"`
+
+	// Objects is the number of objects listed.
+	Objects int `json:"objects"`
+
+	// Pages is the number of List requests used.
+	Pages int `json:"pages"`
+
+	// Duration is the time spent listing the resource.
+	Duration metav1.Duration `json:"duration"`
 }
 
 // ClusterInfo contains metadata about the OpenShift cluster
@@ -229,6 +565,19 @@ type AssessmentSummary struct {
 	// InfoCount is the number of informational findings.
 	InfoCount int `json:"infoCount"`
 
+	// NotApplicableCount is the number of checks that do not apply to the cluster.
+	// +optional
+	NotApplicableCount int `json:"notApplicableCount,omitempty"`
+
+	// ErrorCount is the number of checks that were inconclusive.
+	// +optional
+	ErrorCount int `json:"errorCount,omitempty"`
+
+	// SkippedCount is the number of checks that were skipped, e.g. because
+	// the operator lacks the permissions their validator needs.
+	// +optional
+	SkippedCount int `json:"skippedCount,omitempty"`
+
 	// Score is an optional overall health/maturity score (0-100).
 	// +optional
 	Score *int `json:"score,omitempty"`
@@ -236,6 +585,40 @@ type AssessmentSummary struct {
 	// ProfileUsed is the baseline profile that was used.
 	// +optional
 	ProfileUsed string `json:"profileUsed,omitempty"`
+
+	// ScoringModel is the name of the model that computed Score.
+	// Scores are only comparable between runs that used the same model and version.
+	// +optional
+	ScoringModel string `json:"scoringModel,omitempty"`
+
+	// ScoringModelVersion is the version of the scoring model.
+	// +optional
+	ScoringModelVersion string `json:"scoringModelVersion,omitempty"`
+
+	// CategoryScores are the per-category sub-scores, sorted by category.
+	// +optional
+	CategoryScores []CategoryScore `json:"categoryScores,omitempty"`
+
+	// UnscoredValidators are the validators that could not run. Their
+	// findings are still reported but excluded from the score.
+	// +optional
+	UnscoredValidators []string `json:"unscoredValidators,omitempty"`
+}
+
+// CategoryScore is the score of the findings in a single category.
+type CategoryScore struct {
+	// Category is the finding category.
+	Category string `json:"category"`
+
+	// Score is the category score (0-100).
+	Score int `json:"score"`
+
+	// Weight is the weight of this category in the overall score.
+	// +optional
+	Weight int `json:"weight,omitempty"`
+
+	// ScoredChecks is the number of findings that contributed to the score.
+	ScoredChecks int `json:"scoredChecks"`
 }
 
 // Finding represents a single assessment finding
@@ -257,10 +640,21 @@ type Finding struct {
 	// +optional
 	Namespace string `json:"namespace,omitempty"`
 
-	// Status indicates the finding severity: PASS, WARN, FAIL, or INFO.
-	// +kubebuilder:validation:Enum=PASS;WARN;FAIL;INFO
+	// AffectedResources lists every resource this finding applies to, for
+	// findings that aggregate several resources.
+	// +optional
+	AffectedResources []AffectedResource `json:"affectedResources,omitempty"`
+
+	// Status indicates the finding severity: PASS, WARN, FAIL, INFO,
+	// SKIPPED, NOT_APPLICABLE, or ERROR.
+	// +kubebuilder:validation:Enum=PASS;WARN;FAIL;INFO;SKIPPED;NOT_APPLICABLE;ERROR
 	Status FindingStatus `json:"status"`
 
+	// Severity is the risk of the issue this check detects, independent of
+	// whether the check passed: low, medium, high, or critical.
+	// +optional
+	Severity FindingSeverity `json:"severity,omitempty"`
+
 	// Title is a short, human-readable title for the finding.
 	Title string `json:"title"`
 
@@ -295,6 +689,32 @@ type Finding struct {
 	SuppressionReason string `json:"suppressionReason,omitempty"`
 }
 
+// AffectedResource identifies a single Kubernetes resource a finding applies to.
+type AffectedResource struct {
+	// Kind is the resource kind, e.g. "Pod".
+	Kind string `json:"kind"`
+
+	// Namespace is the namespace of the resource; empty for cluster-scoped resources.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// Name is the name of the resource.
+	Name string `json:"name"`
+
+	// UID is the unique ID of the resource, if known.
+	// +optional
+	UID string `json:"uid,omitempty"`
+}
+
+// Key returns the identity of the resource used to diff findings across
+// runs. The UID is left out so recreated resources are tracked by name.
+func (r AffectedResource) Key() string {
+	if r.Namespace == "" {
+		return r.Kind + "/" + r.Name
+	}
+	return r.Kind + "/" + r.Namespace + "/" + r.Name
+}
+
 // RemediationSafety indicates the safety level of applying the remediation.
 // +kubebuilder:validation:Enum="safe-apply";"requires-review";"destructive"
 type RemediationSafety string
@@ -346,10 +766,37 @@ type RemediationCommand struct {
 	RequiresConfirmation bool `json:"requiresConfirmation,omitempty"`
 }
 
-// SuppressionRule defines a rule for suppressing specific findings.
+// SuppressionRule defines a rule for suppressing findings.
+// A finding is suppressed when it matches every selector that is set. At least
+// one of FindingID, Validator or Category must be set.
+// +kubebuilder:validation:XValidation:rule="has(self.findingID) || has(self.validator) || has(self.category)",message="one of findingID, validator or category is required"
 type SuppressionRule struct {
 	// FindingID is the ID of the finding to suppress.
-	FindingID string `json:"findingID"`
+	// A trailing "*" matches a family of IDs.
+	// +optional
+	FindingID string `json:"findingID,omitempty"`
+
+	// Validator limits the rule to findings reported by this validator.
+	// +optional
+	Validator string `json:"validator,omitempty"`
+
+	// Category limits the rule to findings in this category.
+	// +optional
+	Category string `json:"category,omitempty"`
+
+	// Namespaces limits the rule to resources in these namespaces.
+	// +optional
+	Namespaces []string `json:"namespaces,omitempty"`
+
+	// NamespaceSelector limits the rule to resources in namespaces whose labels
+	// match the selector.
+	// +optional
+	NamespaceSelector *metav1.LabelSelector `json:"namespaceSelector,omitempty"`
+
+	// ResourceNamePattern limits the rule to resources whose name matches this
+	// glob pattern, e.g. "debug-*".
+	// +optional
+	ResourceNamePattern string `json:"resourceNamePattern,omitempty"`
 
 	// Reason explains why this finding is being suppressed.
 	Reason string `json:"reason"`
@@ -358,10 +805,68 @@ type SuppressionRule struct {
 	// After this time, the finding will no longer be suppressed.
 	// +optional
 	ExpiresAt *metav1.Time `json:"expiresAt,omitempty"`
+
+	// ApprovedBy records who approved the suppression.
+	// +optional
+	ApprovedBy string `json:"approvedBy,omitempty"`
+
+	// Ticket references the change or risk-acceptance ticket for the suppression.
+	// +optional
+	Ticket string `json:"ticket,omitempty"`
+
+	// CreatedAt records when the suppression was agreed.
+	// +optional
+	CreatedAt *metav1.Time `json:"createdAt,omitempty"`
+}
+
+// HasResourceScope reports whether the rule restricts the resources it applies to.
+func (r SuppressionRule) HasResourceScope() bool {
+	return len(r.Namespaces) > 0 || r.NamespaceSelector != nil || r.ResourceNamePattern != ""
+}
+
+// SuppressionState describes the outcome of a suppression rule in the last run.
+// +kubebuilder:validation:Enum=Active;Unused;Expiring;Expired;PendingApproval;Invalid
+type SuppressionState string
+
+const (
+	// SuppressionActive indicates the rule suppressed at least one finding.
+	SuppressionActive SuppressionState = "Active"
+	// SuppressionUnused indicates the rule matched no finding.
+	SuppressionUnused SuppressionState = "Unused"
+	// SuppressionExpiring indicates the rule matched findings but expires soon.
+	SuppressionExpiring SuppressionState = "Expiring"
+	// SuppressionExpired indicates the rule has expired and no longer applies.
+	SuppressionExpired SuppressionState = "Expired"
+	// SuppressionPendingApproval indicates a FindingException that has not been approved.
+	SuppressionPendingApproval SuppressionState = "PendingApproval"
+	// SuppressionInvalid indicates the rule cannot be evaluated.
+	SuppressionInvalid SuppressionState = "Invalid"
+)
+
+// SuppressionStatus reports how a suppression rule was applied in the last run.
+type SuppressionStatus struct {
+	// Source identifies the rule, either "spec.suppressions[<index>]" or
+	// "FindingException/<name>".
+	Source string `json:"source"`
+
+	// State is the outcome of the rule in the last run.
+	State SuppressionState `json:"state"`
+
+	// MatchedFindings is the number of findings the rule suppressed.
+	// +optional
+	MatchedFindings int `json:"matchedFindings,omitempty"`
+
+	// ExpiresAt is the expiration time of the rule, if any.
+	// +optional
+	ExpiresAt *metav1.Time `json:"expiresAt,omitempty"`
+
+	// Message provides details, e.g. why the rule is invalid.
+	// +optional
+	Message string `json:"message,omitempty"`
 }
 
 // FindingStatus represents the status of a finding
-// +kubebuilder:validation:Enum=PASS;WARN;FAIL;INFO
+// +kubebuilder:validation:Enum=PASS;WARN;FAIL;INFO;SKIPPED;NOT_APPLICABLE;ERROR
 type FindingStatus string
 
 const (
@@ -373,8 +878,48 @@ const (
 	FindingStatusFail FindingStatus = "FAIL"
 	// FindingStatusInfo indicates informational finding with no action needed.
 	FindingStatusInfo FindingStatus = "INFO"
+	// FindingStatusSkipped indicates a check that could not run, e.g. for
+	// lack of permissions. Skipped findings are excluded from scoring.
+	FindingStatusSkipped FindingStatus = "SKIPPED"
+	// FindingStatusNotApplicable indicates a check that does not apply to the
+	// cluster, e.g. because the feature it covers is not installed.
+	FindingStatusNotApplicable FindingStatus = "NOT_APPLICABLE"
+	// FindingStatusError indicates a check that was inconclusive because the
+	// cluster state could not be read, e.g. after a transient API error.
+	FindingStatusError FindingStatus = "ERROR"
+)
+
+// FindingSeverity represents the risk level of a finding
+// +kubebuilder:validation:Enum=low;medium;high;critical
+type FindingSeverity string
+
+const (
+	// SeverityLow indicates a minor issue or best-practice deviation.
+	SeverityLow FindingSeverity = "low"
+	// SeverityMedium indicates an issue that should be addressed in planned work.
+	SeverityMedium FindingSeverity = "medium"
+	// SeverityHigh indicates an issue with significant reliability or security risk.
+	SeverityHigh FindingSeverity = "high"
+	// SeverityCritical indicates an issue threatening cluster availability or data.
+	SeverityCritical FindingSeverity = "critical"
 )
 
+// SeverityLevel returns the rank of a severity (low=1 ... critical=4), or 0 if unknown.
+func SeverityLevel(s FindingSeverity) int {
+	switch s {
+	case SeverityLow:
+		return 1
+	case SeverityMedium:
+		return 2
+	case SeverityHigh:
+		return 3
+	case SeverityCritical:
+		return 4
+	default:
+		return 0
+	}
+}
+
 // Assessment phase constants
 const (
 	PhasePending   = "Pending"
@@ -383,6 +928,38 @@ const (
 	PhaseFailed    = "Failed"
 )
 
+// Assessment condition types
+const (
+	// ConditionReady is True once the last run completed and False if it failed.
+	ConditionReady = "Ready"
+
+	// ConditionReportStored reports whether the last report was stored in a ConfigMap.
+	ConditionReportStored = "ReportStored"
+
+	// ConditionGitExported reports whether the last report was pushed to Git.
+	ConditionGitExported = "GitExported"
+
+	// ConditionHistoryRecorded reports whether the last run was recorded as a snapshot.
+	ConditionHistoryRecorded = "HistoryRecorded"
+
+	// ConditionValidatorsDegraded is True if validators could not run in the last run.
+	ConditionValidatorsDegraded = "ValidatorsDegraded"
+)
+
+// Assessment condition reasons
+const (
+	ReasonAssessmentCompleted = "AssessmentCompleted"
+	ReasonAssessmentFailed    = "AssessmentFailed"
+	ReasonStored              = "Stored"
+	ReasonStoreFailed         = "StoreFailed"
+	ReasonExported            = "Exported"
+	ReasonExportFailed        = "ExportFailed"
+	ReasonRecorded            = "Recorded"
+	ReasonRecordFailed        = "RecordFailed"
+	ReasonValidatorsFailed    = "ValidatorsFailed"
+	ReasonValidatorsSucceeded = "ValidatorsSucceeded"
+)
+
 // +kubebuilder:object:root=true
 // +kubebuilder:subresource:status
 // +kubebuilder:resource:scope=Cluster,shortName=ca
@@ -0,0 +1,131 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// CustomCheckSpec defines an organization-specific rule evaluated by the
+// customchecks validator against every object it targets.
+type CustomCheckSpec struct {
+	// Target selects the objects the expression is evaluated against.
+	Target CustomCheckTarget `json:"target"`
+
+	// Expression is a CEL expression evaluated once per target object, which is
+	// available as the variable "object". It must return true for objects that
+	// comply, e.g. "has(object.metadata.labels) && 'cost-center' in object.metadata.labels".
+	// +kubebuilder:validation:MinLength=1
+	Expression string `json:"expression"`
+
+	// Finding describes the finding reported by the check.
+	Finding CustomCheckFinding `json:"finding"`
+}
+
+// CustomCheckTarget selects the objects a CustomCheck evaluates.
+type CustomCheckTarget struct {
+	// APIVersion is the group and version of the objects, e.g. "route.openshift.io/v1",
+	// or "v1" for the core group.
+	// +kubebuilder:validation:MinLength=1
+	APIVersion string `json:"apiVersion"`
+
+	// Kind is the kind of the objects, e.g. "Route".
+	// +kubebuilder:validation:MinLength=1
+	Kind string `json:"kind"`
+
+	// Namespace limits the check to objects in this namespace.
+	// Leave empty for all namespaces, or for cluster-scoped kinds.
+	// +optional
+	Namespace string `json:"namespace,omitempty"`
+
+	// LabelSelector limits the check to objects whose labels match.
+	// +optional
+	LabelSelector *metav1.LabelSelector `json:"labelSelector,omitempty"`
+}
+
+// CustomCheckFinding describes the finding a CustomCheck reports. The check
+// reports PASS when every target object satisfies the expression, and Status
+// otherwise, listing the objects that do not.
+type CustomCheckFinding struct {
+	// ID is the finding ID. It must start with "custom-" and be unique across
+	// CustomChecks. Profiles and suppression rules refer to the check by it.
+	// +kubebuilder:validation:Pattern=`^custom-[a-z0-9]([-a-z0-9]*[a-z0-9])?$`
+	// +kubebuilder:validation:MaxLength=128
+	ID string `json:"id"`
+
+	// Status is reported when any target object does not satisfy the expression.
+	// +kubebuilder:validation:Enum=WARN;FAIL;INFO
+	// +kubebuilder:default=WARN
+	// +optional
+	Status FindingStatus `json:"status,omitempty"`
+
+	// Severity is the risk of the issue the check detects.
+	// Defaults to the severity of Status.
+	// +kubebuilder:validation:Enum=low;medium;high;critical
+	// +optional
+	Severity FindingSeverity `json:"severity,omitempty"`
+
+	// Category groups the finding with related findings. Defaults to "Custom".
+	// +optional
+	Category string `json:"category,omitempty"`
+
+	// Title is the human-readable title of the finding.
+	// +kubebuilder:validation:MinLength=1
+	Title string `json:"title"`
+
+	// Description explains what the check verifies.
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// Recommendation is the suggested remediation for objects that fail the check.
+	// +optional
+	Recommendation string `json:"recommendation,omitempty"`
+
+	// References provides links to relevant documentation.
+	// +optional
+	References []string `json:"references,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:resource:scope=Cluster,shortName=cc
+// +kubebuilder:printcolumn:name="Finding",type=string,JSONPath=`.spec.finding.id`
+// +kubebuilder:printcolumn:name="Kind",type=string,JSONPath=`.spec.target.kind`
+// +kubebuilder:printcolumn:name="Status",type=string,JSONPath=`.spec.finding.status`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// CustomCheck is a declarative check written as a CEL expression. Every
+// CustomCheck is evaluated by the customchecks validator, so profiles enable
+// and disable custom checks like the checks of built-in validators.
+type CustomCheck struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec CustomCheckSpec `json:"spec,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// CustomCheckList contains a list of CustomCheck
+type CustomCheckList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []CustomCheck `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&CustomCheck{}, &CustomCheckList{})
+}
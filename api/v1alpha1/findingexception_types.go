@@ -0,0 +1,134 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// FindingExceptionSpec defines a suppression requested outside of a ClusterAssessment.
+// It accepts the same selectors and metadata as a SuppressionRule, except
+// ApprovedBy, and is only applied once it is approved, see FindingExceptionStatus.Approval.
+// +kubebuilder:validation:XValidation:rule="!has(self.approvedBy)",message="approvedBy cannot be set in the spec; approve the exception through status.approval"
+type FindingExceptionSpec struct {
+	// Assessments limits the exception to the named ClusterAssessments.
+	// Leave empty to apply to all assessments.
+	// +optional
+	Assessments []string `json:"assessments,omitempty"`
+
+	SuppressionRule `json:",inline"`
+}
+
+// AppliesTo reports whether the exception targets the named ClusterAssessment.
+func (s FindingExceptionSpec) AppliesTo(assessmentName string) bool {
+	if len(s.Assessments) == 0 {
+		return true
+	}
+	for _, name := range s.Assessments {
+		if name == assessmentName {
+			return true
+		}
+	}
+	return false
+}
+
+// FindingExceptionStatus defines the observed state of a FindingException.
+type FindingExceptionStatus struct {
+	// Approval records who approved the exception. It is written through the
+	// status subresource, and the admission webhook only accepts it from users
+	// allowed to "approve" findingexceptions, under their own user name.
+	// +optional
+	Approval *ExceptionApproval `json:"approval,omitempty"`
+
+	// Assessments reports how the exception was applied by each assessment
+	// that evaluated it.
+	// +optional
+	Assessments []ExceptionAssessmentStatus `json:"assessments,omitempty"`
+}
+
+// ExceptionApproval records the approval of a FindingException.
+type ExceptionApproval struct {
+	// ApprovedBy is the user name of the approver.
+	ApprovedBy string `json:"approvedBy"`
+
+	// Generation is the metadata.generation of the exception that was
+	// approved. Changing the spec afterwards requires a new approval.
+	Generation int64 `json:"generation"`
+
+	// ApprovedAt is when the exception was approved.
+	// +optional
+	ApprovedAt *metav1.Time `json:"approvedAt,omitempty"`
+}
+
+// ExceptionAssessmentStatus reports how a FindingException was applied by one assessment.
+type ExceptionAssessmentStatus struct {
+	// Name is the name of the ClusterAssessment.
+	Name string `json:"name"`
+
+	// State is the outcome of the exception in the assessment's last run.
+	State SuppressionState `json:"state"`
+
+	// MatchedFindings is the number of findings the exception suppressed.
+	// +optional
+	MatchedFindings int `json:"matchedFindings,omitempty"`
+
+	// LastEvaluatedTime is when the assessment last evaluated the exception.
+	// +optional
+	LastEvaluatedTime *metav1.Time `json:"lastEvaluatedTime,omitempty"`
+
+	// Message provides details, e.g. why the exception is invalid.
+	// +optional
+	Message string `json:"message,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,shortName=fe
+// +kubebuilder:printcolumn:name="Finding",type=string,JSONPath=`.spec.findingID`
+// +kubebuilder:printcolumn:name="Approved By",type=string,JSONPath=`.status.approval.approvedBy`
+// +kubebuilder:printcolumn:name="Expires",type=date,JSONPath=`.spec.expiresAt`
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// FindingException lets application teams request suppression of findings
+// without editing the ClusterAssessment. Exceptions take effect on the next
+// assessment run once they have been approved.
+type FindingException struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   FindingExceptionSpec   `json:"spec,omitempty"`
+	Status FindingExceptionStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// Approved reports whether the exception has an approval for its current spec.
+func (e *FindingException) Approved() bool {
+	a := e.Status.Approval
+	return a != nil && a.ApprovedBy != "" && a.Generation == e.Generation
+}
+
+// FindingExceptionList contains a list of FindingException
+type FindingExceptionList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []FindingException `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&FindingException{}, &FindingExceptionList{})
+}
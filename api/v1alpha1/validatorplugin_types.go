@@ -0,0 +1,150 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// ValidatorPluginSpec defines where an out-of-tree validator is served.
+// +kubebuilder:validation:XValidation:rule="has(self.caBundle) != (has(self.insecure) && self.insecure)",message="set either caBundle or insecure: true"
+type ValidatorPluginSpec struct {
+	// Service is the Service in front of the plugin's gRPC server.
+	Service PluginServiceReference `json:"service"`
+
+	// CABundle is a PEM encoded CA bundle used to verify the plugin's serving
+	// certificate. Required unless Insecure is set.
+	// +optional
+	CABundle []byte `json:"caBundle,omitempty"`
+
+	// Insecure calls the plugin over an unencrypted connection instead of
+	// TLS. It cannot be combined with CABundle, and is reported by the
+	// Insecure condition and a warning event.
+	// +optional
+	Insecure bool `json:"insecure,omitempty"`
+}
+
+// PluginServiceReference references the Service of a validator plugin.
+type PluginServiceReference struct {
+	// Namespace is the namespace of the Service.
+	// +kubebuilder:validation:MinLength=1
+	Namespace string `json:"namespace"`
+
+	// Name is the name of the Service.
+	// +kubebuilder:validation:MinLength=1
+	Name string `json:"name"`
+
+	// Port is the Service port serving the plugin's gRPC API.
+	// +kubebuilder:validation:Minimum=1
+	// +kubebuilder:validation:Maximum=65535
+	Port int32 `json:"port"`
+}
+
+// ValidatorPluginStatus defines the observed state of a ValidatorPlugin. It
+// records what the plugin described about itself when it was registered.
+type ValidatorPluginStatus struct {
+	// ObservedGeneration is the generation the status was computed for.
+	// +optional
+	ObservedGeneration int64 `json:"observedGeneration,omitempty"`
+
+	// Description is the plugin validator's description.
+	// +optional
+	Description string `json:"description,omitempty"`
+
+	// Category is the category of the plugin validator's findings.
+	// +optional
+	Category string `json:"category,omitempty"`
+
+	// Checks is the catalog of checks the plugin can report. Check IDs start
+	// with the ValidatorPlugin's name followed by "-".
+	// +optional
+	Checks []PluginCheck `json:"checks,omitempty"`
+
+	// Conditions represent the latest available observations of the plugin's state.
+	// +optional
+	Conditions []metav1.Condition `json:"conditions,omitempty"`
+}
+
+// PluginCheck is a check a validator plugin can report.
+type PluginCheck struct {
+	// ID is the finding ID. A trailing "*" marks a family of IDs.
+	ID string `json:"id"`
+
+	// Title is the human-readable title of the finding.
+	Title string `json:"title"`
+
+	// DefaultStatus is the most severe status this check reports.
+	DefaultStatus FindingStatus `json:"defaultStatus"`
+
+	// Severity is the risk of the issue this check detects.
+	// +optional
+	Severity FindingSeverity `json:"severity,omitempty"`
+
+	// References provides links to relevant documentation.
+	// +optional
+	References []string `json:"references,omitempty"`
+}
+
+// ValidatorPlugin condition types
+const (
+	// ConditionInsecure is True while the plugin is called over an unencrypted
+	// connection. It is removed when the plugin uses TLS.
+	ConditionInsecure = "Insecure"
+)
+
+// ValidatorPlugin condition reasons
+const (
+	ReasonPluginRegistered = "Registered"
+	ReasonDescribeFailed   = "DescribeFailed"
+	ReasonInvalidName      = "InvalidName"
+	ReasonNameConflict     = "NameConflict"
+	ReasonInvalidCatalog   = "InvalidCatalog"
+	ReasonInsecureOptIn    = "InsecureOptIn"
+)
+
+// +kubebuilder:object:root=true
+// +kubebuilder:subresource:status
+// +kubebuilder:resource:scope=Cluster,shortName=vp
+// +kubebuilder:printcolumn:name="Service",type=string,JSONPath=`.spec.service.name`
+// +kubebuilder:printcolumn:name="Category",type=string,JSONPath=`.status.category`
+// +kubebuilder:printcolumn:name="Ready",type=string,JSONPath=`.status.conditions[?(@.type=="Ready")].status`
+// +kubebuilder:printcolumn:name="Insecure",type=string,JSONPath=`.status.conditions[?(@.type=="Insecure")].status`,priority=1
+// +kubebuilder:printcolumn:name="Age",type=date,JSONPath=`.metadata.creationTimestamp`
+
+// ValidatorPlugin registers an out-of-tree validator served over gRPC. The
+// plugin runs as a validator named after the ValidatorPlugin, alongside the
+// built-in validators.
+type ValidatorPlugin struct {
+	metav1.TypeMeta   `json:",inline"`
+	metav1.ObjectMeta `json:"metadata,omitempty"`
+
+	Spec   ValidatorPluginSpec   `json:"spec,omitempty"`
+	Status ValidatorPluginStatus `json:"status,omitempty"`
+}
+
+// +kubebuilder:object:root=true
+
+// ValidatorPluginList contains a list of ValidatorPlugin
+type ValidatorPluginList struct {
+	metav1.TypeMeta `json:",inline"`
+	metav1.ListMeta `json:"metadata,omitempty"`
+	Items           []ValidatorPlugin `json:"items"`
+}
+
+func init() {
+	SchemeBuilder.Register(&ValidatorPlugin{}, &ValidatorPluginList{})
+}
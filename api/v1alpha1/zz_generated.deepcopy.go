@@ -9,6 +9,21 @@ import (
 	runtime "k8s.io/apimachinery/pkg/runtime"
 )
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AffectedResource) DeepCopyInto(out *AffectedResource) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AffectedResource.
+func (in *AffectedResource) DeepCopy() *AffectedResource {
+	if in == nil {
+		return nil
+	}
+	out := new(AffectedResource)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AssessmentProfile) DeepCopyInto(out *AssessmentProfile) {
 	*out = *in
@@ -91,6 +106,29 @@ func (in *AssessmentProfileSpec) DeepCopyInto(out *AssessmentProfileSpec) {
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
+	if in.Parameters != nil {
+		in, out := &in.Parameters, &out.Parameters
+		*out = make(map[string]ValidatorParameters, len(*in))
+		for key, val := range *in {
+			var outVal map[string]string
+			if val == nil {
+				(*out)[key] = nil
+			} else {
+				inVal := (*in)[key]
+				in, out := &inVal, &outVal
+				*out = make(ValidatorParameters, len(*in))
+				for key, val := range *in {
+					(*out)[key] = val
+				}
+			}
+			(*out)[key] = outVal
+		}
+	}
+	if in.Scoring != nil {
+		in, out := &in.Scoring, &out.Scoring
+		*out = new(ScoringSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AssessmentProfileSpec.
@@ -118,6 +156,125 @@ func (in *AssessmentProfileStatus) DeepCopy() *AssessmentProfileStatus {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AssessmentRun) DeepCopyInto(out *AssessmentRun) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	out.Spec = in.Spec
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AssessmentRun.
+func (in *AssessmentRun) DeepCopy() *AssessmentRun {
+	if in == nil {
+		return nil
+	}
+	out := new(AssessmentRun)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AssessmentRun) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AssessmentRunList) DeepCopyInto(out *AssessmentRunList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]AssessmentRun, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AssessmentRunList.
+func (in *AssessmentRunList) DeepCopy() *AssessmentRunList {
+	if in == nil {
+		return nil
+	}
+	out := new(AssessmentRunList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *AssessmentRunList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AssessmentRunSpec) DeepCopyInto(out *AssessmentRunSpec) {
+	*out = *in
+	if in.Validators != nil {
+		in, out := &in.Validators, &out.Validators
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AssessmentRunSpec.
+func (in *AssessmentRunSpec) DeepCopy() *AssessmentRunSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(AssessmentRunSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *AssessmentRunStatus) DeepCopyInto(out *AssessmentRunStatus) {
+	*out = *in
+	if in.StartTime != nil {
+		in, out := &in.StartTime, &out.StartTime
+		*out = (*in).DeepCopy()
+	}
+	if in.CompletionTime != nil {
+		in, out := &in.CompletionTime, &out.CompletionTime
+		*out = (*in).DeepCopy()
+	}
+	if in.ResolvedProfile != nil {
+		in, out := &in.ResolvedProfile, &out.ResolvedProfile
+		*out = new(runtime.RawExtension)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Validators != nil {
+		in, out := &in.Validators, &out.Validators
+		*out = make([]ValidatorRunStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Summary != nil {
+		in, out := &in.Summary, &out.Summary
+		*out = new(AssessmentSummary)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AssessmentRunStatus.
+func (in *AssessmentRunStatus) DeepCopy() *AssessmentRunStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(AssessmentRunStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *AssessmentSnapshot) DeepCopyInto(out *AssessmentSnapshot) {
 	*out = *in
@@ -201,7 +358,9 @@ func (in *AssessmentSnapshotStatus) DeepCopyInto(out *AssessmentSnapshotStatus)
 	if in.Findings != nil {
 		in, out := &in.Findings, &out.Findings
 		*out = make([]FindingSnapshot, len(*in))
-		copy(*out, *in)
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
 	}
 	if in.Delta != nil {
 		in, out := &in.Delta, &out.Delta
@@ -228,6 +387,16 @@ func (in *AssessmentSummary) DeepCopyInto(out *AssessmentSummary) {
 		*out = new(int)
 		**out = **in
 	}
+	if in.CategoryScores != nil {
+		in, out := &in.CategoryScores, &out.CategoryScores
+		*out = make([]CategoryScore, len(*in))
+		copy(*out, *in)
+	}
+	if in.UnscoredValidators != nil {
+		in, out := &in.UnscoredValidators, &out.UnscoredValidators
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new AssessmentSummary.
@@ -240,6 +409,49 @@ func (in *AssessmentSummary) DeepCopy() *AssessmentSummary {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BlackoutWindow) DeepCopyInto(out *BlackoutWindow) {
+	*out = *in
+	if in.Duration != nil {
+		in, out := &in.Duration, &out.Duration
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.Start != nil {
+		in, out := &in.Start, &out.Start
+		*out = (*in).DeepCopy()
+	}
+	if in.End != nil {
+		in, out := &in.End, &out.End
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BlackoutWindow.
+func (in *BlackoutWindow) DeepCopy() *BlackoutWindow {
+	if in == nil {
+		return nil
+	}
+	out := new(BlackoutWindow)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CategoryScore) DeepCopyInto(out *CategoryScore) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CategoryScore.
+func (in *CategoryScore) DeepCopy() *CategoryScore {
+	if in == nil {
+		return nil
+	}
+	out := new(CategoryScore)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterAssessment) DeepCopyInto(out *ClusterAssessment) {
 	*out = *in
@@ -302,6 +514,23 @@ func (in *ClusterAssessmentList) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ClusterAssessmentSpec) DeepCopyInto(out *ClusterAssessmentSpec) {
 	*out = *in
+	if in.StartingDeadlineSeconds != nil {
+		in, out := &in.StartingDeadlineSeconds, &out.StartingDeadlineSeconds
+		*out = new(int64)
+		**out = **in
+	}
+	if in.Jitter != nil {
+		in, out := &in.Jitter, &out.Jitter
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.BlackoutWindows != nil {
+		in, out := &in.BlackoutWindows, &out.BlackoutWindows
+		*out = make([]BlackoutWindow, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 	if in.Validators != nil {
 		in, out := &in.Validators, &out.Validators
 		*out = make([]string, len(*in))
@@ -313,6 +542,28 @@ func (in *ClusterAssessmentSpec) DeepCopyInto(out *ClusterAssessmentSpec) {
 		*out = new(int)
 		**out = **in
 	}
+	if in.Suppressions != nil {
+		in, out := &in.Suppressions, &out.Suppressions
+		*out = make([]SuppressionRule, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Execution != nil {
+		in, out := &in.Execution, &out.Execution
+		*out = new(ExecutionSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Triggers != nil {
+		in, out := &in.Triggers, &out.Triggers
+		*out = new(TriggerSpec)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.FindingStorage != nil {
+		in, out := &in.FindingStorage, &out.FindingStorage
+		*out = new(FindingStorageSpec)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAssessmentSpec.
@@ -336,6 +587,10 @@ func (in *ClusterAssessmentStatus) DeepCopyInto(out *ClusterAssessmentStatus) {
 		in, out := &in.NextRunTime, &out.NextRunTime
 		*out = (*in).DeepCopy()
 	}
+	if in.LastScheduleTime != nil {
+		in, out := &in.LastScheduleTime, &out.LastScheduleTime
+		*out = (*in).DeepCopy()
+	}
 	out.ClusterInfo = in.ClusterInfo
 	in.Summary.DeepCopyInto(&out.Summary)
 	if in.Findings != nil {
@@ -345,6 +600,11 @@ func (in *ClusterAssessmentStatus) DeepCopyInto(out *ClusterAssessmentStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.FindingsRef != nil {
+		in, out := &in.FindingsRef, &out.FindingsRef
+		*out = new(FindingsReference)
+		(*in).DeepCopyInto(*out)
+	}
 	if in.Conditions != nil {
 		in, out := &in.Conditions, &out.Conditions
 		*out = make([]v1.Condition, len(*in))
@@ -357,6 +617,25 @@ func (in *ClusterAssessmentStatus) DeepCopyInto(out *ClusterAssessmentStatus) {
 		*out = new(DeltaSummary)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.Suppressions != nil {
+		in, out := &in.Suppressions, &out.Suppressions
+		*out = make([]SuppressionStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Collection != nil {
+		in, out := &in.Collection, &out.Collection
+		*out = new(CollectionStats)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Validators != nil {
+		in, out := &in.Validators, &out.Validators
+		*out = make([]ValidatorRunStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ClusterAssessmentStatus.
@@ -384,9 +663,35 @@ func (in *ClusterInfo) DeepCopy() *ClusterInfo {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CollectionStats) DeepCopyInto(out *CollectionStats) {
+	*out = *in
+	out.Duration = in.Duration
+	if in.Resources != nil {
+		in, out := &in.Resources, &out.Resources
+		*out = make([]ResourceCollectionStats, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CollectionStats.
+func (in *CollectionStats) DeepCopy() *CollectionStats {
+	if in == nil {
+		return nil
+	}
+	out := new(CollectionStats)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ConfigMapStorageSpec) DeepCopyInto(out *ConfigMapStorageSpec) {
 	*out = *in
+	if in.Retain != nil {
+		in, out := &in.Retain, &out.Retain
+		*out = new(RetentionPolicy)
+		(*in).DeepCopyInto(*out)
+	}
 }
 
 // DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ConfigMapStorageSpec.
@@ -400,91 +705,452 @@ func (in *ConfigMapStorageSpec) DeepCopy() *ConfigMapStorageSpec {
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *DeltaSummary) DeepCopyInto(out *DeltaSummary) {
+func (in *CustomCheck) DeepCopyInto(out *CustomCheck) {
 	*out = *in
-	if in.NewFindings != nil {
-		in, out := &in.NewFindings, &out.NewFindings
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.ResolvedFindings != nil {
-		in, out := &in.ResolvedFindings, &out.ResolvedFindings
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.RegressionFindings != nil {
-		in, out := &in.RegressionFindings, &out.RegressionFindings
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.ImprovedFindings != nil {
-		in, out := &in.ImprovedFindings, &out.ImprovedFindings
-		*out = make([]string, len(*in))
-		copy(*out, *in)
-	}
-	if in.ScoreDelta != nil {
-		in, out := &in.ScoreDelta, &out.ScoreDelta
-		*out = new(int)
-		**out = **in
-	}
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeltaSummary.
-func (in *DeltaSummary) DeepCopy() *DeltaSummary {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomCheck.
+func (in *CustomCheck) DeepCopy() *CustomCheck {
 	if in == nil {
 		return nil
 	}
-	out := new(DeltaSummary)
+	out := new(CustomCheck)
 	in.DeepCopyInto(out)
 	return out
 }
 
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CustomCheck) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *Finding) DeepCopyInto(out *Finding) {
+func (in *CustomCheckFinding) DeepCopyInto(out *CustomCheckFinding) {
 	*out = *in
 	if in.References != nil {
 		in, out := &in.References, &out.References
 		*out = make([]string, len(*in))
 		copy(*out, *in)
 	}
-	if in.Remediation != nil {
-		in, out := &in.Remediation, &out.Remediation
-		*out = new(RemediationGuidance)
-		(*in).DeepCopyInto(*out)
-	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Finding.
-func (in *Finding) DeepCopy() *Finding {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomCheckFinding.
+func (in *CustomCheckFinding) DeepCopy() *CustomCheckFinding {
 	if in == nil {
 		return nil
 	}
-	out := new(Finding)
+	out := new(CustomCheckFinding)
 	in.DeepCopyInto(out)
 	return out
 }
 
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *FindingSnapshot) DeepCopyInto(out *FindingSnapshot) {
+func (in *CustomCheckList) DeepCopyInto(out *CustomCheckList) {
 	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]CustomCheck, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
 }
 
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FindingSnapshot.
-func (in *FindingSnapshot) DeepCopy() *FindingSnapshot {
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomCheckList.
+func (in *CustomCheckList) DeepCopy() *CustomCheckList {
 	if in == nil {
 		return nil
 	}
-	out := new(FindingSnapshot)
+	out := new(CustomCheckList)
 	in.DeepCopyInto(out)
 	return out
 }
 
-// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
-func (in *GitStorageSpec) DeepCopyInto(out *GitStorageSpec) {
-	*out = *in
-}
-
-// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitStorageSpec.
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *CustomCheckList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomCheckSpec) DeepCopyInto(out *CustomCheckSpec) {
+	*out = *in
+	in.Target.DeepCopyInto(&out.Target)
+	in.Finding.DeepCopyInto(&out.Finding)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomCheckSpec.
+func (in *CustomCheckSpec) DeepCopy() *CustomCheckSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomCheckSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CustomCheckTarget) DeepCopyInto(out *CustomCheckTarget) {
+	*out = *in
+	if in.LabelSelector != nil {
+		in, out := &in.LabelSelector, &out.LabelSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CustomCheckTarget.
+func (in *CustomCheckTarget) DeepCopy() *CustomCheckTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(CustomCheckTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *DeltaSummary) DeepCopyInto(out *DeltaSummary) {
+	*out = *in
+	if in.NewFindings != nil {
+		in, out := &in.NewFindings, &out.NewFindings
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ResolvedFindings != nil {
+		in, out := &in.ResolvedFindings, &out.ResolvedFindings
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.RegressionFindings != nil {
+		in, out := &in.RegressionFindings, &out.RegressionFindings
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ImprovedFindings != nil {
+		in, out := &in.ImprovedFindings, &out.ImprovedFindings
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NewAffectedResources != nil {
+		in, out := &in.NewAffectedResources, &out.NewAffectedResources
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ResolvedAffectedResources != nil {
+		in, out := &in.ResolvedAffectedResources, &out.ResolvedAffectedResources
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.ScoreDelta != nil {
+		in, out := &in.ScoreDelta, &out.ScoreDelta
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new DeltaSummary.
+func (in *DeltaSummary) DeepCopy() *DeltaSummary {
+	if in == nil {
+		return nil
+	}
+	out := new(DeltaSummary)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *EventTrigger) DeepCopyInto(out *EventTrigger) {
+	*out = *in
+	if in.Validators != nil {
+		in, out := &in.Validators, &out.Validators
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new EventTrigger.
+func (in *EventTrigger) DeepCopy() *EventTrigger {
+	if in == nil {
+		return nil
+	}
+	out := new(EventTrigger)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExceptionApproval) DeepCopyInto(out *ExceptionApproval) {
+	*out = *in
+	if in.ApprovedAt != nil {
+		in, out := &in.ApprovedAt, &out.ApprovedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExceptionApproval.
+func (in *ExceptionApproval) DeepCopy() *ExceptionApproval {
+	if in == nil {
+		return nil
+	}
+	out := new(ExceptionApproval)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExceptionAssessmentStatus) DeepCopyInto(out *ExceptionAssessmentStatus) {
+	*out = *in
+	if in.LastEvaluatedTime != nil {
+		in, out := &in.LastEvaluatedTime, &out.LastEvaluatedTime
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExceptionAssessmentStatus.
+func (in *ExceptionAssessmentStatus) DeepCopy() *ExceptionAssessmentStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ExceptionAssessmentStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ExecutionSpec) DeepCopyInto(out *ExecutionSpec) {
+	*out = *in
+	in.Resources.DeepCopyInto(&out.Resources)
+	if in.ActiveDeadlineSeconds != nil {
+		in, out := &in.ActiveDeadlineSeconds, &out.ActiveDeadlineSeconds
+		*out = new(int64)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ExecutionSpec.
+func (in *ExecutionSpec) DeepCopy() *ExecutionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ExecutionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Finding) DeepCopyInto(out *Finding) {
+	*out = *in
+	if in.AffectedResources != nil {
+		in, out := &in.AffectedResources, &out.AffectedResources
+		*out = make([]AffectedResource, len(*in))
+		copy(*out, *in)
+	}
+	if in.References != nil {
+		in, out := &in.References, &out.References
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.Remediation != nil {
+		in, out := &in.Remediation, &out.Remediation
+		*out = new(RemediationGuidance)
+		(*in).DeepCopyInto(*out)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Finding.
+func (in *Finding) DeepCopy() *Finding {
+	if in == nil {
+		return nil
+	}
+	out := new(Finding)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FindingException) DeepCopyInto(out *FindingException) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FindingException.
+func (in *FindingException) DeepCopy() *FindingException {
+	if in == nil {
+		return nil
+	}
+	out := new(FindingException)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FindingException) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FindingExceptionList) DeepCopyInto(out *FindingExceptionList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]FindingException, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FindingExceptionList.
+func (in *FindingExceptionList) DeepCopy() *FindingExceptionList {
+	if in == nil {
+		return nil
+	}
+	out := new(FindingExceptionList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *FindingExceptionList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FindingExceptionSpec) DeepCopyInto(out *FindingExceptionSpec) {
+	*out = *in
+	if in.Assessments != nil {
+		in, out := &in.Assessments, &out.Assessments
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	in.SuppressionRule.DeepCopyInto(&out.SuppressionRule)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FindingExceptionSpec.
+func (in *FindingExceptionSpec) DeepCopy() *FindingExceptionSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FindingExceptionSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FindingExceptionStatus) DeepCopyInto(out *FindingExceptionStatus) {
+	*out = *in
+	if in.Approval != nil {
+		in, out := &in.Approval, &out.Approval
+		*out = new(ExceptionApproval)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Assessments != nil {
+		in, out := &in.Assessments, &out.Assessments
+		*out = make([]ExceptionAssessmentStatus, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FindingExceptionStatus.
+func (in *FindingExceptionStatus) DeepCopy() *FindingExceptionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(FindingExceptionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FindingSnapshot) DeepCopyInto(out *FindingSnapshot) {
+	*out = *in
+	if in.AffectedResources != nil {
+		in, out := &in.AffectedResources, &out.AffectedResources
+		*out = make([]AffectedResource, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FindingSnapshot.
+func (in *FindingSnapshot) DeepCopy() *FindingSnapshot {
+	if in == nil {
+		return nil
+	}
+	out := new(FindingSnapshot)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FindingStorageSpec) DeepCopyInto(out *FindingStorageSpec) {
+	*out = *in
+	if in.StatusLimit != nil {
+		in, out := &in.StatusLimit, &out.StatusLimit
+		*out = new(int)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FindingStorageSpec.
+func (in *FindingStorageSpec) DeepCopy() *FindingStorageSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(FindingStorageSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FindingsReference) DeepCopyInto(out *FindingsReference) {
+	*out = *in
+	if in.ConfigMaps != nil {
+		in, out := &in.ConfigMaps, &out.ConfigMaps
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FindingsReference.
+func (in *FindingsReference) DeepCopy() *FindingsReference {
+	if in == nil {
+		return nil
+	}
+	out := new(FindingsReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *GitStorageSpec) DeepCopyInto(out *GitStorageSpec) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new GitStorageSpec.
 func (in *GitStorageSpec) DeepCopy() *GitStorageSpec {
 	if in == nil {
 		return nil
@@ -494,6 +1160,41 @@ func (in *GitStorageSpec) DeepCopy() *GitStorageSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PluginCheck) DeepCopyInto(out *PluginCheck) {
+	*out = *in
+	if in.References != nil {
+		in, out := &in.References, &out.References
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PluginCheck.
+func (in *PluginCheck) DeepCopy() *PluginCheck {
+	if in == nil {
+		return nil
+	}
+	out := new(PluginCheck)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *PluginServiceReference) DeepCopyInto(out *PluginServiceReference) {
+	*out = *in
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new PluginServiceReference.
+func (in *PluginServiceReference) DeepCopy() *PluginServiceReference {
+	if in == nil {
+		return nil
+	}
+	out := new(PluginServiceReference)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *RemediationCommand) DeepCopyInto(out *RemediationCommand) {
 	*out = *in
@@ -540,7 +1241,7 @@ func (in *ReportStorageSpec) DeepCopyInto(out *ReportStorageSpec) {
 	if in.ConfigMap != nil {
 		in, out := &in.ConfigMap, &out.ConfigMap
 		*out = new(ConfigMapStorageSpec)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	if in.Git != nil {
 		in, out := &in.Git, &out.Git
@@ -559,6 +1260,135 @@ func (in *ReportStorageSpec) DeepCopy() *ReportStorageSpec {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ResourceCollectionStats) DeepCopyInto(out *ResourceCollectionStats) {
+	*out = *in
+	out.Duration = in.Duration
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ResourceCollectionStats.
+func (in *ResourceCollectionStats) DeepCopy() *ResourceCollectionStats {
+	if in == nil {
+		return nil
+	}
+	out := new(ResourceCollectionStats)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *RetentionPolicy) DeepCopyInto(out *RetentionPolicy) {
+	*out = *in
+	if in.Count != nil {
+		in, out := &in.Count, &out.Count
+		*out = new(int)
+		**out = **in
+	}
+	if in.MaxAge != nil {
+		in, out := &in.MaxAge, &out.MaxAge
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new RetentionPolicy.
+func (in *RetentionPolicy) DeepCopy() *RetentionPolicy {
+	if in == nil {
+		return nil
+	}
+	out := new(RetentionPolicy)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ScoringSpec) DeepCopyInto(out *ScoringSpec) {
+	*out = *in
+	if in.CategoryWeights != nil {
+		in, out := &in.CategoryWeights, &out.CategoryWeights
+		*out = make(map[string]int, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.CheckCriticality != nil {
+		in, out := &in.CheckCriticality, &out.CheckCriticality
+		*out = make(map[string]int, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.SeverityWeights != nil {
+		in, out := &in.SeverityWeights, &out.SeverityWeights
+		*out = make(map[string]int, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ScoringSpec.
+func (in *ScoringSpec) DeepCopy() *ScoringSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ScoringSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SuppressionRule) DeepCopyInto(out *SuppressionRule) {
+	*out = *in
+	if in.Namespaces != nil {
+		in, out := &in.Namespaces, &out.Namespaces
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.NamespaceSelector != nil {
+		in, out := &in.NamespaceSelector, &out.NamespaceSelector
+		*out = new(v1.LabelSelector)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.ExpiresAt != nil {
+		in, out := &in.ExpiresAt, &out.ExpiresAt
+		*out = (*in).DeepCopy()
+	}
+	if in.CreatedAt != nil {
+		in, out := &in.CreatedAt, &out.CreatedAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SuppressionRule.
+func (in *SuppressionRule) DeepCopy() *SuppressionRule {
+	if in == nil {
+		return nil
+	}
+	out := new(SuppressionRule)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *SuppressionStatus) DeepCopyInto(out *SuppressionStatus) {
+	*out = *in
+	if in.ExpiresAt != nil {
+		in, out := &in.ExpiresAt, &out.ExpiresAt
+		*out = (*in).DeepCopy()
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new SuppressionStatus.
+func (in *SuppressionStatus) DeepCopy() *SuppressionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(SuppressionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ThresholdOverrides) DeepCopyInto(out *ThresholdOverrides) {
 	*out = *in
@@ -623,3 +1453,186 @@ func (in *ThresholdOverrides) DeepCopy() *ThresholdOverrides {
 	in.DeepCopyInto(out)
 	return out
 }
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in ValidatorParameters) DeepCopyInto(out *ValidatorParameters) {
+	{
+		in := &in
+		*out = make(ValidatorParameters, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ValidatorParameters.
+func (in ValidatorParameters) DeepCopy() ValidatorParameters {
+	if in == nil {
+		return nil
+	}
+	out := new(ValidatorParameters)
+	in.DeepCopyInto(out)
+	return *out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *TriggerSpec) DeepCopyInto(out *TriggerSpec) {
+	*out = *in
+	if in.Events != nil {
+		in, out := &in.Events, &out.Events
+		*out = make([]EventTrigger, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Debounce != nil {
+		in, out := &in.Debounce, &out.Debounce
+		*out = new(v1.Duration)
+		**out = **in
+	}
+	if in.MinInterval != nil {
+		in, out := &in.MinInterval, &out.MinInterval
+		*out = new(v1.Duration)
+		**out = **in
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new TriggerSpec.
+func (in *TriggerSpec) DeepCopy() *TriggerSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(TriggerSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ValidatorPlugin) DeepCopyInto(out *ValidatorPlugin) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ObjectMeta.DeepCopyInto(&out.ObjectMeta)
+	in.Spec.DeepCopyInto(&out.Spec)
+	in.Status.DeepCopyInto(&out.Status)
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ValidatorPlugin.
+func (in *ValidatorPlugin) DeepCopy() *ValidatorPlugin {
+	if in == nil {
+		return nil
+	}
+	out := new(ValidatorPlugin)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ValidatorPlugin) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ValidatorPluginList) DeepCopyInto(out *ValidatorPluginList) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	in.ListMeta.DeepCopyInto(&out.ListMeta)
+	if in.Items != nil {
+		in, out := &in.Items, &out.Items
+		*out = make([]ValidatorPlugin, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ValidatorPluginList.
+func (in *ValidatorPluginList) DeepCopy() *ValidatorPluginList {
+	if in == nil {
+		return nil
+	}
+	out := new(ValidatorPluginList)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *ValidatorPluginList) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ValidatorPluginSpec) DeepCopyInto(out *ValidatorPluginSpec) {
+	*out = *in
+	out.Service = in.Service
+	if in.CABundle != nil {
+		in, out := &in.CABundle, &out.CABundle
+		*out = make([]byte, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ValidatorPluginSpec.
+func (in *ValidatorPluginSpec) DeepCopy() *ValidatorPluginSpec {
+	if in == nil {
+		return nil
+	}
+	out := new(ValidatorPluginSpec)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ValidatorPluginStatus) DeepCopyInto(out *ValidatorPluginStatus) {
+	*out = *in
+	if in.Checks != nil {
+		in, out := &in.Checks, &out.Checks
+		*out = make([]PluginCheck, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.Conditions != nil {
+		in, out := &in.Conditions, &out.Conditions
+		*out = make([]v1.Condition, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ValidatorPluginStatus.
+func (in *ValidatorPluginStatus) DeepCopy() *ValidatorPluginStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ValidatorPluginStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *ValidatorRunStatus) DeepCopyInto(out *ValidatorRunStatus) {
+	*out = *in
+	out.Duration = in.Duration
+	if in.MissingPermissions != nil {
+		in, out := &in.MissingPermissions, &out.MissingPermissions
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new ValidatorRunStatus.
+func (in *ValidatorRunStatus) DeepCopy() *ValidatorRunStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(ValidatorRunStatus)
+	in.DeepCopyInto(out)
+	return out
+}
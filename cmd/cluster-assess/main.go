@@ -0,0 +1,410 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Command cluster-assess runs the assessment validators against a cluster
+// reachable through a kubeconfig, without installing the operator, and writes
+// the resulting reports to disk. With --must-gather it runs offline against an
+// extracted must-gather directory instead.
+//
+// The process exit code reflects the worst non-suppressed finding so the
+// binary can gate CI jobs:
+//
+//	0  all findings are PASS or INFO
+//	1  at least one WARN finding
+//	2  at least one FAIL finding
+//	3  the assessment could not be run
+package main
+
+import (
+	"context"
+	"encoding/json"
+	"flag"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/config"
+	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	"sigs.k8s.io/yaml"
+
+	configv1 "github.com/openshift/api/config/v1"
+
+	assessmentv1alpha1 "github.com/openshift-assessment/cluster-assessment-operator/api/v1alpha1"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/clusterinfo"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/machineconfig"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/mustgather"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/profiles"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/report"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/scoring"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/suppression"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/validator"
+
+	// Import validators to register them
+	_ "github.com/openshift-assessment/cluster-assessment-operator/pkg/validators/all"
+)
+
+// Exit codes returned by the CLI.
+const (
+	exitOK    = 0
+	exitWarn  = 1
+	exitFail  = 2
+	exitError = 3
+)
+
+var scheme = runtime.NewScheme()
+
+func init() {
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(assessmentv1alpha1.AddToScheme(scheme))
+	utilruntime.Must(configv1.AddToScheme(scheme))
+	utilruntime.Must(machineconfig.AddToScheme(scheme))
+}
+
+// options holds the parsed command-line flags.
+type options struct {
+	kubeContext      string
+	mustGather       string
+	name             string
+	profile          string
+	profileFile      string
+	validators       string
+	formats          string
+	outputDir        string
+	concurrency      int
+	validatorTimeout time.Duration
+	timeout          time.Duration
+	listChecks       bool
+	suppressionsFile string
+}
+
+func main() {
+	opts := options{}
+
+	// The --kubeconfig flag is registered by controller-runtime's config package.
+	flag.StringVar(&opts.kubeContext, "context", "", "The kubeconfig context to use. Defaults to the current context.")
+	flag.StringVar(&opts.mustGather, "must-gather", "",
+		"Path to an extracted must-gather directory. Runs offline against the archive instead of a live cluster.")
+	flag.StringVar(&opts.name, "name", "cluster-assess", "The assessment name recorded in the reports.")
+	flag.StringVar(&opts.profile, "profile", string(profiles.ProfileProduction),
+		"The built-in profile name or the name of an AssessmentProfile in the cluster.")
+	flag.StringVar(&opts.profileFile, "profile-file", "",
+		"Path to a local AssessmentProfile YAML file. Takes precedence over --profile.")
+	flag.StringVar(&opts.validators, "validators", "", "Comma-separated list of validators to run. Defaults to all.")
+	flag.StringVar(&opts.formats, "format", "json", "Comma-separated report formats to write: json, yaml, html, pdf.")
+	flag.StringVar(&opts.outputDir, "output-dir", ".", "The directory the reports are written to.")
+	flag.IntVar(&opts.concurrency, "validator-concurrency", validator.DefaultConcurrency,
+		"The number of validators run in parallel.")
+	flag.DurationVar(&opts.validatorTimeout, "validator-timeout", validator.DefaultValidatorTimeout,
+		"The maximum time a single validator may run before it is reported as timed out.")
+	flag.StringVar(&opts.suppressionsFile, "suppressions-file", "",
+		"Path to a YAML list of suppression rules, as in a ClusterAssessment's spec.suppressions.")
+	flag.BoolVar(&opts.listChecks, "list-checks", false, "Print the validator check catalog as JSON and exit.")
+	flag.DurationVar(&opts.timeout, "timeout", 30*time.Minute, "The maximum time for the whole assessment.")
+
+	zapOpts := zap.Options{}
+	zapOpts.BindFlags(flag.CommandLine)
+	flag.Parse()
+
+	ctrl.SetLogger(zap.New(zap.UseFlagOptions(&zapOpts)))
+
+	if opts.listChecks {
+		data, err := json.MarshalIndent(validator.DefaultRegistry().Catalog(), "", "  ")
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "error: %v\n", err)
+			os.Exit(exitError)
+		}
+		fmt.Println(string(data))
+		os.Exit(exitOK)
+	}
+
+	code, err := run(opts)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "error: %v\n", err)
+	}
+	os.Exit(code)
+}
+
+// run executes the assessment and returns the process exit code.
+func run(opts options) (int, error) {
+	formats, err := parseFormats(opts.formats)
+	if err != nil {
+		return exitError, err
+	}
+
+	var c client.Client
+	var archiveClient *mustgather.Client
+	if opts.mustGather != "" {
+		archive, err := mustgather.Load(opts.mustGather, scheme)
+		if err != nil {
+			return exitError, err
+		}
+		fmt.Fprintf(os.Stderr, "Loaded %d objects from must-gather archive (%d files skipped)\n",
+			archive.Objects(), archive.Skipped)
+		archiveClient = archive.Client()
+		c = archiveClient
+	} else {
+		cfg, err := config.GetConfigWithContext(opts.kubeContext)
+		if err != nil {
+			return exitError, fmt.Errorf("failed to load kubeconfig: %w", err)
+		}
+		c, err = client.New(cfg, client.Options{Scheme: scheme})
+		if err != nil {
+			return exitError, fmt.Errorf("failed to create client: %w", err)
+		}
+	}
+
+	ctx, cancel := context.WithTimeout(ctrl.SetupSignalHandler(), opts.timeout)
+	defer cancel()
+
+	profile, err := loadProfile(ctx, c, opts)
+	if err != nil {
+		return exitError, err
+	}
+
+	info, err := clusterinfo.Collect(ctx, c)
+	if err != nil {
+		fmt.Fprintf(os.Stderr, "warning: failed to collect cluster info: %v\n", err)
+	}
+
+	registry := validator.DefaultRegistry()
+	runnerOpts := []validator.RunnerOption{
+		validator.WithConcurrency(opts.concurrency),
+		validator.WithValidatorTimeout(opts.validatorTimeout),
+	}
+	if archiveClient == nil {
+		// Archives hold whatever was gathered, so permissions only apply to live clusters
+		runnerOpts = append(runnerOpts, validator.WithPermissionChecker(validator.NewAccessReviewChecker(c)))
+	}
+	runner := validator.NewRunner(registry, c, runnerOpts...)
+	findings, validators, err := runner.RunWithStatus(ctx, profile, splitList(opts.validators))
+	if err != nil {
+		return exitError, fmt.Errorf("assessment failed: %w", err)
+	}
+	if archiveClient != nil {
+		findings = mustgather.MarkUnavailable(findings, archiveClient.Missing(), registry)
+	}
+
+	rules, err := loadSuppressions(ctx, c, opts)
+	if err != nil {
+		return exitError, err
+	}
+	var suppressions []assessmentv1alpha1.SuppressionStatus
+	if len(rules) > 0 {
+		namespaceLabels, err := namespaceLabels(ctx, c, rules)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "warning: failed to list namespaces for suppression namespace selectors: %v\n", err)
+		}
+		suppressions = suppression.Apply(findings, rules, namespaceLabels, time.Now())
+	}
+
+	now := metav1.Now()
+	assessment := &assessmentv1alpha1.ClusterAssessment{}
+	assessment.Name = opts.name
+	assessment.Spec.Profile = string(profile.Name)
+	assessment.Status.Phase = assessmentv1alpha1.PhaseCompleted
+	assessment.Status.Message = fmt.Sprintf("Assessment completed with %d findings", len(findings))
+	assessment.Status.LastRunTime = &now
+	assessment.Status.ClusterInfo = info
+	assessment.Status.Findings = findings
+	assessment.Status.Validators = validators
+	assessment.Status.Suppressions = suppressions
+	assessment.Status.Summary = scoring.CalculateSummaryWithStatus(findings, profile, validators)
+
+	if err := writeReports(assessment, formats, opts.outputDir); err != nil {
+		return exitError, err
+	}
+
+	printSummary(assessment)
+	return exitCodeFor(findings), nil
+}
+
+// loadProfile resolves the profile from a local file or through the cluster.
+func loadProfile(ctx context.Context, c client.Client, opts options) (profiles.Profile, error) {
+	if opts.profileFile == "" {
+		profile, err := profiles.NewResolver(c).Resolve(ctx, opts.profile)
+		if err != nil {
+			return profiles.Profile{}, fmt.Errorf("failed to resolve profile: %w", err)
+		}
+		return profile, nil
+	}
+
+	data, err := os.ReadFile(opts.profileFile)
+	if err != nil {
+		return profiles.Profile{}, fmt.Errorf("failed to read profile file: %w", err)
+	}
+	custom := &assessmentv1alpha1.AssessmentProfile{}
+	if err := yaml.UnmarshalStrict(data, custom); err != nil {
+		return profiles.Profile{}, fmt.Errorf("failed to parse profile file %s: %w", opts.profileFile, err)
+	}
+	if custom.Kind != "" && custom.Kind != "AssessmentProfile" {
+		return profiles.Profile{}, fmt.Errorf("profile file %s has kind %q, expected AssessmentProfile", opts.profileFile, custom.Kind)
+	}
+	if custom.Name == "" {
+		custom.Name = strings.TrimSuffix(filepath.Base(opts.profileFile), filepath.Ext(opts.profileFile))
+	}
+	return profiles.FromAssessmentProfile(custom), nil
+}
+
+// loadSuppressions returns the rules of the suppressions file, followed by
+// those of the FindingExceptions in the cluster that apply to the assessment
+// name. Exceptions only take effect once approved, as in the operator.
+func loadSuppressions(ctx context.Context, c client.Client, opts options) ([]suppression.Rule, error) {
+	var rules []suppression.Rule
+	if opts.suppressionsFile != "" {
+		data, err := os.ReadFile(opts.suppressionsFile)
+		if err != nil {
+			return nil, fmt.Errorf("failed to read suppressions file: %w", err)
+		}
+		var specRules []assessmentv1alpha1.SuppressionRule
+		if err := yaml.UnmarshalStrict(data, &specRules); err != nil {
+			return nil, fmt.Errorf("failed to parse suppressions file %s: %w", opts.suppressionsFile, err)
+		}
+		for i, rule := range specRules {
+			if err := suppression.Validate(rule); err != nil {
+				return nil, fmt.Errorf("suppressions file %s: rule %d: %w", opts.suppressionsFile, i, err)
+			}
+		}
+		rules = suppression.FromSpec(specRules)
+	}
+
+	exceptions := &assessmentv1alpha1.FindingExceptionList{}
+	if err := c.List(ctx, exceptions); err != nil {
+		if !validator.IsNotInstalled(err) {
+			fmt.Fprintf(os.Stderr, "warning: failed to list FindingExceptions, applying file suppressions only: %v\n", err)
+		}
+		return rules, nil
+	}
+	return append(rules, suppression.FromExceptions(exceptions.Items, opts.name)...), nil
+}
+
+// namespaceLabels returns the labels of every namespace, keyed by name, when a
+// rule selects namespaces by label.
+func namespaceLabels(ctx context.Context, c client.Client, rules []suppression.Rule) (map[string]map[string]string, error) {
+	if !suppression.NeedsNamespaceLabels(rules) {
+		return nil, nil
+	}
+	nsList := &corev1.NamespaceList{}
+	if err := c.List(ctx, nsList); err != nil {
+		return nil, err
+	}
+	result := make(map[string]map[string]string, len(nsList.Items))
+	for _, ns := range nsList.Items {
+		result[ns.Name] = ns.Labels
+	}
+	return result, nil
+}
+
+// reportWriters maps each supported format to its file name and generator.
+var reportWriters = map[string]struct {
+	file     string
+	generate func(*assessmentv1alpha1.ClusterAssessment) ([]byte, error)
+}{
+	"json": {file: "report.json", generate: report.GenerateJSON},
+	"yaml": {file: "report.yaml", generate: report.GenerateYAML},
+	"html": {file: "report.html", generate: report.GenerateHTML},
+	"pdf":  {file: "report.pdf", generate: report.GeneratePDF},
+}
+
+// parseFormats validates a comma-separated list of report formats.
+func parseFormats(value string) ([]string, error) {
+	formats := splitList(strings.ToLower(value))
+	if len(formats) == 0 {
+		return nil, fmt.Errorf("at least one report format is required")
+	}
+	for _, f := range formats {
+		if _, ok := reportWriters[f]; !ok {
+			return nil, fmt.Errorf("unsupported report format %q: must be one of json, yaml, html, pdf", f)
+		}
+	}
+	return formats, nil
+}
+
+// writeReports generates each requested format into outputDir.
+func writeReports(assessment *assessmentv1alpha1.ClusterAssessment, formats []string, outputDir string) error {
+	if err := os.MkdirAll(outputDir, 0755); err != nil {
+		return fmt.Errorf("failed to create output directory: %w", err)
+	}
+	for _, f := range formats {
+		w := reportWriters[f]
+		data, err := w.generate(assessment)
+		if err != nil {
+			return fmt.Errorf("failed to generate %s report: %w", f, err)
+		}
+		path := filepath.Join(outputDir, w.file)
+		if err := os.WriteFile(path, data, 0644); err != nil {
+			return fmt.Errorf("failed to write %s: %w", path, err)
+		}
+		fmt.Fprintf(os.Stderr, "Wrote %s\n", path)
+	}
+	return nil
+}
+
+// printSummary prints a one-line result overview.
+func printSummary(assessment *assessmentv1alpha1.ClusterAssessment) {
+	s := assessment.Status.Summary
+	score := "n/a"
+	if s.Score != nil {
+		score = fmt.Sprintf("%d", *s.Score)
+	}
+	fmt.Printf("Profile: %s  Score: %s  Checks: %d  PASS: %d  WARN: %d  FAIL: %d  INFO: %d  N/A: %d  ERROR: %d  SKIPPED: %d\n",
+		s.ProfileUsed, score, s.TotalChecks, s.PassCount, s.WarnCount, s.FailCount, s.InfoCount, s.NotApplicableCount, s.ErrorCount, s.SkippedCount)
+	if len(s.UnscoredValidators) > 0 {
+		fmt.Printf("Not scored (validators could not run): %s\n", strings.Join(s.UnscoredValidators, ", "))
+	}
+	for _, v := range assessment.Status.Validators {
+		if len(v.MissingPermissions) > 0 {
+			fmt.Printf("Skipped %s, missing permissions: %s\n", v.Name, strings.Join(v.MissingPermissions, ", "))
+		}
+	}
+}
+
+// exitCodeFor returns the exit code for the worst non-suppressed finding.
+// Inconclusive ERROR findings exit like warnings, since the cluster may not be healthy.
+func exitCodeFor(findings []assessmentv1alpha1.Finding) int {
+	code := exitOK
+	for _, f := range findings {
+		if f.Suppressed {
+			continue
+		}
+		switch f.Status {
+		case assessmentv1alpha1.FindingStatusFail:
+			return exitFail
+		case assessmentv1alpha1.FindingStatusWarn, assessmentv1alpha1.FindingStatusError:
+			code = exitWarn
+		}
+	}
+	return code
+}
+
+// splitList splits a comma-separated flag value, dropping empty entries.
+func splitList(value string) []string {
+	var out []string
+	for _, item := range strings.Split(value, ",") {
+		if item = strings.TrimSpace(item); item != "" {
+			out = append(out, item)
+		}
+	}
+	return out
+}
@@ -0,0 +1,211 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package main
+
+import (
+	"context"
+	"os"
+	"path/filepath"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	assessmentv1alpha1 "github.com/openshift-assessment/cluster-assessment-operator/api/v1alpha1"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/suppression"
+)
+
+func TestExitCodeFor(t *testing.T) {
+	tests := []struct {
+		name     string
+		findings []assessmentv1alpha1.Finding
+		want     int
+	}{
+		{name: "no findings", want: exitOK},
+		{
+			name: "pass and info only",
+			findings: []assessmentv1alpha1.Finding{
+				{Status: assessmentv1alpha1.FindingStatusPass},
+				{Status: assessmentv1alpha1.FindingStatusInfo},
+			},
+			want: exitOK,
+		},
+		{
+			name: "warn is worst",
+			findings: []assessmentv1alpha1.Finding{
+				{Status: assessmentv1alpha1.FindingStatusPass},
+				{Status: assessmentv1alpha1.FindingStatusWarn},
+			},
+			want: exitWarn,
+		},
+		{
+			name: "inconclusive check exits like a warning",
+			findings: []assessmentv1alpha1.Finding{
+				{Status: assessmentv1alpha1.FindingStatusNotApplicable},
+				{Status: assessmentv1alpha1.FindingStatusError},
+			},
+			want: exitWarn,
+		},
+		{
+			name: "fail is worst",
+			findings: []assessmentv1alpha1.Finding{
+				{Status: assessmentv1alpha1.FindingStatusWarn},
+				{Status: assessmentv1alpha1.FindingStatusFail},
+			},
+			want: exitFail,
+		},
+		{
+			name: "suppressed fail is ignored",
+			findings: []assessmentv1alpha1.Finding{
+				{Status: assessmentv1alpha1.FindingStatusWarn},
+				{Status: assessmentv1alpha1.FindingStatusFail, Suppressed: true},
+			},
+			want: exitWarn,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := exitCodeFor(tt.findings); got != tt.want {
+				t.Errorf("exitCodeFor() = %d, want %d", got, tt.want)
+			}
+		})
+	}
+}
+
+func TestParseFormats(t *testing.T) {
+	formats, err := parseFormats("JSON, pdf,,yaml")
+	if err != nil {
+		t.Fatalf("parseFormats returned error: %v", err)
+	}
+	if len(formats) != 3 || formats[0] != "json" || formats[1] != "pdf" || formats[2] != "yaml" {
+		t.Errorf("Unexpected formats: %v", formats)
+	}
+
+	if _, err := parseFormats("json,xml"); err == nil {
+		t.Error("Expected error for unsupported format")
+	}
+	if _, err := parseFormats(""); err == nil {
+		t.Error("Expected error for empty format list")
+	}
+}
+
+func TestLoadProfile_FromFile(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "strict.yaml")
+	data := []byte(`apiVersion: assessment.openshift.io/v1alpha1
+kind: AssessmentProfile
+metadata:
+  name: strict
+spec:
+  basedOn: development
+  thresholds:
+    minWorkerNodes: 5
+  disabledChecks:
+    - nodes-count
+`)
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+
+	// The client is not used when a profile file is given
+	profile, err := loadProfile(context.Background(), nil, options{profileFile: path})
+	if err != nil {
+		t.Fatalf("loadProfile returned error: %v", err)
+	}
+	if profile.Name != "strict" {
+		t.Errorf("Expected profile name 'strict', got %q", profile.Name)
+	}
+	if profile.Thresholds.MinWorkerNodes != 5 {
+		t.Errorf("Expected MinWorkerNodes=5, got %d", profile.Thresholds.MinWorkerNodes)
+	}
+	if profile.Thresholds.MinControlPlaneNodes != 1 {
+		t.Errorf("Expected MinControlPlaneNodes=1 (development default), got %d", profile.Thresholds.MinControlPlaneNodes)
+	}
+	if len(profile.DisabledChecks) != 1 || profile.DisabledChecks[0] != "nodes-count" {
+		t.Errorf("Expected DisabledChecks [nodes-count], got %v", profile.DisabledChecks)
+	}
+}
+
+func TestLoadProfile_RejectsWrongKind(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "wrong.yaml")
+	if err := os.WriteFile(path, []byte("kind: ConfigMap\nmetadata:\n  name: x\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	if _, err := loadProfile(context.Background(), nil, options{profileFile: path}); err == nil {
+		t.Error("Expected error for non-AssessmentProfile kind")
+	}
+}
+
+func TestLoadSuppressions(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "suppressions.yaml")
+	data := []byte(`- findingID: nodes-count
+  reason: single-node lab cluster
+`)
+	if err := os.WriteFile(path, data, 0644); err != nil {
+		t.Fatal(err)
+	}
+	exception := func(name, findingID string, approved bool) *assessmentv1alpha1.FindingException {
+		e := &assessmentv1alpha1.FindingException{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Generation: 1},
+			Spec: assessmentv1alpha1.FindingExceptionSpec{
+				Assessments:     []string{"cluster-assess"},
+				SuppressionRule: assessmentv1alpha1.SuppressionRule{FindingID: findingID, Reason: "accepted"},
+			},
+		}
+		if approved {
+			e.Status.Approval = &assessmentv1alpha1.ExceptionApproval{ApprovedBy: "alice", Generation: 1}
+		}
+		return e
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(
+		exception("approved", "security-privileged-pods", true),
+		exception("pending", "version-channel", false),
+	).Build()
+
+	rules, err := loadSuppressions(context.Background(), c, options{name: "cluster-assess", suppressionsFile: path})
+	if err != nil {
+		t.Fatalf("loadSuppressions returned error: %v", err)
+	}
+	if len(rules) != 3 {
+		t.Fatalf("Expected the file rule and both exceptions, got %+v", rules)
+	}
+
+	findings := []assessmentv1alpha1.Finding{
+		{ID: "nodes-count", Status: assessmentv1alpha1.FindingStatusFail},
+		{ID: "security-privileged-pods", Status: assessmentv1alpha1.FindingStatusFail},
+		{ID: "version-channel", Status: assessmentv1alpha1.FindingStatusWarn},
+	}
+	suppression.Apply(findings, rules, nil, time.Now())
+	if !findings[0].Suppressed || !findings[1].Suppressed || findings[2].Suppressed {
+		t.Fatalf("Expected the file rule and the approved exception to apply, got %+v", findings)
+	}
+	if got := exitCodeFor(findings); got != exitWarn {
+		t.Errorf("Expected suppressed failures not to fail the run, got exit code %d", got)
+	}
+}
+
+func TestLoadSuppressions_RejectsInvalidRule(t *testing.T) {
+	path := filepath.Join(t.TempDir(), "suppressions.yaml")
+	if err := os.WriteFile(path, []byte("- reason: selects nothing\n"), 0644); err != nil {
+		t.Fatal(err)
+	}
+	c := fake.NewClientBuilder().WithScheme(scheme).Build()
+	if _, err := loadSuppressions(context.Background(), c, options{suppressionsFile: path}); err == nil {
+		t.Error("Expected a rule without a selector to be rejected")
+	}
+}
@@ -24,6 +24,9 @@ import {
     InfoCircleIcon,
     SearchIcon,
     PlusCircleIcon,
+    BanIcon,
+    QuestionCircleIcon,
+    PauseCircleIcon,
 } from '@patternfly/react-icons';
 import { useK8sWatchResource } from '@openshift-console/dynamic-plugin-sdk';
 import { ClusterAssessment } from '../types';
@@ -215,6 +218,27 @@ export default function AssessmentDashboard() {
                                             <span className="ca-plugin__findings-label">Informational</span>
                                             <span className="ca-plugin__findings-count">{summary?.infoCount ?? 0}</span>
                                         </li>
+                                        <li className="ca-plugin__findings-item">
+                                            <span className="ca-plugin__findings-icon ca-plugin__findings-icon--error">
+                                                <QuestionCircleIcon />
+                                            </span>
+                                            <span className="ca-plugin__findings-label">Inconclusive</span>
+                                            <span className="ca-plugin__findings-count">{summary?.errorCount ?? 0}</span>
+                                        </li>
+                                        <li className="ca-plugin__findings-item">
+                                            <span className="ca-plugin__findings-icon ca-plugin__findings-icon--na">
+                                                <BanIcon />
+                                            </span>
+                                            <span className="ca-plugin__findings-label">Not Applicable</span>
+                                            <span className="ca-plugin__findings-count">{summary?.notApplicableCount ?? 0}</span>
+                                        </li>
+                                        <li className="ca-plugin__findings-item">
+                                            <span className="ca-plugin__findings-icon ca-plugin__findings-icon--na">
+                                                <PauseCircleIcon />
+                                            </span>
+                                            <span className="ca-plugin__findings-label">Skipped</span>
+                                            <span className="ca-plugin__findings-count">{summary?.skippedCount ?? 0}</span>
+                                        </li>
                                     </ul>
                                 </div>
                             </div>
@@ -28,7 +28,8 @@ import {
 } from '@patternfly/react-icons';
 import { useK8sWatchResource } from '@openshift-console/dynamic-plugin-sdk';
 import { Link } from 'react-router-dom';
-import { ClusterAssessment } from '../types';
+import { AssessmentRun, ClusterAssessment } from '../types';
+import { useAssessmentFindings } from '../findings';
 import { ScoreGauge } from './ScoreGauge';
 import { FindingsTable } from './FindingsTable';
 import DeltaBanner from './DeltaBanner';
@@ -56,21 +57,26 @@ export default function AssessmentDetails() {
         setRerunStatus('running');
         setRerunError('');
         try {
-            // Use direct fetch for merge patch — k8sPatch with JSON Patch can fail
-            // if the annotations key doesn't exist on the CR yet.
-            const url = `/api/kubernetes/apis/assessment.openshift.io/v1alpha1/clusterassessments/${name}`;
+            // Request the run by creating an AssessmentRun for this assessment
+            const run: AssessmentRun = {
+                apiVersion: 'assessment.openshift.io/v1alpha1',
+                kind: 'AssessmentRun',
+                metadata: {
+                    generateName: `${name}-`,
+                },
+                spec: {
+                    assessmentName: name,
+                    trigger: 'Manual',
+                    reason: 'Requested from the console',
+                },
+            };
+            const url = '/api/kubernetes/apis/assessment.openshift.io/v1alpha1/assessmentruns';
             const response = await fetch(url, {
-                method: 'PATCH',
+                method: 'POST',
                 headers: {
-                    'Content-Type': 'application/merge-patch+json',
+                    'Content-Type': 'application/json',
                 },
-                body: JSON.stringify({
-                    metadata: {
-                        annotations: {
-                            'assessment.openshift.io/trigger': 'run',
-                        },
-                    },
-                }),
+                body: JSON.stringify(run),
             });
             if (!response.ok) {
                 const text = await response.text();
@@ -101,8 +107,8 @@ export default function AssessmentDetails() {
         if (
             watchData &&
             watchData.status &&
-            watchData.status.findings &&
-            watchData.status.findings.length > 0
+            (watchData.status.findingsRef ||
+                (watchData.status.findings && watchData.status.findings.length > 0))
         ) {
             setStableAssessment(watchData);
         }
@@ -110,6 +116,8 @@ export default function AssessmentDetails() {
 
     // Use stableAssessment if available, otherwise fall back to watchData
     const assessment = stableAssessment || watchData;
+    // Findings stored in ConfigMaps are loaded through status.findingsRef
+    const [findings] = useAssessmentFindings(assessment);
 
     if (error && !stableAssessment) {
         return (
@@ -140,7 +148,6 @@ export default function AssessmentDetails() {
 
     const summary = assessment?.status?.summary;
     const clusterInfo = assessment?.status?.clusterInfo;
-    const findings = assessment?.status?.findings || [];
 
     const getScoreClass = (score: number) => {
         if (score >= 80) return 'ca-plugin__score-value--good';
@@ -98,7 +98,7 @@ export default function FindingsTable({ findings }: FindingsTableProps) {
         setCategoryFilter('All');
     };
 
-    const severityOptions = ['All', 'PASS', 'WARN', 'FAIL', 'INFO'];
+    const severityOptions = ['All', 'PASS', 'WARN', 'FAIL', 'INFO', 'SKIPPED', 'NOT_APPLICABLE', 'ERROR'];
 
     // Early return if no findings at all (and thus no filters needed)
     if (findings.length === 0) {
@@ -20,6 +20,8 @@ import {
     ExclamationCircleIcon,
     InfoCircleIcon,
     ExternalLinkAltIcon,
+    BanIcon,
+    QuestionCircleIcon,
 } from '@patternfly/react-icons';
 import { Finding } from '../types';
 import { RemediationPanel } from './RemediationPanel';
@@ -39,6 +41,12 @@ const getStatusIcon = (status: string) => {
             return <ExclamationTriangleIcon color="var(--pf-v5-global--warning-color--100)" />;
         case 'FAIL':
             return <ExclamationCircleIcon color="var(--pf-v5-global--danger-color--100)" />;
+        case 'SKIPPED':
+            return <InfoCircleIcon color="var(--pf-v5-global--disabled-color--100)" />;
+        case 'NOT_APPLICABLE':
+            return <BanIcon color="var(--pf-v5-global--disabled-color--100)" />;
+        case 'ERROR':
+            return <QuestionCircleIcon color="var(--pf-v5-global--palette--purple-500)" />;
         case 'INFO':
         default:
             return <InfoCircleIcon color="var(--pf-v5-global--info-color--100)" />;
@@ -53,6 +61,12 @@ const getStatusLabel = (status: string) => {
             return <Label color="orange">{status}</Label>;
         case 'FAIL':
             return <Label color="red">{status}</Label>;
+        case 'SKIPPED':
+            return <Label color="grey">{status}</Label>;
+        case 'NOT_APPLICABLE':
+            return <Label color="grey">N/A</Label>;
+        case 'ERROR':
+            return <Label color="purple">{status}</Label>;
         case 'INFO':
         default:
             return <Label color="blue">{status}</Label>;
@@ -0,0 +1,75 @@
+// Loads the findings of an assessment that stores them outside its status
+
+import * as React from 'react';
+import { ClusterAssessment, Finding, FindingsReference } from './types';
+
+// Binary data key of the findings ConfigMaps
+const DATA_KEY = 'findings.json.gz';
+
+const base64ToBytes = (data: string): Uint8Array => {
+    const binary = atob(data);
+    const bytes = new Uint8Array(binary.length);
+    for (let i = 0; i < binary.length; i++) {
+        bytes[i] = binary.charCodeAt(i);
+    }
+    return bytes;
+};
+
+// loadFindings reads the ConfigMaps of a findings reference in order and
+// decompresses their concatenated data.
+export const loadFindings = async (ref: FindingsReference): Promise<Finding[]> => {
+    const chunks = await Promise.all(
+        ref.configMaps.map(async (name) => {
+            const url = `/api/kubernetes/api/v1/namespaces/${ref.namespace}/configmaps/${name}`;
+            const response = await fetch(url);
+            if (!response.ok) {
+                throw new Error(`Failed to load findings ConfigMap ${name}: ${response.status}`);
+            }
+            const cm = await response.json();
+            return base64ToBytes(cm.binaryData?.[DATA_KEY] || '');
+        })
+    );
+    const stream = new Blob(chunks).stream().pipeThrough(new DecompressionStream('gzip'));
+    return (await new Response(stream).json()) as Finding[];
+};
+
+// useAssessmentFindings returns the full findings of an assessment, loading
+// them through status.findingsRef when set. The findings in the status are
+// returned while loading or if loading fails.
+export const useAssessmentFindings = (
+    assessment: ClusterAssessment | undefined
+): [Finding[], string | undefined] => {
+    const statusFindings = assessment?.status?.findings || [];
+    const ref = assessment?.status?.findingsRef;
+    const checksum = ref?.checksum;
+    const [loaded, setLoaded] = React.useState<{ checksum: string; findings: Finding[] }>();
+    const [error, setError] = React.useState<string>();
+
+    React.useEffect(() => {
+        if (!ref || loaded?.checksum === checksum) {
+            return;
+        }
+        let cancelled = false;
+        loadFindings(ref)
+            .then((findings) => {
+                if (!cancelled) {
+                    setLoaded({ checksum: ref.checksum, findings });
+                    setError(undefined);
+                }
+            })
+            .catch((err) => {
+                if (!cancelled) {
+                    setError(String(err));
+                }
+            });
+        return () => {
+            cancelled = true;
+        };
+        // eslint-disable-next-line react-hooks/exhaustive-deps
+    }, [checksum]);
+
+    if (ref && loaded?.checksum === checksum) {
+        return [loaded.findings, error];
+    }
+    return [statusFindings, error];
+};
@@ -9,17 +9,34 @@ export interface ClusterAssessment {
     spec: {
         profile?: string;
         schedule?: string;
+        timeZone?: string;
+        findingStorage?: {
+            mode?: 'Status' | 'ConfigMap';
+            statusLimit?: number;
+        };
+        deletionPolicy?: 'Delete' | 'Retain';
+        triggers?: {
+            debounce?: string;
+            minInterval?: string;
+            events: { type: TriggerEventType; validators?: string[] }[];
+        };
     };
     status?: {
         phase?: string;
         lastRunTime?: string;
+        nextRunTime?: string;
+        lastScheduleTime?: string;
         summary?: {
             score?: number;
             passCount: number;
             warnCount: number;
             failCount: number;
             infoCount: number;
+            notApplicableCount?: number;
+            errorCount?: number;
+            skippedCount?: number;
             totalChecks: number;
+            unscoredValidators?: string[];
         };
         clusterInfo?: {
             clusterVersion?: string;
@@ -27,26 +44,143 @@ export interface ClusterAssessment {
             nodeCount?: number;
         };
         findings?: Finding[];
+        findingsRef?: FindingsReference;
         delta?: DeltaSummary;
         snapshotCount?: number;
+        suppressions?: SuppressionStatus[];
+        collection?: CollectionStats;
+        jobName?: string;
+        lastRunName?: string;
+        conditions?: Condition[];
+        validators?: ValidatorRunStatus[];
     };
 }
 
+export interface Condition {
+    type: string;
+    status: 'True' | 'False' | 'Unknown';
+    observedGeneration?: number;
+    lastTransitionTime: string;
+    reason: string;
+    message: string;
+}
+
+export interface FindingsReference {
+    namespace: string;
+    configMaps: string[];
+    count: number;
+    checksum: string;
+}
+
+export type TriggerEventType = 'ClusterUpgrade' | 'MachineConfigPoolUpdate' | 'NamespaceCreated' | 'ProfileChange';
+
+export type RunTrigger = 'Created' | 'Schedule' | 'Manual' | 'ProfileChange' | 'Event';
+
+export type ValidatorOutcome = 'Succeeded' | 'Failed' | 'Skipped' | 'TimedOut';
+
+export interface ValidatorRunStatus {
+    name: string;
+    outcome?: ValidatorOutcome;
+    duration: string;
+    findingCount: number;
+    passCount?: number;
+    warnCount?: number;
+    failCount?: number;
+    infoCount?: number;
+    notApplicableCount?: number;
+    errorCount?: number;
+    skippedCount?: number;
+    error?: string;
+    missingPermissions?: string[];
+}
+
+export interface AssessmentRun {
+    apiVersion?: string;
+    kind?: string;
+    metadata: {
+        name?: string;
+        generateName?: string;
+        creationTimestamp?: string;
+    };
+    spec: {
+        assessmentName: string;
+        trigger?: RunTrigger;
+        reason?: string;
+        validators?: string[];
+    };
+    status?: {
+        phase?: string;
+        startTime?: string;
+        completionTime?: string;
+        profile?: string;
+        validators?: ValidatorRunStatus[];
+        summary?: {
+            score?: number;
+            passCount: number;
+            warnCount: number;
+            failCount: number;
+            infoCount: number;
+            notApplicableCount?: number;
+            errorCount?: number;
+            skippedCount?: number;
+            totalChecks: number;
+        };
+        reportConfigMap?: string;
+        snapshotName?: string;
+        jobName?: string;
+        message?: string;
+    };
+}
+
+export interface CollectionStats {
+    objectsScanned: number;
+    duration: string;
+    resources?: {
+        resource: string;
+        objects: number;
+        pages: number;
+        duration: string;
+    }[];
+}
+
+export type SuppressionState = 'Active' | 'Unused' | 'Expiring' | 'Expired' | 'PendingApproval' | 'Invalid';
+
+export interface SuppressionStatus {
+    source: string;
+    state: SuppressionState;
+    matchedFindings?: number;
+    expiresAt?: string;
+    message?: string;
+}
+
 export interface Finding {
     id: string;
     validator: string;
     category: string;
     resource?: string;
     namespace?: string;
-    status: 'PASS' | 'WARN' | 'FAIL' | 'INFO';
+    affectedResources?: AffectedResource[];
+    status: 'PASS' | 'WARN' | 'FAIL' | 'INFO' | 'SKIPPED' | 'NOT_APPLICABLE' | 'ERROR';
+    severity?: FindingSeverity;
     title: string;
     description: string;
     impact?: string;
     recommendation?: string;
     references?: string[];
     remediation?: RemediationGuidance;
+    suppressed?: boolean;
+    suppressionReason?: string;
 }
 
+export interface AffectedResource {
+    kind: string;
+    namespace?: string;
+    name: string;
+    uid?: string;
+}
+
+export type FindingSeverity = 'low' | 'medium' | 'high' | 'critical';
+
 export type RemediationSafety = 'safe-apply' | 'requires-review' | 'destructive';
 
 export interface RemediationCommand {
@@ -68,6 +202,8 @@ export interface DeltaSummary {
     resolvedFindings?: string[];
     regressionFindings?: string[];
     improvedFindings?: string[];
+    newAffectedResources?: string[];
+    resolvedAffectedResources?: string[];
     scoreDelta?: number;
 }
 
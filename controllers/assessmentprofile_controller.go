@@ -18,7 +18,6 @@ package controllers
 
 import (
 	"context"
-	"fmt"
 
 	"k8s.io/apimachinery/pkg/runtime"
 	ctrl "sigs.k8s.io/controller-runtime"
@@ -74,52 +73,12 @@ func (r *AssessmentProfileReconciler) Reconcile(ctx context.Context, req ctrl.Re
 // validateProfile checks that the AssessmentProfile is valid and returns
 // the ready state, a message, and the resolved validator count.
 func (r *AssessmentProfileReconciler) validateProfile(profile *assessmentv1alpha1.AssessmentProfile) (bool, string, int) {
-	// Validate basedOn
-	basedOn := profile.Spec.BasedOn
-	if basedOn == "" {
-		basedOn = "production"
-	}
-	if basedOn != string(profiles.ProfileProduction) && basedOn != string(profiles.ProfileDevelopment) {
-		return false, fmt.Sprintf("invalid basedOn value %q: must be \"production\" or \"development\"", basedOn), 0
-	}
-
-	registeredNames := r.Registry.Names()
-	registeredSet := make(map[string]bool, len(registeredNames))
-	for _, name := range registeredNames {
-		registeredSet[name] = true
-	}
-
-	// Validate enabledValidators
-	for _, name := range profile.Spec.EnabledValidators {
-		if !registeredSet[name] {
-			return false, fmt.Sprintf("unknown validator %q in enabledValidators", name), 0
-		}
+	if err := r.Registry.ValidateProfile(profile); err != nil {
+		return false, err.Error(), 0
 	}
 
-	// Validate disabledValidators
-	for _, name := range profile.Spec.DisabledValidators {
-		if !registeredSet[name] {
-			return false, fmt.Sprintf("unknown validator %q in disabledValidators", name), 0
-		}
-	}
-
-	// Calculate resolved validator count
-	validatorCount := len(registeredNames)
-	if len(profile.Spec.EnabledValidators) > 0 {
-		validatorCount = len(profile.Spec.EnabledValidators)
-	} else if len(profile.Spec.DisabledValidators) > 0 {
-		disabledSet := make(map[string]bool, len(profile.Spec.DisabledValidators))
-		for _, name := range profile.Spec.DisabledValidators {
-			disabledSet[name] = true
-		}
-		count := 0
-		for _, name := range registeredNames {
-			if !disabledSet[name] {
-				count++
-			}
-		}
-		validatorCount = count
-	}
+	// Resolve the count the same way the runner filters validators
+	validatorCount := len(r.Registry.ForProfile(profiles.FromAssessmentProfile(profile)))
 
 	return true, "Profile is valid", validatorCount
 }
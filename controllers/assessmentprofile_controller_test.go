@@ -18,6 +18,7 @@ package controllers
 
 import (
 	"context"
+	"strings"
 	"testing"
 
 	assessmentv1alpha1 "github.com/openshift-assessment/cluster-assessment-operator/api/v1alpha1"
@@ -137,7 +138,7 @@ func TestValidateProfile_UnknownDisabledValidator(t *testing.T) {
 		ObjectMeta: metav1.ObjectMeta{Name: "test"},
 		Spec: assessmentv1alpha1.AssessmentProfileSpec{
 			BasedOn:            "production",
-			DisabledValidators: []string{"nonexistent-validator"},
+			DisabledValidators: []string{"nonexistent-validator", "nodes", "other-validator"},
 		},
 	}
 
@@ -146,8 +147,11 @@ func TestValidateProfile_UnknownDisabledValidator(t *testing.T) {
 	if ready {
 		t.Error("Expected ready=false for unknown disabled validator")
 	}
-	if message == "" {
-		t.Error("Expected error message for unknown validator")
+	if !strings.Contains(message, `"nonexistent-validator"`) || !strings.Contains(message, `"other-validator"`) {
+		t.Errorf("Expected message to list every unknown validator, got %q", message)
+	}
+	if strings.Contains(message, `"nodes"`) {
+		t.Errorf("Expected message not to list known validators, got %q", message)
 	}
 }
 
@@ -194,3 +198,81 @@ func TestValidateProfile_DisabledValidatorsCount(t *testing.T) {
 		t.Errorf("Expected count=3 (5 total - 2 disabled), got %d", count)
 	}
 }
+
+func TestValidateProfile_DisabledChecks(t *testing.T) {
+	reg := newTestRegistry("security", "nodes")
+	r := &AssessmentProfileReconciler{Registry: reg}
+
+	tests := []struct {
+		name      string
+		checks    []string
+		wantReady bool
+	}{
+		{name: "runner check IDs are known", checks: []string{"security-error", "nodes-timeout"}, wantReady: true},
+		{name: "unknown check ID", checks: []string{"security-error", "bogus-check"}, wantReady: false},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			profile := &assessmentv1alpha1.AssessmentProfile{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec: assessmentv1alpha1.AssessmentProfileSpec{
+					BasedOn:        "production",
+					DisabledChecks: tt.checks,
+				},
+			}
+
+			ready, message, _ := r.validateProfile(profile)
+			if ready != tt.wantReady {
+				t.Errorf("Expected ready=%v, got %v (message: %s)", tt.wantReady, ready, message)
+			}
+		})
+	}
+}
+
+// paramTestValidator is a testValidator that declares a parameter schema.
+type paramTestValidator struct {
+	testValidator
+}
+
+func (v *paramTestValidator) Parameters() []validator.Parameter {
+	return []validator.Parameter{
+		{Name: "warnDays", Type: validator.ParameterInteger, Default: "30", Description: "Warning window"},
+	}
+}
+
+func TestValidateProfile_Parameters(t *testing.T) {
+	reg := newTestRegistry("nodes")
+	_ = reg.Register(&paramTestValidator{testValidator{name: "certificates"}})
+	r := &AssessmentProfileReconciler{Registry: reg}
+
+	tests := []struct {
+		name      string
+		params    map[string]assessmentv1alpha1.ValidatorParameters
+		wantReady bool
+		wantMsg   string
+	}{
+		{name: "valid", params: map[string]assessmentv1alpha1.ValidatorParameters{"certificates": {"warnDays": "14"}}, wantReady: true},
+		{name: "unknown validator", params: map[string]assessmentv1alpha1.ValidatorParameters{"missing": {"warnDays": "14"}}, wantMsg: `unknown validator "missing"`},
+		{name: "unknown parameter", params: map[string]assessmentv1alpha1.ValidatorParameters{"certificates": {"bogus": "1"}}, wantMsg: `no parameter "bogus"`},
+		{name: "wrong type", params: map[string]assessmentv1alpha1.ValidatorParameters{"certificates": {"warnDays": "soon"}}, wantMsg: "not an integer"},
+		{name: "validator without schema", params: map[string]assessmentv1alpha1.ValidatorParameters{"nodes": {"warnDays": "1"}}, wantMsg: `no parameter "warnDays"`},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			profile := &assessmentv1alpha1.AssessmentProfile{
+				ObjectMeta: metav1.ObjectMeta{Name: "test"},
+				Spec:       assessmentv1alpha1.AssessmentProfileSpec{Parameters: tt.params},
+			}
+
+			ready, message, _ := r.validateProfile(profile)
+			if ready != tt.wantReady {
+				t.Errorf("ready = %v, want %v (message %q)", ready, tt.wantReady, message)
+			}
+			if tt.wantMsg != "" && !strings.Contains(message, tt.wantMsg) {
+				t.Errorf("Expected message to contain %q, got %q", tt.wantMsg, message)
+			}
+		})
+	}
+}
@@ -0,0 +1,191 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	assessmentv1alpha1 "github.com/openshift-assessment/cluster-assessment-operator/api/v1alpha1"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/history"
+)
+
+const (
+	// cleanupFinalizer delays the removal of an assessment until its reports,
+	// findings and snapshots are deleted.
+	cleanupFinalizer = "assessment.openshift.io/cleanup"
+
+	// reportComponent is the app.kubernetes.io/component label of report ConfigMaps.
+	reportComponent = "assessment-report"
+)
+
+// deletesData reports whether the assessment's reports and history are deleted
+// along with it.
+func deletesData(assessment *assessmentv1alpha1.ClusterAssessment) bool {
+	return assessment.Spec.DeletionPolicy != assessmentv1alpha1.DeletionPolicyRetain
+}
+
+// reconcileFinalizer adds the cleanup finalizer to assessments that delete
+// their data and removes it from those that retain it.
+func (r *ClusterAssessmentReconciler) reconcileFinalizer(ctx context.Context, assessment *assessmentv1alpha1.ClusterAssessment) error {
+	var changed bool
+	if deletesData(assessment) {
+		changed = controllerutil.AddFinalizer(assessment, cleanupFinalizer)
+	} else {
+		changed = controllerutil.RemoveFinalizer(assessment, cleanupFinalizer)
+	}
+	if !changed {
+		return nil
+	}
+	if err := r.Update(ctx, assessment); err != nil {
+		return fmt.Errorf("failed to update finalizers: %w", err)
+	}
+	return nil
+}
+
+// finalize deletes the data of an assessment being deleted, unless its
+// deletion policy retains it, and removes the cleanup finalizer.
+func (r *ClusterAssessmentReconciler) finalize(ctx context.Context, assessment *assessmentv1alpha1.ClusterAssessment) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	if r.triggers != nil {
+		r.triggers.Forget(assessment.Name)
+	}
+	if !controllerutil.ContainsFinalizer(assessment, cleanupFinalizer) {
+		return ctrl.Result{}, nil
+	}
+
+	if deletesData(assessment) {
+		if err := r.deleteAssessmentData(ctx, assessment.Name); err != nil {
+			logger.Error(err, "Failed to clean up assessment data")
+			return ctrl.Result{}, err
+		}
+		logger.Info("Deleted reports, findings and snapshots of the assessment")
+	}
+
+	controllerutil.RemoveFinalizer(assessment, cleanupFinalizer)
+	if err := r.Update(ctx, assessment); err != nil {
+		return ctrl.Result{}, err
+	}
+	return ctrl.Result{}, nil
+}
+
+// deleteAssessmentData deletes the ConfigMaps the operator created for the
+// assessment in any namespace, and its snapshots. AssessmentRuns are owned by
+// the assessment and garbage collected with it.
+func (r *ClusterAssessmentReconciler) deleteAssessmentData(ctx context.Context, name string) error {
+	configMaps := &corev1.ConfigMapList{}
+	if err := r.List(ctx, configMaps, assessmentDataLabels(name)); err != nil {
+		return fmt.Errorf("failed to list ConfigMaps: %w", err)
+	}
+	for i := range configMaps.Items {
+		if err := r.Delete(ctx, &configMaps.Items[i]); err != nil && !errors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete ConfigMap %s/%s: %w", configMaps.Items[i].Namespace, configMaps.Items[i].Name, err)
+		}
+	}
+
+	if err := history.NewSnapshotManager(r.Client).DeleteHistory(ctx, name); err != nil {
+		return err
+	}
+	return nil
+}
+
+// pruneReports deletes the report ConfigMaps of the assessment exceeding its
+// retention policy. The current report is always kept.
+func (r *ClusterAssessmentReconciler) pruneReports(ctx context.Context, assessment *assessmentv1alpha1.ClusterAssessment) {
+	logger := log.FromContext(ctx)
+
+	configMaps := &corev1.ConfigMapList{}
+	if err := r.List(ctx, configMaps, assessmentDataLabels(assessment.Name)); err != nil {
+		logger.Error(err, "Failed to list report ConfigMaps for pruning")
+		return
+	}
+
+	// Reports created before the component label was added have none
+	var reports []corev1.ConfigMap
+	for _, cm := range configMaps.Items {
+		component := cm.Labels["app.kubernetes.io/component"]
+		if (component == "" || component == reportComponent) && cm.Name != assessment.Status.ReportConfigMap {
+			reports = append(reports, cm)
+		}
+	}
+
+	var policy *assessmentv1alpha1.RetentionPolicy
+	if storage := assessment.Spec.ReportStorage.ConfigMap; storage != nil {
+		policy = storage.Retain
+	}
+	for _, cm := range reportsToPrune(reports, policy, time.Now()) {
+		if err := r.Delete(ctx, &cm); err != nil && !errors.IsNotFound(err) {
+			logger.Error(err, "Failed to delete old report ConfigMap", "configMap", cm.Name, "namespace", cm.Namespace)
+			continue
+		}
+		logger.Info("Pruned old report ConfigMap", "configMap", cm.Name, "namespace", cm.Namespace)
+	}
+}
+
+// reportsToPrune returns the reports exceeding the retention policy, given the
+// reports other than the current one. Without a policy every report is kept.
+func reportsToPrune(reports []corev1.ConfigMap, policy *assessmentv1alpha1.RetentionPolicy, now time.Time) []corev1.ConfigMap {
+	var count int
+	var maxAge time.Duration
+	if policy != nil {
+		if policy.Count != nil {
+			count = *policy.Count
+		}
+		if policy.MaxAge != nil {
+			maxAge = policy.MaxAge.Duration
+		}
+	}
+
+	// Newest first; names end in the creation time within the same second
+	sorted := make([]corev1.ConfigMap, len(reports))
+	copy(sorted, reports)
+	sort.Slice(sorted, func(i, j int) bool {
+		if !sorted[i].CreationTimestamp.Equal(&sorted[j].CreationTimestamp) {
+			return sorted[j].CreationTimestamp.Before(&sorted[i].CreationTimestamp)
+		}
+		return sorted[i].Name > sorted[j].Name
+	})
+
+	var prune []corev1.ConfigMap
+	for i, cm := range sorted {
+		// The current report counts towards the limit
+		tooMany := count > 0 && i+1 >= count
+		tooOld := maxAge > 0 && now.Sub(cm.CreationTimestamp.Time) > maxAge
+		if tooMany || tooOld {
+			prune = append(prune, cm)
+		}
+	}
+	return prune
+}
+
+// assessmentDataLabels selects the ConfigMaps the operator created for an assessment.
+func assessmentDataLabels(name string) client.MatchingLabels {
+	return client.MatchingLabels{
+		"app.kubernetes.io/managed-by": "cluster-assessment-operator",
+		history.LabelAssessmentName:    name,
+	}
+}
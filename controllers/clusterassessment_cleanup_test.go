@@ -0,0 +1,219 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+
+	assessmentv1alpha1 "github.com/openshift-assessment/cluster-assessment-operator/api/v1alpha1"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/history"
+)
+
+func reportConfigMap(name, namespace, assessment, component string, created time.Time) *corev1.ConfigMap {
+	labels := map[string]string{
+		"app.kubernetes.io/managed-by": "cluster-assessment-operator",
+		history.LabelAssessmentName:    assessment,
+	}
+	if component != "" {
+		labels["app.kubernetes.io/component"] = component
+	}
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:              name,
+			Namespace:         namespace,
+			Labels:            labels,
+			CreationTimestamp: metav1.NewTime(created),
+		},
+	}
+}
+
+func TestReportsToPrune(t *testing.T) {
+	now := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	var reports []corev1.ConfigMap
+	for i := 1; i <= 12; i++ {
+		// report-1 is the newest
+		reports = append(reports, *reportConfigMap(fmt.Sprintf("report-%02d", i), testNamespace, "nightly", reportComponent,
+			now.Add(-time.Duration(i)*24*time.Hour)))
+	}
+	count := func(n int) *int { return &n }
+
+	tests := []struct {
+		name   string
+		policy *assessmentv1alpha1.RetentionPolicy
+		want   []string
+	}{
+		{
+			name: "no policy",
+		},
+		{
+			name:   "no count",
+			policy: &assessmentv1alpha1.RetentionPolicy{},
+		},
+		{
+			name:   "count",
+			policy: &assessmentv1alpha1.RetentionPolicy{Count: count(11)},
+			want:   []string{"report-11", "report-12"},
+		},
+		{
+			name:   "unlimited",
+			policy: &assessmentv1alpha1.RetentionPolicy{Count: count(0)},
+		},
+		{
+			name:   "max age",
+			policy: &assessmentv1alpha1.RetentionPolicy{Count: count(0), MaxAge: &metav1.Duration{Duration: 10*24*time.Hour + time.Hour}},
+			want:   []string{"report-11", "report-12"},
+		},
+		{
+			name:   "count and max age",
+			policy: &assessmentv1alpha1.RetentionPolicy{Count: count(3), MaxAge: &metav1.Duration{Duration: 30 * 24 * time.Hour}},
+			want:   []string{"report-03", "report-04", "report-05", "report-06", "report-07", "report-08", "report-09", "report-10", "report-11", "report-12"},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			var got []string
+			for _, cm := range reportsToPrune(reports, tt.policy, now) {
+				got = append(got, cm.Name)
+			}
+			if fmt.Sprint(got) != fmt.Sprint(tt.want) {
+				t.Errorf("Expected %v to be pruned, got %v", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestPruneReports(t *testing.T) {
+	now := time.Now()
+	assessment := jobModeAssessment(assessmentv1alpha1.PhaseCompleted, "")
+	assessment.Spec.ReportStorage.ConfigMap = &assessmentv1alpha1.ConfigMapStorageSpec{
+		Enabled: true,
+		Retain:  &assessmentv1alpha1.RetentionPolicy{MaxAge: &metav1.Duration{Duration: time.Hour}},
+	}
+	// The current report is kept even if it is older than the maximum age
+	assessment.Status.ReportConfigMap = "nightly-report-current"
+
+	r := newJobTestReconciler(
+		reportConfigMap("nightly-report-current", testNamespace, "nightly", reportComponent, now.Add(-2*time.Hour)),
+		reportConfigMap("nightly-report-old", testNamespace, "nightly", "", now.Add(-3*time.Hour)),
+		reportConfigMap("nightly-report-recent", testNamespace, "nightly", reportComponent, now.Add(-time.Minute)),
+		reportConfigMap("nightly-findings-old", testNamespace, "nightly", "assessment-findings", now.Add(-3*time.Hour)),
+		reportConfigMap("weekly-report-old", testNamespace, "weekly", reportComponent, now.Add(-3*time.Hour)),
+	)
+	r.pruneReports(context.Background(), assessment)
+
+	for name, wantKept := range map[string]bool{
+		"nightly-report-current": true,
+		"nightly-report-old":     false,
+		"nightly-report-recent":  true,
+		"nightly-findings-old":   true,
+		"weekly-report-old":      true,
+	} {
+		err := r.Get(context.Background(), client.ObjectKey{Namespace: testNamespace, Name: name}, &corev1.ConfigMap{})
+		if kept := err == nil; kept != wantKept {
+			t.Errorf("Expected %s kept=%v, got %v", name, wantKept, err)
+		}
+	}
+}
+
+func TestReconcile_CleanupOnDeletion(t *testing.T) {
+	ctx := context.Background()
+	now := time.Now()
+	assessment := jobModeAssessment(assessmentv1alpha1.PhaseCompleted, "")
+	snapshot := &assessmentv1alpha1.AssessmentSnapshot{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:   "nightly-20260301-100000",
+			Labels: map[string]string{history.LabelAssessmentName: "nightly"},
+		},
+	}
+	r := newJobTestReconciler(
+		assessment,
+		snapshot,
+		reportConfigMap("nightly-report-a", testNamespace, "nightly", reportComponent, now),
+		reportConfigMap("nightly-report-b", "reports", "nightly", reportComponent, now),
+		reportConfigMap("nightly-findings-a", testNamespace, "nightly", "assessment-findings", now),
+		reportConfigMap("weekly-report-a", testNamespace, "weekly", reportComponent, now),
+	)
+	req := ctrl.Request{NamespacedName: client.ObjectKey{Name: "nightly"}}
+
+	if _, err := r.Reconcile(ctx, req); err != nil {
+		t.Fatalf("Reconcile returned error: %v", err)
+	}
+	latest := getAssessment(t, r)
+	if !controllerutil.ContainsFinalizer(latest, cleanupFinalizer) {
+		t.Fatalf("Expected the cleanup finalizer to be added, got %v", latest.Finalizers)
+	}
+
+	if err := r.Delete(ctx, latest); err != nil {
+		t.Fatalf("Failed to delete assessment: %v", err)
+	}
+	if _, err := r.Reconcile(ctx, req); err != nil {
+		t.Fatalf("Reconcile returned error: %v", err)
+	}
+
+	err := r.Get(ctx, client.ObjectKey{Name: "nightly"}, &assessmentv1alpha1.ClusterAssessment{})
+	if !errors.IsNotFound(err) {
+		t.Errorf("Expected the assessment to be removed, got %v", err)
+	}
+	configMaps := &corev1.ConfigMapList{}
+	if err := r.List(ctx, configMaps); err != nil {
+		t.Fatalf("Failed to list ConfigMaps: %v", err)
+	}
+	if len(configMaps.Items) != 1 || configMaps.Items[0].Name != "weekly-report-a" {
+		t.Errorf("Expected only the ConfigMap of another assessment to remain, got %d", len(configMaps.Items))
+	}
+	err = r.Get(ctx, client.ObjectKey{Name: snapshot.Name}, &assessmentv1alpha1.AssessmentSnapshot{})
+	if !errors.IsNotFound(err) {
+		t.Errorf("Expected the snapshot to be deleted, got %v", err)
+	}
+}
+
+func TestReconcile_RetainOnDeletion(t *testing.T) {
+	ctx := context.Background()
+	assessment := jobModeAssessment(assessmentv1alpha1.PhaseCompleted, "")
+	assessment.Spec.DeletionPolicy = assessmentv1alpha1.DeletionPolicyRetain
+	assessment.Finalizers = []string{cleanupFinalizer}
+	r := newJobTestReconciler(
+		assessment,
+		reportConfigMap("nightly-report-a", testNamespace, "nightly", reportComponent, time.Now()),
+	)
+
+	if _, err := r.Reconcile(ctx, ctrl.Request{NamespacedName: client.ObjectKey{Name: "nightly"}}); err != nil {
+		t.Fatalf("Reconcile returned error: %v", err)
+	}
+	latest := getAssessment(t, r)
+	if len(latest.Finalizers) != 0 {
+		t.Fatalf("Expected the cleanup finalizer to be removed, got %v", latest.Finalizers)
+	}
+
+	if err := r.Delete(ctx, latest); err != nil {
+		t.Fatalf("Failed to delete assessment: %v", err)
+	}
+	if err := r.Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: "nightly-report-a"}, &corev1.ConfigMap{}); err != nil {
+		t.Errorf("Expected the report to be retained, got %v", err)
+	}
+}
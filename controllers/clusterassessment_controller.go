@@ -29,25 +29,31 @@ import (
 	"github.com/go-git/go-git/v5/plumbing"
 	"github.com/go-git/go-git/v5/plumbing/object"
 	"github.com/go-git/go-git/v5/plumbing/transport/http"
-	"github.com/robfig/cron/v3"
+	configv1 "github.com/openshift/api/config/v1"
+	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
-	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/client-go/tools/record"
 	"k8s.io/client-go/util/retry"
 	ctrl "sigs.k8s.io/controller-runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/handler"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
-	configv1 "github.com/openshift/api/config/v1"
-
 	assessmentv1alpha1 "github.com/openshift-assessment/cluster-assessment-operator/api/v1alpha1"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/execution"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/findingstore"
 	"github.com/openshift-assessment/cluster-assessment-operator/pkg/history"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/machineconfig"
 	"github.com/openshift-assessment/cluster-assessment-operator/pkg/metrics"
 	"github.com/openshift-assessment/cluster-assessment-operator/pkg/profiles"
 	"github.com/openshift-assessment/cluster-assessment-operator/pkg/report"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/schedule"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/scoring"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/suppression"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/trigger"
 	"github.com/openshift-assessment/cluster-assessment-operator/pkg/validator"
 )
 
@@ -57,15 +63,52 @@ type ClusterAssessmentReconciler struct {
 	Scheme            *runtime.Scheme
 	Registry          *validator.Registry
 	OperatorNamespace string
+
+	// ValidatorConcurrency is the number of validators run in parallel.
+	// Zero uses validator.DefaultConcurrency.
+	ValidatorConcurrency int
+
+	// ValidatorTimeout bounds the runtime of each validator.
+	// Zero uses validator.DefaultValidatorTimeout.
+	ValidatorTimeout time.Duration
+
+	// APIReader lists the shared cluster state directly from the API server,
+	// so large resource types are paginated instead of cached by informers.
+	// Nil uses the reconciler's client.
+	APIReader client.Reader
+
+	// JobImage is the operator image run by assessments in Job mode.
+	JobImage string
+
+	// JobServiceAccount is the service account of assessment Jobs that do not
+	// set one. Empty uses the namespace's default service account.
+	JobServiceAccount string
+
+	// Recorder emits Kubernetes Events for the assessment lifecycle.
+	// Nil disables events.
+	Recorder record.EventRecorder
+
+	// triggers holds the debounced trigger events of each assessment.
+	triggers *trigger.Tracker
 }
 
 // +kubebuilder:rbac:groups=assessment.openshift.io,resources=clusterassessments,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=assessment.openshift.io,resources=clusterassessments/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=assessment.openshift.io,resources=clusterassessments/finalizers,verbs=update
+// +kubebuilder:rbac:groups=assessment.openshift.io,resources=assessmentruns,verbs=get;list;watch;create;update;patch;delete
+// +kubebuilder:rbac:groups=assessment.openshift.io,resources=assessmentruns/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups=assessment.openshift.io,resources=assessmentsnapshots,verbs=get;list;watch;create;update;patch;delete
 // +kubebuilder:rbac:groups=assessment.openshift.io,resources=assessmentsnapshots/status,verbs=get;update;patch
+// +kubebuilder:rbac:groups=assessment.openshift.io,resources=customchecks,verbs=get;list;watch
+// +kubebuilder:rbac:groups=assessment.openshift.io,resources=findingexceptions,verbs=get;list;watch
+// +kubebuilder:rbac:groups=assessment.openshift.io,resources=findingexceptions/status,verbs=get;update;patch
 // +kubebuilder:rbac:groups="",resources=nodes;namespaces;pods;services;configmaps;secrets;persistentvolumes;persistentvolumeclaims;serviceaccounts,verbs=get;list;watch
 // +kubebuilder:rbac:groups="",resources=configmaps,verbs=create;update;patch;delete
+// +kubebuilder:rbac:groups="",resources=events,verbs=create;patch
+// +kubebuilder:rbac:groups="",resources=resourcequotas;limitranges,verbs=get;list;watch
+// +kubebuilder:rbac:groups=authorization.k8s.io,resources=selfsubjectaccessreviews,verbs=create
+// +kubebuilder:rbac:groups=batch,resources=jobs,verbs=get;list;watch;create;delete
+// +kubebuilder:rbac:groups=batch,resources=cronjobs,verbs=get;list;watch
 // +kubebuilder:rbac:groups=config.openshift.io,resources=*,verbs=get;list;watch
 // +kubebuilder:rbac:groups=machineconfiguration.openshift.io,resources=*,verbs=get;list;watch
 // +kubebuilder:rbac:groups=security.openshift.io,resources=securitycontextconstraints,verbs=get;list;watch
@@ -81,6 +124,9 @@ type ClusterAssessmentReconciler struct {
 // +kubebuilder:rbac:groups=machine.openshift.io,resources=machinesets,verbs=get;list;watch
 // +kubebuilder:rbac:groups=velero.io,resources=schedules;backups,verbs=get;list;watch
 // +kubebuilder:rbac:groups=oadp.openshift.io,resources=dataprotectionapplications,verbs=get;list;watch
+// +kubebuilder:rbac:groups=imageregistry.operator.openshift.io,resources=configs;imagepruners,verbs=get;list;watch
+// +kubebuilder:rbac:groups=operators.coreos.com,resources=clusterserviceversions,verbs=get;list;watch
+// +kubebuilder:rbac:groups=logging.openshift.io,resources=clusterloggings;clusterlogforwarders,verbs=get;list;watch
 
 // Reconcile handles ClusterAssessment reconciliation.
 func (r *ClusterAssessmentReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
@@ -97,44 +143,74 @@ func (r *ClusterAssessmentReconciler) Reconcile(ctx context.Context, req ctrl.Re
 		return ctrl.Result{}, err
 	}
 
-	// Check if this is a scheduled assessment
-	if assessment.Spec.Schedule != "" {
-		return r.reconcileScheduled(ctx, assessment)
+	// Reports and history are cleaned up before the assessment is removed
+	if !assessment.DeletionTimestamp.IsZero() {
+		return r.finalize(ctx, assessment)
+	}
+	if err := r.reconcileFinalizer(ctx, assessment); err != nil {
+		logger.Error(err, "Failed to reconcile finalizer")
+		return ctrl.Result{}, err
 	}
 
-	// One-time assessment
-	return r.reconcileOneTime(ctx, assessment)
-}
-
-// reconcileOneTime handles one-time assessments.
-func (r *ClusterAssessmentReconciler) reconcileOneTime(ctx context.Context, assessment *assessmentv1alpha1.ClusterAssessment) (ctrl.Result, error) {
-	logger := log.FromContext(ctx)
+	// Runs executing in a Job are tracked through the Job's status
+	if assessment.Status.Phase == assessmentv1alpha1.PhaseRunning && assessment.Status.JobName != "" {
+		return r.reconcileAssessmentJob(ctx, assessment)
+	}
 
-	// Check for re-run trigger annotation — allows manual re-execution of completed assessments.
-	// This creates a new snapshot each time, enabling the History & Trends feature.
-	const triggerAnnotation = "assessment.openshift.io/trigger"
-	if assessment.Annotations != nil && assessment.Annotations[triggerAnnotation] == "run" {
-		logger.Info("Re-run trigger detected, resetting assessment")
-		// Remove annotation and reset phase
+	// The trigger annotation is converted into an AssessmentRun request
+	if assessment.Annotations[triggerAnnotation] == "run" {
+		logger.Info("Re-run trigger detected, requesting an AssessmentRun")
 		delete(assessment.Annotations, triggerAnnotation)
 		if err := r.Update(ctx, assessment); err != nil {
 			return ctrl.Result{}, err
 		}
-		// Reset status phase so runAssessment proceeds
-		assessment.Status.Phase = ""
-		assessment.Status.Message = "Re-run triggered"
-		if err := r.Status().Update(ctx, assessment); err != nil {
+		if _, err := r.createRun(ctx, assessment, assessmentv1alpha1.RunTriggerManual,
+			"Requested with the "+triggerAnnotation+" annotation", nil); err != nil {
 			return ctrl.Result{}, err
 		}
 		return ctrl.Result{Requeue: true}, nil
 	}
 
+	// Requested runs execute even if the assessment is completed or suspended
+	if assessment.Status.Phase != assessmentv1alpha1.PhaseRunning {
+		run, err := r.nextPendingRun(ctx, assessment)
+		if err != nil {
+			logger.Error(err, "Failed to list AssessmentRuns")
+			return ctrl.Result{}, err
+		}
+		if run != nil {
+			logger.Info("Running requested assessment run", "run", run.Name)
+			return r.runAssessment(ctx, assessment, run)
+		}
+	}
+
+	// Debounced cluster events start a triggered run
+	triggerResult, started, err := r.reconcileTriggers(ctx, assessment)
+	if started || err != nil {
+		return triggerResult, err
+	}
+
+	// Check if this is a scheduled assessment
+	var result ctrl.Result
+	if assessment.Spec.Schedule != "" {
+		result, err = r.reconcileScheduled(ctx, assessment)
+	} else {
+		// One-time assessment
+		result, err = r.reconcileOneTime(ctx, assessment)
+	}
+	return sooner(result, triggerResult), err
+}
+
+// reconcileOneTime handles one-time assessments.
+func (r *ClusterAssessmentReconciler) reconcileOneTime(ctx context.Context, assessment *assessmentv1alpha1.ClusterAssessment) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
 	// Skip if already completed
 	if assessment.Status.Phase == assessmentv1alpha1.PhaseCompleted {
 		return ctrl.Result{}, nil
 	}
 
-	// Check for stuck Running assessments (timeout after 5 minutes)
+	// Check for stuck Running assessments
 	if assessment.Status.Phase == assessmentv1alpha1.PhaseRunning {
 		// Re-fetch to get latest status (avoid race with concurrent completion)
 		latestAssessment := &assessmentv1alpha1.ClusterAssessment{}
@@ -150,13 +226,27 @@ func (r *ClusterAssessmentReconciler) reconcileOneTime(ctx context.Context, asse
 
 		if latestAssessment.Status.LastRunTime != nil {
 			stuckDuration := time.Since(latestAssessment.Status.LastRunTime.Time)
-			if stuckDuration > 5*time.Minute {
+			stuckTimeout := r.stuckTimeout()
+			if stuckDuration > stuckTimeout {
 				logger.Info("Assessment appears stuck, resetting to allow retry", "stuckDuration", stuckDuration)
 				latestAssessment.Status.Phase = assessmentv1alpha1.PhaseFailed
-				latestAssessment.Status.Message = "Assessment timed out after 5 minutes, restarting..."
+				latestAssessment.Status.Message = fmt.Sprintf("Assessment timed out after %s, restarting...", stuckTimeout)
+				setConditions(&latestAssessment.Status, []metav1.Condition{
+					newCondition(latestAssessment, assessmentv1alpha1.ConditionReady, metav1.ConditionFalse,
+						assessmentv1alpha1.ReasonAssessmentFailed, latestAssessment.Status.Message),
+				})
 				if err := r.Status().Update(ctx, latestAssessment); err != nil {
 					return ctrl.Result{RequeueAfter: time.Second}, nil // Retry on conflict
 				}
+				r.event(latestAssessment, corev1.EventTypeWarning, eventAssessmentFailed, "%s", latestAssessment.Status.Message)
+				now := metav1.Now()
+				if err := r.updateRunStatus(ctx, r.currentRun(ctx, latestAssessment), func(status *assessmentv1alpha1.AssessmentRunStatus) {
+					status.Phase = assessmentv1alpha1.PhaseFailed
+					status.CompletionTime = &now
+					status.Message = latestAssessment.Status.Message
+				}); err != nil {
+					logger.Error(err, "Failed to mark stuck AssessmentRun as failed")
+				}
 				// Requeue to run the assessment
 				return ctrl.Result{Requeue: true}, nil
 			} else {
@@ -178,7 +268,40 @@ func (r *ClusterAssessmentReconciler) reconcileOneTime(ctx context.Context, asse
 	}
 
 	// Run the assessment
-	return r.runAssessment(ctx, assessment)
+	reason := ""
+	if assessment.Status.Phase == assessmentv1alpha1.PhaseFailed {
+		reason = "Retrying after a failed run"
+	}
+	return r.startRun(ctx, assessment, assessmentv1alpha1.RunTriggerCreated, reason)
+}
+
+// minStuckTimeout is the shortest time a run may stay in the Running phase
+// before it is considered stuck.
+const minStuckTimeout = 5 * time.Minute
+
+// stuckTimeout returns how long a run may stay in the Running phase before it is
+// considered stuck. It covers the worst case of every validator wave hitting its
+// per-validator timeout, so slow but progressing runs are not reset.
+func (r *ClusterAssessmentReconciler) stuckTimeout() time.Duration {
+	concurrency := r.ValidatorConcurrency
+	if concurrency <= 0 {
+		concurrency = validator.DefaultConcurrency
+	}
+	timeout := r.ValidatorTimeout
+	if timeout <= 0 {
+		timeout = validator.DefaultValidatorTimeout
+	}
+
+	count := 0
+	if r.Registry != nil {
+		count = len(r.Registry.Names())
+	}
+	waves := (count + concurrency - 1) / concurrency
+	worstCase := time.Duration(waves)*timeout + time.Minute
+	if worstCase < minStuckTimeout {
+		return minStuckTimeout
+	}
+	return worstCase
 }
 
 // reconcileScheduled handles scheduled assessments.
@@ -191,52 +314,54 @@ func (r *ClusterAssessmentReconciler) reconcileScheduled(ctx context.Context, as
 		return ctrl.Result{}, nil
 	}
 
-	// Parse the cron schedule
-	schedule, err := cron.ParseStandard(assessment.Spec.Schedule)
+	// Parse the schedule, time zone and blackout windows
+	sched, err := schedule.New(assessment)
 	if err != nil {
-		logger.Error(err, "Invalid cron schedule")
-		return r.updateStatus(ctx, assessment, assessmentv1alpha1.PhaseFailed,
-			fmt.Sprintf("Invalid cron schedule: %v", err))
+		logger.Error(err, "Invalid schedule")
+		message := fmt.Sprintf("Invalid schedule: %v", err)
+		r.event(assessment, corev1.EventTypeWarning, eventAssessmentFailed, "%s", message)
+		return r.updateStatus(ctx, assessment, assessmentv1alpha1.PhaseFailed, message,
+			newCondition(assessment, assessmentv1alpha1.ConditionReady, metav1.ConditionFalse,
+				assessmentv1alpha1.ReasonAssessmentFailed, message))
 	}
 
 	now := time.Now()
-
-	// Calculate next run time
-	var nextRun time.Time
-	if assessment.Status.LastRunTime != nil {
-		nextRun = schedule.Next(assessment.Status.LastRunTime.Time)
-	} else {
-		// First run - schedule for now
-		nextRun = now
+	decision := sched.Evaluate(lastScheduleTime(assessment), now)
+	if err := r.recordSchedule(ctx, assessment, decision); err != nil {
+		logger.Error(err, "Failed to update status")
+		return ctrl.Result{}, err
 	}
 
-	// Update next run time in status
-	assessment.Status.NextRunTime = &metav1.Time{Time: nextRun}
-
 	// Check if it's time to run
-	if now.Before(nextRun) {
-		// Not time yet, requeue for next run
-		requeueAfter := nextRun.Sub(now)
-		logger.Info("Scheduled assessment not due yet", "nextRun", nextRun, "requeueAfter", requeueAfter)
-		if err := r.Status().Update(ctx, assessment); err != nil {
-			logger.Error(err, "Failed to update status")
-			return ctrl.Result{}, err
+	if !decision.Due {
+		if decision.Skipped == "" {
+			logger.Info("Scheduled assessment not due yet", "nextRun", decision.NextRunTime)
 		}
-		return ctrl.Result{RequeueAfter: requeueAfter}, nil
+		return requeueAt(decision.NextRunTime, now), nil
 	}
 
 	// Time to run!
-	logger.Info("Running scheduled assessment")
-	return r.runAssessment(ctx, assessment)
+	logger.Info("Running scheduled assessment", "scheduledTime", decision.ScheduledTime)
+	return r.startRun(ctx, assessment, assessmentv1alpha1.RunTriggerSchedule, scheduledReason(decision))
 }
 
-// runAssessment executes the assessment.
-func (r *ClusterAssessmentReconciler) runAssessment(ctx context.Context, assessment *assessmentv1alpha1.ClusterAssessment) (ctrl.Result, error) {
+// runAssessment executes a run of the assessment, in the operator process or
+// in a Job.
+func (r *ClusterAssessmentReconciler) runAssessment(ctx context.Context, assessment *assessmentv1alpha1.ClusterAssessment, run *assessmentv1alpha1.AssessmentRun) (ctrl.Result, error) {
+	if err := r.beginRun(ctx, assessment, run); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to start AssessmentRun", "run", run.Name)
+		return ctrl.Result{}, err
+	}
+	r.event(assessment, corev1.EventTypeNormal, eventAssessmentStarted, "Started run %s (trigger: %s)", run.Name, run.Spec.Trigger)
+	if assessment.Spec.JobMode() {
+		return r.startAssessmentJob(ctx, assessment, run)
+	}
+
 	logger := log.FromContext(ctx)
 	startTime := time.Now()
 
 	// Update status to Running
-	if _, err := r.updateStatus(ctx, assessment, assessmentv1alpha1.PhaseRunning, "Assessment in progress"); err != nil {
+	if err := r.markRunning(ctx, assessment, run.Name, "", "Assessment in progress"); err != nil {
 		return ctrl.Result{}, err
 	}
 
@@ -245,28 +370,59 @@ func (r *ClusterAssessmentReconciler) runAssessment(ctx context.Context, assessm
 	profile, err := resolver.Resolve(ctx, assessment.Spec.Profile)
 	if err != nil {
 		logger.Error(err, "Failed to resolve profile", "profile", assessment.Spec.Profile)
-		return r.updateStatus(ctx, assessment, assessmentv1alpha1.PhaseFailed,
-			fmt.Sprintf("Profile resolution failed: %v", err))
+		return r.failRun(ctx, assessment, run, fmt.Sprintf("Profile resolution failed: %v", err))
 	}
 	logger.Info("Using profile", "profile", profile.Name)
 
-	// Collect cluster info
-	clusterInfo, err := r.collectClusterInfo(ctx)
+	// Collect cluster info and run validators, sharing one cluster state snapshot
+	result, err := execution.Run(ctx, r.Client, r.apiReader(), r.Registry, profile, runValidators(assessment, run),
+		validator.WithConcurrency(r.ValidatorConcurrency),
+		validator.WithValidatorTimeout(r.ValidatorTimeout))
 	if err != nil {
-		logger.Error(err, "Failed to collect cluster info")
-		// Continue anyway, cluster info is optional
+		logger.Error(err, "Assessment failed")
+		return r.failRun(ctx, assessment, run, fmt.Sprintf("Assessment failed: %v", err))
 	}
-	assessment.Status.ClusterInfo = clusterInfo
 
-	// Create validator runner
-	runner := validator.NewRunner(r.Registry, r.Client)
+	return r.completeAssessment(ctx, assessment, run, profile, result, startTime)
+}
 
-	// Run validators
-	findings, err := runner.Run(ctx, profile, assessment.Spec.Validators)
-	if err != nil {
-		logger.Error(err, "Assessment failed")
-		return r.updateStatus(ctx, assessment, assessmentv1alpha1.PhaseFailed,
-			fmt.Sprintf("Assessment failed: %v", err))
+// completeAssessment applies filtering, suppressions and scoring to the
+// validator results of a run, stores the reports and snapshot, and marks the
+// assessment and the run Completed.
+func (r *ClusterAssessmentReconciler) completeAssessment(ctx context.Context, assessment *assessmentv1alpha1.ClusterAssessment, run *assessmentv1alpha1.AssessmentRun, profile profiles.Profile, result *execution.Result, startTime time.Time) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+	findings := result.Findings
+	if run != nil && len(run.Spec.Validators) > 0 {
+		previous, err := findingstore.Load(ctx, r.apiReader(), assessment)
+		if err != nil {
+			logger.Error(err, "Failed to load previous findings, keeping those in the status")
+			previous = assessment.Status.Findings
+		}
+		findings = keepUnrunFindings(previous, findings, run)
+	}
+
+	historyLimit := 90
+	if assessment.Spec.HistoryLimit != nil {
+		historyLimit = *assessment.Spec.HistoryLimit
+	}
+
+	// Without snapshots, change events compare against the previous run's status
+	var previousStatus *assessmentv1alpha1.ClusterAssessmentStatus
+	if historyLimit <= 0 && assessment.Status.LastRunTime != nil {
+		previousStatus = assessment.Status.DeepCopy()
+		previousFindings, err := findingstore.Load(ctx, r.apiReader(), assessment)
+		if err != nil {
+			logger.Error(err, "Failed to load previous findings, comparing against those in the status")
+		} else {
+			previousStatus.Findings = previousFindings
+		}
+	}
+	clusterInfo := result.ClusterInfo
+	assessment.Status.ClusterInfo = clusterInfo
+
+	collection := result.Collection
+	if collection != nil {
+		logger.Info("Collected cluster state", "objects", collection.ObjectsScanned, "duration", collection.Duration.Duration)
 	}
 
 	// Apply severity filtering if configured
@@ -275,30 +431,99 @@ func (r *ClusterAssessmentReconciler) runAssessment(ctx context.Context, assessm
 		logger.Info("Filtered findings by severity", "minSeverity", assessment.Spec.MinSeverity, "filteredCount", len(findings))
 	}
 
-	// Apply suppression rules
-	if len(assessment.Spec.Suppressions) > 0 {
-		findings = r.applySuppressions(findings, assessment.Spec.Suppressions)
-		logger.Info("Applied suppression rules", "suppressionCount", len(assessment.Spec.Suppressions))
+	// Apply suppression rules and approved FindingExceptions
+	var unknownSuppressions []string
+	var suppressionStatuses []assessmentv1alpha1.SuppressionStatus
+	rules, exceptions, err := r.suppressionRules(ctx, assessment)
+	if err != nil {
+		logger.Error(err, "Failed to list FindingExceptions, applying spec suppressions only")
+	}
+	if len(rules) > 0 {
+		namespaceLabels, nsErr := r.namespaceLabels(ctx, rules)
+		if nsErr != nil {
+			logger.Error(nsErr, "Failed to list namespaces for suppression namespace selectors")
+		}
+		suppressionStatuses = suppression.Apply(findings, rules, namespaceLabels, time.Now())
+		logger.Info("Applied suppression rules", "suppressionCount", len(rules))
+
+		unknownSuppressions = r.unknownSuppressions(rules)
+		if len(unknownSuppressions) > 0 {
+			logger.Info("Suppression rules reference unknown check IDs", "findingIDs", unknownSuppressions)
+		}
+		r.updateExceptionStatuses(ctx, assessment.Name, exceptions, suppressionStatuses)
 	}
 
 	// Update findings
 	assessment.Status.Findings = findings
 
+	// Record how each validator ran; partial runs keep the others' entries
+	validators := keepUnrunValidators(assessment.Status.Validators, result.Validators, run)
+	assessment.Status.Validators = validators
+
 	// Calculate summary
-	assessment.Status.Summary = r.calculateSummary(findings, string(profile.Name))
+	assessment.Status.Summary = r.calculateSummary(findings, profile, validators)
+
+	// Conditions recorded with the completed status; steps that are not
+	// configured have their condition removed
+	var conditions []metav1.Condition
+	var unsetConditions []string
+	if failed := failedValidators(result.Validators); len(failed) > 0 {
+		message := fmt.Sprintf("Validators could not run: %s", strings.Join(failed, ", "))
+		conditions = append(conditions, newCondition(assessment, assessmentv1alpha1.ConditionValidatorsDegraded,
+			metav1.ConditionTrue, assessmentv1alpha1.ReasonValidatorsFailed, message))
+		r.event(assessment, corev1.EventTypeWarning, eventValidatorsFailed, "%s", message)
+	} else {
+		conditions = append(conditions, newCondition(assessment, assessmentv1alpha1.ConditionValidatorsDegraded,
+			metav1.ConditionFalse, assessmentv1alpha1.ReasonValidatorsSucceeded, "All validators ran"))
+	}
+	if historyLimit <= 0 {
+		unsetConditions = append(unsetConditions, assessmentv1alpha1.ConditionHistoryRecorded)
+	}
 
 	// Generate and store report
 	if assessment.Spec.ReportStorage.ConfigMap != nil && assessment.Spec.ReportStorage.ConfigMap.Enabled {
 		if err := r.storeReportInConfigMap(ctx, assessment); err != nil {
 			logger.Error(err, "Failed to store report in ConfigMap")
+			conditions = append(conditions, newCondition(assessment, assessmentv1alpha1.ConditionReportStored,
+				metav1.ConditionFalse, assessmentv1alpha1.ReasonStoreFailed, err.Error()))
+			r.event(assessment, corev1.EventTypeWarning, eventReportStoreFailed, "Failed to store report: %v", err)
+		} else {
+			conditions = append(conditions, newCondition(assessment, assessmentv1alpha1.ConditionReportStored,
+				metav1.ConditionTrue, assessmentv1alpha1.ReasonStored,
+				fmt.Sprintf("Report stored in ConfigMap %s", assessment.Status.ReportConfigMap)))
+			r.pruneReports(ctx, assessment)
 		}
+	} else {
+		unsetConditions = append(unsetConditions, assessmentv1alpha1.ConditionReportStored)
 	}
 
 	// Export to Git if configured
 	if assessment.Spec.ReportStorage.Git != nil && assessment.Spec.ReportStorage.Git.Enabled {
 		if err := r.exportToGit(ctx, assessment); err != nil {
 			logger.Error(err, "Failed to export report to Git")
+			conditions = append(conditions, newCondition(assessment, assessmentv1alpha1.ConditionGitExported,
+				metav1.ConditionFalse, assessmentv1alpha1.ReasonExportFailed, err.Error()))
+			r.event(assessment, corev1.EventTypeWarning, eventGitExportFailed, "Failed to export report to Git: %v", err)
+		} else {
+			conditions = append(conditions, newCondition(assessment, assessmentv1alpha1.ConditionGitExported,
+				metav1.ConditionTrue, assessmentv1alpha1.ReasonExported,
+				fmt.Sprintf("Report exported to %s", assessment.Spec.ReportStorage.Git.URL)))
+		}
+	} else {
+		unsetConditions = append(unsetConditions, assessmentv1alpha1.ConditionGitExported)
+	}
+
+	// Store the full findings outside the status if configured
+	statusFindings := findings
+	var findingsRef *assessmentv1alpha1.FindingsReference
+	if findingstore.Enabled(assessment) {
+		findingsRef, err = findingstore.Store(ctx, r.Client, r.OperatorNamespace, assessment.Name, findings)
+		if err != nil {
+			logger.Error(err, "Failed to store findings")
+			return r.failRun(ctx, assessment, run, fmt.Sprintf("Failed to store findings: %v", err))
 		}
+		statusFindings = findingstore.Top(findings, findingstore.StatusLimit(assessment))
+		logger.Info("Stored findings in ConfigMaps", "configMaps", len(findingsRef.ConfigMaps), "statusFindings", len(statusFindings))
 	}
 
 	// Update status to Completed with retry on conflict
@@ -314,21 +539,23 @@ func (r *ClusterAssessmentReconciler) runAssessment(ctx context.Context, assessm
 		latest.Status.LastRunTime = &now
 		latest.Status.Phase = assessmentv1alpha1.PhaseCompleted
 		latest.Status.Message = fmt.Sprintf("Assessment completed with %d findings", len(findings))
+		if len(unknownSuppressions) > 0 {
+			latest.Status.Message += fmt.Sprintf(" (suppressions reference unknown check IDs: %s)",
+				strings.Join(unknownSuppressions, ", "))
+		}
 		latest.Status.ClusterInfo = clusterInfo
-		latest.Status.Findings = findings
-		latest.Status.Summary = r.calculateSummary(findings, string(profile.Name))
+		latest.Status.Findings = statusFindings
+		latest.Status.FindingsRef = findingsRef
+		latest.Status.Summary = r.calculateSummary(findings, profile, validators)
+		latest.Status.Validators = validators
 		latest.Status.ReportConfigMap = assessment.Status.ReportConfigMap
+		latest.Status.Suppressions = suppressionStatuses
+		latest.Status.Collection = collection
 
 		// Update conditions
-		latest.Status.Conditions = []metav1.Condition{
-			{
-				Type:               "Ready",
-				Status:             metav1.ConditionTrue,
-				LastTransitionTime: now,
-				Reason:             "AssessmentCompleted",
-				Message:            latest.Status.Message,
-			},
-		}
+		ready := newCondition(assessment, assessmentv1alpha1.ConditionReady, metav1.ConditionTrue,
+			assessmentv1alpha1.ReasonAssessmentCompleted, latest.Status.Message)
+		setConditions(&latest.Status, append(conditions, ready), unsetConditions...)
 
 		return r.Status().Update(ctx, latest)
 	})
@@ -337,18 +564,35 @@ func (r *ClusterAssessmentReconciler) runAssessment(ctx context.Context, assessm
 		return ctrl.Result{}, err
 	}
 
-	// Create historical snapshot if tracking is enabled
-	historyLimit := 90
-	if assessment.Spec.HistoryLimit != nil {
-		historyLimit = *assessment.Spec.HistoryLimit
+	// Remove the findings ConfigMaps of earlier runs
+	if err := findingstore.Prune(ctx, r.Client, r.OperatorNamespace, assessment.Name, findingsRef); err != nil {
+		logger.Error(err, "Failed to prune findings ConfigMaps")
 	}
+
+	// Create historical snapshot if tracking is enabled
+	snapshotName := ""
 	if historyLimit > 0 {
 		snapshotMgr := history.NewSnapshotManager(r.Client)
-		delta, snapshotCount, snapErr := snapshotMgr.CreateSnapshot(ctx, assessment)
+		snapshot, snapshotCount, snapErr := snapshotMgr.CreateSnapshot(ctx, assessment)
 		if snapErr != nil {
 			logger.Error(snapErr, "Failed to create assessment snapshot")
+			r.event(assessment, corev1.EventTypeWarning, eventHistoryRecordFailed, "Failed to record snapshot: %v", snapErr)
+			_ = retry.RetryOnConflict(retry.DefaultRetry, func() error {
+				latest := &assessmentv1alpha1.ClusterAssessment{}
+				if err := r.Get(ctx, client.ObjectKeyFromObject(assessment), latest); err != nil {
+					return err
+				}
+				setConditions(&latest.Status, []metav1.Condition{
+					newCondition(assessment, assessmentv1alpha1.ConditionHistoryRecorded, metav1.ConditionFalse,
+						assessmentv1alpha1.ReasonRecordFailed, snapErr.Error()),
+				})
+				return r.Status().Update(ctx, latest)
+			})
 		} else {
-			// Update delta and snapshot count in status
+			snapshotName = snapshot.Name
+			delta := snapshot.Status.Delta
+
+			// Update delta, snapshot count and history condition in status
 			_ = retry.RetryOnConflict(retry.DefaultRetry, func() error {
 				latest := &assessmentv1alpha1.ClusterAssessment{}
 				if err := r.Get(ctx, client.ObjectKeyFromObject(assessment), latest); err != nil {
@@ -356,8 +600,13 @@ func (r *ClusterAssessmentReconciler) runAssessment(ctx context.Context, assessm
 				}
 				latest.Status.Delta = delta
 				latest.Status.SnapshotCount = snapshotCount
+				setConditions(&latest.Status, []metav1.Condition{
+					newCondition(assessment, assessmentv1alpha1.ConditionHistoryRecorded, metav1.ConditionTrue,
+						assessmentv1alpha1.ReasonRecorded, fmt.Sprintf("Recorded snapshot %s", snapshotName)),
+				})
 				return r.Status().Update(ctx, latest)
 			})
+			r.recordDeltaEvents(assessment, findings, delta)
 
 			// Record trend metrics
 			if delta != nil {
@@ -370,20 +619,19 @@ func (r *ClusterAssessmentReconciler) runAssessment(ctx context.Context, assessm
 				)
 			}
 		}
+	} else if previousStatus != nil {
+		r.recordDeltaEvents(assessment, findings, history.StatusDelta(findings, assessment.Status.Summary, previousStatus))
 	}
 
 	// Record Prometheus metrics
 	duration := time.Since(startTime).Seconds()
-	summary := r.calculateSummary(findings, string(profile.Name))
-	score := 0
-	if summary.Score != nil {
-		score = *summary.Score
-	}
+	summary := r.calculateSummary(findings, profile, validators)
 	metrics.RecordAssessmentMetrics(
 		assessment.Name,
 		string(profile.Name),
-		score,
+		summary.Score,
 		summary.PassCount, summary.WarnCount, summary.FailCount, summary.InfoCount,
+		summary.NotApplicableCount, summary.ErrorCount, summary.SkippedCount,
 		float64(time.Now().Unix()),
 		duration,
 	)
@@ -395,114 +643,55 @@ func (r *ClusterAssessmentReconciler) runAssessment(ctx context.Context, assessm
 	)
 	// Record per-validator metrics
 	r.recordValidatorMetrics(assessment.Name, findings)
+	for _, v := range result.Validators {
+		metrics.RecordValidatorRun(assessment.Name, v.Name, string(validatorOutcome(v)), v.Duration.Seconds())
+	}
+	if collection != nil {
+		objects := make(map[string]int, len(collection.Resources))
+		for _, rs := range collection.Resources {
+			objects[rs.Resource] = rs.Objects
+		}
+		metrics.RecordCollectionMetrics(assessment.Name, objects, collection.Duration.Seconds())
+	}
+
+	// Record the outcome of the run
+	completionTime := metav1.Now()
+	if err := r.updateRunStatus(ctx, run, func(status *assessmentv1alpha1.AssessmentRunStatus) {
+		status.Phase = assessmentv1alpha1.PhaseCompleted
+		status.CompletionTime = &completionTime
+		status.Profile = string(profile.Name)
+		status.ResolvedProfile = resolvedProfile(profile)
+		status.Validators = result.Validators
+		status.Summary = &summary
+		status.ReportConfigMap = assessment.Status.ReportConfigMap
+		status.SnapshotName = snapshotName
+		status.Message = fmt.Sprintf("Assessment completed with %d findings", len(findings))
+	}); err != nil {
+		logger.Error(err, "Failed to update AssessmentRun status", "run", run.Name)
+	}
+	r.pruneRuns(ctx, assessment)
 
+	if summary.Score != nil {
+		r.event(assessment, corev1.EventTypeNormal, eventAssessmentCompleted,
+			"Assessment completed with score %d (%d FAIL, %d WARN)", *summary.Score, summary.FailCount, summary.WarnCount)
+	} else {
+		r.event(assessment, corev1.EventTypeNormal, eventAssessmentCompleted,
+			"Assessment completed, not scored (%d FAIL, %d WARN)", summary.FailCount, summary.WarnCount)
+	}
 	logger.Info("Assessment completed", "findings", len(findings), "duration", duration)
 
 	// If scheduled, requeue for next run
 	if assessment.Spec.Schedule != "" {
-		schedule, _ := cron.ParseStandard(assessment.Spec.Schedule)
-		now := time.Now()
-		nextRun := schedule.Next(now)
-		requeueAfter := nextRun.Sub(now)
-		return ctrl.Result{RequeueAfter: requeueAfter}, nil
+		return nextScheduledReconcile(assessment), nil
 	}
 
 	return ctrl.Result{}, nil
 }
 
-// collectClusterInfo gathers metadata about the cluster.
-func (r *ClusterAssessmentReconciler) collectClusterInfo(ctx context.Context) (assessmentv1alpha1.ClusterInfo, error) {
-	info := assessmentv1alpha1.ClusterInfo{}
-
-	// Get ClusterVersion
-	cv := &configv1.ClusterVersion{}
-	if err := r.Get(ctx, client.ObjectKey{Name: "version"}, cv); err == nil {
-		info.ClusterID = string(cv.Spec.ClusterID)
-		if len(cv.Status.History) > 0 {
-			info.ClusterVersion = cv.Status.History[0].Version
-		}
-		info.Channel = cv.Spec.Channel
-	}
-
-	// Get Infrastructure
-	infra := &configv1.Infrastructure{}
-	if err := r.Get(ctx, client.ObjectKey{Name: "cluster"}, infra); err == nil {
-		info.Platform = string(infra.Status.PlatformStatus.Type)
-	}
-
-	// Count nodes
-	// Optimization: Use PartialObjectMetadataList to avoid fetching full Node objects (Status, Spec)
-	// when we only need labels. This significantly reduces memory usage and API bandwidth.
-	nodes := &metav1.PartialObjectMetadataList{}
-	nodes.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "",
-		Version: "v1",
-		Kind:    "NodeList",
-	})
-	if err := r.List(ctx, nodes); err == nil {
-		info.NodeCount = len(nodes.Items)
-		for _, node := range nodes.Items {
-			if _, ok := node.Labels["node-role.kubernetes.io/master"]; ok {
-				info.ControlPlaneNodes++
-			}
-			if _, ok := node.Labels["node-role.kubernetes.io/control-plane"]; ok {
-				info.ControlPlaneNodes++
-			}
-			if _, ok := node.Labels["node-role.kubernetes.io/worker"]; ok {
-				info.WorkerNodes++
-			}
-		}
-	}
-
-	return info, nil
-}
-
-// calculateSummary computes the assessment summary from findings.
-func (r *ClusterAssessmentReconciler) calculateSummary(findings []assessmentv1alpha1.Finding, profileName string) assessmentv1alpha1.AssessmentSummary {
-	summary := assessmentv1alpha1.AssessmentSummary{
-		TotalChecks: len(findings),
-		ProfileUsed: profileName,
-	}
-
-	for _, f := range findings {
-		switch f.Status {
-		case assessmentv1alpha1.FindingStatusPass:
-			summary.PassCount++
-		case assessmentv1alpha1.FindingStatusWarn:
-			summary.WarnCount++
-		case assessmentv1alpha1.FindingStatusFail:
-			summary.FailCount++
-		case assessmentv1alpha1.FindingStatusInfo:
-			summary.InfoCount++
-		}
-	}
-
-	// Calculate a simple score (0-100)
-	// Suppressed findings are excluded from score calculation
-	scoredChecks := 0
-	scoredWeight := 0
-	for _, f := range findings {
-		if f.Suppressed {
-			continue
-		}
-		scoredChecks++
-		switch f.Status {
-		case assessmentv1alpha1.FindingStatusPass:
-			scoredWeight += 100
-		case assessmentv1alpha1.FindingStatusInfo:
-			scoredWeight += 80
-		case assessmentv1alpha1.FindingStatusWarn:
-			scoredWeight += 50
-		case assessmentv1alpha1.FindingStatusFail:
-			// 0
-		}
-	}
-	if scoredChecks > 0 {
-		score := scoredWeight / scoredChecks
-		summary.Score = &score
-	}
-
-	return summary
+// calculateSummary computes the assessment summary from findings, leaving
+// the validators that could not run out of the score.
+func (r *ClusterAssessmentReconciler) calculateSummary(findings []assessmentv1alpha1.Finding, profile profiles.Profile, validators []assessmentv1alpha1.ValidatorRunStatus) assessmentv1alpha1.AssessmentSummary {
+	return scoring.CalculateSummaryWithStatus(findings, profile, validators)
 }
 
 // storeReportInConfigMap creates a ConfigMap with the full report.
@@ -576,6 +765,7 @@ func (r *ClusterAssessmentReconciler) storeReportInConfigMap(ctx context.Context
 			Labels: map[string]string{
 				"app.kubernetes.io/name":       "cluster-assessment-operator",
 				"app.kubernetes.io/managed-by": "cluster-assessment-operator",
+				"app.kubernetes.io/component":  reportComponent,
 				"assessment.openshift.io/name": assessment.Name,
 			},
 		},
@@ -585,7 +775,8 @@ func (r *ClusterAssessmentReconciler) storeReportInConfigMap(ctx context.Context
 
 	// Note: ClusterAssessment is cluster-scoped, so we cannot set a standard
 	// owner reference on a namespace-scoped ConfigMap. We use labels instead
-	// to track the relationship.
+	// to track the relationship; the cleanup finalizer deletes labeled
+	// ConfigMaps with the assessment.
 
 	// Create or update
 	existingCM := &corev1.ConfigMap{}
@@ -781,8 +972,9 @@ func (r *ClusterAssessmentReconciler) exportToGit(ctx context.Context, assessmen
 	return nil
 }
 
-// updateStatus updates the assessment status with retry on conflict.
-func (r *ClusterAssessmentReconciler) updateStatus(ctx context.Context, assessment *assessmentv1alpha1.ClusterAssessment, phase, message string) (ctrl.Result, error) {
+// updateStatus updates the assessment phase, message and the given
+// conditions with retry on conflict.
+func (r *ClusterAssessmentReconciler) updateStatus(ctx context.Context, assessment *assessmentv1alpha1.ClusterAssessment, phase, message string, conditions ...metav1.Condition) (ctrl.Result, error) {
 	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
 		// Fetch latest version
 		latest := &assessmentv1alpha1.ClusterAssessment{}
@@ -791,6 +983,7 @@ func (r *ClusterAssessmentReconciler) updateStatus(ctx context.Context, assessme
 		}
 		latest.Status.Phase = phase
 		latest.Status.Message = message
+		setConditions(&latest.Status, conditions)
 		return r.Status().Update(ctx, latest)
 	})
 	if err != nil {
@@ -799,16 +992,50 @@ func (r *ClusterAssessmentReconciler) updateStatus(ctx context.Context, assessme
 	// Update the local copy
 	assessment.Status.Phase = phase
 	assessment.Status.Message = message
+	setConditions(&assessment.Status, conditions)
 	return ctrl.Result{}, nil
 }
 
+// markRunning moves the assessment to the Running phase, recording the current
+// AssessmentRun and the Job executing it, or clearing the Job for runs in the
+// operator process.
+func (r *ClusterAssessmentReconciler) markRunning(ctx context.Context, assessment *assessmentv1alpha1.ClusterAssessment, runName, jobName, message string) error {
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		latest := &assessmentv1alpha1.ClusterAssessment{}
+		if err := r.Get(ctx, client.ObjectKeyFromObject(assessment), latest); err != nil {
+			return err
+		}
+		latest.Status.Phase = assessmentv1alpha1.PhaseRunning
+		latest.Status.Message = message
+		latest.Status.JobName = jobName
+		latest.Status.LastRunName = runName
+		return r.Status().Update(ctx, latest)
+	})
+	if err != nil {
+		return err
+	}
+	assessment.Status.Phase = assessmentv1alpha1.PhaseRunning
+	assessment.Status.Message = message
+	assessment.Status.JobName = jobName
+	assessment.Status.LastRunName = runName
+	return nil
+}
+
 // recordValidatorMetrics records metrics for each validator
 func (r *ClusterAssessmentReconciler) recordValidatorMetrics(assessmentName string, findings []assessmentv1alpha1.Finding) {
 	// Group findings by validator
 	validatorCounts := make(map[string]map[string]int)
 	categoryCounts := make(map[string]map[string]int)
+	severityCounts := make(map[assessmentv1alpha1.FindingSeverity]int)
 
 	for _, f := range findings {
+		// By severity, counting only open issues
+		switch f.Status {
+		case assessmentv1alpha1.FindingStatusPass, assessmentv1alpha1.FindingStatusNotApplicable, assessmentv1alpha1.FindingStatusSkipped:
+		default:
+			severityCounts[f.Severity]++
+		}
+
 		// By validator
 		if validatorCounts[f.Validator] == nil {
 			validatorCounts[f.Validator] = make(map[string]int)
@@ -827,29 +1054,61 @@ func (r *ClusterAssessmentReconciler) recordValidatorMetrics(assessmentName stri
 		metrics.RecordValidatorMetrics(
 			assessmentName, validator,
 			counts["PASS"], counts["WARN"], counts["FAIL"], counts["INFO"],
+			counts["NOT_APPLICABLE"], counts["ERROR"], counts["SKIPPED"],
 		)
 	}
 
+	// Record severity metrics
+	metrics.RecordSeverityMetrics(
+		assessmentName,
+		severityCounts[assessmentv1alpha1.SeverityLow], severityCounts[assessmentv1alpha1.SeverityMedium],
+		severityCounts[assessmentv1alpha1.SeverityHigh], severityCounts[assessmentv1alpha1.SeverityCritical],
+	)
+
 	// Record category metrics
 	for category, counts := range categoryCounts {
 		metrics.RecordCategoryMetrics(
 			assessmentName, category,
 			counts["PASS"], counts["WARN"], counts["FAIL"], counts["INFO"],
+			counts["NOT_APPLICABLE"], counts["ERROR"], counts["SKIPPED"],
 		)
 	}
 }
 
 // severityOrder defines the precedence of finding statuses.
 var severityOrder = map[string]int{
-	"INFO": 0,
-	"PASS": 1,
-	"WARN": 2,
-	"FAIL": 3,
+	"NOT_APPLICABLE": 0,
+	"INFO":           0,
+	"PASS":           1,
+	"ERROR":          2,
+	"WARN":           2,
+	"FAIL":           3,
 }
 
 // filterBySeverity filters findings to only include those at or above the minimum severity.
-// Severity order (from lowest to highest): INFO < PASS < WARN < FAIL
+// Status order (from lowest to highest): NOT_APPLICABLE, INFO < PASS < ERROR, WARN < FAIL.
+// A finding severity (low < medium < high < critical) instead keeps the
+// findings that are neither PASS nor NOT_APPLICABLE and whose severity is at
+// least that level. SKIPPED findings are always kept, since they explain why
+// results are missing.
 func (r *ClusterAssessmentReconciler) filterBySeverity(findings []assessmentv1alpha1.Finding, minSeverity string) []assessmentv1alpha1.Finding {
+	if minRisk := assessmentv1alpha1.SeverityLevel(assessmentv1alpha1.FindingSeverity(minSeverity)); minRisk > 0 {
+		filtered := make([]assessmentv1alpha1.Finding, 0, len(findings))
+		for _, f := range findings {
+			if f.Status == assessmentv1alpha1.FindingStatusSkipped {
+				filtered = append(filtered, f)
+				continue
+			}
+			if f.Status == assessmentv1alpha1.FindingStatusPass || f.Status == assessmentv1alpha1.FindingStatusNotApplicable {
+				continue
+			}
+			if assessmentv1alpha1.SeverityLevel(f.Severity) >= minRisk {
+				filtered = append(filtered, f)
+			}
+		}
+		return filtered
+	}
+
 	minLevel, ok := severityOrder[minSeverity]
 	if !ok {
 		// Invalid minSeverity, return all findings
@@ -858,6 +1117,10 @@ func (r *ClusterAssessmentReconciler) filterBySeverity(findings []assessmentv1al
 
 	filtered := make([]assessmentv1alpha1.Finding, 0, len(findings))
 	for _, f := range findings {
+		if f.Status == assessmentv1alpha1.FindingStatusSkipped {
+			filtered = append(filtered, f)
+			continue
+		}
 		level, ok := severityOrder[string(f.Status)]
 		if !ok {
 			continue
@@ -870,42 +1133,152 @@ func (r *ClusterAssessmentReconciler) filterBySeverity(findings []assessmentv1al
 	return filtered
 }
 
-// applySuppressions marks findings that match suppression rules.
-// Suppressed findings remain in the list but are flagged and excluded from scoring.
-func (r *ClusterAssessmentReconciler) applySuppressions(findings []assessmentv1alpha1.Finding, rules []assessmentv1alpha1.SuppressionRule) []assessmentv1alpha1.Finding {
-	now := time.Now()
+// suppressionRules returns the suppression rules from the assessment spec
+// followed by those of the FindingExceptions that target the assessment.
+// The matching exceptions are returned so their status can be updated.
+func (r *ClusterAssessmentReconciler) suppressionRules(ctx context.Context, assessment *assessmentv1alpha1.ClusterAssessment) ([]suppression.Rule, []assessmentv1alpha1.FindingException, error) {
+	rules := suppression.FromSpec(assessment.Spec.Suppressions)
 
-	// Build active suppression map (exclude expired rules)
-	suppressions := make(map[string]string, len(rules))
-	for _, rule := range rules {
-		if rule.ExpiresAt != nil && rule.ExpiresAt.Time.Before(now) {
-			continue // Expired suppression
+	exceptionList := &assessmentv1alpha1.FindingExceptionList{}
+	if err := r.List(ctx, exceptionList); err != nil {
+		return rules, nil, err
+	}
+	var exceptions []assessmentv1alpha1.FindingException
+	for _, e := range exceptionList.Items {
+		if e.Spec.AppliesTo(assessment.Name) {
+			exceptions = append(exceptions, e)
 		}
-		suppressions[rule.FindingID] = rule.Reason
+	}
+	return append(rules, suppression.FromExceptions(exceptions, assessment.Name)...), exceptions, nil
+}
+
+// namespaceLabels returns the labels of every namespace, keyed by name, when a
+// rule selects namespaces by label.
+func (r *ClusterAssessmentReconciler) namespaceLabels(ctx context.Context, rules []suppression.Rule) (map[string]map[string]string, error) {
+	if !suppression.NeedsNamespaceLabels(rules) {
+		return nil, nil
+	}
+	nsList := &corev1.NamespaceList{}
+	if err := r.List(ctx, nsList); err != nil {
+		return nil, err
+	}
+	result := make(map[string]map[string]string, len(nsList.Items))
+	for _, ns := range nsList.Items {
+		result[ns.Name] = ns.Labels
+	}
+	return result, nil
+}
+
+// updateExceptionStatuses records the outcome of each FindingException in its
+// status, under the entry for this assessment.
+func (r *ClusterAssessmentReconciler) updateExceptionStatuses(ctx context.Context, assessmentName string, exceptions []assessmentv1alpha1.FindingException, statuses []assessmentv1alpha1.SuppressionStatus) {
+	logger := log.FromContext(ctx)
+
+	bySource := make(map[string]assessmentv1alpha1.SuppressionStatus, len(statuses))
+	for _, s := range statuses {
+		bySource[s.Source] = s
 	}
 
-	for i := range findings {
-		if reason, ok := suppressions[findings[i].ID]; ok {
-			findings[i].Suppressed = true
-			findings[i].SuppressionReason = reason
+	now := metav1.Now()
+	for _, e := range exceptions {
+		s, ok := bySource[suppression.ExceptionSource(e.Name)]
+		if !ok {
+			continue
+		}
+		entry := assessmentv1alpha1.ExceptionAssessmentStatus{
+			Name:              assessmentName,
+			State:             s.State,
+			MatchedFindings:   s.MatchedFindings,
+			LastEvaluatedTime: &now,
+			Message:           s.Message,
+		}
+		err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+			latest := &assessmentv1alpha1.FindingException{}
+			if err := r.Get(ctx, client.ObjectKeyFromObject(&e), latest); err != nil {
+				return err
+			}
+			replaced := false
+			for i := range latest.Status.Assessments {
+				if latest.Status.Assessments[i].Name == assessmentName {
+					latest.Status.Assessments[i] = entry
+					replaced = true
+				}
+			}
+			if !replaced {
+				latest.Status.Assessments = append(latest.Status.Assessments, entry)
+			}
+			return r.Status().Update(ctx, latest)
+		})
+		if err != nil {
+			logger.Error(err, "Failed to update FindingException status", "exception", e.Name)
 		}
 	}
+}
 
-	return findings
+// unknownSuppressions returns the finding IDs of suppression rules that do not
+// match any check in the validator catalog. Rules without a finding ID are
+// skipped, and a wildcard ID is known if any check ID starts with its prefix.
+func (r *ClusterAssessmentReconciler) unknownSuppressions(rules []suppression.Rule) []string {
+	var unknown []string
+	for _, rule := range rules {
+		if rule.FindingID == "" || r.Registry.KnownCheckID(rule.FindingID) {
+			continue
+		}
+		unknown = append(unknown, rule.FindingID)
+	}
+	return unknown
 }
 
 // SetupWithManager sets up the controller with the Manager.
 func (r *ClusterAssessmentReconciler) SetupWithManager(mgr ctrl.Manager) error {
-	return ctrl.NewControllerManagedBy(mgr).
+	r.triggers = trigger.NewTracker()
+
+	b := ctrl.NewControllerManagedBy(mgr).
 		For(&assessmentv1alpha1.ClusterAssessment{}).
 		Owns(&corev1.ConfigMap{}).
-		Watches(&assessmentv1alpha1.AssessmentProfile{},
-			handler.EnqueueRequestsFromMapFunc(r.findAssessmentsForProfile)).
-		Complete(r)
+		Watches(&assessmentv1alpha1.AssessmentRun{},
+			handler.EnqueueRequestsFromMapFunc(r.findAssessmentForRun)).
+		Watches(&batchv1.Job{},
+			handler.EnqueueRequestsFromMapFunc(r.findAssessmentForJob)).
+		Watches(&assessmentv1alpha1.AssessmentProfile{}, &triggerHandler{
+			r:         r,
+			eventType: assessmentv1alpha1.TriggerProfileChange,
+			detect:    profileChanged,
+			mapFunc:   r.findAssessmentsForProfile,
+		}).
+		Watches(&corev1.Namespace{}, &triggerHandler{
+			r:         r,
+			eventType: assessmentv1alpha1.TriggerNamespaceCreated,
+			detect:    r.namespaceCreated,
+		})
+
+	// ClusterVersion and MachineConfigPool are only served by OpenShift
+	openshiftTriggers := []struct {
+		obj       client.Object
+		eventType assessmentv1alpha1.TriggerEventType
+		detect    changeDetector
+	}{
+		{&configv1.ClusterVersion{}, assessmentv1alpha1.TriggerClusterUpgrade, clusterUpgraded},
+		{&machineconfig.MachineConfigPool{}, assessmentv1alpha1.TriggerMachineConfigPoolUpdate, machineConfigPoolUpdated},
+	}
+	for _, t := range openshiftTriggers {
+		served, err := servedKind(mgr, t.obj)
+		if err != nil {
+			return err
+		}
+		if !served {
+			mgr.GetLogger().Info("Kind not served by the cluster, trigger disabled", "trigger", t.eventType)
+			continue
+		}
+		b = b.Watches(t.obj, &triggerHandler{r: r, eventType: t.eventType, detect: t.detect})
+	}
+
+	return b.Complete(r)
 }
 
 // findAssessmentsForProfile returns reconcile requests for all ClusterAssessments
-// that reference the given AssessmentProfile.
+// that reference the given AssessmentProfile. Profile changes start a run of the
+// assessments with a ProfileChange trigger; see recordTrigger.
 func (r *ClusterAssessmentReconciler) findAssessmentsForProfile(ctx context.Context, obj client.Object) []ctrl.Request {
 	logger := log.FromContext(ctx)
 	profileName := obj.GetName()
@@ -20,6 +20,8 @@ import (
 	"testing"
 
 	assessmentv1alpha1 "github.com/openshift-assessment/cluster-assessment-operator/api/v1alpha1"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/profiles"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/suppression"
 )
 
 func TestFilterBySeverity(t *testing.T) {
@@ -87,6 +89,50 @@ func TestFilterBySeverity(t *testing.T) {
 	}
 }
 
+func TestFilterBySeverity_FindingSeverity(t *testing.T) {
+	r := &ClusterAssessmentReconciler{}
+
+	findings := []assessmentv1alpha1.Finding{
+		{ID: "pass-critical", Status: assessmentv1alpha1.FindingStatusPass, Severity: assessmentv1alpha1.SeverityCritical},
+		{ID: "fail-critical", Status: assessmentv1alpha1.FindingStatusFail, Severity: assessmentv1alpha1.SeverityCritical},
+		{ID: "fail-low", Status: assessmentv1alpha1.FindingStatusFail, Severity: assessmentv1alpha1.SeverityLow},
+		{ID: "warn-high", Status: assessmentv1alpha1.FindingStatusWarn, Severity: assessmentv1alpha1.SeverityHigh},
+		{ID: "info-medium", Status: assessmentv1alpha1.FindingStatusInfo, Severity: assessmentv1alpha1.SeverityMedium},
+		{ID: "warn-unset", Status: assessmentv1alpha1.FindingStatusWarn},
+		{ID: "nodes-skipped", Status: assessmentv1alpha1.FindingStatusSkipped, Severity: assessmentv1alpha1.SeverityMedium},
+		{ID: "backup-not-installed", Status: assessmentv1alpha1.FindingStatusNotApplicable, Severity: assessmentv1alpha1.SeverityHigh},
+		{ID: "nodes-error", Status: assessmentv1alpha1.FindingStatusError, Severity: assessmentv1alpha1.SeverityMedium},
+	}
+
+	// SKIPPED findings are kept at every level, NOT_APPLICABLE ones never are
+	tests := []struct {
+		minSeverity string
+		wantIDs     []string
+	}{
+		{minSeverity: "critical", wantIDs: []string{"fail-critical", "nodes-skipped"}},
+		{minSeverity: "high", wantIDs: []string{"fail-critical", "warn-high", "nodes-skipped"}},
+		{minSeverity: "medium", wantIDs: []string{"fail-critical", "warn-high", "info-medium", "nodes-skipped", "nodes-error"}},
+		{minSeverity: "low", wantIDs: []string{"fail-critical", "fail-low", "warn-high", "info-medium", "nodes-skipped", "nodes-error"}},
+		{minSeverity: "FAIL", wantIDs: []string{"fail-critical", "fail-low", "nodes-skipped"}},
+		{minSeverity: "WARN", wantIDs: []string{"fail-critical", "fail-low", "warn-high", "warn-unset", "nodes-skipped", "nodes-error"}},
+		{minSeverity: "INFO", wantIDs: []string{"pass-critical", "fail-critical", "fail-low", "warn-high", "info-medium", "warn-unset", "nodes-skipped", "backup-not-installed", "nodes-error"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.minSeverity, func(t *testing.T) {
+			filtered := r.filterBySeverity(findings, tt.minSeverity)
+			if len(filtered) != len(tt.wantIDs) {
+				t.Fatalf("filterBySeverity(%s) returned %d findings, want %d", tt.minSeverity, len(filtered), len(tt.wantIDs))
+			}
+			for i, id := range tt.wantIDs {
+				if filtered[i].ID != id {
+					t.Errorf("filtered[%d] = %s, want %s", i, filtered[i].ID, id)
+				}
+			}
+		})
+	}
+}
+
 func TestCalculateSummary(t *testing.T) {
 	r := &ClusterAssessmentReconciler{}
 
@@ -98,7 +144,7 @@ func TestCalculateSummary(t *testing.T) {
 		{ID: "fail-1", Status: assessmentv1alpha1.FindingStatusFail},
 	}
 
-	summary := r.calculateSummary(findings, "production")
+	summary := r.calculateSummary(findings, profiles.GetProfile("production"), nil)
 
 	if summary.TotalChecks != 5 {
 		t.Errorf("Expected TotalChecks=5, got %d", summary.TotalChecks)
@@ -144,7 +190,7 @@ func TestCalculateSummary_AllPass(t *testing.T) {
 		{ID: "pass-3", Status: assessmentv1alpha1.FindingStatusPass},
 	}
 
-	summary := r.calculateSummary(findings, "production")
+	summary := r.calculateSummary(findings, profiles.GetProfile("production"), nil)
 
 	if summary.Score == nil {
 		t.Error("Expected Score to be set")
@@ -161,7 +207,7 @@ func TestCalculateSummary_AllFail(t *testing.T) {
 		{ID: "fail-2", Status: assessmentv1alpha1.FindingStatusFail},
 	}
 
-	summary := r.calculateSummary(findings, "production")
+	summary := r.calculateSummary(findings, profiles.GetProfile("production"), nil)
 
 	if summary.Score == nil {
 		t.Error("Expected Score to be set")
@@ -175,7 +221,7 @@ func TestCalculateSummary_Empty(t *testing.T) {
 
 	findings := []assessmentv1alpha1.Finding{}
 
-	summary := r.calculateSummary(findings, "production")
+	summary := r.calculateSummary(findings, profiles.GetProfile("production"), nil)
 
 	if summary.TotalChecks != 0 {
 		t.Errorf("Expected TotalChecks=0, got %d", summary.TotalChecks)
@@ -185,3 +231,20 @@ func TestCalculateSummary_Empty(t *testing.T) {
 		t.Error("Expected Score to be nil for empty findings")
 	}
 }
+
+func TestUnknownSuppressions(t *testing.T) {
+	r := &ClusterAssessmentReconciler{Registry: newTestRegistry("etcd")}
+
+	rules := suppression.FromSpec([]assessmentv1alpha1.SuppressionRule{
+		{FindingID: "etcd-error"},
+		{FindingID: "etcd-*"},
+		{FindingID: "etcd-missing"},
+		{FindingID: "other-*"},
+		{Validator: "etcd"},
+	})
+
+	unknown := r.unknownSuppressions(rules)
+	if len(unknown) != 2 || unknown[0] != "etcd-missing" || unknown[1] != "other-*" {
+		t.Errorf("Expected [etcd-missing other-*], got %v", unknown)
+	}
+}
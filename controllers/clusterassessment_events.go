@@ -0,0 +1,152 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	assessmentv1alpha1 "github.com/openshift-assessment/cluster-assessment-operator/api/v1alpha1"
+)
+
+// Event reasons emitted on ClusterAssessments.
+const (
+	eventAssessmentStarted   = "AssessmentStarted"
+	eventAssessmentCompleted = "AssessmentCompleted"
+	eventAssessmentFailed    = "AssessmentFailed"
+	eventScoreDecreased      = "ScoreDecreased"
+	eventNewFailingFindings  = "NewFailingFindings"
+	eventValidatorsFailed    = "ValidatorsFailed"
+	eventReportStoreFailed   = "ReportStoreFailed"
+	eventGitExportFailed     = "GitExportFailed"
+	eventHistoryRecordFailed = "HistoryRecordFailed"
+)
+
+// maxEventFindings is the number of finding IDs listed in an event message.
+const maxEventFindings = 5
+
+// event records a Kubernetes Event on the object if the reconciler has a recorder.
+func (r *ClusterAssessmentReconciler) event(obj runtime.Object, eventType, reason, messageFmt string, args ...interface{}) {
+	if r.Recorder == nil {
+		return
+	}
+	r.Recorder.Eventf(obj, eventType, reason, messageFmt, args...)
+}
+
+// recordDeltaEvents emits warning events for a score drop and for findings
+// that started failing since the previous snapshot.
+func (r *ClusterAssessmentReconciler) recordDeltaEvents(assessment *assessmentv1alpha1.ClusterAssessment, findings []assessmentv1alpha1.Finding, delta *assessmentv1alpha1.DeltaSummary) {
+	if delta == nil {
+		return
+	}
+	if delta.ScoreDelta != nil && *delta.ScoreDelta < 0 {
+		score := 0
+		if assessment.Status.Summary.Score != nil {
+			score = *assessment.Status.Summary.Score
+		}
+		r.event(assessment, corev1.EventTypeWarning, eventScoreDecreased,
+			"Score decreased by %d to %d", -*delta.ScoreDelta, score)
+	}
+	if failing := newFailingFindings(findings, delta); len(failing) > 0 {
+		r.event(assessment, corev1.EventTypeWarning, eventNewFailingFindings,
+			"%d new failing findings: %s", len(failing), truncatedList(failing, maxEventFindings))
+	}
+}
+
+// newFailingFindings returns the IDs of the unsuppressed FAIL findings that
+// are new or regressed since the previous snapshot.
+func newFailingFindings(findings []assessmentv1alpha1.Finding, delta *assessmentv1alpha1.DeltaSummary) []string {
+	changed := make(map[string]bool, len(delta.NewFindings)+len(delta.RegressionFindings))
+	for _, id := range delta.NewFindings {
+		changed[id] = true
+	}
+	for _, id := range delta.RegressionFindings {
+		changed[id] = true
+	}
+
+	var failing []string
+	seen := map[string]bool{}
+	for _, f := range findings {
+		if !changed[f.ID] || seen[f.ID] || f.Suppressed || f.Status != assessmentv1alpha1.FindingStatusFail {
+			continue
+		}
+		seen[f.ID] = true
+		failing = append(failing, f.ID)
+	}
+	sort.Strings(failing)
+	return failing
+}
+
+// failedValidators describes the validators that could not run, with their outcome.
+func failedValidators(validators []assessmentv1alpha1.ValidatorRunStatus) []string {
+	var failed []string
+	for _, v := range validators {
+		if !v.Ran() {
+			failed = append(failed, fmt.Sprintf("%s (%s)", v.Name, validatorOutcome(v)))
+		}
+	}
+	return failed
+}
+
+// validatorOutcome returns the outcome of a validator, deriving it from the
+// error for statuses recorded before outcomes existed.
+func validatorOutcome(v assessmentv1alpha1.ValidatorRunStatus) assessmentv1alpha1.ValidatorOutcome {
+	switch {
+	case v.Outcome != "":
+		return v.Outcome
+	case v.Ran():
+		return assessmentv1alpha1.ValidatorSucceeded
+	default:
+		return assessmentv1alpha1.ValidatorFailed
+	}
+}
+
+// truncatedList joins up to max items, noting how many were left out.
+func truncatedList(items []string, max int) string {
+	if len(items) <= max {
+		return strings.Join(items, ", ")
+	}
+	return fmt.Sprintf("%s and %d more", strings.Join(items[:max], ", "), len(items)-max)
+}
+
+// newCondition returns a condition observed for the assessment's current generation.
+func newCondition(assessment *assessmentv1alpha1.ClusterAssessment, conditionType string, status metav1.ConditionStatus, reason, message string) metav1.Condition {
+	return metav1.Condition{
+		Type:               conditionType,
+		Status:             status,
+		ObservedGeneration: assessment.Generation,
+		Reason:             reason,
+		Message:            message,
+	}
+}
+
+// setConditions sets the conditions in the status and removes the unset
+// condition types, which belong to steps the assessment does not configure.
+func setConditions(status *assessmentv1alpha1.ClusterAssessmentStatus, conditions []metav1.Condition, unset ...string) {
+	for _, c := range conditions {
+		meta.SetStatusCondition(&status.Conditions, c)
+	}
+	for _, t := range unset {
+		meta.RemoveStatusCondition(&status.Conditions, t)
+	}
+}
@@ -0,0 +1,314 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/prometheus/client_golang/prometheus"
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/record"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	assessmentv1alpha1 "github.com/openshift-assessment/cluster-assessment-operator/api/v1alpha1"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/execution"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/metrics"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/scoring"
+)
+
+// drainEvents returns the events recorded so far.
+func drainEvents(recorder *record.FakeRecorder) []string {
+	var events []string
+	for {
+		select {
+		case e := <-recorder.Events:
+			events = append(events, e)
+		default:
+			return events
+		}
+	}
+}
+
+func hasEvent(events []string, prefix string) bool {
+	for _, e := range events {
+		if strings.HasPrefix(e, prefix) {
+			return true
+		}
+	}
+	return false
+}
+
+func TestNewFailingFindings(t *testing.T) {
+	findings := []assessmentv1alpha1.Finding{
+		{ID: "new-fail", Status: assessmentv1alpha1.FindingStatusFail},
+		{ID: "new-warn", Status: assessmentv1alpha1.FindingStatusWarn},
+		{ID: "regressed", Status: assessmentv1alpha1.FindingStatusFail},
+		{ID: "regressed", Status: assessmentv1alpha1.FindingStatusFail},
+		{ID: "old-fail", Status: assessmentv1alpha1.FindingStatusFail},
+		{ID: "suppressed", Status: assessmentv1alpha1.FindingStatusFail, Suppressed: true},
+	}
+	delta := &assessmentv1alpha1.DeltaSummary{
+		NewFindings:        []string{"new-fail", "new-warn", "suppressed"},
+		RegressionFindings: []string{"regressed"},
+	}
+
+	got := newFailingFindings(findings, delta)
+	want := []string{"new-fail", "regressed"}
+	if !reflect.DeepEqual(got, want) {
+		t.Errorf("Expected %v, got %v", want, got)
+	}
+}
+
+func TestTruncatedList(t *testing.T) {
+	if got := truncatedList([]string{"a", "b"}, 2); got != "a, b" {
+		t.Errorf("Expected the full list, got %q", got)
+	}
+	if got := truncatedList([]string{"a", "b", "c", "d"}, 2); got != "a, b and 2 more" {
+		t.Errorf("Expected a truncated list, got %q", got)
+	}
+}
+
+func TestRecordDeltaEvents(t *testing.T) {
+	recorder := record.NewFakeRecorder(10)
+	r := &ClusterAssessmentReconciler{Recorder: recorder}
+	score, drop := 70, -10
+	assessment := &assessmentv1alpha1.ClusterAssessment{
+		ObjectMeta: metav1.ObjectMeta{Name: "nightly"},
+		Status: assessmentv1alpha1.ClusterAssessmentStatus{
+			Summary: assessmentv1alpha1.AssessmentSummary{Score: &score},
+		},
+	}
+	findings := []assessmentv1alpha1.Finding{{ID: "security-fail", Status: assessmentv1alpha1.FindingStatusFail}}
+
+	r.recordDeltaEvents(assessment, findings, &assessmentv1alpha1.DeltaSummary{
+		NewFindings: []string{"security-fail"},
+		ScoreDelta:  &drop,
+	})
+	events := drainEvents(recorder)
+	if !hasEvent(events, "Warning ScoreDecreased Score decreased by 10 to 70") {
+		t.Errorf("Expected a score decrease event, got %v", events)
+	}
+	if !hasEvent(events, "Warning NewFailingFindings 1 new failing findings: security-fail") {
+		t.Errorf("Expected a new failing findings event, got %v", events)
+	}
+
+	// An improved score without new failures emits nothing
+	gain := 5
+	r.recordDeltaEvents(assessment, findings, &assessmentv1alpha1.DeltaSummary{ScoreDelta: &gain})
+	if events := drainEvents(recorder); len(events) != 0 {
+		t.Errorf("Expected no events, got %v", events)
+	}
+}
+
+func TestReconcileAssessmentJob_Conditions(t *testing.T) {
+	const jobName = "nightly-abc12"
+	ctx := context.Background()
+
+	setup := newJobTestReconciler()
+	result := &execution.Result{
+		Findings: []assessmentv1alpha1.Finding{
+			{ID: "security-fail", Validator: "security", Status: assessmentv1alpha1.FindingStatusFail},
+		},
+		Validators: []assessmentv1alpha1.ValidatorRunStatus{
+			{Name: "network", Outcome: assessmentv1alpha1.ValidatorTimedOut, Error: "timed out after 2m0s"},
+			{Name: "security", Outcome: assessmentv1alpha1.ValidatorSucceeded, FindingCount: 1, FailCount: 1},
+		},
+	}
+	if err := execution.WriteResult(ctx, setup.Client, testNamespace, jobName, "nightly", result); err != nil {
+		t.Fatalf("WriteResult failed: %v", err)
+	}
+	resultCM := &corev1.ConfigMap{}
+	_ = setup.Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: jobName}, resultCM)
+	resultCM.ResourceVersion = ""
+
+	assessment := jobModeAssessment(assessmentv1alpha1.PhaseRunning, jobName)
+	assessment.Generation = 3
+	assessment.Spec.ReportStorage.ConfigMap = &assessmentv1alpha1.ConfigMapStorageSpec{Enabled: true}
+	// Left over from an earlier configuration
+	assessment.Status.Conditions = []metav1.Condition{{
+		Type: assessmentv1alpha1.ConditionGitExported, Status: metav1.ConditionFalse, Reason: assessmentv1alpha1.ReasonExportFailed,
+	}}
+	r := newJobTestReconciler(assessment, finishedJob(jobName, batchv1.JobComplete, ""), resultCM)
+	recorder := record.NewFakeRecorder(10)
+	r.Recorder = recorder
+
+	if _, err := r.reconcileAssessmentJob(ctx, assessment); err != nil {
+		t.Fatalf("reconcileAssessmentJob returned error: %v", err)
+	}
+
+	latest := getAssessment(t, r)
+	if len(latest.Status.Validators) != 2 || latest.Status.Validators[0].Name != "network" {
+		t.Errorf("Expected the validator statuses in the assessment status, got %+v", latest.Status.Validators)
+	}
+	if len(latest.Status.Summary.UnscoredValidators) != 1 || latest.Status.Summary.UnscoredValidators[0] != "network" {
+		t.Errorf("Expected network to be left out of the score, got %+v", latest.Status.Summary.UnscoredValidators)
+	}
+
+	conditions := latest.Status.Conditions
+	for _, want := range []struct {
+		conditionType string
+		status        metav1.ConditionStatus
+		reason        string
+	}{
+		{assessmentv1alpha1.ConditionReady, metav1.ConditionTrue, assessmentv1alpha1.ReasonAssessmentCompleted},
+		{assessmentv1alpha1.ConditionReportStored, metav1.ConditionTrue, assessmentv1alpha1.ReasonStored},
+		{assessmentv1alpha1.ConditionValidatorsDegraded, metav1.ConditionTrue, assessmentv1alpha1.ReasonValidatorsFailed},
+	} {
+		c := meta.FindStatusCondition(conditions, want.conditionType)
+		if c == nil {
+			t.Errorf("Expected condition %s, got %+v", want.conditionType, conditions)
+			continue
+		}
+		if c.Status != want.status || c.Reason != want.reason || c.ObservedGeneration != 3 {
+			t.Errorf("Expected %s %s/%s at generation 3, got %+v", want.conditionType, want.status, want.reason, c)
+		}
+	}
+	for _, unset := range []string{assessmentv1alpha1.ConditionGitExported, assessmentv1alpha1.ConditionHistoryRecorded} {
+		if c := meta.FindStatusCondition(conditions, unset); c != nil {
+			t.Errorf("Expected no %s condition for an unconfigured step, got %+v", unset, c)
+		}
+	}
+
+	events := drainEvents(recorder)
+	if !hasEvent(events, "Warning ValidatorsFailed Validators could not run: network (TimedOut)") {
+		t.Errorf("Expected a validators failed event, got %v", events)
+	}
+	if !hasEvent(events, "Normal AssessmentCompleted") {
+		t.Errorf("Expected a completion event, got %v", events)
+	}
+}
+
+func TestReconcileAssessmentJob_UnscoredRun(t *testing.T) {
+	const jobName = "nightly-abc12"
+	ctx := context.Background()
+
+	// The only validator timed out, so no finding is scored
+	setup := newJobTestReconciler()
+	result := &execution.Result{
+		Findings: []assessmentv1alpha1.Finding{
+			{ID: "security-timeout", Validator: "security", Status: assessmentv1alpha1.FindingStatusError},
+		},
+		Validators: []assessmentv1alpha1.ValidatorRunStatus{
+			{Name: "security", Outcome: assessmentv1alpha1.ValidatorTimedOut, Error: "timed out after 2m0s"},
+		},
+	}
+	if err := execution.WriteResult(ctx, setup.Client, testNamespace, jobName, "nightly", result); err != nil {
+		t.Fatalf("WriteResult failed: %v", err)
+	}
+	resultCM := &corev1.ConfigMap{}
+	_ = setup.Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: jobName}, resultCM)
+	resultCM.ResourceVersion = ""
+
+	// Score of the previous run
+	metrics.AssessmentScore.WithLabelValues("nightly", "production").Set(80)
+
+	assessment := jobModeAssessment(assessmentv1alpha1.PhaseRunning, jobName)
+	r := newJobTestReconciler(assessment, finishedJob(jobName, batchv1.JobComplete, ""), resultCM)
+	recorder := record.NewFakeRecorder(10)
+	r.Recorder = recorder
+
+	if _, err := r.reconcileAssessmentJob(ctx, assessment); err != nil {
+		t.Fatalf("reconcileAssessmentJob returned error: %v", err)
+	}
+
+	if score := getAssessment(t, r).Status.Summary.Score; score != nil {
+		t.Fatalf("Expected no score, got %d", *score)
+	}
+	if n := metrics.AssessmentScore.DeletePartialMatch(prometheus.Labels{"assessment_name": "nightly"}); n != 0 {
+		t.Errorf("Expected the score gauge to be removed, found %d series", n)
+	}
+	events := drainEvents(recorder)
+	if !hasEvent(events, "Normal AssessmentCompleted Assessment completed, not scored (0 FAIL, 0 WARN)") {
+		t.Errorf("Expected a completion event without a score, got %v", events)
+	}
+}
+
+func TestReconcileAssessmentJob_FailedRunEvent(t *testing.T) {
+	const jobName = "nightly-abc12"
+	assessment := jobModeAssessment(assessmentv1alpha1.PhaseRunning, jobName)
+	r := newJobTestReconciler(assessment, finishedJob(jobName, batchv1.JobFailed, "BackoffLimitExceeded"))
+	recorder := record.NewFakeRecorder(10)
+	r.Recorder = recorder
+
+	if _, err := r.reconcileAssessmentJob(context.Background(), assessment); err != nil {
+		t.Fatalf("reconcileAssessmentJob returned error: %v", err)
+	}
+
+	ready := meta.FindStatusCondition(getAssessment(t, r).Status.Conditions, assessmentv1alpha1.ConditionReady)
+	if ready == nil || ready.Status != metav1.ConditionFalse || ready.Reason != assessmentv1alpha1.ReasonAssessmentFailed {
+		t.Errorf("Expected Ready to be False after a failed run, got %+v", ready)
+	}
+	if events := drainEvents(recorder); !hasEvent(events, "Warning AssessmentFailed") {
+		t.Errorf("Expected a failure event, got %v", events)
+	}
+}
+
+func TestReconcileAssessmentJob_DeltaEventsWithoutHistory(t *testing.T) {
+	const jobName = "nightly-abc12"
+	ctx := context.Background()
+
+	setup := newJobTestReconciler()
+	result := &execution.Result{
+		Findings: []assessmentv1alpha1.Finding{
+			{ID: "security-fail", Validator: "security", Status: assessmentv1alpha1.FindingStatusFail},
+		},
+	}
+	if err := execution.WriteResult(ctx, setup.Client, testNamespace, jobName, "nightly", result); err != nil {
+		t.Fatalf("WriteResult failed: %v", err)
+	}
+	resultCM := &corev1.ConfigMap{}
+	_ = setup.Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: jobName}, resultCM)
+	resultCM.ResourceVersion = ""
+
+	// No snapshots are recorded, so the previous run is only in the status
+	assessment := jobModeAssessment(assessmentv1alpha1.PhaseRunning, jobName)
+	lastRun := metav1.Now()
+	score := 100
+	assessment.Status.LastRunTime = &lastRun
+	assessment.Status.Summary = assessmentv1alpha1.AssessmentSummary{
+		Score:               &score,
+		ScoringModel:        scoring.ModelSimple,
+		ScoringModelVersion: scoring.ModelSimpleVersion,
+	}
+	assessment.Status.Findings = []assessmentv1alpha1.Finding{
+		{ID: "security-fail", Validator: "security", Status: assessmentv1alpha1.FindingStatusPass},
+	}
+	r := newJobTestReconciler(assessment, finishedJob(jobName, batchv1.JobComplete, ""), resultCM)
+	recorder := record.NewFakeRecorder(10)
+	r.Recorder = recorder
+
+	if _, err := r.reconcileAssessmentJob(ctx, assessment); err != nil {
+		t.Fatalf("reconcileAssessmentJob returned error: %v", err)
+	}
+
+	events := drainEvents(recorder)
+	if !hasEvent(events, "Warning ScoreDecreased Score decreased by 100 to 0") {
+		t.Errorf("Expected a score decrease event, got %v", events)
+	}
+	if !hasEvent(events, "Warning NewFailingFindings 1 new failing findings: security-fail") {
+		t.Errorf("Expected a new failing findings event, got %v", events)
+	}
+	if getAssessment(t, r).Status.SnapshotCount != 0 {
+		t.Error("Expected no snapshot to be recorded")
+	}
+}
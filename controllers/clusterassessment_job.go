@@ -0,0 +1,174 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	assessmentv1alpha1 "github.com/openshift-assessment/cluster-assessment-operator/api/v1alpha1"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/execution"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/profiles"
+)
+
+// apiReader returns the reader used for reads that must not be served from a
+// stale cache.
+func (r *ClusterAssessmentReconciler) apiReader() client.Reader {
+	if r.APIReader != nil {
+		return r.APIReader
+	}
+	return r.Client
+}
+
+// startAssessmentJob launches a Job running the assessment's validators and
+// moves the assessment to Running. The run is completed by
+// reconcileAssessmentJob once the Job finishes.
+func (r *ClusterAssessmentReconciler) startAssessmentJob(ctx context.Context, assessment *assessmentv1alpha1.ClusterAssessment, run *assessmentv1alpha1.AssessmentRun) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	if r.JobImage == "" {
+		return r.failRun(ctx, assessment, run,
+			"Job execution requires the operator image; set --assessment-job-image or OPERATOR_IMAGE")
+	}
+
+	name := execution.JobName(assessment.Name, time.Now())
+	job := execution.NewJob(assessment, name, execution.JobOptions{
+		Namespace:            r.OperatorNamespace,
+		Image:                r.JobImage,
+		ServiceAccountName:   r.JobServiceAccount,
+		ValidatorConcurrency: r.ValidatorConcurrency,
+		ValidatorTimeout:     r.ValidatorTimeout,
+		ActiveDeadline:       r.stuckTimeout(),
+		Validators:           run.Spec.Validators,
+	})
+
+	// Record the Job before creating it, so a crash in between is reported as
+	// a missing Job instead of starting a second one.
+	if err := r.markRunning(ctx, assessment, run.Name, name, fmt.Sprintf("Assessment running in Job %s", name)); err != nil {
+		return ctrl.Result{}, err
+	}
+	if err := r.updateRunStatus(ctx, run, func(status *assessmentv1alpha1.AssessmentRunStatus) {
+		status.JobName = name
+		status.Message = fmt.Sprintf("Assessment running in Job %s", name)
+	}); err != nil {
+		logger.Error(err, "Failed to update AssessmentRun status", "run", run.Name)
+	}
+	if err := r.Create(ctx, job); err != nil && !errors.IsAlreadyExists(err) {
+		logger.Error(err, "Failed to create assessment Job", "job", name)
+		return r.failRun(ctx, assessment, run, fmt.Sprintf("Failed to create assessment Job: %v", err))
+	}
+
+	logger.Info("Started assessment Job", "job", name, "namespace", r.OperatorNamespace)
+	return ctrl.Result{}, nil
+}
+
+// reconcileAssessmentJob follows the Job executing a Running assessment. Phase
+// and failure detection come from the Job's status: the Job's active deadline
+// replaces the stuck-run timer used for runs in the operator process.
+func (r *ClusterAssessmentReconciler) reconcileAssessmentJob(ctx context.Context, assessment *assessmentv1alpha1.ClusterAssessment) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	// Re-read the assessment uncached, so a run completed by a previous
+	// reconcile is not processed twice
+	latest := &assessmentv1alpha1.ClusterAssessment{}
+	if err := r.apiReader().Get(ctx, client.ObjectKeyFromObject(assessment), latest); err != nil {
+		return ctrl.Result{}, err
+	}
+	if latest.Status.Phase != assessmentv1alpha1.PhaseRunning || latest.Status.JobName != assessment.Status.JobName {
+		return ctrl.Result{}, nil
+	}
+	name := latest.Status.JobName
+	run := r.currentRun(ctx, latest)
+
+	job := &batchv1.Job{}
+	if err := r.apiReader().Get(ctx, client.ObjectKey{Namespace: r.OperatorNamespace, Name: name}, job); err != nil {
+		if errors.IsNotFound(err) {
+			logger.Info("Assessment Job not found", "job", name)
+			return r.failRun(ctx, assessment, run, fmt.Sprintf("Assessment Job %s no longer exists", name))
+		}
+		return ctrl.Result{}, err
+	}
+
+	finished, succeeded, message := execution.JobFinished(job)
+	if !finished {
+		logger.Info("Assessment Job still running", "job", name, "active", job.Status.Active)
+		if latest.Spec.Schedule != "" && !latest.Spec.Suspend {
+			return r.scheduleWhileRunning(ctx, latest, run, job)
+		}
+		return ctrl.Result{}, nil
+	}
+
+	result, readErr := execution.ReadResult(ctx, r.apiReader(), r.OperatorNamespace, name)
+	defer r.deleteJobResult(ctx, name)
+
+	if !succeeded || (readErr == nil && result.Error != "") {
+		if readErr == nil && result.Error != "" {
+			message = result.Error
+		}
+		logger.Info("Assessment Job failed", "job", name, "message", message)
+		return r.failRun(ctx, assessment, run, fmt.Sprintf("Assessment Job %s failed: %s", name, message))
+	}
+	if readErr != nil {
+		logger.Error(readErr, "Failed to read assessment Job result", "job", name)
+		return r.failRun(ctx, assessment, run, fmt.Sprintf("Failed to read result of Job %s: %v", name, readErr))
+	}
+
+	// Score with the profile the Job ran with, which may have changed since.
+	// Results of Jobs started by older operators do not record it.
+	var profile profiles.Profile
+	if result.Profile != nil {
+		profile = *result.Profile
+	} else {
+		resolved, err := profiles.NewResolver(r.Client).Resolve(ctx, assessment.Spec.Profile)
+		if err != nil {
+			logger.Error(err, "Failed to resolve profile", "profile", assessment.Spec.Profile)
+			return r.failRun(ctx, assessment, run, fmt.Sprintf("Profile resolution failed: %v", err))
+		}
+		profile = resolved
+	}
+
+	startTime := job.CreationTimestamp.Time
+	if job.Status.StartTime != nil {
+		startTime = job.Status.StartTime.Time
+	}
+	logger.Info("Assessment Job completed", "job", name, "findings", len(result.Findings))
+	return r.completeAssessment(ctx, assessment, run, profile, result, startTime)
+}
+
+// deleteJobResult removes the results ConfigMaps of a finished Job.
+func (r *ClusterAssessmentReconciler) deleteJobResult(ctx context.Context, name string) {
+	if err := execution.DeleteResult(ctx, r.Client, r.OperatorNamespace, name); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to delete assessment Job result", "configMap", name)
+	}
+}
+
+// findAssessmentForJob returns a reconcile request for the ClusterAssessment
+// that launched the given Job.
+func (r *ClusterAssessmentReconciler) findAssessmentForJob(ctx context.Context, obj client.Object) []ctrl.Request {
+	name, ok := obj.GetLabels()[execution.AssessmentLabel]
+	if !ok || obj.GetNamespace() != r.OperatorNamespace {
+		return nil
+	}
+	return []ctrl.Request{{NamespacedName: client.ObjectKey{Name: name}}}
+}
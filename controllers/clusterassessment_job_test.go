@@ -0,0 +1,320 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	assessmentv1alpha1 "github.com/openshift-assessment/cluster-assessment-operator/api/v1alpha1"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/execution"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/findingstore"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/profiles"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/scoring"
+)
+
+const testNamespace = "cluster-assessment-operator"
+
+func newJobTestReconciler(objs ...client.Object) *ClusterAssessmentReconciler {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(assessmentv1alpha1.AddToScheme(scheme))
+
+	c := fake.NewClientBuilder().
+		WithScheme(scheme).
+		WithObjects(objs...).
+		WithStatusSubresource(&assessmentv1alpha1.ClusterAssessment{}, &assessmentv1alpha1.AssessmentRun{}).
+		Build()
+	return &ClusterAssessmentReconciler{
+		Client:            c,
+		Scheme:            scheme,
+		Registry:          newTestRegistry("security"),
+		OperatorNamespace: testNamespace,
+		JobImage:          "example.com/operator:test",
+		JobServiceAccount: "cluster-assessment-operator",
+	}
+}
+
+func jobModeAssessment(phase, jobName string) *assessmentv1alpha1.ClusterAssessment {
+	zero := 0
+	return &assessmentv1alpha1.ClusterAssessment{
+		ObjectMeta: metav1.ObjectMeta{Name: "nightly"},
+		Spec: assessmentv1alpha1.ClusterAssessmentSpec{
+			Profile:      "production",
+			HistoryLimit: &zero,
+			Execution:    &assessmentv1alpha1.ExecutionSpec{Mode: assessmentv1alpha1.ExecutionModeJob},
+		},
+		Status: assessmentv1alpha1.ClusterAssessmentStatus{Phase: phase, JobName: jobName},
+	}
+}
+
+func finishedJob(name string, condition batchv1.JobConditionType, message string) *batchv1.Job {
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: testNamespace},
+		Status: batchv1.JobStatus{
+			Conditions: []batchv1.JobCondition{{Type: condition, Status: corev1.ConditionTrue, Message: message}},
+		},
+	}
+}
+
+func getAssessment(t *testing.T, r *ClusterAssessmentReconciler) *assessmentv1alpha1.ClusterAssessment {
+	t.Helper()
+	latest := &assessmentv1alpha1.ClusterAssessment{}
+	if err := r.Get(context.Background(), client.ObjectKey{Name: "nightly"}, latest); err != nil {
+		t.Fatalf("Failed to get assessment: %v", err)
+	}
+	return latest
+}
+
+func TestRunAssessment_JobModeCreatesJob(t *testing.T) {
+	assessment := jobModeAssessment("", "")
+	r := newJobTestReconciler(assessment)
+
+	if _, err := r.startRun(context.Background(), assessment, assessmentv1alpha1.RunTriggerManual, ""); err != nil {
+		t.Fatalf("startRun returned error: %v", err)
+	}
+
+	latest := getAssessment(t, r)
+	if latest.Status.Phase != assessmentv1alpha1.PhaseRunning || latest.Status.JobName == "" {
+		t.Fatalf("Expected Running with a Job name, got phase %q job %q", latest.Status.Phase, latest.Status.JobName)
+	}
+	run := r.currentRun(context.Background(), latest)
+	if run == nil || run.Status.Phase != assessmentv1alpha1.PhaseRunning || run.Status.JobName != latest.Status.JobName {
+		t.Errorf("Expected the current AssessmentRun to record the Job, got %+v", run)
+	}
+
+	job := &batchv1.Job{}
+	if err := r.Get(context.Background(), client.ObjectKey{Namespace: testNamespace, Name: latest.Status.JobName}, job); err != nil {
+		t.Fatalf("Expected Job to be created: %v", err)
+	}
+	if job.Spec.ActiveDeadlineSeconds == nil || *job.Spec.ActiveDeadlineSeconds != int64(r.stuckTimeout().Seconds()) {
+		t.Errorf("Expected active deadline to default to the stuck timeout, got %v", job.Spec.ActiveDeadlineSeconds)
+	}
+	if got := r.findAssessmentForJob(context.Background(), job); len(got) != 1 || got[0].Name != "nightly" {
+		t.Errorf("Expected Job to map back to its assessment, got %v", got)
+	}
+}
+
+func TestRunAssessment_JobModeRequiresImage(t *testing.T) {
+	assessment := jobModeAssessment("", "")
+	r := newJobTestReconciler(assessment)
+	r.JobImage = ""
+
+	if _, err := r.startRun(context.Background(), assessment, assessmentv1alpha1.RunTriggerManual, ""); err != nil {
+		t.Fatalf("startRun returned error: %v", err)
+	}
+	if latest := getAssessment(t, r); latest.Status.Phase != assessmentv1alpha1.PhaseFailed {
+		t.Errorf("Expected Failed without a Job image, got %q", latest.Status.Phase)
+	}
+}
+
+func TestReconcileAssessmentJob(t *testing.T) {
+	const jobName = "nightly-1700000000"
+
+	resultConfigMap := func(result *execution.Result) client.Object {
+		r := newJobTestReconciler()
+		if err := execution.WriteResult(context.Background(), r.Client, testNamespace, jobName, "nightly", result); err != nil {
+			t.Fatalf("WriteResult failed: %v", err)
+		}
+		cm := &corev1.ConfigMap{}
+		_ = r.Get(context.Background(), client.ObjectKey{Namespace: testNamespace, Name: jobName}, cm)
+		cm.ResourceVersion = ""
+		return cm
+	}
+
+	tests := []struct {
+		name        string
+		objs        []client.Object
+		wantPhase   string
+		wantMessage string
+	}{
+		{
+			name:      "job still running",
+			objs:      []client.Object{&batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: jobName, Namespace: testNamespace}}},
+			wantPhase: assessmentv1alpha1.PhaseRunning,
+		},
+		{
+			name:        "job missing",
+			wantPhase:   assessmentv1alpha1.PhaseFailed,
+			wantMessage: "no longer exists",
+		},
+		{
+			name:        "job exceeded deadline",
+			objs:        []client.Object{finishedJob(jobName, batchv1.JobFailed, "Job was active longer than specified deadline")},
+			wantPhase:   assessmentv1alpha1.PhaseFailed,
+			wantMessage: "longer than specified deadline",
+		},
+		{
+			name: "job reported error",
+			objs: []client.Object{
+				finishedJob(jobName, batchv1.JobFailed, "BackoffLimitExceeded"),
+				resultConfigMap(&execution.Result{Error: "profile resolution failed"}),
+			},
+			wantPhase:   assessmentv1alpha1.PhaseFailed,
+			wantMessage: "profile resolution failed",
+		},
+		{
+			name: "job completed",
+			objs: []client.Object{
+				finishedJob(jobName, batchv1.JobComplete, ""),
+				resultConfigMap(&execution.Result{Findings: []assessmentv1alpha1.Finding{
+					{ID: "security-check", Validator: "security", Status: assessmentv1alpha1.FindingStatusPass},
+				}}),
+			},
+			wantPhase:   assessmentv1alpha1.PhaseCompleted,
+			wantMessage: "1 findings",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			assessment := jobModeAssessment(assessmentv1alpha1.PhaseRunning, jobName)
+			r := newJobTestReconciler(append(tt.objs, assessment)...)
+
+			if _, err := r.reconcileAssessmentJob(context.Background(), assessment); err != nil {
+				t.Fatalf("reconcileAssessmentJob returned error: %v", err)
+			}
+
+			latest := getAssessment(t, r)
+			if latest.Status.Phase != tt.wantPhase {
+				t.Errorf("Expected phase %q, got %q (%s)", tt.wantPhase, latest.Status.Phase, latest.Status.Message)
+			}
+			if !strings.Contains(latest.Status.Message, tt.wantMessage) {
+				t.Errorf("Expected message to contain %q, got %q", tt.wantMessage, latest.Status.Message)
+			}
+
+			if tt.wantPhase != assessmentv1alpha1.PhaseRunning {
+				err := r.Get(context.Background(), client.ObjectKey{Namespace: testNamespace, Name: jobName}, &corev1.ConfigMap{})
+				if !errors.IsNotFound(err) {
+					t.Errorf("Expected results ConfigMap to be deleted, got %v", err)
+				}
+			}
+		})
+	}
+}
+
+func TestReconcileAssessmentJob_FindingStorage(t *testing.T) {
+	const jobName = "nightly-abc12"
+	ctx := context.Background()
+
+	setup := newJobTestReconciler()
+	findings := []assessmentv1alpha1.Finding{
+		{ID: "security-pass", Validator: "security", Status: assessmentv1alpha1.FindingStatusPass},
+		{ID: "security-warn", Validator: "security", Status: assessmentv1alpha1.FindingStatusWarn, Severity: assessmentv1alpha1.SeverityLow},
+		{ID: "security-fail", Validator: "security", Status: assessmentv1alpha1.FindingStatusFail, Severity: assessmentv1alpha1.SeverityHigh},
+	}
+	if err := execution.WriteResult(ctx, setup.Client, testNamespace, jobName, "nightly", &execution.Result{Findings: findings}); err != nil {
+		t.Fatalf("WriteResult failed: %v", err)
+	}
+	resultCM := &corev1.ConfigMap{}
+	_ = setup.Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: jobName}, resultCM)
+	resultCM.ResourceVersion = ""
+
+	// Findings stored by an earlier run
+	stale, err := findingstore.Store(ctx, setup.Client, testNamespace, "nightly", findings[:1])
+	if err != nil {
+		t.Fatalf("Store failed: %v", err)
+	}
+	staleCM := &corev1.ConfigMap{}
+	_ = setup.Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: stale.ConfigMaps[0]}, staleCM)
+	staleCM.ResourceVersion = ""
+
+	limit := 2
+	assessment := jobModeAssessment(assessmentv1alpha1.PhaseRunning, jobName)
+	assessment.Spec.FindingStorage = &assessmentv1alpha1.FindingStorageSpec{
+		Mode:        assessmentv1alpha1.FindingStorageConfigMap,
+		StatusLimit: &limit,
+	}
+	assessment.Status.FindingsRef = stale
+	r := newJobTestReconciler(assessment, finishedJob(jobName, batchv1.JobComplete, ""), resultCM, staleCM)
+
+	if _, err := r.reconcileAssessmentJob(ctx, assessment); err != nil {
+		t.Fatalf("reconcileAssessmentJob returned error: %v", err)
+	}
+
+	latest := getAssessment(t, r)
+	if latest.Status.Phase != assessmentv1alpha1.PhaseCompleted {
+		t.Fatalf("Expected phase Completed, got %q (%s)", latest.Status.Phase, latest.Status.Message)
+	}
+	if len(latest.Status.Findings) != 2 || latest.Status.Findings[0].ID != "security-fail" || latest.Status.Findings[1].ID != "security-warn" {
+		t.Errorf("Expected the two most severe findings in the status, got %+v", latest.Status.Findings)
+	}
+	if latest.Status.FindingsRef == nil || latest.Status.FindingsRef.Count != 3 {
+		t.Fatalf("Expected a reference to all three findings, got %+v", latest.Status.FindingsRef)
+	}
+	if latest.Status.Summary.TotalChecks != 3 {
+		t.Errorf("Expected the summary to cover all findings, got %d checks", latest.Status.Summary.TotalChecks)
+	}
+
+	stored, err := findingstore.Load(ctx, r.Client, latest)
+	if err != nil {
+		t.Fatalf("Load returned error: %v", err)
+	}
+	if len(stored) != 3 {
+		t.Errorf("Expected all findings to be stored, got %d", len(stored))
+	}
+	err = r.Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: stale.ConfigMaps[0]}, &corev1.ConfigMap{})
+	if !errors.IsNotFound(err) {
+		t.Errorf("Expected the findings of the earlier run to be pruned, got %v", err)
+	}
+}
+
+func TestReconcileAssessmentJob_ScoresWithRecordedProfile(t *testing.T) {
+	const jobName = "nightly-def34"
+	ctx := context.Background()
+
+	// The profile the Job ran with; the assessment's profile no longer exists
+	profile := profiles.Profile{Name: "edited", Scoring: profiles.ScoringConfig{Model: scoring.ModelWeighted}}
+	setup := newJobTestReconciler()
+	result := &execution.Result{
+		Findings: []assessmentv1alpha1.Finding{{ID: "security-check", Validator: "security", Status: assessmentv1alpha1.FindingStatusPass}},
+		Profile:  &profile,
+	}
+	if err := execution.WriteResult(ctx, setup.Client, testNamespace, jobName, "nightly", result); err != nil {
+		t.Fatalf("WriteResult failed: %v", err)
+	}
+	resultCM := &corev1.ConfigMap{}
+	_ = setup.Get(ctx, client.ObjectKey{Namespace: testNamespace, Name: jobName}, resultCM)
+	resultCM.ResourceVersion = ""
+
+	assessment := jobModeAssessment(assessmentv1alpha1.PhaseRunning, jobName)
+	assessment.Spec.Profile = "deleted-profile"
+	r := newJobTestReconciler(assessment, finishedJob(jobName, batchv1.JobComplete, ""), resultCM)
+
+	if _, err := r.reconcileAssessmentJob(ctx, assessment); err != nil {
+		t.Fatalf("reconcileAssessmentJob returned error: %v", err)
+	}
+
+	latest := getAssessment(t, r)
+	if latest.Status.Phase != assessmentv1alpha1.PhaseCompleted {
+		t.Fatalf("Expected phase Completed, got %q (%s)", latest.Status.Phase, latest.Status.Message)
+	}
+	if latest.Status.Summary.ScoringModel != scoring.ModelWeighted {
+		t.Errorf("Expected scoring with the recorded profile's model, got %q", latest.Status.Summary.ScoringModel)
+	}
+}
@@ -0,0 +1,317 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"encoding/json"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller/controllerutil"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	assessmentv1alpha1 "github.com/openshift-assessment/cluster-assessment-operator/api/v1alpha1"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/history"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/profiles"
+)
+
+// triggerAnnotation requests a manual run when set to "run". It is kept for
+// compatibility; creating an AssessmentRun is the preferred way to request a run.
+const triggerAnnotation = "assessment.openshift.io/trigger"
+
+// startRun records a new AssessmentRun for the assessment and executes it.
+func (r *ClusterAssessmentReconciler) startRun(ctx context.Context, assessment *assessmentv1alpha1.ClusterAssessment, trigger assessmentv1alpha1.RunTrigger, reason string) (ctrl.Result, error) {
+	run, err := r.createRun(ctx, assessment, trigger, reason, nil)
+	if err != nil {
+		log.FromContext(ctx).Error(err, "Failed to create AssessmentRun")
+		return ctrl.Result{}, err
+	}
+	return r.runAssessment(ctx, assessment, run)
+}
+
+// createRun creates a pending AssessmentRun owned by the assessment. Empty
+// validators run all of the assessment's validators.
+func (r *ClusterAssessmentReconciler) createRun(ctx context.Context, assessment *assessmentv1alpha1.ClusterAssessment, trigger assessmentv1alpha1.RunTrigger, reason string, validators []string) (*assessmentv1alpha1.AssessmentRun, error) {
+	run := &assessmentv1alpha1.AssessmentRun{
+		ObjectMeta: metav1.ObjectMeta{
+			GenerateName: assessment.Name + "-",
+			Labels:       runLabels(assessment.Name),
+		},
+		Spec: assessmentv1alpha1.AssessmentRunSpec{
+			AssessmentName: assessment.Name,
+			Trigger:        trigger,
+			Reason:         reason,
+			Validators:     validators,
+		},
+	}
+	if err := controllerutil.SetControllerReference(assessment, run, r.Scheme); err != nil {
+		return nil, err
+	}
+	if err := r.Create(ctx, run); err != nil {
+		return nil, err
+	}
+	log.FromContext(ctx).Info("Created AssessmentRun", "run", run.Name, "trigger", trigger)
+	return run, nil
+}
+
+// runLabels returns the labels of the AssessmentRuns of an assessment.
+func runLabels(assessmentName string) map[string]string {
+	return map[string]string{
+		history.LabelAssessmentName:    assessmentName,
+		"app.kubernetes.io/managed-by": "cluster-assessment-operator",
+		"app.kubernetes.io/name":       "cluster-assessment-operator",
+	}
+}
+
+// nextPendingRun returns the oldest AssessmentRun requested for the assessment
+// that has not started yet, or nil if there is none.
+func (r *ClusterAssessmentReconciler) nextPendingRun(ctx context.Context, assessment *assessmentv1alpha1.ClusterAssessment) (*assessmentv1alpha1.AssessmentRun, error) {
+	runs := &assessmentv1alpha1.AssessmentRunList{}
+	if err := r.List(ctx, runs); err != nil {
+		return nil, err
+	}
+
+	var pending []assessmentv1alpha1.AssessmentRun
+	for _, run := range runs.Items {
+		if run.Spec.AssessmentName == assessment.Name && (run.Status.Phase == "" || run.Status.Phase == assessmentv1alpha1.PhasePending) {
+			pending = append(pending, run)
+		}
+	}
+	sort.Slice(pending, func(i, j int) bool {
+		if !pending[i].CreationTimestamp.Equal(&pending[j].CreationTimestamp) {
+			return pending[i].CreationTimestamp.Before(&pending[j].CreationTimestamp)
+		}
+		return pending[i].Name < pending[j].Name
+	})
+
+	// The cache may still show a run that was just executed as pending
+	for i := range pending {
+		latest := &assessmentv1alpha1.AssessmentRun{}
+		if err := r.apiReader().Get(ctx, client.ObjectKeyFromObject(&pending[i]), latest); err != nil {
+			if errors.IsNotFound(err) {
+				continue
+			}
+			return nil, err
+		}
+		if latest.Status.Phase == "" || latest.Status.Phase == assessmentv1alpha1.PhasePending {
+			return latest, nil
+		}
+	}
+	return nil, nil
+}
+
+// beginRun adopts the run, marks it Running and records it as the
+// assessment's current run.
+func (r *ClusterAssessmentReconciler) beginRun(ctx context.Context, assessment *assessmentv1alpha1.ClusterAssessment, run *assessmentv1alpha1.AssessmentRun) error {
+	// Runs requested by users are labeled and owned like the operator's own
+	if run.Labels[history.LabelAssessmentName] != assessment.Name || metav1.GetControllerOf(run) == nil {
+		if run.Labels == nil {
+			run.Labels = map[string]string{}
+		}
+		for k, v := range runLabels(assessment.Name) {
+			run.Labels[k] = v
+		}
+		if err := controllerutil.SetControllerReference(assessment, run, r.Scheme); err != nil {
+			return err
+		}
+		if err := r.Update(ctx, run); err != nil {
+			return err
+		}
+	}
+
+	now := metav1.Now()
+	return r.updateRunStatus(ctx, run, func(status *assessmentv1alpha1.AssessmentRunStatus) {
+		status.Phase = assessmentv1alpha1.PhaseRunning
+		status.StartTime = &now
+		status.Profile = assessment.Spec.Profile
+		status.Message = "Assessment in progress"
+	})
+}
+
+// currentRun returns the AssessmentRun recorded as the assessment's current or
+// last run, or nil if it is unknown or was deleted.
+func (r *ClusterAssessmentReconciler) currentRun(ctx context.Context, assessment *assessmentv1alpha1.ClusterAssessment) *assessmentv1alpha1.AssessmentRun {
+	if assessment.Status.LastRunName == "" {
+		return nil
+	}
+	run := &assessmentv1alpha1.AssessmentRun{}
+	if err := r.Get(ctx, client.ObjectKey{Name: assessment.Status.LastRunName}, run); err != nil {
+		if !errors.IsNotFound(err) {
+			log.FromContext(ctx).Error(err, "Failed to get AssessmentRun", "run", assessment.Status.LastRunName)
+		}
+		return nil
+	}
+	return run
+}
+
+// updateRunStatus applies mutate to the latest status of the run, retrying on
+// conflict. A nil run is ignored.
+func (r *ClusterAssessmentReconciler) updateRunStatus(ctx context.Context, run *assessmentv1alpha1.AssessmentRun, mutate func(*assessmentv1alpha1.AssessmentRunStatus)) error {
+	if run == nil {
+		return nil
+	}
+	return retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		latest := &assessmentv1alpha1.AssessmentRun{}
+		if err := r.Get(ctx, client.ObjectKeyFromObject(run), latest); err != nil {
+			return err
+		}
+		mutate(&latest.Status)
+		if err := r.Status().Update(ctx, latest); err != nil {
+			return err
+		}
+		run.Status = latest.Status
+		return nil
+	})
+}
+
+// failRun marks the run and the assessment as Failed.
+func (r *ClusterAssessmentReconciler) failRun(ctx context.Context, assessment *assessmentv1alpha1.ClusterAssessment, run *assessmentv1alpha1.AssessmentRun, message string) (ctrl.Result, error) {
+	now := metav1.Now()
+	if err := r.updateRunStatus(ctx, run, func(status *assessmentv1alpha1.AssessmentRunStatus) {
+		status.Phase = assessmentv1alpha1.PhaseFailed
+		status.CompletionTime = &now
+		status.Message = message
+	}); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to update AssessmentRun status", "run", run.Name)
+	}
+	r.event(assessment, corev1.EventTypeWarning, eventAssessmentFailed, "%s", message)
+	return r.updateStatus(ctx, assessment, assessmentv1alpha1.PhaseFailed, message,
+		newCondition(assessment, assessmentv1alpha1.ConditionReady, metav1.ConditionFalse,
+			assessmentv1alpha1.ReasonAssessmentFailed, message))
+}
+
+// resolvedProfile encodes the profile a run used for its status.
+func resolvedProfile(profile profiles.Profile) *runtime.RawExtension {
+	data, err := json.Marshal(profile)
+	if err != nil {
+		return nil
+	}
+	return &runtime.RawExtension{Raw: data}
+}
+
+// runValidators returns the validators executed by a run: the run's own
+// validators if it lists any, otherwise the assessment's.
+func runValidators(assessment *assessmentv1alpha1.ClusterAssessment, run *assessmentv1alpha1.AssessmentRun) []string {
+	if run != nil && len(run.Spec.Validators) > 0 {
+		return run.Spec.Validators
+	}
+	return assessment.Spec.Validators
+}
+
+// keepUnrunFindings adds the previous findings of the validators a partial run
+// did not execute, so the assessment keeps reporting them. Their suppression
+// is applied again together with the run's findings.
+func keepUnrunFindings(previous, findings []assessmentv1alpha1.Finding, run *assessmentv1alpha1.AssessmentRun) []assessmentv1alpha1.Finding {
+	if run == nil || len(run.Spec.Validators) == 0 {
+		return findings
+	}
+	ran := make(map[string]bool, len(run.Spec.Validators))
+	for _, name := range run.Spec.Validators {
+		ran[name] = true
+	}
+
+	merged := make([]assessmentv1alpha1.Finding, 0, len(previous)+len(findings))
+	for _, f := range previous {
+		if ran[f.Validator] {
+			continue
+		}
+		f.Suppressed = false
+		f.SuppressionReason = ""
+		merged = append(merged, f)
+	}
+	return append(merged, findings...)
+}
+
+// keepUnrunValidators adds the previous statuses of the validators a partial
+// run did not execute, keeping the list in name order.
+func keepUnrunValidators(previous, statuses []assessmentv1alpha1.ValidatorRunStatus, run *assessmentv1alpha1.AssessmentRun) []assessmentv1alpha1.ValidatorRunStatus {
+	if run == nil || len(run.Spec.Validators) == 0 {
+		return statuses
+	}
+	ran := make(map[string]bool, len(statuses))
+	for _, s := range statuses {
+		ran[s.Name] = true
+	}
+
+	merged := append([]assessmentv1alpha1.ValidatorRunStatus(nil), statuses...)
+	for _, s := range previous {
+		if !ran[s.Name] {
+			merged = append(merged, s)
+		}
+	}
+	sort.SliceStable(merged, func(i, j int) bool {
+		return merged[i].Name < merged[j].Name
+	})
+	return merged
+}
+
+// pruneRuns deletes the oldest finished AssessmentRuns of the assessment beyond
+// its history limit. The latest run is always kept.
+func (r *ClusterAssessmentReconciler) pruneRuns(ctx context.Context, assessment *assessmentv1alpha1.ClusterAssessment) {
+	logger := log.FromContext(ctx)
+
+	limit := 90
+	if assessment.Spec.HistoryLimit != nil {
+		limit = *assessment.Spec.HistoryLimit
+	}
+	if limit < 1 {
+		limit = 1
+	}
+
+	runs := &assessmentv1alpha1.AssessmentRunList{}
+	if err := r.List(ctx, runs, client.MatchingLabels{history.LabelAssessmentName: assessment.Name}); err != nil {
+		logger.Error(err, "Failed to list AssessmentRuns for pruning")
+		return
+	}
+
+	var finished []assessmentv1alpha1.AssessmentRun
+	for _, run := range runs.Items {
+		if run.Status.Finished() {
+			finished = append(finished, run)
+		}
+	}
+	if len(finished) <= limit {
+		return
+	}
+
+	// Newest first
+	sort.Slice(finished, func(i, j int) bool {
+		return finished[j].CreationTimestamp.Before(&finished[i].CreationTimestamp)
+	})
+	for i := limit; i < len(finished); i++ {
+		if err := r.Delete(ctx, &finished[i]); err != nil && !errors.IsNotFound(err) {
+			logger.Error(err, "Failed to delete old AssessmentRun", "run", finished[i].Name)
+		}
+	}
+}
+
+// findAssessmentForRun returns a reconcile request for the ClusterAssessment an
+// AssessmentRun belongs to.
+func (r *ClusterAssessmentReconciler) findAssessmentForRun(ctx context.Context, obj client.Object) []ctrl.Request {
+	run, ok := obj.(*assessmentv1alpha1.AssessmentRun)
+	if !ok || run.Spec.AssessmentName == "" {
+		return nil
+	}
+	return []ctrl.Request{{NamespacedName: client.ObjectKey{Name: run.Spec.AssessmentName}}}
+}
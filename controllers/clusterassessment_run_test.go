@@ -0,0 +1,167 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	assessmentv1alpha1 "github.com/openshift-assessment/cluster-assessment-operator/api/v1alpha1"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/history"
+)
+
+func listRuns(t *testing.T, r *ClusterAssessmentReconciler) []assessmentv1alpha1.AssessmentRun {
+	t.Helper()
+	runs := &assessmentv1alpha1.AssessmentRunList{}
+	if err := r.List(context.Background(), runs); err != nil {
+		t.Fatalf("Failed to list AssessmentRuns: %v", err)
+	}
+	return runs.Items
+}
+
+func TestReconcile_RunsPendingAssessmentRun(t *testing.T) {
+	assessment := jobModeAssessment(assessmentv1alpha1.PhaseCompleted, "")
+	assessment.Spec.Execution = nil
+	assessment.Spec.Suspend = true
+	requested := &assessmentv1alpha1.AssessmentRun{
+		ObjectMeta: metav1.ObjectMeta{Name: "nightly-manual"},
+		Spec: assessmentv1alpha1.AssessmentRunSpec{
+			AssessmentName: "nightly",
+			Trigger:        assessmentv1alpha1.RunTriggerManual,
+		},
+	}
+	r := newJobTestReconciler(assessment, requested)
+
+	if _, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: client.ObjectKey{Name: "nightly"}}); err != nil {
+		t.Fatalf("Reconcile returned error: %v", err)
+	}
+
+	latest := getAssessment(t, r)
+	if latest.Status.LastRunName != "nightly-manual" || latest.Status.Phase != assessmentv1alpha1.PhaseCompleted {
+		t.Errorf("Expected completed assessment with last run nightly-manual, got %q (%s)", latest.Status.LastRunName, latest.Status.Phase)
+	}
+
+	run := &assessmentv1alpha1.AssessmentRun{}
+	if err := r.Get(context.Background(), client.ObjectKey{Name: "nightly-manual"}, run); err != nil {
+		t.Fatalf("Failed to get AssessmentRun: %v", err)
+	}
+	if run.Status.Phase != assessmentv1alpha1.PhaseCompleted || run.Status.StartTime == nil || run.Status.CompletionTime == nil {
+		t.Errorf("Expected completed run with start and completion times, got %+v", run.Status)
+	}
+	if run.Status.Profile != "production" || run.Status.ResolvedProfile == nil || run.Status.Summary == nil {
+		t.Errorf("Expected profile and summary to be recorded, got %+v", run.Status)
+	}
+	if len(run.Status.Validators) != 1 || run.Status.Validators[0].Name != "security" {
+		t.Errorf("Expected security validator status, got %+v", run.Status.Validators)
+	}
+	if run.Labels[history.LabelAssessmentName] != "nightly" || metav1.GetControllerOf(run) == nil {
+		t.Errorf("Expected run to be adopted by its assessment, got labels %v owners %v", run.Labels, run.OwnerReferences)
+	}
+	if got := r.findAssessmentForRun(context.Background(), run); len(got) != 1 || got[0].Name != "nightly" {
+		t.Errorf("Expected run to map back to its assessment, got %v", got)
+	}
+}
+
+func TestReconcile_TriggerAnnotationRequestsRun(t *testing.T) {
+	assessment := jobModeAssessment(assessmentv1alpha1.PhaseCompleted, "")
+	assessment.Annotations = map[string]string{triggerAnnotation: "run"}
+	r := newJobTestReconciler(assessment)
+
+	result, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: client.ObjectKey{Name: "nightly"}})
+	if err != nil {
+		t.Fatalf("Reconcile returned error: %v", err)
+	}
+	if !result.Requeue {
+		t.Error("Expected requeue to execute the requested run")
+	}
+	if _, ok := getAssessment(t, r).Annotations[triggerAnnotation]; ok {
+		t.Error("Expected trigger annotation to be removed")
+	}
+
+	runs := listRuns(t, r)
+	if len(runs) != 1 || runs[0].Spec.Trigger != assessmentv1alpha1.RunTriggerManual || runs[0].Status.Phase != "" {
+		t.Fatalf("Expected one pending manual run, got %+v", runs)
+	}
+}
+
+func TestPruneRuns(t *testing.T) {
+	limit := 2
+	assessment := jobModeAssessment(assessmentv1alpha1.PhaseCompleted, "")
+	assessment.Spec.HistoryLimit = &limit
+
+	objs := []client.Object{assessment}
+	base := time.Now().Add(-time.Hour)
+	for i, phase := range []string{
+		assessmentv1alpha1.PhaseCompleted,
+		assessmentv1alpha1.PhaseFailed,
+		assessmentv1alpha1.PhaseCompleted,
+		assessmentv1alpha1.PhaseCompleted,
+		assessmentv1alpha1.PhaseRunning,
+	} {
+		objs = append(objs, &assessmentv1alpha1.AssessmentRun{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:              fmt.Sprintf("nightly-%d", i),
+				Labels:            runLabels("nightly"),
+				CreationTimestamp: metav1.NewTime(base.Add(time.Duration(i) * time.Minute)),
+			},
+			Spec:   assessmentv1alpha1.AssessmentRunSpec{AssessmentName: "nightly"},
+			Status: assessmentv1alpha1.AssessmentRunStatus{Phase: phase},
+		})
+	}
+	r := newJobTestReconciler(objs...)
+
+	r.pruneRuns(context.Background(), assessment)
+
+	var names []string
+	for _, run := range listRuns(t, r) {
+		names = append(names, run.Name)
+	}
+	want := []string{"nightly-2", "nightly-3", "nightly-4"}
+	if fmt.Sprint(names) != fmt.Sprint(want) {
+		t.Errorf("Expected remaining runs %v, got %v", want, names)
+	}
+}
+
+func TestKeepUnrunValidators(t *testing.T) {
+	previous := []assessmentv1alpha1.ValidatorRunStatus{
+		{Name: "network", Outcome: assessmentv1alpha1.ValidatorSucceeded},
+		{Name: "security", Outcome: assessmentv1alpha1.ValidatorFailed, Error: "forbidden"},
+	}
+	statuses := []assessmentv1alpha1.ValidatorRunStatus{
+		{Name: "security", Outcome: assessmentv1alpha1.ValidatorSucceeded},
+	}
+
+	full := keepUnrunValidators(previous, statuses, &assessmentv1alpha1.AssessmentRun{})
+	if len(full) != 1 || full[0].Name != "security" {
+		t.Errorf("Expected a full run to replace the statuses, got %+v", full)
+	}
+
+	partial := &assessmentv1alpha1.AssessmentRun{Spec: assessmentv1alpha1.AssessmentRunSpec{Validators: []string{"security"}}}
+	merged := keepUnrunValidators(previous, statuses, partial)
+	if len(merged) != 2 || merged[0].Name != "network" || merged[1].Name != "security" {
+		t.Fatalf("Expected both validators in name order, got %+v", merged)
+	}
+	if merged[1].Outcome != assessmentv1alpha1.ValidatorSucceeded {
+		t.Errorf("Expected the run's status to replace the previous one, got %+v", merged[1])
+	}
+}
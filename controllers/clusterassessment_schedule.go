@@ -0,0 +1,166 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/util/retry"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	assessmentv1alpha1 "github.com/openshift-assessment/cluster-assessment-operator/api/v1alpha1"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/metrics"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/schedule"
+)
+
+// lastScheduleTime returns the time the schedule is evaluated from. Assessments
+// that predate LastScheduleTime fall back to their last run.
+func lastScheduleTime(assessment *assessmentv1alpha1.ClusterAssessment) time.Time {
+	if assessment.Status.LastScheduleTime != nil {
+		return assessment.Status.LastScheduleTime.Time
+	}
+	if assessment.Status.LastRunTime != nil {
+		return assessment.Status.LastRunTime.Time
+	}
+	return time.Time{}
+}
+
+// requeueAt returns a result requeueing at the given time, or no requeue for
+// a zero time.
+func requeueAt(next, now time.Time) ctrl.Result {
+	if next.IsZero() {
+		return ctrl.Result{}
+	}
+	requeueAfter := next.Sub(now)
+	if requeueAfter < time.Second {
+		requeueAfter = time.Second
+	}
+	return ctrl.Result{RequeueAfter: requeueAfter}
+}
+
+// nextScheduledReconcile returns when a scheduled assessment needs to be
+// reconciled again after a run.
+func nextScheduledReconcile(assessment *assessmentv1alpha1.ClusterAssessment) ctrl.Result {
+	sched, err := schedule.New(assessment)
+	if err != nil {
+		return ctrl.Result{}
+	}
+	now := time.Now()
+	decision := sched.Evaluate(lastScheduleTime(assessment), now)
+	if decision.Due || decision.Skipped != "" {
+		return ctrl.Result{Requeue: true}
+	}
+	return requeueAt(decision.NextRunTime, now)
+}
+
+// scheduledReason describes a scheduled run in its AssessmentRun.
+func scheduledReason(decision schedule.Decision) string {
+	return fmt.Sprintf("Scheduled for %s", decision.ScheduledTime.Format(time.RFC3339))
+}
+
+// recordSchedule stores the next run time and the scheduled time of a due or
+// skipped run in the assessment status. Skipped runs are also reported in the
+// status message and the skipped runs metric.
+func (r *ClusterAssessmentReconciler) recordSchedule(ctx context.Context, assessment *assessmentv1alpha1.ClusterAssessment, decision schedule.Decision) error {
+	if decision.Skipped != "" {
+		log.FromContext(ctx).Info("Skipped scheduled run", "reason", decision.Skipped, "message", decision.Message)
+		metrics.RecordSkippedRun(assessment.Name, string(decision.Skipped))
+	}
+
+	update := func(status *assessmentv1alpha1.ClusterAssessmentStatus) bool {
+		changed := false
+		if !decision.NextRunTime.IsZero() && (status.NextRunTime == nil || !status.NextRunTime.Time.Equal(decision.NextRunTime)) {
+			status.NextRunTime = &metav1.Time{Time: decision.NextRunTime}
+			changed = true
+		}
+		if !decision.ScheduledTime.IsZero() {
+			status.LastScheduleTime = &metav1.Time{Time: decision.ScheduledTime}
+			changed = true
+		}
+		if decision.Skipped != "" {
+			status.Message = decision.Message
+			changed = true
+		}
+		return changed
+	}
+
+	err := retry.RetryOnConflict(retry.DefaultRetry, func() error {
+		latest := &assessmentv1alpha1.ClusterAssessment{}
+		if err := r.Get(ctx, client.ObjectKeyFromObject(assessment), latest); err != nil {
+			return err
+		}
+		if !update(&latest.Status) {
+			return nil
+		}
+		return r.Status().Update(ctx, latest)
+	})
+	if err != nil {
+		return err
+	}
+	update(&assessment.Status)
+	return nil
+}
+
+// scheduleWhileRunning applies the concurrency policy to a scheduled run that
+// is due while the previous run is still executing in a Job. Forbid skips the
+// scheduled run; Replace deletes the Job, fails its run and starts the
+// scheduled one.
+func (r *ClusterAssessmentReconciler) scheduleWhileRunning(ctx context.Context, assessment *assessmentv1alpha1.ClusterAssessment, run *assessmentv1alpha1.AssessmentRun, job *batchv1.Job) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	sched, err := schedule.New(assessment)
+	if err != nil {
+		// Reported once the running Job finishes
+		return ctrl.Result{}, nil
+	}
+
+	now := time.Now()
+	decision := sched.Evaluate(lastScheduleTime(assessment), now)
+	if decision.Due && assessment.Spec.ConcurrencyPolicy == assessmentv1alpha1.ConcurrencyPolicyReplace {
+		logger.Info("Replacing running assessment Job with scheduled run", "job", job.Name, "scheduledTime", decision.ScheduledTime)
+		if err := r.Delete(ctx, job, client.PropagationPolicy(metav1.DeletePropagationBackground)); err != nil && !errors.IsNotFound(err) {
+			return ctrl.Result{}, err
+		}
+		r.deleteJobResult(ctx, job.Name)
+		if _, err := r.failRun(ctx, assessment, run,
+			fmt.Sprintf("Replaced by the run scheduled for %s", decision.ScheduledTime.Format(time.RFC3339))); err != nil {
+			return ctrl.Result{}, err
+		}
+		if err := r.recordSchedule(ctx, assessment, decision); err != nil {
+			return ctrl.Result{}, err
+		}
+		return r.startRun(ctx, assessment, assessmentv1alpha1.RunTriggerSchedule, scheduledReason(decision))
+	}
+
+	if decision.Due {
+		decision.Due = false
+		decision.Skipped = schedule.SkipConcurrency
+		decision.Message = fmt.Sprintf("Skipped run scheduled for %s: the previous run is still running in Job %s",
+			decision.ScheduledTime.Format(time.RFC3339), job.Name)
+	}
+	if err := r.recordSchedule(ctx, assessment, decision); err != nil {
+		return ctrl.Result{}, err
+	}
+	return requeueAt(decision.NextRunTime, now), nil
+}
@@ -0,0 +1,112 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	assessmentv1alpha1 "github.com/openshift-assessment/cluster-assessment-operator/api/v1alpha1"
+)
+
+func TestReconcileScheduled_MissedDeadline(t *testing.T) {
+	deadline := int64(60)
+	lastSchedule := metav1.NewTime(time.Now().Add(-3 * time.Hour))
+	assessment := jobModeAssessment("", "")
+	assessment.Spec.Schedule = "0 * * * *"
+	assessment.Spec.StartingDeadlineSeconds = &deadline
+	assessment.Status.LastScheduleTime = &lastSchedule
+	r := newJobTestReconciler(assessment)
+
+	result, err := r.reconcileScheduled(context.Background(), assessment)
+	if err != nil {
+		t.Fatalf("reconcileScheduled returned error: %v", err)
+	}
+	if result.RequeueAfter <= 0 || result.RequeueAfter > time.Hour {
+		t.Errorf("Expected requeue for the next hourly run, got %v", result.RequeueAfter)
+	}
+
+	latest := getAssessment(t, r)
+	if !strings.Contains(latest.Status.Message, "missed the starting deadline") {
+		t.Errorf("Expected missed deadline message, got %q", latest.Status.Message)
+	}
+	if !latest.Status.LastScheduleTime.After(lastSchedule.Time) || latest.Status.NextRunTime == nil {
+		t.Errorf("Expected schedule times to advance, got last %v next %v", latest.Status.LastScheduleTime, latest.Status.NextRunTime)
+	}
+	if runs := listRuns(t, r); len(runs) != 0 {
+		t.Errorf("Expected no run for a skipped schedule, got %d", len(runs))
+	}
+}
+
+func TestReconcileAssessmentJob_ConcurrencyPolicy(t *testing.T) {
+	const jobName = "nightly-1700000000"
+
+	tests := []struct {
+		name        string
+		policy      assessmentv1alpha1.ConcurrencyPolicy
+		wantJob     bool
+		wantMessage string
+	}{
+		{name: "forbid skips the scheduled run", policy: assessmentv1alpha1.ConcurrencyPolicyForbid, wantJob: true, wantMessage: "still running"},
+		{name: "replace starts the scheduled run", policy: assessmentv1alpha1.ConcurrencyPolicyReplace, wantMessage: "Assessment running in Job"},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			lastSchedule := metav1.NewTime(time.Now().Add(-2 * time.Hour))
+			assessment := jobModeAssessment(assessmentv1alpha1.PhaseRunning, jobName)
+			assessment.Spec.Schedule = "0 * * * *"
+			assessment.Spec.ConcurrencyPolicy = tt.policy
+			assessment.Status.LastScheduleTime = &lastSchedule
+			running := &batchv1.Job{ObjectMeta: metav1.ObjectMeta{Name: jobName, Namespace: testNamespace}}
+			r := newJobTestReconciler(assessment, running)
+
+			if _, err := r.reconcileAssessmentJob(context.Background(), assessment); err != nil {
+				t.Fatalf("reconcileAssessmentJob returned error: %v", err)
+			}
+
+			err := r.Get(context.Background(), client.ObjectKey{Namespace: testNamespace, Name: jobName}, &batchv1.Job{})
+			if tt.wantJob && err != nil {
+				t.Errorf("Expected running Job to be kept, got %v", err)
+			}
+			if !tt.wantJob && !errors.IsNotFound(err) {
+				t.Errorf("Expected running Job to be deleted, got %v", err)
+			}
+
+			latest := getAssessment(t, r)
+			if latest.Status.Phase != assessmentv1alpha1.PhaseRunning {
+				t.Errorf("Expected assessment to stay Running, got %q", latest.Status.Phase)
+			}
+			if !strings.Contains(latest.Status.Message, tt.wantMessage) {
+				t.Errorf("Expected message to contain %q, got %q", tt.wantMessage, latest.Status.Message)
+			}
+			if !latest.Status.LastScheduleTime.After(lastSchedule.Time) {
+				t.Errorf("Expected last schedule time to advance, got %v", latest.Status.LastScheduleTime)
+			}
+			if !tt.wantJob && latest.Status.JobName == jobName {
+				t.Error("Expected a new Job for the scheduled run")
+			}
+		})
+	}
+}
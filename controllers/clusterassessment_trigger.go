@@ -0,0 +1,283 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	configv1 "github.com/openshift/api/config/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/client-go/util/workqueue"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	assessmentv1alpha1 "github.com/openshift-assessment/cluster-assessment-operator/api/v1alpha1"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/machineconfig"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/trigger"
+)
+
+// changeDetector reports whether a change of a watched object is a trigger
+// event, and describes it. old is nil for created objects.
+type changeDetector func(old, obj client.Object) (string, bool)
+
+// clusterUpgraded detects a new Completed entry in the ClusterVersion history.
+func clusterUpgraded(old, obj client.Object) (string, bool) {
+	cv, ok := obj.(*configv1.ClusterVersion)
+	if !ok || old == nil {
+		return "", false
+	}
+	oldCV, ok := old.(*configv1.ClusterVersion)
+	if !ok {
+		return "", false
+	}
+
+	completed := lastCompletedUpdate(cv)
+	if completed == nil {
+		return "", false
+	}
+	previous := lastCompletedUpdate(oldCV)
+	if previous != nil && previous.Version == completed.Version && previous.StartedTime.Equal(&completed.StartedTime) {
+		return "", false
+	}
+	return fmt.Sprintf("ClusterVersion %s completed the update to %s", cv.Name, completed.Version), true
+}
+
+// lastCompletedUpdate returns the most recent Completed entry of the
+// ClusterVersion history, or nil if there is none.
+func lastCompletedUpdate(cv *configv1.ClusterVersion) *configv1.UpdateHistory {
+	for i := range cv.Status.History {
+		if cv.Status.History[i].State == configv1.CompletedUpdate {
+			return &cv.Status.History[i]
+		}
+	}
+	return nil
+}
+
+// machineConfigPoolUpdated detects a MachineConfigPool finishing the rollout
+// of a configuration.
+func machineConfigPoolUpdated(old, obj client.Object) (string, bool) {
+	pool, ok := obj.(*machineconfig.MachineConfigPool)
+	if !ok || old == nil || !poolUpdated(pool) {
+		return "", false
+	}
+	oldPool, ok := old.(*machineconfig.MachineConfigPool)
+	if !ok || (poolUpdated(oldPool) && oldPool.Status.Configuration.Name == pool.Status.Configuration.Name) {
+		return "", false
+	}
+	return fmt.Sprintf("MachineConfigPool %s finished rolling out %s", pool.Name, pool.Status.Configuration.Name), true
+}
+
+// poolUpdated reports whether the pool's Updated condition is true.
+func poolUpdated(pool *machineconfig.MachineConfigPool) bool {
+	for _, cond := range pool.Status.Conditions {
+		if cond.Type == machineconfig.MachineConfigPoolUpdated {
+			return cond.Status == string(corev1.ConditionTrue)
+		}
+	}
+	return false
+}
+
+// namespaceCreated detects namespaces created while the operator is running.
+// Namespaces reported by the informer's initial list are not new.
+func (r *ClusterAssessmentReconciler) namespaceCreated(old, obj client.Object) (string, bool) {
+	if old != nil || obj.GetCreationTimestamp().Time.Before(r.triggers.Started().Truncate(time.Second)) {
+		return "", false
+	}
+	return fmt.Sprintf("Namespace %s created", obj.GetName()), true
+}
+
+// profileChanged detects changes to the spec of an AssessmentProfile.
+func profileChanged(old, obj client.Object) (string, bool) {
+	if old == nil || old.GetGeneration() == obj.GetGeneration() {
+		return "", false
+	}
+	return fmt.Sprintf("AssessmentProfile %s changed", obj.GetName()), true
+}
+
+// triggerHandler records trigger events of one type for the assessments that
+// handle them and enqueues those assessments. mapFunc, if set, enqueues
+// additional assessments for every event of the watched type.
+type triggerHandler struct {
+	r         *ClusterAssessmentReconciler
+	eventType assessmentv1alpha1.TriggerEventType
+	detect    changeDetector
+	mapFunc   handler.MapFunc
+}
+
+var _ handler.EventHandler = &triggerHandler{}
+
+// Create implements handler.EventHandler.
+func (h *triggerHandler) Create(ctx context.Context, e event.CreateEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	h.handle(ctx, nil, e.Object, q)
+}
+
+// Update implements handler.EventHandler.
+func (h *triggerHandler) Update(ctx context.Context, e event.UpdateEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	h.handle(ctx, e.ObjectOld, e.ObjectNew, q)
+}
+
+// Delete implements handler.EventHandler.
+func (h *triggerHandler) Delete(ctx context.Context, e event.DeleteEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	h.enqueueMapped(ctx, e.Object, q)
+}
+
+// Generic implements handler.EventHandler.
+func (h *triggerHandler) Generic(ctx context.Context, e event.GenericEvent, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	h.enqueueMapped(ctx, e.Object, q)
+}
+
+func (h *triggerHandler) handle(ctx context.Context, old, obj client.Object, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	h.enqueueMapped(ctx, obj, q)
+	source, ok := h.detect(old, obj)
+	if !ok {
+		return
+	}
+	for _, req := range h.r.recordTrigger(ctx, h.eventType, obj, source) {
+		q.Add(req)
+	}
+}
+
+func (h *triggerHandler) enqueueMapped(ctx context.Context, obj client.Object, q workqueue.TypedRateLimitingInterface[reconcile.Request]) {
+	if h.mapFunc == nil {
+		return
+	}
+	for _, req := range h.mapFunc(ctx, obj) {
+		q.Add(req)
+	}
+}
+
+// recordTrigger records a trigger event for every assessment with a trigger
+// of the event's type and returns reconcile requests for them. Profile changes
+// only concern the assessments using the profile.
+func (r *ClusterAssessmentReconciler) recordTrigger(ctx context.Context, eventType assessmentv1alpha1.TriggerEventType, obj client.Object, source string) []ctrl.Request {
+	logger := log.FromContext(ctx)
+
+	assessmentList := &assessmentv1alpha1.ClusterAssessmentList{}
+	if err := r.List(ctx, assessmentList); err != nil {
+		logger.Error(err, "Failed to list ClusterAssessments for trigger event", "event", eventType)
+		return nil
+	}
+
+	now := time.Now()
+	var requests []ctrl.Request
+	for _, assessment := range assessmentList.Items {
+		if assessment.Spec.Triggers == nil || assessment.Spec.Suspend {
+			continue
+		}
+		if eventType == assessmentv1alpha1.TriggerProfileChange && assessment.Spec.Profile != obj.GetName() {
+			continue
+		}
+		for _, t := range assessment.Spec.Triggers.Events {
+			if t.Type != eventType {
+				continue
+			}
+			logger.Info("Recorded trigger event", "assessment", assessment.Name, "event", eventType, "source", source)
+			r.triggers.Record(assessment.Name, trigger.Event{
+				Type:       eventType,
+				Source:     source,
+				Validators: t.Validators,
+				Time:       now,
+			})
+			requests = append(requests, ctrl.Request{NamespacedName: client.ObjectKeyFromObject(&assessment)})
+			break
+		}
+	}
+	return requests
+}
+
+// reconcileTriggers starts a run for the trigger events recorded for the
+// assessment once they are debounced. started reports whether a run was
+// started; otherwise the result requeues for the pending events, if any.
+func (r *ClusterAssessmentReconciler) reconcileTriggers(ctx context.Context, assessment *assessmentv1alpha1.ClusterAssessment) (result ctrl.Result, started bool, err error) {
+	logger := log.FromContext(ctx)
+
+	if r.triggers == nil {
+		return ctrl.Result{}, false, nil
+	}
+	pending := r.triggers.Pending(assessment.Name)
+	if pending == nil {
+		return ctrl.Result{}, false, nil
+	}
+	if assessment.Spec.Triggers == nil || assessment.Spec.Suspend {
+		r.triggers.Forget(assessment.Name)
+		return ctrl.Result{}, false, nil
+	}
+	// Events observed during a run start the next run once it finished
+	if assessment.Status.Phase == assessmentv1alpha1.PhaseRunning {
+		return ctrl.Result{}, false, nil
+	}
+
+	debounce, minInterval := trigger.Intervals(assessment.Spec.Triggers)
+	var lastRun time.Time
+	if assessment.Status.LastRunTime != nil {
+		lastRun = assessment.Status.LastRunTime.Time
+	}
+	now := time.Now()
+	if runAt := pending.RunAt(debounce, minInterval, lastRun); runAt.After(now) {
+		logger.Info("Waiting for trigger events to settle", "events", pending.Count, "runAt", runAt)
+		return requeueAt(runAt, now), false, nil
+	}
+
+	run, err := r.createRun(ctx, assessment, assessmentv1alpha1.RunTriggerEvent, pending.Reason(), pending.Validators)
+	if err != nil {
+		logger.Error(err, "Failed to create AssessmentRun")
+		return ctrl.Result{}, false, err
+	}
+	// Events recorded since pending was read are covered by the run, which
+	// collects the cluster state after them
+	r.triggers.Forget(assessment.Name)
+
+	logger.Info("Running triggered assessment", "run", run.Name, "events", pending.Count)
+	result, err = r.runAssessment(ctx, assessment, run)
+	return result, true, err
+}
+
+// sooner returns whichever result requeues first.
+func sooner(a, b ctrl.Result) ctrl.Result {
+	switch {
+	case a.Requeue || (b.RequeueAfter == 0 && !b.Requeue):
+		return a
+	case b.Requeue || a.RequeueAfter == 0 || b.RequeueAfter < a.RequeueAfter:
+		return b
+	default:
+		return a
+	}
+}
+
+// servedKind reports whether the cluster serves the kind of obj. Watches on
+// OpenShift-specific kinds are skipped on other clusters.
+func servedKind(mgr ctrl.Manager, obj client.Object) (bool, error) {
+	gvk, err := apiutil.GVKForObject(obj, mgr.GetScheme())
+	if err != nil {
+		return false, err
+	}
+	if _, err := mgr.GetRESTMapper().RESTMapping(gvk.GroupKind(), gvk.Version); err != nil {
+		if meta.IsNoMatchError(err) {
+			return false, nil
+		}
+		return false, err
+	}
+	return true, nil
+}
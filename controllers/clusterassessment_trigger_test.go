@@ -0,0 +1,220 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	configv1 "github.com/openshift/api/config/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	assessmentv1alpha1 "github.com/openshift-assessment/cluster-assessment-operator/api/v1alpha1"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/machineconfig"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/trigger"
+)
+
+func triggeredAssessment() *assessmentv1alpha1.ClusterAssessment {
+	assessment := jobModeAssessment(assessmentv1alpha1.PhaseCompleted, "")
+	assessment.Spec.Execution = nil
+	assessment.Spec.Triggers = &assessmentv1alpha1.TriggerSpec{
+		Events: []assessmentv1alpha1.EventTrigger{
+			{Type: assessmentv1alpha1.TriggerNamespaceCreated, Validators: []string{"security"}},
+		},
+	}
+	return assessment
+}
+
+func TestClusterUpgraded(t *testing.T) {
+	started := metav1.NewTime(time.Now().Add(-time.Hour))
+	previous := configv1.UpdateHistory{State: configv1.CompletedUpdate, Version: "4.15.1", StartedTime: metav1.NewTime(started.Add(-24 * time.Hour))}
+	partial := &configv1.ClusterVersion{
+		ObjectMeta: metav1.ObjectMeta{Name: "version"},
+		Status: configv1.ClusterVersionStatus{History: []configv1.UpdateHistory{
+			{State: configv1.PartialUpdate, Version: "4.16.0", StartedTime: started},
+			previous,
+		}},
+	}
+	completed := partial.DeepCopy()
+	completed.Status.History[0].State = configv1.CompletedUpdate
+
+	if _, ok := clusterUpgraded(nil, completed); ok {
+		t.Error("Expected the initial list not to be an upgrade")
+	}
+	if _, ok := clusterUpgraded(partial, partial.DeepCopy()); ok {
+		t.Error("Expected an update in progress not to be an upgrade")
+	}
+	source, ok := clusterUpgraded(partial, completed)
+	if !ok || !strings.Contains(source, "4.16.0") {
+		t.Errorf("Expected completed upgrade to 4.16.0, got %q %v", source, ok)
+	}
+	if _, ok := clusterUpgraded(completed, completed.DeepCopy()); ok {
+		t.Error("Expected an unchanged history not to be an upgrade")
+	}
+}
+
+func TestMachineConfigPoolUpdated(t *testing.T) {
+	pool := func(updated, config string) *machineconfig.MachineConfigPool {
+		return &machineconfig.MachineConfigPool{
+			ObjectMeta: metav1.ObjectMeta{Name: "worker"},
+			Status: machineconfig.MachineConfigPoolStatus{
+				Configuration: machineconfig.MachineConfigPoolStatusConfiguration{Name: config},
+				Conditions: []machineconfig.MachineConfigPoolCondition{
+					{Type: machineconfig.MachineConfigPoolUpdated, Status: updated},
+				},
+			},
+		}
+	}
+
+	tests := []struct {
+		name     string
+		old, obj *machineconfig.MachineConfigPool
+		want     bool
+	}{
+		{name: "rollout finished", old: pool("False", "rendered-worker-a"), obj: pool("True", "rendered-worker-b"), want: true},
+		{name: "rollout in progress", old: pool("True", "rendered-worker-a"), obj: pool("False", "rendered-worker-a")},
+		{name: "unchanged", old: pool("True", "rendered-worker-a"), obj: pool("True", "rendered-worker-a")},
+		{name: "configuration replaced", old: pool("True", "rendered-worker-a"), obj: pool("True", "rendered-worker-b"), want: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if _, ok := machineConfigPoolUpdated(tt.old, tt.obj); ok != tt.want {
+				t.Errorf("Expected %v, got %v", tt.want, ok)
+			}
+		})
+	}
+}
+
+func TestRecordTrigger(t *testing.T) {
+	assessment := triggeredAssessment()
+	untriggered := jobModeAssessment(assessmentv1alpha1.PhaseCompleted, "")
+	untriggered.Name = "weekly"
+	r := newJobTestReconciler(assessment, untriggered)
+	r.triggers = trigger.NewTracker()
+
+	ns := &metav1.PartialObjectMetadata{ObjectMeta: metav1.ObjectMeta{Name: "team-a"}}
+	requests := r.recordTrigger(context.Background(), assessmentv1alpha1.TriggerNamespaceCreated, ns, "Namespace team-a created")
+	if len(requests) != 1 || requests[0].Name != "nightly" {
+		t.Errorf("Expected only the triggered assessment to be enqueued, got %v", requests)
+	}
+	if r.triggers.Pending("weekly") != nil {
+		t.Error("Expected no pending events for an assessment without triggers")
+	}
+
+	requests = r.recordTrigger(context.Background(), assessmentv1alpha1.TriggerClusterUpgrade, ns, "ClusterVersion version completed the update to 4.16.0")
+	if len(requests) != 0 {
+		t.Errorf("Expected no assessment to handle cluster upgrades, got %v", requests)
+	}
+}
+
+func TestReconcile_TriggeredRun(t *testing.T) {
+	lastRun := metav1.NewTime(time.Now().Add(-time.Hour))
+	assessment := triggeredAssessment()
+	assessment.Status.LastRunTime = &lastRun
+	assessment.Status.Findings = []assessmentv1alpha1.Finding{
+		{ID: "nodes-ready", Validator: "nodes", Status: assessmentv1alpha1.FindingStatusPass},
+		{ID: "security-old", Validator: "security", Status: assessmentv1alpha1.FindingStatusFail},
+	}
+	r := newJobTestReconciler(assessment)
+	r.triggers = trigger.NewTracker()
+	req := ctrl.Request{NamespacedName: client.ObjectKey{Name: "nightly"}}
+
+	// A fresh event is debounced
+	r.triggers.Record("nightly", trigger.Event{
+		Type:       assessmentv1alpha1.TriggerNamespaceCreated,
+		Source:     "Namespace team-a created",
+		Validators: []string{"security"},
+		Time:       time.Now(),
+	})
+	result, err := r.Reconcile(context.Background(), req)
+	if err != nil {
+		t.Fatalf("Reconcile returned error: %v", err)
+	}
+	if result.RequeueAfter <= 0 || result.RequeueAfter > trigger.DefaultDebounce {
+		t.Errorf("Expected requeue within the debounce period, got %v", result.RequeueAfter)
+	}
+	if runs := listRuns(t, r); len(runs) != 0 {
+		t.Fatalf("Expected no run while debouncing, got %d", len(runs))
+	}
+
+	// Once settled, the events start one partial run
+	r.triggers.Forget("nightly")
+	for i := 0; i < 3; i++ {
+		r.triggers.Record("nightly", trigger.Event{
+			Type:       assessmentv1alpha1.TriggerNamespaceCreated,
+			Source:     fmt.Sprintf("Namespace team-%d created", i),
+			Validators: []string{"security"},
+			Time:       time.Now().Add(-10 * time.Minute),
+		})
+	}
+	if _, err := r.Reconcile(context.Background(), req); err != nil {
+		t.Fatalf("Reconcile returned error: %v", err)
+	}
+
+	runs := listRuns(t, r)
+	if len(runs) != 1 {
+		t.Fatalf("Expected one triggered run, got %d", len(runs))
+	}
+	run := runs[0]
+	if run.Spec.Trigger != assessmentv1alpha1.RunTriggerEvent || fmt.Sprint(run.Spec.Validators) != "[security]" {
+		t.Errorf("Expected an Event run of the security validator, got %+v", run.Spec)
+	}
+	if !strings.Contains(run.Spec.Reason, "Namespace team-0 created") {
+		t.Errorf("Expected the reason to describe the events, got %q", run.Spec.Reason)
+	}
+	if run.Status.Phase != assessmentv1alpha1.PhaseCompleted {
+		t.Errorf("Expected the triggered run to complete, got %q", run.Status.Phase)
+	}
+	if r.triggers.Pending("nightly") != nil {
+		t.Error("Expected pending events to be cleared")
+	}
+
+	// Findings of the validators that did not run are kept
+	latest := getAssessment(t, r)
+	if len(latest.Status.Findings) != 1 || latest.Status.Findings[0].ID != "nodes-ready" {
+		t.Errorf("Expected only the nodes finding to be kept, got %+v", latest.Status.Findings)
+	}
+}
+
+func TestReconcile_TriggerRespectsMinInterval(t *testing.T) {
+	lastRun := metav1.NewTime(time.Now().Add(-time.Minute))
+	assessment := triggeredAssessment()
+	assessment.Status.LastRunTime = &lastRun
+	r := newJobTestReconciler(assessment)
+	r.triggers = trigger.NewTracker()
+	r.triggers.Record("nightly", trigger.Event{
+		Type: assessmentv1alpha1.TriggerNamespaceCreated,
+		Time: time.Now().Add(-10 * time.Minute),
+	})
+
+	result, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: client.ObjectKey{Name: "nightly"}})
+	if err != nil {
+		t.Fatalf("Reconcile returned error: %v", err)
+	}
+	if result.RequeueAfter < 13*time.Minute || result.RequeueAfter > trigger.DefaultMinInterval {
+		t.Errorf("Expected requeue at the end of the minimum interval, got %v", result.RequeueAfter)
+	}
+	if runs := listRuns(t, r); len(runs) != 0 {
+		t.Errorf("Expected no run within the minimum interval, got %d", len(runs))
+	}
+}
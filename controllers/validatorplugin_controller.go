@@ -0,0 +1,248 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+
+	assessmentv1alpha1 "github.com/openshift-assessment/cluster-assessment-operator/api/v1alpha1"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/plugins"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/validator"
+)
+
+const (
+	// pluginDescribeTimeout bounds a plugin's Describe call.
+	pluginDescribeTimeout = 30 * time.Second
+
+	// pluginRefreshInterval is how often ready plugins are described again to
+	// pick up catalog changes from plugin upgrades.
+	pluginRefreshInterval = 10 * time.Minute
+
+	// pluginRetryInterval is how soon a plugin that could not be described is retried.
+	pluginRetryInterval = time.Minute
+
+	// eventPluginInsecure is emitted when a plugin opts in to an unencrypted connection.
+	eventPluginInsecure = "InsecureConnection"
+)
+
+// ValidatorPluginReconciler registers ValidatorPlugins as validators.
+type ValidatorPluginReconciler struct {
+	client.Client
+	Scheme   *runtime.Scheme
+	Registry *validator.Registry
+
+	// OperatorNamespace is where the check catalog is republished when the
+	// registered plugins change. The catalog is not republished if empty.
+	OperatorNamespace string
+
+	// Connect creates the client for a plugin. Defaults to plugins.Connect.
+	Connect func(spec assessmentv1alpha1.ValidatorPluginSpec) (*plugins.Client, error)
+
+	// Recorder records warning events for plugins called without TLS.
+	Recorder record.EventRecorder
+}
+
+// +kubebuilder:rbac:groups=assessment.openshift.io,resources=validatorplugins,verbs=get;list;watch
+// +kubebuilder:rbac:groups=assessment.openshift.io,resources=validatorplugins/status,verbs=get;update;patch
+
+func (r *ValidatorPluginReconciler) Reconcile(ctx context.Context, req ctrl.Request) (ctrl.Result, error) {
+	logger := log.FromContext(ctx)
+
+	plugin := &assessmentv1alpha1.ValidatorPlugin{}
+	if err := r.Get(ctx, req.NamespacedName, plugin); err != nil {
+		if client.IgnoreNotFound(err) == nil {
+			r.unregister(ctx, req.Name)
+			return ctrl.Result{}, nil
+		}
+		return ctrl.Result{}, err
+	}
+	if !plugin.DeletionTimestamp.IsZero() {
+		r.unregister(ctx, plugin.Name)
+		return ctrl.Result{}, nil
+	}
+
+	status := plugin.Status.DeepCopy()
+	status.ObservedGeneration = plugin.Generation
+	result := ctrl.Result{RequeueAfter: pluginRefreshInterval}
+
+	if err := r.register(ctx, plugin, status); err != nil {
+		logger.Info("ValidatorPlugin not registered", "name", plugin.Name, "reason", err.reason, "message", err.message)
+		r.unregister(ctx, plugin.Name)
+		meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+			Type:               assessmentv1alpha1.ConditionReady,
+			Status:             metav1.ConditionFalse,
+			Reason:             err.reason,
+			Message:            err.message,
+			ObservedGeneration: plugin.Generation,
+		})
+		result = ctrl.Result{}
+		if err.retry {
+			result.RequeueAfter = pluginRetryInterval
+		}
+	} else {
+		meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+			Type:               assessmentv1alpha1.ConditionReady,
+			Status:             metav1.ConditionTrue,
+			Reason:             assessmentv1alpha1.ReasonPluginRegistered,
+			Message:            fmt.Sprintf("Registered as validator %s with %d checks", plugin.Name, len(status.Checks)),
+			ObservedGeneration: plugin.Generation,
+		})
+	}
+	r.setInsecureCondition(plugin, status)
+
+	if !equality.Semantic.DeepEqual(&plugin.Status, status) {
+		plugin.Status = *status
+		if err := r.Status().Update(ctx, plugin); err != nil {
+			logger.Error(err, "Failed to update ValidatorPlugin status")
+			return ctrl.Result{}, err
+		}
+	}
+	return result, nil
+}
+
+// setInsecureCondition reports a plugin that opted in to an unencrypted
+// connection, with a warning event when it starts doing so.
+func (r *ValidatorPluginReconciler) setInsecureCondition(plugin *assessmentv1alpha1.ValidatorPlugin, status *assessmentv1alpha1.ValidatorPluginStatus) {
+	if !plugin.Spec.Insecure {
+		meta.RemoveStatusCondition(&status.Conditions, assessmentv1alpha1.ConditionInsecure)
+		return
+	}
+	message := "Plugin is called without TLS; set caBundle to verify its serving certificate"
+	if !meta.IsStatusConditionTrue(plugin.Status.Conditions, assessmentv1alpha1.ConditionInsecure) && r.Recorder != nil {
+		r.Recorder.Event(plugin, corev1.EventTypeWarning, eventPluginInsecure, message)
+	}
+	meta.SetStatusCondition(&status.Conditions, metav1.Condition{
+		Type:               assessmentv1alpha1.ConditionInsecure,
+		Status:             metav1.ConditionTrue,
+		Reason:             assessmentv1alpha1.ReasonInsecureOptIn,
+		Message:            message,
+		ObservedGeneration: plugin.Generation,
+	})
+}
+
+// registerError explains why a plugin could not be registered.
+type registerError struct {
+	reason  string
+	message string
+	retry   bool
+}
+
+// register describes the plugin and registers it as a validator, replacing
+// the validator of an earlier description. The description is recorded in
+// status.
+func (r *ValidatorPluginReconciler) register(ctx context.Context, plugin *assessmentv1alpha1.ValidatorPlugin, status *assessmentv1alpha1.ValidatorPluginStatus) *registerError {
+	if err := plugins.ValidateName(plugin.Name); err != nil {
+		return &registerError{reason: assessmentv1alpha1.ReasonInvalidName, message: err.Error()}
+	}
+	if existing, ok := r.Registry.Get(plugin.Name); ok {
+		if _, isPlugin := existing.(*plugins.Validator); !isPlugin {
+			return &registerError{reason: assessmentv1alpha1.ReasonNameConflict,
+				message: fmt.Sprintf("A built-in validator is named %s; rename the ValidatorPlugin", plugin.Name)}
+		}
+	}
+
+	connect := r.Connect
+	if connect == nil {
+		connect = plugins.Connect
+	}
+	pc, err := connect(plugin.Spec)
+	if err != nil {
+		return &registerError{reason: assessmentv1alpha1.ReasonDescribeFailed, message: err.Error()}
+	}
+	describeCtx, cancel := context.WithTimeout(ctx, pluginDescribeTimeout)
+	defer cancel()
+	desc, err := pc.Describe(describeCtx)
+	if err != nil {
+		_ = pc.Close()
+		return &registerError{reason: assessmentv1alpha1.ReasonDescribeFailed,
+			message: fmt.Sprintf("Describe failed: %v", err), retry: true}
+	}
+	// An upgraded plugin may fix its catalog, so it is described again
+	if err := desc.ValidateFor(plugin.Name); err != nil {
+		_ = pc.Close()
+		return &registerError{reason: assessmentv1alpha1.ReasonInvalidCatalog, message: err.Error(), retry: true}
+	}
+
+	v := plugins.NewValidator(plugin.Name, *desc, pc)
+	r.unregisterQuietly(plugin.Name)
+	if err := r.Registry.Register(v); err != nil {
+		_ = v.Close()
+		return &registerError{reason: assessmentv1alpha1.ReasonNameConflict, message: err.Error()}
+	}
+	r.publishCatalog(ctx)
+
+	status.Description = desc.Description
+	status.Category = v.Category()
+	status.Checks = desc.Checks
+	return nil
+}
+
+// unregister removes the plugin's validator, if registered, and republishes
+// the catalog.
+func (r *ValidatorPluginReconciler) unregister(ctx context.Context, name string) {
+	if r.unregisterQuietly(name) {
+		log.FromContext(ctx).Info("Unregistered validator plugin", "name", name)
+		r.publishCatalog(ctx)
+	}
+}
+
+// unregisterQuietly removes and closes the plugin's validator. Built-in
+// validators with the same name are left alone.
+func (r *ValidatorPluginReconciler) unregisterQuietly(name string) bool {
+	existing, ok := r.Registry.Get(name)
+	if !ok {
+		return false
+	}
+	if _, isPlugin := existing.(*plugins.Validator); !isPlugin {
+		return false
+	}
+	if v, ok := r.Registry.Unregister(name); ok {
+		_ = v.(*plugins.Validator).Close()
+	}
+	return true
+}
+
+// publishCatalog republishes the check catalog so it lists plugin checks.
+func (r *ValidatorPluginReconciler) publishCatalog(ctx context.Context) {
+	if r.OperatorNamespace == "" {
+		return
+	}
+	if err := validator.PublishCatalog(ctx, r.Client, r.Registry, r.OperatorNamespace); err != nil {
+		log.FromContext(ctx).Error(err, "Failed to publish validator catalog")
+	}
+}
+
+// SetupWithManager sets up the controller with the Manager.
+func (r *ValidatorPluginReconciler) SetupWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewControllerManagedBy(mgr).
+		For(&assessmentv1alpha1.ValidatorPlugin{}, builder.WithPredicates(predicate.GenerationChangedPredicate{})).
+		Complete(r)
+}
@@ -0,0 +1,214 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package controllers
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"k8s.io/client-go/tools/record"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	assessmentv1alpha1 "github.com/openshift-assessment/cluster-assessment-operator/api/v1alpha1"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/plugins"
+)
+
+// testPlugin is a plugin server with a fixed description.
+type testPlugin struct {
+	err error
+}
+
+func (p *testPlugin) Describe(_ context.Context) (*plugins.Description, error) {
+	if p.err != nil {
+		return nil, p.err
+	}
+	return &plugins.Description{
+		Description: "Validates Acme operator configuration",
+		Category:    "Acme",
+		Checks: []assessmentv1alpha1.PluginCheck{
+			{ID: "acme-license-valid", Title: "Acme License Valid", DefaultStatus: assessmentv1alpha1.FindingStatusFail},
+		},
+	}, nil
+}
+
+func (p *testPlugin) Validate(_ context.Context, _ *plugins.ValidateRequest) (*plugins.ValidateResponse, error) {
+	return &plugins.ValidateResponse{}, nil
+}
+
+// newPluginReconciler returns a reconciler whose plugins are served by srv.
+func newPluginReconciler(t *testing.T, srv plugins.Server, objs ...client.Object) *ValidatorPluginReconciler {
+	t.Helper()
+	lis := bufconn.Listen(1 << 20)
+	s := grpc.NewServer()
+	plugins.RegisterServer(s, srv)
+	go func() { _ = s.Serve(lis) }()
+	t.Cleanup(s.Stop)
+
+	scheme := runtime.NewScheme()
+	utilruntime.Must(assessmentv1alpha1.AddToScheme(scheme))
+	return &ValidatorPluginReconciler{
+		Client: fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).
+			WithStatusSubresource(&assessmentv1alpha1.ValidatorPlugin{}).Build(),
+		Registry: newTestRegistry("security"),
+		Connect: func(_ assessmentv1alpha1.ValidatorPluginSpec) (*plugins.Client, error) {
+			conn, err := grpc.NewClient("passthrough:///plugin",
+				grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+				grpc.WithTransportCredentials(insecure.NewCredentials()))
+			if err != nil {
+				return nil, err
+			}
+			return plugins.NewClient(conn), nil
+		},
+	}
+}
+
+func newValidatorPlugin(name string) *assessmentv1alpha1.ValidatorPlugin {
+	return &assessmentv1alpha1.ValidatorPlugin{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: assessmentv1alpha1.ValidatorPluginSpec{
+			Service: assessmentv1alpha1.PluginServiceReference{Namespace: "acme-system", Name: "acme-plugin", Port: 8443},
+		},
+	}
+}
+
+func reconcilePlugin(t *testing.T, r *ValidatorPluginReconciler, name string) (ctrl.Result, *assessmentv1alpha1.ValidatorPlugin) {
+	t.Helper()
+	result, err := r.Reconcile(context.Background(), ctrl.Request{NamespacedName: types.NamespacedName{Name: name}})
+	if err != nil {
+		t.Fatalf("Reconcile returned error: %v", err)
+	}
+	plugin := &assessmentv1alpha1.ValidatorPlugin{}
+	if err := r.Get(context.Background(), types.NamespacedName{Name: name}, plugin); err != nil {
+		return result, nil
+	}
+	return result, plugin
+}
+
+func TestValidatorPluginReconciler_RegistersPlugin(t *testing.T) {
+	r := newPluginReconciler(t, &testPlugin{}, newValidatorPlugin("acme"))
+
+	result, plugin := reconcilePlugin(t, r, "acme")
+	v, ok := r.Registry.Get("acme")
+	if !ok || v.Category() != "Acme" {
+		t.Fatalf("Expected the plugin to be registered, got %v", v)
+	}
+	if _, ok := r.Registry.LookupCheck("acme-license-valid"); !ok {
+		t.Error("Expected the plugin's checks in the catalog")
+	}
+	if !plugins.IsReady(plugin) || plugin.Status.Category != "Acme" || len(plugin.Status.Checks) != 1 {
+		t.Errorf("Expected a ready status recording the description, got %+v", plugin.Status)
+	}
+	if result.RequeueAfter != pluginRefreshInterval {
+		t.Errorf("Expected the plugin to be described again after %s, got %s", pluginRefreshInterval, result.RequeueAfter)
+	}
+
+	// Reconciling again replaces the validator instead of failing on the name
+	reconcilePlugin(t, r, "acme")
+	if _, ok := r.Registry.Get("acme"); !ok {
+		t.Error("Expected the plugin to stay registered")
+	}
+
+	if err := r.Delete(context.Background(), plugin); err != nil {
+		t.Fatalf("Delete returned error: %v", err)
+	}
+	reconcilePlugin(t, r, "acme")
+	if _, ok := r.Registry.Get("acme"); ok {
+		t.Error("Expected the deleted plugin to be unregistered")
+	}
+}
+
+func TestValidatorPluginReconciler_NotReady(t *testing.T) {
+	tests := []struct {
+		name       string
+		plugin     string
+		srv        *testPlugin
+		wantReason string
+		wantRetry  bool
+	}{
+		{name: "describe fails", plugin: "acme", srv: &testPlugin{err: errors.New("unavailable")}, wantReason: assessmentv1alpha1.ReasonDescribeFailed, wantRetry: true},
+		{name: "built-in name", plugin: "security", srv: &testPlugin{}, wantReason: assessmentv1alpha1.ReasonNameConflict},
+		{name: "invalid name", plugin: "acme.operator", srv: &testPlugin{}, wantReason: assessmentv1alpha1.ReasonInvalidName},
+		{name: "checks of another name", plugin: "globex", srv: &testPlugin{}, wantReason: assessmentv1alpha1.ReasonInvalidCatalog, wantRetry: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			r := newPluginReconciler(t, tt.srv, newValidatorPlugin(tt.plugin))
+
+			result, plugin := reconcilePlugin(t, r, tt.plugin)
+			cond := meta.FindStatusCondition(plugin.Status.Conditions, assessmentv1alpha1.ConditionReady)
+			if cond == nil || cond.Status != metav1.ConditionFalse || cond.Reason != tt.wantReason {
+				t.Errorf("Expected Ready=False with reason %s, got %+v", tt.wantReason, cond)
+			}
+			if (result.RequeueAfter > 0) != tt.wantRetry {
+				t.Errorf("Expected retry=%v, got %+v", tt.wantRetry, result)
+			}
+			if v, ok := r.Registry.Get(tt.plugin); ok {
+				if _, isPlugin := v.(*plugins.Validator); isPlugin {
+					t.Error("Expected the plugin not to be registered")
+				}
+			}
+		})
+	}
+}
+
+func TestValidatorPluginReconciler_InsecureCondition(t *testing.T) {
+	plugin := newValidatorPlugin("acme")
+	plugin.Spec.Insecure = true
+	r := newPluginReconciler(t, &testPlugin{}, plugin)
+	recorder := record.NewFakeRecorder(10)
+	r.Recorder = recorder
+
+	_, plugin = reconcilePlugin(t, r, "acme")
+	cond := meta.FindStatusCondition(plugin.Status.Conditions, assessmentv1alpha1.ConditionInsecure)
+	if cond == nil || cond.Status != metav1.ConditionTrue || cond.Reason != assessmentv1alpha1.ReasonInsecureOptIn {
+		t.Errorf("Expected Insecure=True, got %+v", cond)
+	}
+	if !plugins.IsReady(plugin) {
+		t.Errorf("Expected the insecure plugin to be registered, got %+v", plugin.Status.Conditions)
+	}
+
+	// The warning is emitted once, not on every refresh
+	reconcilePlugin(t, r, "acme")
+	events := drainEvents(recorder)
+	if len(events) != 1 || !strings.HasPrefix(events[0], "Warning InsecureConnection") {
+		t.Errorf("Expected one insecure connection event, got %v", events)
+	}
+
+	plugin.Spec.Insecure = false
+	plugin.Spec.CABundle = []byte("ca")
+	if err := r.Update(context.Background(), plugin); err != nil {
+		t.Fatalf("Update returned error: %v", err)
+	}
+	_, plugin = reconcilePlugin(t, r, "acme")
+	if cond := meta.FindStatusCondition(plugin.Status.Conditions, assessmentv1alpha1.ConditionInsecure); cond != nil {
+		t.Errorf("Expected the Insecure condition to be removed with TLS, got %+v", cond)
+	}
+}
@@ -17,48 +17,39 @@ limitations under the License.
 package main
 
 import (
+	"context"
 	"flag"
+	"fmt"
 	"os"
+	"strings"
+	"time"
 
+	batchv1 "k8s.io/api/batch/v1"
+	"k8s.io/apimachinery/pkg/labels"
 	"k8s.io/apimachinery/pkg/runtime"
 	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
 	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
 	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/cache"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/healthz"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
 	metricsserver "sigs.k8s.io/controller-runtime/pkg/metrics/server"
 
 	configv1 "github.com/openshift/api/config/v1"
 
 	assessmentv1alpha1 "github.com/openshift-assessment/cluster-assessment-operator/api/v1alpha1"
 	"github.com/openshift-assessment/cluster-assessment-operator/controllers"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/execution"
 	"github.com/openshift-assessment/cluster-assessment-operator/pkg/machineconfig"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/plugins"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/profiles"
 	"github.com/openshift-assessment/cluster-assessment-operator/pkg/validator"
+	"github.com/openshift-assessment/cluster-assessment-operator/webhooks"
 
 	// Import validators to register them
-	_ "github.com/openshift-assessment/cluster-assessment-operator/pkg/validators/apiserver"
-	_ "github.com/openshift-assessment/cluster-assessment-operator/pkg/validators/certificates"
-	_ "github.com/openshift-assessment/cluster-assessment-operator/pkg/validators/clusterautoscaler"
-	_ "github.com/openshift-assessment/cluster-assessment-operator/pkg/validators/compliance"
-	_ "github.com/openshift-assessment/cluster-assessment-operator/pkg/validators/costoptimization"
-	_ "github.com/openshift-assessment/cluster-assessment-operator/pkg/validators/deprecation"
-	_ "github.com/openshift-assessment/cluster-assessment-operator/pkg/validators/etcdbackup"
-	_ "github.com/openshift-assessment/cluster-assessment-operator/pkg/validators/imageregistry"
-	_ "github.com/openshift-assessment/cluster-assessment-operator/pkg/validators/ingresstls"
-	_ "github.com/openshift-assessment/cluster-assessment-operator/pkg/validators/logging"
-	_ "github.com/openshift-assessment/cluster-assessment-operator/pkg/validators/machineconfig"
-	_ "github.com/openshift-assessment/cluster-assessment-operator/pkg/validators/monitoring"
-	_ "github.com/openshift-assessment/cluster-assessment-operator/pkg/validators/networking"
-	_ "github.com/openshift-assessment/cluster-assessment-operator/pkg/validators/networkpolicyaudit"
-	_ "github.com/openshift-assessment/cluster-assessment-operator/pkg/validators/nodes"
-	_ "github.com/openshift-assessment/cluster-assessment-operator/pkg/validators/oadpbackup"
-	_ "github.com/openshift-assessment/cluster-assessment-operator/pkg/validators/operators"
-	_ "github.com/openshift-assessment/cluster-assessment-operator/pkg/validators/podsecurityadmission"
-	_ "github.com/openshift-assessment/cluster-assessment-operator/pkg/validators/rbacaudit"
-	_ "github.com/openshift-assessment/cluster-assessment-operator/pkg/validators/resourcequotas"
-	_ "github.com/openshift-assessment/cluster-assessment-operator/pkg/validators/security"
-	_ "github.com/openshift-assessment/cluster-assessment-operator/pkg/validators/storage"
-	_ "github.com/openshift-assessment/cluster-assessment-operator/pkg/validators/version"
+	_ "github.com/openshift-assessment/cluster-assessment-operator/pkg/validators/all"
 )
 
 var (
@@ -74,15 +65,33 @@ func init() {
 }
 
 func main() {
+	// Assessment Jobs run the same image with the assess subcommand
+	if len(os.Args) > 1 && os.Args[1] == execution.Command {
+		os.Exit(runAssess(os.Args[2:]))
+	}
+
 	var metricsAddr string
 	var enableLeaderElection bool
 	var probeAddr string
+	var validatorConcurrency int
+	var validatorTimeout time.Duration
+	var jobImage string
+	var enableWebhooks bool
 
 	flag.StringVar(&metricsAddr, "metrics-bind-address", ":8080", "The address the metric endpoint binds to.")
 	flag.StringVar(&probeAddr, "health-probe-bind-address", ":8081", "The address the probe endpoint binds to.")
 	flag.BoolVar(&enableLeaderElection, "leader-elect", false,
 		"Enable leader election for controller manager. "+
 			"Enabling this will ensure there is only one active controller manager.")
+	flag.IntVar(&validatorConcurrency, "validator-concurrency", validator.DefaultConcurrency,
+		"The number of validators run in parallel during an assessment.")
+	flag.DurationVar(&validatorTimeout, "validator-timeout", validator.DefaultValidatorTimeout,
+		"The maximum time a single validator may run before it is reported as timed out.")
+	flag.StringVar(&jobImage, "assessment-job-image", os.Getenv("OPERATOR_IMAGE"),
+		"The image used by assessments running in Job mode. Defaults to $OPERATOR_IMAGE.")
+	flag.BoolVar(&enableWebhooks, "enable-webhooks", os.Getenv("ENABLE_WEBHOOKS") != "false",
+		"Serve the defaulting and validating admission webhooks. Requires a serving certificate in "+
+			"/tmp/k8s-webhook-server/serving-certs. Defaults to true unless $ENABLE_WEBHOOKS is \"false\".")
 
 	opts := zap.Options{
 		Development: true,
@@ -102,6 +111,14 @@ func main() {
 		HealthProbeBindAddress: probeAddr,
 		LeaderElection:         enableLeaderElection,
 		LeaderElectionID:       "cluster-assessment-operator.openshift.io",
+		Cache: cache.Options{
+			ByObject: map[client.Object]cache.ByObject{
+				// Only assessment Jobs are watched, not every Job in the cluster
+				&batchv1.Job{}: {Label: labels.SelectorFromSet(labels.Set{
+					"app.kubernetes.io/component": execution.JobComponent,
+				})},
+			},
+		},
 	})
 	if err != nil {
 		setupLog.Error(err, "unable to start manager")
@@ -118,10 +135,16 @@ func main() {
 	}
 
 	if err = (&controllers.ClusterAssessmentReconciler{
-		Client:            mgr.GetClient(),
-		Scheme:            mgr.GetScheme(),
-		Registry:          registry,
-		OperatorNamespace: operatorNamespace,
+		Client:               mgr.GetClient(),
+		Scheme:               mgr.GetScheme(),
+		Registry:             registry,
+		OperatorNamespace:    operatorNamespace,
+		ValidatorConcurrency: validatorConcurrency,
+		ValidatorTimeout:     validatorTimeout,
+		APIReader:            mgr.GetAPIReader(),
+		JobImage:             jobImage,
+		JobServiceAccount:    os.Getenv("OPERATOR_SERVICE_ACCOUNT"),
+		Recorder:             mgr.GetEventRecorderFor("cluster-assessment-operator"),
 	}).SetupWithManager(mgr); err != nil {
 		setupLog.Error(err, "unable to create controller", "controller", "ClusterAssessment")
 		os.Exit(1)
@@ -136,6 +159,57 @@ func main() {
 		os.Exit(1)
 	}
 
+	if err = (&controllers.ValidatorPluginReconciler{
+		Client:            mgr.GetClient(),
+		Scheme:            mgr.GetScheme(),
+		Registry:          registry,
+		OperatorNamespace: operatorNamespace,
+		Recorder:          mgr.GetEventRecorderFor("cluster-assessment-operator"),
+	}).SetupWithManager(mgr); err != nil {
+		setupLog.Error(err, "unable to create controller", "controller", "ValidatorPlugin")
+		os.Exit(1)
+	}
+
+	if enableWebhooks {
+		if err = (&webhooks.ClusterAssessmentWebhook{
+			Client:   mgr.GetClient(),
+			Registry: registry,
+		}).SetupWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "ClusterAssessment")
+			os.Exit(1)
+		}
+		if err = (&webhooks.AssessmentProfileWebhook{
+			Client:   mgr.GetClient(),
+			Registry: registry,
+		}).SetupWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "AssessmentProfile")
+			os.Exit(1)
+		}
+		if err = (&webhooks.CustomCheckWebhook{
+			Client: mgr.GetClient(),
+		}).SetupWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "CustomCheck")
+			os.Exit(1)
+		}
+		if err = (&webhooks.FindingExceptionWebhook{
+			Client: mgr.GetClient(),
+		}).SetupWebhookWithManager(mgr); err != nil {
+			setupLog.Error(err, "unable to create webhook", "webhook", "FindingException")
+			os.Exit(1)
+		}
+	}
+
+	// Publish the check catalog once the manager (and its cache) has started
+	if err := mgr.Add(manager.RunnableFunc(func(ctx context.Context) error {
+		if err := validator.PublishCatalog(ctx, mgr.GetClient(), registry, operatorNamespace); err != nil {
+			setupLog.Error(err, "unable to publish validator catalog")
+		}
+		return nil
+	})); err != nil {
+		setupLog.Error(err, "unable to set up validator catalog publisher")
+		os.Exit(1)
+	}
+
 	if err := mgr.AddHealthzCheck("healthz", healthz.Ping); err != nil {
 		setupLog.Error(err, "unable to set up health check")
 		os.Exit(1)
@@ -144,6 +218,12 @@ func main() {
 		setupLog.Error(err, "unable to set up ready check")
 		os.Exit(1)
 	}
+	if enableWebhooks {
+		if err := mgr.AddReadyzCheck("webhook", mgr.GetWebhookServer().StartedChecker()); err != nil {
+			setupLog.Error(err, "unable to set up webhook ready check")
+			os.Exit(1)
+		}
+	}
 
 	setupLog.Info("starting manager")
 	if err := mgr.Start(ctrl.SetupSignalHandler()); err != nil {
@@ -151,3 +231,83 @@ func main() {
 		os.Exit(1)
 	}
 }
+
+// runAssess runs the validators of one ClusterAssessment and writes the result
+// to a ConfigMap in the operator namespace for the controller to complete the
+// run. It is the entrypoint of assessment Jobs and returns the exit code.
+func runAssess(args []string) int {
+	fs := flag.NewFlagSet(execution.Command, flag.ExitOnError)
+	assessmentName := fs.String("assessment", "", "The name of the ClusterAssessment to run.")
+	resultsConfigMap := fs.String("results-configmap", "", "The ConfigMap the result is written to.")
+	concurrency := fs.Int("validator-concurrency", validator.DefaultConcurrency,
+		"The number of validators run in parallel.")
+	validatorTimeout := fs.Duration("validator-timeout", validator.DefaultValidatorTimeout,
+		"The maximum time a single validator may run before it is reported as timed out.")
+	validators := fs.String("validators", "",
+		"Comma-separated validators to run instead of the assessment's validators.")
+	opts := zap.Options{}
+	opts.BindFlags(fs)
+	_ = fs.Parse(args)
+
+	ctrl.SetLogger(zap.New(zap.UseFlagOptions(&opts)))
+	logger := ctrl.Log.WithName(execution.Command)
+
+	if *assessmentName == "" || *resultsConfigMap == "" {
+		logger.Error(nil, "--assessment and --results-configmap are required")
+		return 2
+	}
+	namespace := os.Getenv("OPERATOR_NAMESPACE")
+	if namespace == "" {
+		namespace = "cluster-assessment-operator"
+	}
+
+	c, err := client.New(ctrl.GetConfigOrDie(), client.Options{Scheme: scheme})
+	if err != nil {
+		logger.Error(err, "unable to create client")
+		return 1
+	}
+	ctx := ctrl.SetupSignalHandler()
+
+	var only []string
+	if *validators != "" {
+		only = strings.Split(*validators, ",")
+	}
+	result, err := assess(ctx, c, *assessmentName, only, *concurrency, *validatorTimeout)
+	if err != nil {
+		logger.Error(err, "assessment failed", "assessment", *assessmentName)
+		result = &execution.Result{Error: err.Error()}
+	}
+	if err := execution.WriteResult(ctx, c, namespace, *resultsConfigMap, *assessmentName, result); err != nil {
+		logger.Error(err, "unable to write result", "configMap", *resultsConfigMap)
+		return 1
+	}
+	if result.Error != "" {
+		return 1
+	}
+	logger.Info("assessment finished", "assessment", *assessmentName, "findings", len(result.Findings))
+	return 0
+}
+
+// assess resolves the assessment's profile and runs its validators, or the
+// given validators of a partial run.
+func assess(ctx context.Context, c client.Client, name string, validators []string, concurrency int, validatorTimeout time.Duration) (*execution.Result, error) {
+	assessment := &assessmentv1alpha1.ClusterAssessment{}
+	if err := c.Get(ctx, client.ObjectKey{Name: name}, assessment); err != nil {
+		return nil, fmt.Errorf("failed to get ClusterAssessment: %w", err)
+	}
+	profile, err := profiles.NewResolver(c).Resolve(ctx, assessment.Spec.Profile)
+	if err != nil {
+		return nil, fmt.Errorf("profile resolution failed: %w", err)
+	}
+	if len(validators) == 0 {
+		validators = assessment.Spec.Validators
+	}
+	// Plugins are registered by the operator process; load the ready ones here
+	registry := validator.DefaultRegistry()
+	if err := plugins.Load(ctx, c, registry); err != nil {
+		ctrl.Log.WithName(execution.Command).Error(err, "some validator plugins could not be loaded")
+	}
+	return execution.Run(ctx, c, c, registry, profile, validators,
+		validator.WithConcurrency(concurrency),
+		validator.WithValidatorTimeout(validatorTimeout))
+}
@@ -0,0 +1,77 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package clusterinfo gathers identifying metadata about the assessed cluster.
+package clusterinfo
+
+import (
+	"context"
+
+	configv1 "github.com/openshift/api/config/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	assessmentv1alpha1 "github.com/openshift-assessment/cluster-assessment-operator/api/v1alpha1"
+)
+
+// Collect gathers metadata about the cluster. Lookups that fail are left empty,
+// since cluster info is informational and must not block an assessment.
+func Collect(ctx context.Context, c client.Reader) (assessmentv1alpha1.ClusterInfo, error) {
+	info := assessmentv1alpha1.ClusterInfo{}
+
+	// Get ClusterVersion
+	cv := &configv1.ClusterVersion{}
+	if err := c.Get(ctx, client.ObjectKey{Name: "version"}, cv); err == nil {
+		info.ClusterID = string(cv.Spec.ClusterID)
+		if len(cv.Status.History) > 0 {
+			info.ClusterVersion = cv.Status.History[0].Version
+		}
+		info.Channel = cv.Spec.Channel
+	}
+
+	// Get Infrastructure
+	infra := &configv1.Infrastructure{}
+	if err := c.Get(ctx, client.ObjectKey{Name: "cluster"}, infra); err == nil && infra.Status.PlatformStatus != nil {
+		info.Platform = string(infra.Status.PlatformStatus.Type)
+	}
+
+	// Count nodes
+	// Optimization: Use PartialObjectMetadataList to avoid fetching full Node objects (Status, Spec)
+	// when we only need labels. This significantly reduces memory usage and API bandwidth.
+	nodes := &metav1.PartialObjectMetadataList{}
+	nodes.SetGroupVersionKind(schema.GroupVersionKind{
+		Group:   "",
+		Version: "v1",
+		Kind:    "NodeList",
+	})
+	if err := c.List(ctx, nodes); err == nil {
+		info.NodeCount = len(nodes.Items)
+		for _, node := range nodes.Items {
+			if _, ok := node.Labels["node-role.kubernetes.io/master"]; ok {
+				info.ControlPlaneNodes++
+			}
+			if _, ok := node.Labels["node-role.kubernetes.io/control-plane"]; ok {
+				info.ControlPlaneNodes++
+			}
+			if _, ok := node.Labels["node-role.kubernetes.io/worker"]; ok {
+				info.WorkerNodes++
+			}
+		}
+	}
+
+	return info, nil
+}
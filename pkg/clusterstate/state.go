@@ -0,0 +1,293 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package clusterstate collects the resource types shared by several validators
+// once per assessment run and serves them through a read-only, indexed view.
+package clusterstate
+
+import (
+	"context"
+	"sort"
+	"sync"
+	"time"
+
+	assessmentv1alpha1 "github.com/openshift-assessment/cluster-assessment-operator/api/v1alpha1"
+	appsv1 "k8s.io/api/apps/v1"
+	corev1 "k8s.io/api/core/v1"
+	networkingv1 "k8s.io/api/networking/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// DefaultPageSize is the number of objects requested per List call.
+const DefaultPageSize = 500
+
+// State lists each resource type on first use and keeps it for the rest of
+// the run. Objects returned by State are shared between validators and must
+// not be modified. State is safe for concurrent use.
+type State struct {
+	reader   client.Reader
+	pageSize int64
+
+	pods                resourceCache[corev1.Pod]
+	namespaces          resourceCache[metav1.PartialObjectMetadata]
+	deployments         resourceCache[appsv1.Deployment]
+	networkPolicies     resourceCache[networkingv1.NetworkPolicy]
+	clusterRoles        resourceCache[rbacv1.ClusterRole]
+	clusterRoleBindings resourceCache[rbacv1.ClusterRoleBinding]
+	roles               resourceCache[rbacv1.Role]
+	roleBindings        resourceCache[rbacv1.RoleBinding]
+
+	mu    sync.Mutex
+	stats map[string]assessmentv1alpha1.ResourceCollectionStats
+}
+
+// Option configures a State.
+type Option func(*State)
+
+// WithPageSize sets the number of objects requested per List call.
+// Values below 1 are ignored.
+func WithPageSize(n int64) Option {
+	return func(s *State) {
+		if n > 0 {
+			s.pageSize = n
+		}
+	}
+}
+
+// New creates an empty State that lists resources through the given reader.
+func New(reader client.Reader, opts ...Option) *State {
+	s := &State{
+		reader:   reader,
+		pageSize: DefaultPageSize,
+		stats:    make(map[string]assessmentv1alpha1.ResourceCollectionStats),
+	}
+	for _, opt := range opts {
+		opt(s)
+	}
+	return s
+}
+
+// stateKey is the context key under which the run's State is stored.
+type stateKey struct{}
+
+// NewContext returns a context carrying the run's State.
+func NewContext(ctx context.Context, s *State) context.Context {
+	return context.WithValue(ctx, stateKey{}, s)
+}
+
+// FromContext returns the State stored by NewContext, or a new State backed by
+// the given reader when the caller is not running under a Runner.
+func FromContext(ctx context.Context, reader client.Reader) *State {
+	if s, ok := ctx.Value(stateKey{}).(*State); ok && s != nil {
+		return s
+	}
+	return New(reader)
+}
+
+// Pods returns all pods in the cluster.
+func (s *State) Pods(ctx context.Context) ([]corev1.Pod, error) {
+	return load(ctx, s, &s.pods, "pods", func() *corev1.PodList { return &corev1.PodList{} },
+		func(l *corev1.PodList) []corev1.Pod { return l.Items })
+}
+
+// PodsInNamespace returns the pods in a single namespace.
+func (s *State) PodsInNamespace(ctx context.Context, namespace string) ([]corev1.Pod, error) {
+	if _, err := s.Pods(ctx); err != nil {
+		return nil, err
+	}
+	return s.pods.inNamespace(namespace), nil
+}
+
+// Namespaces returns the metadata of all namespaces in the cluster. Only the
+// metadata is listed, since validators look at namespace names and labels.
+func (s *State) Namespaces(ctx context.Context) ([]metav1.PartialObjectMetadata, error) {
+	return load(ctx, s, &s.namespaces, "namespaces", newNamespaceMetadataList,
+		func(l *metav1.PartialObjectMetadataList) []metav1.PartialObjectMetadata { return l.Items })
+}
+
+// newNamespaceMetadataList returns an empty metadata-only namespace list.
+func newNamespaceMetadataList() *metav1.PartialObjectMetadataList {
+	list := &metav1.PartialObjectMetadataList{}
+	list.SetGroupVersionKind(corev1.SchemeGroupVersion.WithKind("NamespaceList"))
+	return list
+}
+
+// Namespace returns the metadata of the named namespace, or nil if it does not exist.
+func (s *State) Namespace(ctx context.Context, name string) (*metav1.PartialObjectMetadata, error) {
+	namespaces, err := s.Namespaces(ctx)
+	if err != nil {
+		return nil, err
+	}
+	for i := range namespaces {
+		if namespaces[i].Name == name {
+			return &namespaces[i], nil
+		}
+	}
+	return nil, nil
+}
+
+// NamespacesMatching returns the metadata of the namespaces whose labels match the selector.
+func (s *State) NamespacesMatching(ctx context.Context, selector labels.Selector) ([]metav1.PartialObjectMetadata, error) {
+	namespaces, err := s.Namespaces(ctx)
+	if err != nil {
+		return nil, err
+	}
+	var matching []metav1.PartialObjectMetadata
+	for _, ns := range namespaces {
+		if selector.Matches(labels.Set(ns.Labels)) {
+			matching = append(matching, ns)
+		}
+	}
+	return matching, nil
+}
+
+// Deployments returns all deployments in the cluster.
+func (s *State) Deployments(ctx context.Context) ([]appsv1.Deployment, error) {
+	return load(ctx, s, &s.deployments, "deployments", func() *appsv1.DeploymentList { return &appsv1.DeploymentList{} },
+		func(l *appsv1.DeploymentList) []appsv1.Deployment { return l.Items })
+}
+
+// NetworkPolicies returns all network policies in the cluster.
+func (s *State) NetworkPolicies(ctx context.Context) ([]networkingv1.NetworkPolicy, error) {
+	return load(ctx, s, &s.networkPolicies, "networkpolicies", func() *networkingv1.NetworkPolicyList { return &networkingv1.NetworkPolicyList{} },
+		func(l *networkingv1.NetworkPolicyList) []networkingv1.NetworkPolicy { return l.Items })
+}
+
+// NetworkPoliciesInNamespace returns the network policies in a single namespace.
+func (s *State) NetworkPoliciesInNamespace(ctx context.Context, namespace string) ([]networkingv1.NetworkPolicy, error) {
+	if _, err := s.NetworkPolicies(ctx); err != nil {
+		return nil, err
+	}
+	return s.networkPolicies.inNamespace(namespace), nil
+}
+
+// ClusterRoles returns all cluster roles.
+func (s *State) ClusterRoles(ctx context.Context) ([]rbacv1.ClusterRole, error) {
+	return load(ctx, s, &s.clusterRoles, "clusterroles", func() *rbacv1.ClusterRoleList { return &rbacv1.ClusterRoleList{} },
+		func(l *rbacv1.ClusterRoleList) []rbacv1.ClusterRole { return l.Items })
+}
+
+// ClusterRoleBindings returns all cluster role bindings.
+func (s *State) ClusterRoleBindings(ctx context.Context) ([]rbacv1.ClusterRoleBinding, error) {
+	return load(ctx, s, &s.clusterRoleBindings, "clusterrolebindings", func() *rbacv1.ClusterRoleBindingList { return &rbacv1.ClusterRoleBindingList{} },
+		func(l *rbacv1.ClusterRoleBindingList) []rbacv1.ClusterRoleBinding { return l.Items })
+}
+
+// Roles returns all namespaced roles in the cluster.
+func (s *State) Roles(ctx context.Context) ([]rbacv1.Role, error) {
+	return load(ctx, s, &s.roles, "roles", func() *rbacv1.RoleList { return &rbacv1.RoleList{} },
+		func(l *rbacv1.RoleList) []rbacv1.Role { return l.Items })
+}
+
+// RoleBindings returns all namespaced role bindings in the cluster.
+func (s *State) RoleBindings(ctx context.Context) ([]rbacv1.RoleBinding, error) {
+	return load(ctx, s, &s.roleBindings, "rolebindings", func() *rbacv1.RoleBindingList { return &rbacv1.RoleBindingList{} },
+		func(l *rbacv1.RoleBindingList) []rbacv1.RoleBinding { return l.Items })
+}
+
+// Stats returns how many objects were listed per resource type and how long
+// the listing took, or nil if nothing was collected.
+func (s *State) Stats() *assessmentv1alpha1.CollectionStats {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	if len(s.stats) == 0 {
+		return nil
+	}
+	stats := &assessmentv1alpha1.CollectionStats{}
+	for _, rs := range s.stats {
+		stats.ObjectsScanned += rs.Objects
+		stats.Duration.Duration += rs.Duration.Duration
+		stats.Resources = append(stats.Resources, rs)
+	}
+	sort.Slice(stats.Resources, func(i, j int) bool {
+		return stats.Resources[i].Resource < stats.Resources[j].Resource
+	})
+	return stats
+}
+
+// record stores the collection statistics of one resource type.
+func (s *State) record(resource string, objects, pages int, d time.Duration) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.stats[resource] = assessmentv1alpha1.ResourceCollectionStats{
+		Resource: resource,
+		Objects:  objects,
+		Pages:    pages,
+		Duration: metav1.Duration{Duration: d},
+	}
+}
+
+// resourceCache holds the objects of one resource type, indexed by namespace.
+// Failed lists are not cached, so a later caller retries with its own context.
+type resourceCache[T any] struct {
+	mu          sync.Mutex
+	loaded      bool
+	items       []T
+	byNamespace map[string][]T
+}
+
+// inNamespace returns the cached objects in a namespace. The cache must be loaded.
+func (c *resourceCache[T]) inNamespace(namespace string) []T {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	return c.byNamespace[namespace]
+}
+
+// load lists a resource type into its cache on first use, one page at a time.
+func load[T any, L client.ObjectList](ctx context.Context, s *State, c *resourceCache[T], resource string, newList func() L, items func(L) []T) ([]T, error) {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+	if c.loaded {
+		return c.items, nil
+	}
+
+	start := time.Now()
+	var all []T
+	pages := 0
+	continueToken := ""
+	for {
+		list := newList()
+		opts := []client.ListOption{client.Limit(s.pageSize)}
+		if continueToken != "" {
+			opts = append(opts, client.Continue(continueToken))
+		}
+		if err := s.reader.List(ctx, list, opts...); err != nil {
+			return nil, err
+		}
+		pages++
+		all = append(all, items(list)...)
+		continueToken = list.GetContinue()
+		if continueToken == "" {
+			break
+		}
+	}
+
+	c.byNamespace = make(map[string][]T)
+	for i := range all {
+		if obj, ok := any(&all[i]).(client.Object); ok {
+			c.byNamespace[obj.GetNamespace()] = append(c.byNamespace[obj.GetNamespace()], all[i])
+		}
+	}
+	c.items = all
+	c.loaded = true
+
+	s.record(resource, len(all), pages, time.Since(start))
+	return all, nil
+}
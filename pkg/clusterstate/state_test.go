@@ -0,0 +1,210 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterstate
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strconv"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+// pagedReader serves pods and namespace metadata one page at a time, honouring
+// the Limit and Continue list options. Full namespace lists are rejected.
+type pagedReader struct {
+	pods       []corev1.Pod
+	namespaces []metav1.PartialObjectMetadata
+	listCalls  map[string]int
+	err        error
+}
+
+func (r *pagedReader) Get(ctx context.Context, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+	return errors.New("not implemented")
+}
+
+func (r *pagedReader) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	listOpts := &client.ListOptions{}
+	listOpts.ApplyOptions(opts)
+
+	switch l := list.(type) {
+	case *corev1.PodList:
+		r.listCalls["pods"]++
+		if r.err != nil {
+			return r.err
+		}
+		start, end, next := page(len(r.pods), listOpts)
+		l.Items = r.pods[start:end]
+		l.Continue = next
+	case *metav1.PartialObjectMetadataList:
+		if l.GroupVersionKind() != corev1.SchemeGroupVersion.WithKind("NamespaceList") {
+			return fmt.Errorf("unexpected metadata list kind %s", l.GroupVersionKind())
+		}
+		r.listCalls["namespaces"]++
+		if r.err != nil {
+			return r.err
+		}
+		start, end, next := page(len(r.namespaces), listOpts)
+		l.Items = r.namespaces[start:end]
+		l.Continue = next
+	default:
+		return fmt.Errorf("unexpected list type %T", list)
+	}
+	return nil
+}
+
+func page(total int, opts *client.ListOptions) (start, end int, next string) {
+	if opts.Continue != "" {
+		start, _ = strconv.Atoi(opts.Continue)
+	}
+	end = total
+	if opts.Limit > 0 && start+int(opts.Limit) < total {
+		end = start + int(opts.Limit)
+		next = strconv.Itoa(end)
+	}
+	return start, end, next
+}
+
+func newReader() *pagedReader {
+	r := &pagedReader{listCalls: make(map[string]int)}
+	for i := 0; i < 5; i++ {
+		ns := "team-a"
+		if i%2 == 1 {
+			ns = "team-b"
+		}
+		r.pods = append(r.pods, corev1.Pod{ObjectMeta: metav1.ObjectMeta{Name: fmt.Sprintf("pod-%d", i), Namespace: ns}})
+	}
+	r.namespaces = []metav1.PartialObjectMetadata{
+		{ObjectMeta: metav1.ObjectMeta{Name: "team-a", Labels: map[string]string{"tenant": "a"}}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "team-b", Labels: map[string]string{"tenant": "b"}}},
+		{ObjectMeta: metav1.ObjectMeta{Name: "kube-system"}},
+	}
+	return r
+}
+
+func TestState_PaginatesAndListsOnce(t *testing.T) {
+	ctx := context.Background()
+	reader := newReader()
+	state := New(reader, WithPageSize(2))
+
+	for i := 0; i < 2; i++ {
+		pods, err := state.Pods(ctx)
+		if err != nil {
+			t.Fatalf("unexpected error: %v", err)
+		}
+		if len(pods) != 5 {
+			t.Fatalf("Expected 5 pods, got %d", len(pods))
+		}
+	}
+	if reader.listCalls["pods"] != 3 {
+		t.Errorf("Expected 3 paged List calls, got %d", reader.listCalls["pods"])
+	}
+
+	teamB, err := state.PodsInNamespace(ctx, "team-b")
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(teamB) != 2 {
+		t.Errorf("Expected 2 pods in team-b, got %d", len(teamB))
+	}
+	if reader.listCalls["pods"] != 3 {
+		t.Errorf("Expected namespace lookup to use the cache, got %d List calls", reader.listCalls["pods"])
+	}
+}
+
+func TestState_Namespaces(t *testing.T) {
+	ctx := context.Background()
+	state := New(newReader())
+
+	ns, err := state.Namespace(ctx, "team-a")
+	if err != nil || ns == nil || ns.Labels["tenant"] != "a" {
+		t.Fatalf("Expected team-a namespace, got %v (err %v)", ns, err)
+	}
+	if ns, _ := state.Namespace(ctx, "missing"); ns != nil {
+		t.Errorf("Expected nil for missing namespace, got %v", ns)
+	}
+
+	selector, _ := labels.Parse("tenant")
+	matching, err := state.NamespacesMatching(ctx, selector)
+	if err != nil {
+		t.Fatalf("unexpected error: %v", err)
+	}
+	if len(matching) != 2 {
+		t.Errorf("Expected 2 tenant namespaces, got %d", len(matching))
+	}
+}
+
+func TestState_ErrorsAreNotCached(t *testing.T) {
+	ctx := context.Background()
+	reader := newReader()
+	reader.err = errors.New("forbidden")
+	state := New(reader)
+
+	if _, err := state.Pods(ctx); err == nil {
+		t.Fatal("Expected list error")
+	}
+	reader.err = nil
+	pods, err := state.Pods(ctx)
+	if err != nil {
+		t.Fatalf("Expected retry to succeed, got %v", err)
+	}
+	if len(pods) != 5 {
+		t.Errorf("Expected 5 pods, got %d", len(pods))
+	}
+}
+
+func TestState_Stats(t *testing.T) {
+	ctx := context.Background()
+	state := New(newReader(), WithPageSize(2))
+
+	if state.Stats() != nil {
+		t.Error("Expected nil stats before anything was listed")
+	}
+	_, _ = state.Pods(ctx)
+	_, _ = state.Namespaces(ctx)
+
+	stats := state.Stats()
+	if stats == nil {
+		t.Fatal("Expected stats")
+	}
+	if stats.ObjectsScanned != 8 {
+		t.Errorf("Expected 8 objects scanned, got %d", stats.ObjectsScanned)
+	}
+	if len(stats.Resources) != 2 || stats.Resources[0].Resource != "namespaces" || stats.Resources[1].Resource != "pods" {
+		t.Fatalf("Expected sorted namespaces and pods stats, got %+v", stats.Resources)
+	}
+	if stats.Resources[0].Pages != 2 || stats.Resources[1].Pages != 3 {
+		t.Errorf("Expected 2 and 3 pages, got %d and %d", stats.Resources[0].Pages, stats.Resources[1].Pages)
+	}
+}
+
+func TestFromContext(t *testing.T) {
+	state := New(newReader())
+	ctx := NewContext(context.Background(), state)
+
+	if FromContext(ctx, nil) != state {
+		t.Error("Expected the state stored in the context")
+	}
+	if FromContext(context.Background(), newReader()) == nil {
+		t.Error("Expected a new state without one in the context")
+	}
+}
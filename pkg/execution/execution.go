@@ -0,0 +1,401 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package execution runs the validators of an assessment and hands the raw
+// results to the controller, either in the operator process or from a Job
+// through a results ConfigMap.
+package execution
+
+import (
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	assessmentv1alpha1 "github.com/openshift-assessment/cluster-assessment-operator/api/v1alpha1"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/clusterinfo"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/clusterstate"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/findingstore"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/profiles"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/validator"
+)
+
+const (
+	// Command is the operator subcommand that runs an assessment inside a Job.
+	Command = "assess"
+
+	// AssessmentLabel records the ClusterAssessment a Job or ConfigMap belongs to.
+	AssessmentLabel = "assessment.openshift.io/name"
+
+	// JobComponent is the app.kubernetes.io/component label of assessment Jobs.
+	JobComponent = "assessment-job"
+
+	// ResultKey is the binary data key of the results ConfigMaps holding a
+	// chunk of the gzip-compressed JSON Result.
+	ResultKey = "result.json.gz"
+
+	// ResultChunksAnnotation on the first results ConfigMap records how many
+	// ConfigMaps the Result is split across.
+	ResultChunksAnnotation = "assessment.openshift.io/result-chunks"
+
+	// ResultChecksumAnnotation on the first results ConfigMap records the
+	// SHA-256 checksum of the compressed Result.
+	ResultChecksumAnnotation = "assessment.openshift.io/result-checksum"
+
+	// JobTTL is how long finished Jobs are kept for inspection.
+	JobTTL = 24 * time.Hour
+
+	// maxJobNameLength keeps Job names valid as the job-name label of their pods.
+	maxJobNameLength = 63
+)
+
+// Result is the outcome of running an assessment's validators, before
+// suppressions, scoring and reporting are applied by the controller.
+type Result struct {
+	// ClusterInfo describes the assessed cluster.
+	ClusterInfo assessmentv1alpha1.ClusterInfo `json:"clusterInfo"`
+
+	// Findings are the findings returned by the validators.
+	Findings []assessmentv1alpha1.Finding `json:"findings,omitempty"`
+
+	// Collection reports the cluster state listed for the run.
+	Collection *assessmentv1alpha1.CollectionStats `json:"collection,omitempty"`
+
+	// Validators reports how each validator ran.
+	Validators []assessmentv1alpha1.ValidatorRunStatus `json:"validators,omitempty"`
+
+	// Profile is the profile the validators ran with, resolved when the run
+	// started. The findings are scored with it.
+	Profile *profiles.Profile `json:"profile,omitempty"`
+
+	// Error is set when a Job could not run the assessment.
+	Error string `json:"error,omitempty"`
+}
+
+// Run collects cluster info and runs the validators selected by the profile
+// and the validator list, sharing one cluster state read through reader.
+// Validators are skipped when c lacks the permissions they declare.
+func Run(ctx context.Context, c client.Client, reader client.Reader, registry *validator.Registry, profile profiles.Profile, validators []string, opts ...validator.RunnerOption) (*Result, error) {
+	logger := log.FromContext(ctx)
+
+	// Cluster info is optional, so collection errors do not fail the run
+	info, err := clusterinfo.Collect(ctx, c)
+	if err != nil {
+		logger.Error(err, "Failed to collect cluster info")
+	}
+
+	state := clusterstate.New(reader)
+	opts = append([]validator.RunnerOption{validator.WithPermissionChecker(validator.NewAccessReviewChecker(c))}, opts...)
+	runner := validator.NewRunner(registry, c, append(opts, validator.WithClusterState(state))...)
+	findings, statuses, err := runner.RunWithStatus(ctx, profile, validators)
+	if err != nil {
+		return nil, err
+	}
+
+	return &Result{
+		ClusterInfo: info,
+		Findings:    findings,
+		Collection:  state.Stats(),
+		Validators:  statuses,
+		Profile:     &profile,
+	}, nil
+}
+
+// JobOptions configures the Jobs created for an assessment.
+type JobOptions struct {
+	// Namespace is the namespace the Job and its results ConfigMap live in.
+	Namespace string
+
+	// Image is the operator image providing the assess subcommand.
+	Image string
+
+	// ServiceAccountName is used when the assessment does not set one.
+	ServiceAccountName string
+
+	// ValidatorConcurrency and ValidatorTimeout are passed to the Job's runner.
+	ValidatorConcurrency int
+	ValidatorTimeout     time.Duration
+
+	// ActiveDeadline is used when the assessment does not set one.
+	ActiveDeadline time.Duration
+
+	// Validators replaces the assessment's validators for a partial run.
+	Validators []string
+}
+
+// JobName returns the name of the Job for a run of the assessment started at
+// the given time. The results ConfigMap uses the same name.
+func JobName(assessmentName string, start time.Time) string {
+	suffix := "-" + strconv.FormatInt(start.Unix(), 10)
+	if len(assessmentName)+len(suffix) > maxJobNameLength {
+		assessmentName = assessmentName[:maxJobNameLength-len(suffix)]
+	}
+	return assessmentName + suffix
+}
+
+// NewJob builds the Job running the assessment's validators. The Job writes
+// its Result to a ConfigMap named after the Job.
+func NewJob(assessment *assessmentv1alpha1.ClusterAssessment, name string, opts JobOptions) *batchv1.Job {
+	var exec assessmentv1alpha1.ExecutionSpec
+	if assessment.Spec.Execution != nil {
+		exec = *assessment.Spec.Execution
+	}
+
+	serviceAccount := exec.ServiceAccountName
+	if serviceAccount == "" {
+		serviceAccount = opts.ServiceAccountName
+	}
+	deadline := exec.ActiveDeadlineSeconds
+	if deadline == nil && opts.ActiveDeadline > 0 {
+		seconds := int64(opts.ActiveDeadline.Seconds())
+		deadline = &seconds
+	}
+
+	args := []string{
+		Command,
+		"--assessment=" + assessment.Name,
+		"--results-configmap=" + name,
+	}
+	if opts.ValidatorConcurrency > 0 {
+		args = append(args, "--validator-concurrency="+strconv.Itoa(opts.ValidatorConcurrency))
+	}
+	if opts.ValidatorTimeout > 0 {
+		args = append(args, "--validator-timeout="+opts.ValidatorTimeout.String())
+	}
+	if len(opts.Validators) > 0 {
+		args = append(args, "--validators="+strings.Join(opts.Validators, ","))
+	}
+
+	labels := map[string]string{
+		"app.kubernetes.io/name":       "cluster-assessment-operator",
+		"app.kubernetes.io/managed-by": "cluster-assessment-operator",
+		"app.kubernetes.io/component":  JobComponent,
+		AssessmentLabel:                assessment.Name,
+	}
+	backoffLimit := int32(0)
+	ttl := int32(JobTTL.Seconds())
+	allowPrivilegeEscalation := false
+	runAsNonRoot := true
+
+	return &batchv1.Job{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      name,
+			Namespace: opts.Namespace,
+			Labels:    labels,
+		},
+		Spec: batchv1.JobSpec{
+			BackoffLimit:            &backoffLimit,
+			ActiveDeadlineSeconds:   deadline,
+			TTLSecondsAfterFinished: &ttl,
+			Template: corev1.PodTemplateSpec{
+				ObjectMeta: metav1.ObjectMeta{Labels: labels},
+				Spec: corev1.PodSpec{
+					RestartPolicy:      corev1.RestartPolicyNever,
+					ServiceAccountName: serviceAccount,
+					SecurityContext: &corev1.PodSecurityContext{
+						RunAsNonRoot:   &runAsNonRoot,
+						SeccompProfile: &corev1.SeccompProfile{Type: corev1.SeccompProfileTypeRuntimeDefault},
+					},
+					Containers: []corev1.Container{{
+						Name:      "assess",
+						Image:     opts.Image,
+						Command:   []string{"/manager"},
+						Args:      args,
+						Resources: exec.Resources,
+						Env: []corev1.EnvVar{{
+							Name: "OPERATOR_NAMESPACE",
+							ValueFrom: &corev1.EnvVarSource{
+								FieldRef: &corev1.ObjectFieldSelector{FieldPath: "metadata.namespace"},
+							},
+						}},
+						SecurityContext: &corev1.SecurityContext{
+							AllowPrivilegeEscalation: &allowPrivilegeEscalation,
+							Capabilities:             &corev1.Capabilities{Drop: []corev1.Capability{"ALL"}},
+						},
+					}},
+				},
+			},
+		},
+	}
+}
+
+// JobFinished reports whether the Job has completed or failed, and whether it
+// succeeded. message explains a failure.
+func JobFinished(job *batchv1.Job) (finished, succeeded bool, message string) {
+	for _, cond := range job.Status.Conditions {
+		if cond.Status != corev1.ConditionTrue {
+			continue
+		}
+		switch cond.Type {
+		case batchv1.JobComplete:
+			return true, true, ""
+		case batchv1.JobFailed:
+			message = cond.Message
+			if message == "" {
+				message = cond.Reason
+			}
+			return true, false, message
+		}
+	}
+	return false, false, ""
+}
+
+// WriteResult stores a Result in ConfigMaps named after the Job, creating or
+// replacing them. The Result is gzip-compressed and split like stored
+// findings, so large clusters do not exceed the ConfigMap size limit: the
+// first chunk is stored under name, the following ones under name-1, name-2...
+func WriteResult(ctx context.Context, c client.Client, namespace, name, assessmentName string, result *Result) error {
+	data, err := findingstore.Compress(result)
+	if err != nil {
+		return fmt.Errorf("failed to encode result: %w", err)
+	}
+	sum := sha256.Sum256(data)
+	chunks := findingstore.Split(data)
+
+	previous := 0
+	existing := &corev1.ConfigMap{}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, existing); err == nil {
+		previous, _ = strconv.Atoi(existing.Annotations[ResultChunksAnnotation])
+	} else if !errors.IsNotFound(err) {
+		return fmt.Errorf("failed to get results ConfigMap: %w", err)
+	}
+
+	// The first ConfigMap names the chunks and is written last, so readers
+	// never combine chunks of two writes
+	for i := len(chunks) - 1; i >= 0; i-- {
+		cm := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      resultChunkName(name, i),
+				Namespace: namespace,
+				Labels: map[string]string{
+					"app.kubernetes.io/name":       "cluster-assessment-operator",
+					"app.kubernetes.io/managed-by": "cluster-assessment-operator",
+					"app.kubernetes.io/component":  "assessment-result",
+					AssessmentLabel:                assessmentName,
+				},
+			},
+			BinaryData: map[string][]byte{ResultKey: chunks[i]},
+		}
+		if i == 0 {
+			cm.Annotations = map[string]string{
+				ResultChunksAnnotation:   strconv.Itoa(len(chunks)),
+				ResultChecksumAnnotation: hex.EncodeToString(sum[:]),
+			}
+		}
+		if err := createOrReplace(ctx, c, cm); err != nil {
+			return err
+		}
+	}
+	return deleteResultChunks(ctx, c, namespace, name, len(chunks), previous)
+}
+
+// ReadResult loads the Result stored in the ConfigMaps named after the Job.
+func ReadResult(ctx context.Context, c client.Reader, namespace, name string) (*Result, error) {
+	cm := &corev1.ConfigMap{}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, cm); err != nil {
+		return nil, fmt.Errorf("failed to get results ConfigMap: %w", err)
+	}
+	count, err := strconv.Atoi(cm.Annotations[ResultChunksAnnotation])
+	if err != nil || count < 1 {
+		return nil, fmt.Errorf("results ConfigMap %s/%s has no valid %s annotation", namespace, name, ResultChunksAnnotation)
+	}
+
+	data := cm.BinaryData[ResultKey]
+	for i := 1; i < count; i++ {
+		chunk := &corev1.ConfigMap{}
+		if err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: resultChunkName(name, i)}, chunk); err != nil {
+			return nil, fmt.Errorf("failed to get results ConfigMap: %w", err)
+		}
+		data = append(data, chunk.BinaryData[ResultKey]...)
+	}
+	if sum := sha256.Sum256(data); hex.EncodeToString(sum[:]) != cm.Annotations[ResultChecksumAnnotation] {
+		return nil, fmt.Errorf("result in %s/%s does not match its checksum", namespace, name)
+	}
+
+	result := &Result{}
+	if err := findingstore.Decompress(data, result); err != nil {
+		return nil, fmt.Errorf("failed to decode result: %w", err)
+	}
+	return result, nil
+}
+
+// DeleteResult removes the ConfigMaps holding the Result of a Job.
+func DeleteResult(ctx context.Context, c client.Client, namespace, name string) error {
+	cm := &corev1.ConfigMap{}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, cm); err != nil {
+		if errors.IsNotFound(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to get results ConfigMap: %w", err)
+	}
+	count, _ := strconv.Atoi(cm.Annotations[ResultChunksAnnotation])
+	if count < 1 {
+		count = 1
+	}
+	return deleteResultChunks(ctx, c, namespace, name, 0, count)
+}
+
+// deleteResultChunks deletes the result ConfigMaps with an index in [from, to).
+func deleteResultChunks(ctx context.Context, c client.Client, namespace, name string, from, to int) error {
+	for i := from; i < to; i++ {
+		cm := &corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: resultChunkName(name, i), Namespace: namespace}}
+		if err := c.Delete(ctx, cm); err != nil && !errors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete results ConfigMap %s: %w", cm.Name, err)
+		}
+	}
+	return nil
+}
+
+// createOrReplace creates the ConfigMap, or replaces the data and labels of
+// an existing one.
+func createOrReplace(ctx context.Context, c client.Client, cm *corev1.ConfigMap) error {
+	existing := &corev1.ConfigMap{}
+	err := c.Get(ctx, client.ObjectKeyFromObject(cm), existing)
+	if errors.IsNotFound(err) {
+		if err := c.Create(ctx, cm); err != nil {
+			return fmt.Errorf("failed to create results ConfigMap: %w", err)
+		}
+		return nil
+	} else if err != nil {
+		return fmt.Errorf("failed to get results ConfigMap: %w", err)
+	}
+	existing.Data = nil
+	existing.BinaryData = cm.BinaryData
+	existing.Labels = cm.Labels
+	existing.Annotations = cm.Annotations
+	if err := c.Update(ctx, existing); err != nil {
+		return fmt.Errorf("failed to update results ConfigMap: %w", err)
+	}
+	return nil
+}
+
+// resultChunkName returns the name of the ConfigMap holding a result chunk.
+func resultChunkName(name string, index int) string {
+	if index == 0 {
+		return name
+	}
+	return name + "-" + strconv.Itoa(index)
+}
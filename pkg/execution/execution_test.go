@@ -0,0 +1,302 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package execution
+
+import (
+	"context"
+	"encoding/base64"
+	"fmt"
+	"math/rand"
+	"strings"
+	"testing"
+	"time"
+
+	batchv1 "k8s.io/api/batch/v1"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	assessmentv1alpha1 "github.com/openshift-assessment/cluster-assessment-operator/api/v1alpha1"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/findingstore"
+)
+
+func TestJobName(t *testing.T) {
+	start := time.Unix(1700000000, 0)
+
+	if got := JobName("nightly", start); got != "nightly-1700000000" {
+		t.Errorf("Expected nightly-1700000000, got %s", got)
+	}
+	long := JobName(strings.Repeat("a", 80), start)
+	if len(long) != maxJobNameLength || !strings.HasSuffix(long, "-1700000000") {
+		t.Errorf("Expected truncated name of %d characters, got %s", maxJobNameLength, long)
+	}
+}
+
+func TestNewJob(t *testing.T) {
+	opts := JobOptions{
+		Namespace:            "operators",
+		Image:                "example.com/operator:v1",
+		ServiceAccountName:   "operator",
+		ValidatorConcurrency: 2,
+		ValidatorTimeout:     time.Minute,
+		ActiveDeadline:       10 * time.Minute,
+	}
+
+	t.Run("defaults", func(t *testing.T) {
+		assessment := &assessmentv1alpha1.ClusterAssessment{ObjectMeta: metav1.ObjectMeta{Name: "nightly"}}
+		job := NewJob(assessment, "nightly-1", opts)
+
+		if job.Namespace != "operators" || job.Labels[AssessmentLabel] != "nightly" {
+			t.Errorf("Unexpected Job metadata: %+v", job.ObjectMeta)
+		}
+		if *job.Spec.BackoffLimit != 0 || *job.Spec.ActiveDeadlineSeconds != 600 {
+			t.Errorf("Expected no retries and a 600s deadline, got %d and %d",
+				*job.Spec.BackoffLimit, *job.Spec.ActiveDeadlineSeconds)
+		}
+		pod := job.Spec.Template.Spec
+		if pod.ServiceAccountName != "operator" || pod.RestartPolicy != corev1.RestartPolicyNever {
+			t.Errorf("Unexpected pod spec: service account %q, restart policy %q", pod.ServiceAccountName, pod.RestartPolicy)
+		}
+		args := strings.Join(pod.Containers[0].Args, " ")
+		for _, want := range []string{"assess", "--assessment=nightly", "--results-configmap=nightly-1", "--validator-concurrency=2", "--validator-timeout=1m0s"} {
+			if !strings.Contains(args, want) {
+				t.Errorf("Expected args to contain %q, got %q", want, args)
+			}
+		}
+	})
+
+	t.Run("assessment overrides", func(t *testing.T) {
+		deadline := int64(120)
+		assessment := &assessmentv1alpha1.ClusterAssessment{
+			ObjectMeta: metav1.ObjectMeta{Name: "nightly"},
+			Spec: assessmentv1alpha1.ClusterAssessmentSpec{
+				Execution: &assessmentv1alpha1.ExecutionSpec{
+					Mode:                  assessmentv1alpha1.ExecutionModeJob,
+					ServiceAccountName:    "assessment-runner",
+					ActiveDeadlineSeconds: &deadline,
+					Resources: corev1.ResourceRequirements{
+						Limits: corev1.ResourceList{corev1.ResourceMemory: resource.MustParse("1Gi")},
+					},
+				},
+			},
+		}
+		job := NewJob(assessment, "nightly-1", opts)
+
+		pod := job.Spec.Template.Spec
+		if pod.ServiceAccountName != "assessment-runner" {
+			t.Errorf("Expected assessment service account, got %q", pod.ServiceAccountName)
+		}
+		if *job.Spec.ActiveDeadlineSeconds != 120 {
+			t.Errorf("Expected 120s deadline, got %d", *job.Spec.ActiveDeadlineSeconds)
+		}
+		if pod.Containers[0].Resources.Limits.Memory().String() != "1Gi" {
+			t.Errorf("Expected 1Gi memory limit, got %s", pod.Containers[0].Resources.Limits.Memory())
+		}
+	})
+
+	t.Run("partial run", func(t *testing.T) {
+		assessment := &assessmentv1alpha1.ClusterAssessment{ObjectMeta: metav1.ObjectMeta{Name: "nightly"}}
+		partial := opts
+		partial.Validators = []string{"security", "nodes"}
+		job := NewJob(assessment, "nightly-1", partial)
+
+		args := strings.Join(job.Spec.Template.Spec.Containers[0].Args, " ")
+		if !strings.Contains(args, "--validators=security,nodes") {
+			t.Errorf("Expected args to list the run's validators, got %q", args)
+		}
+	})
+}
+
+func TestJobFinished(t *testing.T) {
+	tests := []struct {
+		name          string
+		conditions    []batchv1.JobCondition
+		wantFinished  bool
+		wantSucceeded bool
+		wantMessage   string
+	}{
+		{name: "running"},
+		{
+			name:          "complete",
+			conditions:    []batchv1.JobCondition{{Type: batchv1.JobComplete, Status: corev1.ConditionTrue}},
+			wantFinished:  true,
+			wantSucceeded: true,
+		},
+		{
+			name:         "failed",
+			conditions:   []batchv1.JobCondition{{Type: batchv1.JobFailed, Status: corev1.ConditionTrue, Reason: "DeadlineExceeded"}},
+			wantFinished: true,
+			wantMessage:  "DeadlineExceeded",
+		},
+		{
+			name:       "condition not true",
+			conditions: []batchv1.JobCondition{{Type: batchv1.JobFailed, Status: corev1.ConditionFalse}},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			job := &batchv1.Job{Status: batchv1.JobStatus{Conditions: tt.conditions}}
+			finished, succeeded, message := JobFinished(job)
+			if finished != tt.wantFinished || succeeded != tt.wantSucceeded || message != tt.wantMessage {
+				t.Errorf("Expected (%v, %v, %q), got (%v, %v, %q)",
+					tt.wantFinished, tt.wantSucceeded, tt.wantMessage, finished, succeeded, message)
+			}
+		})
+	}
+}
+
+func TestWriteReadResult(t *testing.T) {
+	ctx := context.Background()
+	c := fake.NewClientBuilder().Build()
+
+	result := &Result{
+		ClusterInfo: assessmentv1alpha1.ClusterInfo{ClusterVersion: "4.16.3"},
+		Findings:    []assessmentv1alpha1.Finding{{ID: "security-check", Status: assessmentv1alpha1.FindingStatusWarn}},
+		Collection:  &assessmentv1alpha1.CollectionStats{ObjectsScanned: 42},
+	}
+	if err := WriteResult(ctx, c, "operators", "nightly-1", "nightly", result); err != nil {
+		t.Fatalf("WriteResult failed: %v", err)
+	}
+	// Writing again replaces the stored result
+	result.Findings[0].Status = assessmentv1alpha1.FindingStatusFail
+	if err := WriteResult(ctx, c, "operators", "nightly-1", "nightly", result); err != nil {
+		t.Fatalf("WriteResult failed on update: %v", err)
+	}
+
+	got, err := ReadResult(ctx, c, "operators", "nightly-1")
+	if err != nil {
+		t.Fatalf("ReadResult failed: %v", err)
+	}
+	if got.ClusterInfo.ClusterVersion != "4.16.3" || got.Collection.ObjectsScanned != 42 {
+		t.Errorf("Unexpected result: %+v", got)
+	}
+	if len(got.Findings) != 1 || got.Findings[0].Status != assessmentv1alpha1.FindingStatusFail {
+		t.Errorf("Expected updated finding, got %+v", got.Findings)
+	}
+
+	if _, err := ReadResult(ctx, c, "operators", "missing"); err == nil {
+		t.Error("Expected error for missing results ConfigMap")
+	}
+}
+
+// largeResult returns a Result whose compressed encoding spans several chunks.
+func largeResult() *Result {
+	rng := rand.New(rand.NewSource(1))
+	result := &Result{}
+	for i := 0; i < 2000; i++ {
+		noise := make([]byte, 768)
+		rng.Read(noise)
+		result.Findings = append(result.Findings, assessmentv1alpha1.Finding{
+			ID:          fmt.Sprintf("security-check-%d", i),
+			Status:      assessmentv1alpha1.FindingStatusWarn,
+			Description: base64.StdEncoding.EncodeToString(noise),
+		})
+	}
+	return result
+}
+
+func TestWriteReadResult_Chunked(t *testing.T) {
+	ctx := context.Background()
+	c := fake.NewClientBuilder().Build()
+
+	result := largeResult()
+	if err := WriteResult(ctx, c, "operators", "nightly-1", "nightly", result); err != nil {
+		t.Fatalf("WriteResult failed: %v", err)
+	}
+
+	list := &corev1.ConfigMapList{}
+	if err := c.List(ctx, list); err != nil {
+		t.Fatal(err)
+	}
+	if len(list.Items) < 2 {
+		t.Fatalf("Expected the result to be split across ConfigMaps, got %d", len(list.Items))
+	}
+	for _, cm := range list.Items {
+		if size := len(cm.BinaryData[ResultKey]); size > findingstore.ChunkSize {
+			t.Errorf("ConfigMap %s holds %d bytes, above the chunk size", cm.Name, size)
+		}
+	}
+
+	got, err := ReadResult(ctx, c, "operators", "nightly-1")
+	if err != nil {
+		t.Fatalf("ReadResult failed: %v", err)
+	}
+	if len(got.Findings) != len(result.Findings) || got.Findings[1999].Description != result.Findings[1999].Description {
+		t.Errorf("Expected %d findings to round-trip, got %d", len(result.Findings), len(got.Findings))
+	}
+
+	// A smaller result replaces the chunks of the larger one
+	if err := WriteResult(ctx, c, "operators", "nightly-1", "nightly", &Result{Error: "no permissions"}); err != nil {
+		t.Fatalf("WriteResult failed on update: %v", err)
+	}
+	if err := c.List(ctx, list); err != nil {
+		t.Fatal(err)
+	}
+	if len(list.Items) != 1 {
+		t.Errorf("Expected stale chunks to be deleted, got %d ConfigMaps", len(list.Items))
+	}
+	if got, err := ReadResult(ctx, c, "operators", "nightly-1"); err != nil || got.Error != "no permissions" {
+		t.Errorf("Expected replaced result, got %+v (err %v)", got, err)
+	}
+}
+
+func TestReadResult_ChecksumMismatch(t *testing.T) {
+	ctx := context.Background()
+	c := fake.NewClientBuilder().Build()
+
+	if err := WriteResult(ctx, c, "operators", "nightly-1", "nightly", largeResult()); err != nil {
+		t.Fatalf("WriteResult failed: %v", err)
+	}
+	// A chunk left over from another write does not belong to this result
+	chunk := &corev1.ConfigMap{}
+	if err := c.Get(ctx, client.ObjectKey{Namespace: "operators", Name: "nightly-1-1"}, chunk); err != nil {
+		t.Fatal(err)
+	}
+	chunk.BinaryData[ResultKey] = chunk.BinaryData[ResultKey][1:]
+	if err := c.Update(ctx, chunk); err != nil {
+		t.Fatal(err)
+	}
+
+	if _, err := ReadResult(ctx, c, "operators", "nightly-1"); err == nil || !strings.Contains(err.Error(), "checksum") {
+		t.Errorf("Expected checksum error, got %v", err)
+	}
+}
+
+func TestDeleteResult(t *testing.T) {
+	ctx := context.Background()
+	c := fake.NewClientBuilder().Build()
+
+	if err := WriteResult(ctx, c, "operators", "nightly-1", "nightly", largeResult()); err != nil {
+		t.Fatalf("WriteResult failed: %v", err)
+	}
+	if err := DeleteResult(ctx, c, "operators", "nightly-1"); err != nil {
+		t.Fatalf("DeleteResult failed: %v", err)
+	}
+	list := &corev1.ConfigMapList{}
+	if err := c.List(ctx, list); err != nil {
+		t.Fatal(err)
+	}
+	if len(list.Items) != 0 {
+		t.Errorf("Expected all result ConfigMaps to be deleted, got %d", len(list.Items))
+	}
+	if err := DeleteResult(ctx, c, "operators", "nightly-1"); err != nil {
+		t.Errorf("Expected deleting a missing result to succeed, got %v", err)
+	}
+}
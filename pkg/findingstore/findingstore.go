@@ -0,0 +1,275 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package findingstore stores the full findings of an assessment outside its
+// status, as gzip-compressed JSON split across ConfigMaps, so large clusters
+// do not exceed the etcd object size limit.
+package findingstore
+
+import (
+	"bytes"
+	"compress/gzip"
+	"context"
+	"crypto/sha256"
+	"encoding/hex"
+	"encoding/json"
+	"fmt"
+	"io"
+	"sort"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	assessmentv1alpha1 "github.com/openshift-assessment/cluster-assessment-operator/api/v1alpha1"
+)
+
+const (
+	// Component is the app.kubernetes.io/component label of findings ConfigMaps.
+	Component = "assessment-findings"
+
+	// AssessmentLabel records the ClusterAssessment a findings ConfigMap belongs to.
+	AssessmentLabel = "assessment.openshift.io/name"
+
+	// DataKey is the binary data key holding a chunk of the compressed findings.
+	DataKey = "findings.json.gz"
+
+	// ChunkSize is the maximum size of the compressed data stored in one
+	// ConfigMap, leaving room for metadata below the 1 MiB object limit.
+	ChunkSize = 900 * 1024
+
+	// DefaultStatusLimit is the number of findings kept in the status when
+	// the assessment does not set one.
+	DefaultStatusLimit = 20
+
+	// maxNameLength keeps ConfigMap names valid as label values.
+	maxNameLength = 63
+)
+
+// Enabled reports whether the assessment stores its findings in ConfigMaps.
+func Enabled(assessment *assessmentv1alpha1.ClusterAssessment) bool {
+	storage := assessment.Spec.FindingStorage
+	return storage != nil && storage.Mode == assessmentv1alpha1.FindingStorageConfigMap
+}
+
+// StatusLimit returns the number of findings the assessment keeps in its
+// status when they are stored in ConfigMaps.
+func StatusLimit(assessment *assessmentv1alpha1.ClusterAssessment) int {
+	storage := assessment.Spec.FindingStorage
+	if storage == nil || storage.StatusLimit == nil {
+		return DefaultStatusLimit
+	}
+	return *storage.StatusLimit
+}
+
+// Store writes the findings to ConfigMaps in the namespace and returns the
+// reference to record in the assessment status. ConfigMap names are derived
+// from the content, so readers never see a mix of two runs; Prune removes the
+// ConfigMaps of earlier runs once the status references the new ones.
+func Store(ctx context.Context, c client.Client, namespace, assessmentName string, findings []assessmentv1alpha1.Finding) (*assessmentv1alpha1.FindingsReference, error) {
+	data, err := Encode(findings)
+	if err != nil {
+		return nil, err
+	}
+	sum := sha256.Sum256(data)
+	checksum := hex.EncodeToString(sum[:])
+
+	ref := &assessmentv1alpha1.FindingsReference{
+		Namespace: namespace,
+		Count:     len(findings),
+		Checksum:  checksum,
+	}
+	for i, chunk := range Split(data) {
+		name := chunkName(assessmentName, checksum, i)
+		cm := &corev1.ConfigMap{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:      name,
+				Namespace: namespace,
+				Labels:    labels(assessmentName),
+			},
+			BinaryData: map[string][]byte{DataKey: chunk},
+		}
+		// Identical content is already stored under the same name
+		if err := c.Create(ctx, cm); err != nil && !errors.IsAlreadyExists(err) {
+			return nil, fmt.Errorf("failed to create findings ConfigMap %s: %w", name, err)
+		}
+		ref.ConfigMaps = append(ref.ConfigMaps, name)
+	}
+	return ref, nil
+}
+
+// Load returns the full findings of the assessment, reading them through its
+// findings reference if it has one.
+func Load(ctx context.Context, c client.Reader, assessment *assessmentv1alpha1.ClusterAssessment) ([]assessmentv1alpha1.Finding, error) {
+	ref := assessment.Status.FindingsRef
+	if ref == nil {
+		return assessment.Status.Findings, nil
+	}
+
+	var data []byte
+	for _, name := range ref.ConfigMaps {
+		cm := &corev1.ConfigMap{}
+		if err := c.Get(ctx, client.ObjectKey{Namespace: ref.Namespace, Name: name}, cm); err != nil {
+			return nil, fmt.Errorf("failed to get findings ConfigMap %s/%s: %w", ref.Namespace, name, err)
+		}
+		data = append(data, cm.BinaryData[DataKey]...)
+	}
+	if sum := sha256.Sum256(data); hex.EncodeToString(sum[:]) != ref.Checksum {
+		return nil, fmt.Errorf("findings of %s do not match their checksum", assessment.Name)
+	}
+	return Decode(data)
+}
+
+// Prune deletes the findings ConfigMaps of the assessment that are not part of
+// keep. A nil keep deletes all of them.
+func Prune(ctx context.Context, c client.Client, namespace, assessmentName string, keep *assessmentv1alpha1.FindingsReference) error {
+	list := &corev1.ConfigMapList{}
+	if err := c.List(ctx, list, client.InNamespace(namespace), client.MatchingLabels(labels(assessmentName))); err != nil {
+		return fmt.Errorf("failed to list findings ConfigMaps: %w", err)
+	}
+
+	kept := map[string]bool{}
+	if keep != nil {
+		for _, name := range keep.ConfigMaps {
+			kept[name] = true
+		}
+	}
+	for i := range list.Items {
+		if kept[list.Items[i].Name] {
+			continue
+		}
+		if err := c.Delete(ctx, &list.Items[i]); err != nil && !errors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete findings ConfigMap %s: %w", list.Items[i].Name, err)
+		}
+	}
+	return nil
+}
+
+// Top returns the n most important findings: failing before warning before
+// the rest, by severity within each status. The order of equal findings is kept.
+func Top(findings []assessmentv1alpha1.Finding, n int) []assessmentv1alpha1.Finding {
+	if n <= 0 {
+		return nil
+	}
+	sorted := make([]assessmentv1alpha1.Finding, len(findings))
+	copy(sorted, findings)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if ri, rj := statusRank(sorted[i].Status), statusRank(sorted[j].Status); ri != rj {
+			return ri > rj
+		}
+		return assessmentv1alpha1.SeverityLevel(sorted[i].Severity) > assessmentv1alpha1.SeverityLevel(sorted[j].Severity)
+	})
+	if len(sorted) > n {
+		sorted = sorted[:n]
+	}
+	return sorted
+}
+
+// Encode returns the gzip-compressed JSON encoding of the findings.
+func Encode(findings []assessmentv1alpha1.Finding) ([]byte, error) {
+	if findings == nil {
+		findings = []assessmentv1alpha1.Finding{}
+	}
+	data, err := Compress(findings)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode findings: %w", err)
+	}
+	return data, nil
+}
+
+// Decode parses findings encoded by Encode.
+func Decode(data []byte) ([]assessmentv1alpha1.Finding, error) {
+	var findings []assessmentv1alpha1.Finding
+	if err := Decompress(data, &findings); err != nil {
+		return nil, fmt.Errorf("failed to decode findings: %w", err)
+	}
+	return findings, nil
+}
+
+// Compress returns the gzip-compressed JSON encoding of v.
+func Compress(v any) ([]byte, error) {
+	var buf bytes.Buffer
+	zw := gzip.NewWriter(&buf)
+	if err := json.NewEncoder(zw).Encode(v); err != nil {
+		return nil, err
+	}
+	if err := zw.Close(); err != nil {
+		return nil, fmt.Errorf("failed to compress: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// Decompress parses data compressed by Compress into v.
+func Decompress(data []byte, v any) error {
+	zr, err := gzip.NewReader(bytes.NewReader(data))
+	if err != nil {
+		return fmt.Errorf("failed to decompress: %w", err)
+	}
+	defer zr.Close()
+	raw, err := io.ReadAll(zr)
+	if err != nil {
+		return fmt.Errorf("failed to decompress: %w", err)
+	}
+	return json.Unmarshal(raw, v)
+}
+
+// Split cuts data into chunks of at most ChunkSize bytes, each small enough
+// for one ConfigMap. Empty data yields a single empty chunk.
+func Split(data []byte) [][]byte {
+	var chunks [][]byte
+	for i := 0; i == 0 || i*ChunkSize < len(data); i++ {
+		end := (i + 1) * ChunkSize
+		if end > len(data) {
+			end = len(data)
+		}
+		chunks = append(chunks, data[i*ChunkSize:end])
+	}
+	return chunks
+}
+
+// chunkName returns the name of a findings ConfigMap.
+func chunkName(assessmentName, checksum string, index int) string {
+	suffix := fmt.Sprintf("-findings-%s-%d", checksum[:10], index)
+	if len(assessmentName)+len(suffix) > maxNameLength {
+		assessmentName = assessmentName[:maxNameLength-len(suffix)]
+	}
+	return assessmentName + suffix
+}
+
+// labels returns the labels of the findings ConfigMaps of an assessment.
+func labels(assessmentName string) map[string]string {
+	return map[string]string{
+		"app.kubernetes.io/name":       "cluster-assessment-operator",
+		"app.kubernetes.io/managed-by": "cluster-assessment-operator",
+		"app.kubernetes.io/component":  Component,
+		AssessmentLabel:                assessmentName,
+	}
+}
+
+// statusRank orders finding statuses by importance.
+func statusRank(status assessmentv1alpha1.FindingStatus) int {
+	switch status {
+	case assessmentv1alpha1.FindingStatusFail:
+		return 3
+	case assessmentv1alpha1.FindingStatusWarn, assessmentv1alpha1.FindingStatusError:
+		return 2
+	case assessmentv1alpha1.FindingStatusInfo:
+		return 1
+	default:
+		return 0
+	}
+}
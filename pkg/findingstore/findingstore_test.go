@@ -0,0 +1,206 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package findingstore
+
+import (
+	"context"
+	"crypto/rand"
+	"encoding/base64"
+	"fmt"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	assessmentv1alpha1 "github.com/openshift-assessment/cluster-assessment-operator/api/v1alpha1"
+)
+
+const testNamespace = "cluster-assessment-operator"
+
+// randomFindings returns findings with incompressible descriptions, so their
+// encoding spans several ConfigMaps.
+func randomFindings(t *testing.T, n, size int) []assessmentv1alpha1.Finding {
+	t.Helper()
+	findings := make([]assessmentv1alpha1.Finding, n)
+	buf := make([]byte, size)
+	for i := range findings {
+		if _, err := rand.Read(buf); err != nil {
+			t.Fatalf("Failed to generate data: %v", err)
+		}
+		findings[i] = assessmentv1alpha1.Finding{
+			ID:          fmt.Sprintf("finding-%d", i),
+			Validator:   "nodes",
+			Status:      assessmentv1alpha1.FindingStatusPass,
+			Description: base64.StdEncoding.EncodeToString(buf),
+		}
+	}
+	return findings
+}
+
+func listChunks(t *testing.T, c client.Client) []corev1.ConfigMap {
+	t.Helper()
+	list := &corev1.ConfigMapList{}
+	if err := c.List(context.Background(), list, client.InNamespace(testNamespace)); err != nil {
+		t.Fatalf("Failed to list ConfigMaps: %v", err)
+	}
+	return list.Items
+}
+
+func TestStoreAndLoad(t *testing.T) {
+	ctx := context.Background()
+	c := fake.NewClientBuilder().Build()
+	findings := randomFindings(t, 1500, 1024)
+
+	ref, err := Store(ctx, c, testNamespace, "nightly", findings)
+	if err != nil {
+		t.Fatalf("Store returned error: %v", err)
+	}
+	if len(ref.ConfigMaps) < 2 {
+		t.Errorf("Expected the findings to span several ConfigMaps, got %v", ref.ConfigMaps)
+	}
+	if ref.Count != len(findings) || ref.Namespace != testNamespace {
+		t.Errorf("Unexpected reference %+v", ref)
+	}
+	for _, cm := range listChunks(t, c) {
+		if len(cm.BinaryData[DataKey]) > ChunkSize {
+			t.Errorf("ConfigMap %s holds %d bytes, more than %d", cm.Name, len(cm.BinaryData[DataKey]), ChunkSize)
+		}
+		if cm.Labels[AssessmentLabel] != "nightly" {
+			t.Errorf("Expected ConfigMap %s to be labeled with its assessment", cm.Name)
+		}
+	}
+
+	// Storing the same findings again reuses the ConfigMaps
+	again, err := Store(ctx, c, testNamespace, "nightly", findings)
+	if err != nil {
+		t.Fatalf("Store returned error: %v", err)
+	}
+	if fmt.Sprint(again.ConfigMaps) != fmt.Sprint(ref.ConfigMaps) {
+		t.Errorf("Expected identical findings to map to the same ConfigMaps, got %v and %v", ref.ConfigMaps, again.ConfigMaps)
+	}
+
+	assessment := &assessmentv1alpha1.ClusterAssessment{}
+	assessment.Name = "nightly"
+	assessment.Status.FindingsRef = ref
+	loaded, err := Load(ctx, c, assessment)
+	if err != nil {
+		t.Fatalf("Load returned error: %v", err)
+	}
+	if len(loaded) != len(findings) || loaded[42].Description != findings[42].Description {
+		t.Errorf("Expected the stored findings to be loaded, got %d findings", len(loaded))
+	}
+}
+
+func TestLoad_Status(t *testing.T) {
+	assessment := &assessmentv1alpha1.ClusterAssessment{}
+	assessment.Status.Findings = []assessmentv1alpha1.Finding{{ID: "nodes-ready"}}
+
+	findings, err := Load(context.Background(), fake.NewClientBuilder().Build(), assessment)
+	if err != nil {
+		t.Fatalf("Load returned error: %v", err)
+	}
+	if len(findings) != 1 || findings[0].ID != "nodes-ready" {
+		t.Errorf("Expected the status findings without a reference, got %+v", findings)
+	}
+}
+
+func TestLoad_ChecksumMismatch(t *testing.T) {
+	ctx := context.Background()
+	c := fake.NewClientBuilder().Build()
+	ref, err := Store(ctx, c, testNamespace, "nightly", []assessmentv1alpha1.Finding{{ID: "nodes-ready"}})
+	if err != nil {
+		t.Fatalf("Store returned error: %v", err)
+	}
+
+	assessment := &assessmentv1alpha1.ClusterAssessment{}
+	assessment.Name = "nightly"
+	assessment.Status.FindingsRef = ref.DeepCopy()
+	assessment.Status.FindingsRef.Checksum = strings.Repeat("0", 64)
+	if _, err := Load(ctx, c, assessment); err == nil {
+		t.Error("Expected an error for findings not matching their checksum")
+	}
+}
+
+func TestPrune(t *testing.T) {
+	ctx := context.Background()
+	c := fake.NewClientBuilder().Build()
+	old, err := Store(ctx, c, testNamespace, "nightly", []assessmentv1alpha1.Finding{{ID: "old"}})
+	if err != nil {
+		t.Fatalf("Store returned error: %v", err)
+	}
+	current, err := Store(ctx, c, testNamespace, "nightly", []assessmentv1alpha1.Finding{{ID: "current"}})
+	if err != nil {
+		t.Fatalf("Store returned error: %v", err)
+	}
+	other, err := Store(ctx, c, testNamespace, "weekly", []assessmentv1alpha1.Finding{{ID: "old"}})
+	if err != nil {
+		t.Fatalf("Store returned error: %v", err)
+	}
+
+	if err := Prune(ctx, c, testNamespace, "nightly", current); err != nil {
+		t.Fatalf("Prune returned error: %v", err)
+	}
+	names := map[string]bool{}
+	for _, cm := range listChunks(t, c) {
+		names[cm.Name] = true
+	}
+	if names[old.ConfigMaps[0]] || !names[current.ConfigMaps[0]] || !names[other.ConfigMaps[0]] {
+		t.Errorf("Expected only the old findings of nightly to be pruned, got %v", names)
+	}
+
+	if err := Prune(ctx, c, testNamespace, "nightly", nil); err != nil {
+		t.Fatalf("Prune returned error: %v", err)
+	}
+	if chunks := listChunks(t, c); len(chunks) != 1 || chunks[0].Name != other.ConfigMaps[0] {
+		t.Errorf("Expected only the findings of weekly to remain, got %d ConfigMaps", len(chunks))
+	}
+}
+
+func TestTop(t *testing.T) {
+	findings := []assessmentv1alpha1.Finding{
+		{ID: "pass", Status: assessmentv1alpha1.FindingStatusPass},
+		{ID: "warn-low", Status: assessmentv1alpha1.FindingStatusWarn, Severity: "low"},
+		{ID: "fail-medium", Status: assessmentv1alpha1.FindingStatusFail, Severity: "medium"},
+		{ID: "info", Status: assessmentv1alpha1.FindingStatusInfo},
+		{ID: "fail-critical", Status: assessmentv1alpha1.FindingStatusFail, Severity: "critical"},
+		{ID: "warn-high", Status: assessmentv1alpha1.FindingStatusWarn, Severity: "high"},
+	}
+
+	var ids []string
+	for _, f := range Top(findings, 4) {
+		ids = append(ids, f.ID)
+	}
+	want := "[fail-critical fail-medium warn-high warn-low]"
+	if fmt.Sprint(ids) != want {
+		t.Errorf("Expected %s, got %v", want, ids)
+	}
+	if findings[0].ID != "pass" {
+		t.Error("Expected Top not to reorder its input")
+	}
+	if got := Top(findings, 0); len(got) != 0 {
+		t.Errorf("Expected no findings for a limit of 0, got %d", len(got))
+	}
+}
+
+func TestChunkName(t *testing.T) {
+	name := chunkName(strings.Repeat("a", 80), strings.Repeat("f", 64), 3)
+	if len(name) > maxNameLength || !strings.HasSuffix(name, "-findings-ffffffffff-3") {
+		t.Errorf("Unexpected chunk name %q", name)
+	}
+}
@@ -23,6 +23,8 @@ import (
 	"time"
 
 	assessmentv1alpha1 "github.com/openshift-assessment/cluster-assessment-operator/api/v1alpha1"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/scoring"
+	"k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/labels"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -32,6 +34,10 @@ import (
 const (
 	// LabelAssessmentName is the label key used to link snapshots to assessments.
 	LabelAssessmentName = "assessment.openshift.io/name"
+
+	// MaxSnapshotAffectedResources is the number of affected resources stored
+	// per snapshot finding, so snapshot size does not grow with the cluster.
+	MaxSnapshotAffectedResources = 50
 )
 
 // SnapshotManager handles creating, querying, and pruning assessment snapshots.
@@ -46,8 +52,8 @@ func NewSnapshotManager(c client.Client) *SnapshotManager {
 
 // CreateSnapshot creates a new AssessmentSnapshot from a completed assessment.
 // It computes the delta from the previous snapshot and prunes old snapshots.
-// Returns the created snapshot's delta summary and snapshot count.
-func (m *SnapshotManager) CreateSnapshot(ctx context.Context, assessment *assessmentv1alpha1.ClusterAssessment) (*assessmentv1alpha1.DeltaSummary, int, error) {
+// Returns the created snapshot, whose status carries the delta, and the snapshot count.
+func (m *SnapshotManager) CreateSnapshot(ctx context.Context, assessment *assessmentv1alpha1.ClusterAssessment) (*assessmentv1alpha1.AssessmentSnapshot, int, error) {
 	logger := log.FromContext(ctx)
 
 	// Convert findings to compact format
@@ -64,7 +70,12 @@ func (m *SnapshotManager) CreateSnapshot(ctx context.Context, assessment *assess
 	if len(previousSnapshots) > 0 {
 		prev := &previousSnapshots[0]
 		previousName = prev.Name
-		delta = ComputeDelta(compactFindings, assessment.Status.Summary.Score, prev)
+		// Scores from different scoring models are not comparable
+		score := assessment.Status.Summary.Score
+		if !scoring.Comparable(assessment.Status.Summary, prev.Status.Summary) {
+			score = nil
+		}
+		delta = ComputeDelta(compactFindings, score, prev)
 	}
 
 	// Create snapshot CR
@@ -115,7 +126,7 @@ func (m *SnapshotManager) CreateSnapshot(ctx context.Context, assessment *assess
 	}
 
 	logger.Info("Created assessment snapshot", "snapshot", snapshotName, "delta", delta != nil)
-	return delta, snapshotCount, nil
+	return snapshot, snapshotCount, nil
 }
 
 // GetHistory returns snapshots for an assessment, sorted by runTime descending.
@@ -183,18 +194,41 @@ func (m *SnapshotManager) PruneHistory(ctx context.Context, assessmentName strin
 	return limit, nil
 }
 
-// compactFindings converts full findings to compact snapshots.
+// DeleteHistory removes every snapshot of the assessment.
+func (m *SnapshotManager) DeleteHistory(ctx context.Context, assessmentName string) error {
+	snapshotList := &assessmentv1alpha1.AssessmentSnapshotList{}
+	if err := m.client.List(ctx, snapshotList, client.MatchingLabels{LabelAssessmentName: assessmentName}); err != nil {
+		return fmt.Errorf("failed to list snapshots: %w", err)
+	}
+	for i := range snapshotList.Items {
+		if err := m.client.Delete(ctx, &snapshotList.Items[i]); err != nil && !errors.IsNotFound(err) {
+			return fmt.Errorf("failed to delete snapshot %s: %w", snapshotList.Items[i].Name, err)
+		}
+	}
+	return nil
+}
+
+// compactFindings converts full findings to compact snapshots. Only the
+// first MaxSnapshotAffectedResources affected resources are kept, with the
+// total count.
 func compactFindings(findings []assessmentv1alpha1.Finding) []assessmentv1alpha1.FindingSnapshot {
 	compact := make([]assessmentv1alpha1.FindingSnapshot, len(findings))
 	for i, f := range findings {
+		affected := f.AffectedResources
+		if len(affected) > MaxSnapshotAffectedResources {
+			affected = affected[:MaxSnapshotAffectedResources]
+		}
 		compact[i] = assessmentv1alpha1.FindingSnapshot{
-			ID:        f.ID,
-			Validator: f.Validator,
-			Category:  f.Category,
-			Status:    f.Status,
-			Title:     f.Title,
-			Resource:  f.Resource,
-			Namespace: f.Namespace,
+			ID:                    f.ID,
+			Validator:             f.Validator,
+			Category:              f.Category,
+			Status:                f.Status,
+			Severity:              f.Severity,
+			Title:                 f.Title,
+			Resource:              f.Resource,
+			Namespace:             f.Namespace,
+			AffectedResources:     affected,
+			AffectedResourceCount: len(f.AffectedResources),
 		}
 	}
 	return compact
@@ -242,6 +276,10 @@ func ComputeDelta(current []assessmentv1alpha1.FindingSnapshot, currentScore *in
 		if currentStatus == previousStatus {
 			continue
 		}
+		// A check that could not tell in either run neither regressed nor improved.
+		if severityLevel(currentStatus) < 0 || severityLevel(previousStatus) < 0 {
+			continue
+		}
 		if severityLevel(currentStatus) > severityLevel(previousStatus) {
 			delta.RegressionFindings = append(delta.RegressionFindings, id)
 		} else {
@@ -249,6 +287,34 @@ func ComputeDelta(current []assessmentv1alpha1.FindingSnapshot, currentScore *in
 		}
 	}
 
+	// Affected resources: for findings present in both runs, track which
+	// individual resources were added or removed. Truncated lists (nil
+	// sets) are skipped, since resources missing from them may be affected.
+	currentResources := affectedResourceKeys(current)
+	previousResources := affectedResourceKeys(previous.Status.Findings)
+	for id, keys := range currentResources {
+		previousKeys, listed := previousResources[id]
+		if _, exists := previousMap[id]; !exists || keys == nil || (listed && previousKeys == nil) {
+			continue
+		}
+		for key := range keys {
+			if !previousResources[id][key] {
+				delta.NewAffectedResources = append(delta.NewAffectedResources, id+": "+key)
+			}
+		}
+	}
+	for id, keys := range previousResources {
+		currentKeys, listed := currentResources[id]
+		if _, exists := currentMap[id]; !exists || keys == nil || (listed && currentKeys == nil) {
+			continue
+		}
+		for key := range keys {
+			if !currentResources[id][key] {
+				delta.ResolvedAffectedResources = append(delta.ResolvedAffectedResources, id+": "+key)
+			}
+		}
+	}
+
 	// Score delta
 	if currentScore != nil && previous.Status.Summary.Score != nil {
 		scoreDiff := *currentScore - *previous.Status.Summary.Score
@@ -260,15 +326,63 @@ func ComputeDelta(current []assessmentv1alpha1.FindingSnapshot, currentScore *in
 	sort.Strings(delta.ResolvedFindings)
 	sort.Strings(delta.RegressionFindings)
 	sort.Strings(delta.ImprovedFindings)
+	sort.Strings(delta.NewAffectedResources)
+	sort.Strings(delta.ResolvedAffectedResources)
 
 	return delta
 }
 
+// StatusDelta computes the delta between the current findings and summary and
+// those of the previous run recorded in an assessment status, for assessments
+// that keep no snapshots. It returns nil when there was no previous run.
+func StatusDelta(findings []assessmentv1alpha1.Finding, summary assessmentv1alpha1.AssessmentSummary, previous *assessmentv1alpha1.ClusterAssessmentStatus) *assessmentv1alpha1.DeltaSummary {
+	if previous == nil || previous.LastRunTime == nil {
+		return nil
+	}
+	prev := &assessmentv1alpha1.AssessmentSnapshot{
+		Status: assessmentv1alpha1.AssessmentSnapshotStatus{
+			Summary:  previous.Summary,
+			Findings: compactFindings(previous.Findings),
+		},
+	}
+	// Scores from different scoring models are not comparable
+	score := summary.Score
+	if !scoring.Comparable(summary, previous.Summary) {
+		score = nil
+	}
+	return ComputeDelta(compactFindings(findings), score, prev)
+}
+
+// affectedResourceKeys builds a map of finding ID to the set of affected
+// resource keys for that finding. Findings whose list was truncated map to a
+// nil set.
+func affectedResourceKeys(findings []assessmentv1alpha1.FindingSnapshot) map[string]map[string]bool {
+	keys := make(map[string]map[string]bool, len(findings))
+	for _, f := range findings {
+		if f.AffectedResourceCount > len(f.AffectedResources) {
+			keys[f.ID] = nil
+			continue
+		}
+		if len(f.AffectedResources) == 0 {
+			continue
+		}
+		set := make(map[string]bool, len(f.AffectedResources))
+		for _, r := range f.AffectedResources {
+			set[r.Key()] = true
+		}
+		keys[f.ID] = set
+	}
+	return keys
+}
+
 // severityLevel returns a numeric level for comparison.
-// Higher = more severe.
+// Higher = more severe. SKIPPED and ERROR findings, whose checks were
+// inconclusive, return -1.
 func severityLevel(s assessmentv1alpha1.FindingStatus) int {
 	switch s {
-	case assessmentv1alpha1.FindingStatusInfo:
+	case assessmentv1alpha1.FindingStatusSkipped, assessmentv1alpha1.FindingStatusError:
+		return -1
+	case assessmentv1alpha1.FindingStatusInfo, assessmentv1alpha1.FindingStatusNotApplicable:
 		return 0
 	case assessmentv1alpha1.FindingStatusPass:
 		return 1
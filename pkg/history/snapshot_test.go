@@ -17,6 +17,7 @@ limitations under the License.
 package history
 
 import (
+	"fmt"
 	"sort"
 	"testing"
 
@@ -192,6 +193,30 @@ func TestComputeDelta_Improvements(t *testing.T) {
 	}
 }
 
+func TestComputeDelta_InconclusiveIsNeitherRegressionNorImprovement(t *testing.T) {
+	current := []assessmentv1alpha1.FindingSnapshot{
+		{ID: "check-1", Status: assessmentv1alpha1.FindingStatusError}, // was PASS
+		{ID: "check-2", Status: assessmentv1alpha1.FindingStatusPass},  // was ERROR
+		{ID: "check-3", Status: assessmentv1alpha1.FindingStatusFail},  // was SKIPPED
+	}
+
+	previous := &assessmentv1alpha1.AssessmentSnapshot{
+		Status: assessmentv1alpha1.AssessmentSnapshotStatus{
+			Findings: []assessmentv1alpha1.FindingSnapshot{
+				{ID: "check-1", Status: assessmentv1alpha1.FindingStatusPass},
+				{ID: "check-2", Status: assessmentv1alpha1.FindingStatusError},
+				{ID: "check-3", Status: assessmentv1alpha1.FindingStatusSkipped},
+			},
+		},
+	}
+
+	delta := ComputeDelta(current, nil, previous)
+
+	if len(delta.RegressionFindings) != 0 || len(delta.ImprovedFindings) != 0 {
+		t.Errorf("Expected no regressions or improvements, got %v and %v", delta.RegressionFindings, delta.ImprovedFindings)
+	}
+}
+
 func TestComputeDelta_ScoreDelta(t *testing.T) {
 	current := []assessmentv1alpha1.FindingSnapshot{}
 	previous := &assessmentv1alpha1.AssessmentSnapshot{
@@ -315,6 +340,109 @@ func TestComputeDelta_MixedChanges(t *testing.T) {
 	}
 }
 
+func TestComputeDelta_AffectedResources(t *testing.T) {
+	pod := func(ns, name string) assessmentv1alpha1.AffectedResource {
+		return assessmentv1alpha1.AffectedResource{Kind: "Pod", Namespace: ns, Name: name}
+	}
+
+	current := []assessmentv1alpha1.FindingSnapshot{
+		{
+			ID:                "security-privileged-pods",
+			Status:            assessmentv1alpha1.FindingStatusWarn,
+			AffectedResources: []assessmentv1alpha1.AffectedResource{pod("team-a", "web"), pod("team-b", "agent")},
+		},
+		{
+			ID:                "security-host-pid",
+			Status:            assessmentv1alpha1.FindingStatusWarn,
+			AffectedResources: []assessmentv1alpha1.AffectedResource{pod("team-c", "debug")},
+		},
+	}
+
+	previous := &assessmentv1alpha1.AssessmentSnapshot{
+		Status: assessmentv1alpha1.AssessmentSnapshotStatus{
+			Findings: []assessmentv1alpha1.FindingSnapshot{
+				{
+					ID:                "security-privileged-pods",
+					Status:            assessmentv1alpha1.FindingStatusWarn,
+					AffectedResources: []assessmentv1alpha1.AffectedResource{pod("team-a", "web"), pod("team-a", "old")},
+				},
+			},
+		},
+	}
+
+	delta := ComputeDelta(current, nil, previous)
+
+	// Resources of a brand-new finding are covered by NewFindings
+	if len(delta.NewAffectedResources) != 1 || delta.NewAffectedResources[0] != "security-privileged-pods: Pod/team-b/agent" {
+		t.Errorf("Expected 1 new affected resource (team-b/agent), got %v", delta.NewAffectedResources)
+	}
+	if len(delta.ResolvedAffectedResources) != 1 || delta.ResolvedAffectedResources[0] != "security-privileged-pods: Pod/team-a/old" {
+		t.Errorf("Expected 1 resolved affected resource (team-a/old), got %v", delta.ResolvedAffectedResources)
+	}
+	if len(delta.NewFindings) != 1 || delta.NewFindings[0] != "security-host-pid" {
+		t.Errorf("Expected 1 new finding (security-host-pid), got %v", delta.NewFindings)
+	}
+}
+
+func TestCompactFindings_CapsAffectedResources(t *testing.T) {
+	affected := make([]assessmentv1alpha1.AffectedResource, MaxSnapshotAffectedResources+10)
+	for i := range affected {
+		affected[i] = assessmentv1alpha1.AffectedResource{Kind: "Pod", Namespace: "team-a", Name: fmt.Sprintf("pod-%d", i)}
+	}
+	findings := []assessmentv1alpha1.Finding{
+		{ID: "security-privileged-pods", Status: assessmentv1alpha1.FindingStatusWarn, AffectedResources: affected},
+		{ID: "security-host-pid", Status: assessmentv1alpha1.FindingStatusWarn, AffectedResources: affected[:2]},
+	}
+
+	compact := compactFindings(findings)
+
+	if len(compact[0].AffectedResources) != MaxSnapshotAffectedResources {
+		t.Errorf("Expected %d stored affected resources, got %d", MaxSnapshotAffectedResources, len(compact[0].AffectedResources))
+	}
+	if compact[0].AffectedResourceCount != len(affected) {
+		t.Errorf("Expected AffectedResourceCount=%d, got %d", len(affected), compact[0].AffectedResourceCount)
+	}
+	if len(compact[1].AffectedResources) != 2 || compact[1].AffectedResourceCount != 2 {
+		t.Errorf("Expected 2 stored and counted affected resources, got %d of %d",
+			len(compact[1].AffectedResources), compact[1].AffectedResourceCount)
+	}
+}
+
+func TestComputeDelta_TruncatedAffectedResources(t *testing.T) {
+	pod := func(name string) assessmentv1alpha1.AffectedResource {
+		return assessmentv1alpha1.AffectedResource{Kind: "Pod", Namespace: "team-a", Name: name}
+	}
+
+	current := []assessmentv1alpha1.FindingSnapshot{
+		{
+			ID:                    "security-privileged-pods",
+			Status:                assessmentv1alpha1.FindingStatusWarn,
+			AffectedResources:     []assessmentv1alpha1.AffectedResource{pod("web"), pod("agent")},
+			AffectedResourceCount: 80,
+		},
+	}
+	previous := &assessmentv1alpha1.AssessmentSnapshot{
+		Status: assessmentv1alpha1.AssessmentSnapshotStatus{
+			Findings: []assessmentv1alpha1.FindingSnapshot{
+				{
+					ID:                    "security-privileged-pods",
+					Status:                assessmentv1alpha1.FindingStatusWarn,
+					AffectedResources:     []assessmentv1alpha1.AffectedResource{pod("web"), pod("old")},
+					AffectedResourceCount: 2,
+				},
+			},
+		},
+	}
+
+	delta := ComputeDelta(current, nil, previous)
+
+	// Resources beyond the stored list may be affected, so no resource delta is reported
+	if len(delta.NewAffectedResources) != 0 || len(delta.ResolvedAffectedResources) != 0 {
+		t.Errorf("Expected no resource deltas for a truncated list, got new=%v resolved=%v",
+			delta.NewAffectedResources, delta.ResolvedAffectedResources)
+	}
+}
+
 func TestSeverityLevel(t *testing.T) {
 	tests := []struct {
 		status assessmentv1alpha1.FindingStatus
@@ -324,6 +452,9 @@ func TestSeverityLevel(t *testing.T) {
 		{assessmentv1alpha1.FindingStatusPass, 1},
 		{assessmentv1alpha1.FindingStatusWarn, 2},
 		{assessmentv1alpha1.FindingStatusFail, 3},
+		{assessmentv1alpha1.FindingStatusNotApplicable, 0},
+		{assessmentv1alpha1.FindingStatusError, -1},
+		{assessmentv1alpha1.FindingStatusSkipped, -1},
 	}
 
 	for _, tt := range tests {
@@ -336,3 +467,43 @@ func TestSeverityLevel(t *testing.T) {
 
 // Ensure metav1 import is used
 var _ = metav1.Now
+
+func TestStatusDelta(t *testing.T) {
+	lastRun := metav1.Now()
+	previous := &assessmentv1alpha1.ClusterAssessmentStatus{
+		LastRunTime: &lastRun,
+		Summary:     assessmentv1alpha1.AssessmentSummary{Score: intPtr(90)},
+		Findings: []assessmentv1alpha1.Finding{
+			{ID: "check-a", Status: assessmentv1alpha1.FindingStatusPass},
+			{ID: "check-b", Status: assessmentv1alpha1.FindingStatusWarn},
+		},
+	}
+	findings := []assessmentv1alpha1.Finding{
+		{ID: "check-a", Status: assessmentv1alpha1.FindingStatusFail},
+		{ID: "check-c", Status: assessmentv1alpha1.FindingStatusFail},
+	}
+
+	delta := StatusDelta(findings, assessmentv1alpha1.AssessmentSummary{Score: intPtr(60)}, previous)
+	if delta == nil || delta.ScoreDelta == nil || *delta.ScoreDelta != -30 {
+		t.Fatalf("Expected a score delta of -30, got %+v", delta)
+	}
+	if len(delta.RegressionFindings) != 1 || delta.RegressionFindings[0] != "check-a" {
+		t.Errorf("Expected check-a to regress, got %v", delta.RegressionFindings)
+	}
+	if len(delta.NewFindings) != 1 || delta.NewFindings[0] != "check-c" {
+		t.Errorf("Expected check-c to be new, got %v", delta.NewFindings)
+	}
+	if len(delta.ResolvedFindings) != 1 || delta.ResolvedFindings[0] != "check-b" {
+		t.Errorf("Expected check-b to be resolved, got %v", delta.ResolvedFindings)
+	}
+
+	// Scores of another scoring model are not compared
+	weighted := assessmentv1alpha1.AssessmentSummary{Score: intPtr(60), ScoringModel: "weighted", ScoringModelVersion: "3"}
+	if delta := StatusDelta(findings, weighted, previous); delta.ScoreDelta != nil {
+		t.Errorf("Expected no score delta across scoring models, got %d", *delta.ScoreDelta)
+	}
+
+	if StatusDelta(findings, weighted, &assessmentv1alpha1.ClusterAssessmentStatus{}) != nil {
+		t.Error("Expected no delta without a previous run")
+	}
+}
@@ -49,6 +49,15 @@ var (
 		[]string{"assessment_name", "category", "status"},
 	)
 
+	// FindingsBySeverity is a gauge that tracks open findings by severity
+	FindingsBySeverity = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "cluster_assessment_findings_by_severity",
+			Help: "Number of open findings by severity, excluding PASS, NOT_APPLICABLE and SKIPPED",
+		},
+		[]string{"assessment_name", "severity"},
+	)
+
 	// LastRunTimestamp is a gauge that tracks when the last assessment ran
 	LastRunTimestamp = prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
@@ -76,6 +85,24 @@ var (
 		[]string{"assessment_name", "validator", "status"},
 	)
 
+	// ValidatorOutcome reports the outcome of each validator in the last run
+	ValidatorOutcome = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "cluster_assessment_validator_outcome",
+			Help: "Outcome of each validator in the last assessment run (1 for the current outcome)",
+		},
+		[]string{"assessment_name", "validator", "outcome"},
+	)
+
+	// ValidatorDuration tracks how long each validator ran in the last run
+	ValidatorDuration = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "cluster_assessment_validator_duration_seconds",
+			Help: "Time each validator took in the last assessment run",
+		},
+		[]string{"assessment_name", "validator"},
+	)
+
 	// ClusterInfo is a gauge that provides cluster metadata as labels
 	ClusterInfo = prometheus.NewGaugeVec(
 		prometheus.GaugeOpts{
@@ -120,6 +147,33 @@ var (
 		},
 		[]string{"assessment_name"},
 	)
+
+	// ObjectsScanned tracks how many objects of each resource type the last run listed
+	ObjectsScanned = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "cluster_assessment_objects_scanned",
+			Help: "Number of objects listed by the last assessment run, by resource",
+		},
+		[]string{"assessment_name", "resource"},
+	)
+
+	// CollectionDuration tracks how long the last run spent listing cluster state
+	CollectionDuration = prometheus.NewGaugeVec(
+		prometheus.GaugeOpts{
+			Name: "cluster_assessment_collection_duration_seconds",
+			Help: "Time spent listing shared cluster state in the last assessment run",
+		},
+		[]string{"assessment_name"},
+	)
+
+	// SkippedRuns counts scheduled runs that did not start, by reason
+	SkippedRuns = prometheus.NewCounterVec(
+		prometheus.CounterOpts{
+			Name: "cluster_assessment_skipped_runs_total",
+			Help: "Number of scheduled assessment runs that were skipped, by reason",
+		},
+		[]string{"assessment_name", "reason"},
+	)
 )
 
 func init() {
@@ -128,34 +182,50 @@ func init() {
 		AssessmentScore,
 		FindingsTotal,
 		FindingsByCategory,
+		FindingsBySeverity,
 		LastRunTimestamp,
 		AssessmentDuration,
 		ValidatorFindings,
+		ValidatorOutcome,
+		ValidatorDuration,
 		ClusterInfo,
 		ScoreTrend,
 		NewFindingsCount,
 		ResolvedFindingsCount,
 		RegressionCount,
+		ObjectsScanned,
+		CollectionDuration,
+		SkippedRuns,
 	)
 }
 
-// RecordAssessmentMetrics records all metrics for an assessment
+// RecordAssessmentMetrics records all metrics for an assessment. A nil score,
+// for a run with no scored findings, removes the score gauge so it does not
+// report a score of 0.
 func RecordAssessmentMetrics(
 	assessmentName string,
 	profile string,
-	score int,
+	score *int,
 	passCount, warnCount, failCount, infoCount int,
+	notApplicableCount, errorCount, skippedCount int,
 	lastRunUnix float64,
 	durationSeconds float64,
 ) {
 	// Record score
-	AssessmentScore.WithLabelValues(assessmentName, profile).Set(float64(score))
+	if score != nil {
+		AssessmentScore.WithLabelValues(assessmentName, profile).Set(float64(*score))
+	} else {
+		AssessmentScore.DeletePartialMatch(prometheus.Labels{"assessment_name": assessmentName})
+	}
 
 	// Record findings by status
 	FindingsTotal.WithLabelValues(assessmentName, "PASS").Set(float64(passCount))
 	FindingsTotal.WithLabelValues(assessmentName, "WARN").Set(float64(warnCount))
 	FindingsTotal.WithLabelValues(assessmentName, "FAIL").Set(float64(failCount))
 	FindingsTotal.WithLabelValues(assessmentName, "INFO").Set(float64(infoCount))
+	FindingsTotal.WithLabelValues(assessmentName, "NOT_APPLICABLE").Set(float64(notApplicableCount))
+	FindingsTotal.WithLabelValues(assessmentName, "ERROR").Set(float64(errorCount))
+	FindingsTotal.WithLabelValues(assessmentName, "SKIPPED").Set(float64(skippedCount))
 
 	// Record timestamp and duration
 	LastRunTimestamp.WithLabelValues(assessmentName).Set(lastRunUnix)
@@ -168,19 +238,40 @@ func RecordClusterInfo(clusterID, clusterVersion, platform, channel string) {
 }
 
 // RecordValidatorMetrics records findings for a specific validator
-func RecordValidatorMetrics(assessmentName, validator string, passCount, warnCount, failCount, infoCount int) {
+func RecordValidatorMetrics(assessmentName, validator string, passCount, warnCount, failCount, infoCount, notApplicableCount, errorCount, skippedCount int) {
 	ValidatorFindings.WithLabelValues(assessmentName, validator, "PASS").Set(float64(passCount))
 	ValidatorFindings.WithLabelValues(assessmentName, validator, "WARN").Set(float64(warnCount))
 	ValidatorFindings.WithLabelValues(assessmentName, validator, "FAIL").Set(float64(failCount))
 	ValidatorFindings.WithLabelValues(assessmentName, validator, "INFO").Set(float64(infoCount))
+	ValidatorFindings.WithLabelValues(assessmentName, validator, "NOT_APPLICABLE").Set(float64(notApplicableCount))
+	ValidatorFindings.WithLabelValues(assessmentName, validator, "ERROR").Set(float64(errorCount))
+	ValidatorFindings.WithLabelValues(assessmentName, validator, "SKIPPED").Set(float64(skippedCount))
+}
+
+// RecordValidatorRun records the outcome and duration of a validator, replacing its previous outcome
+func RecordValidatorRun(assessmentName, validator, outcome string, durationSeconds float64) {
+	ValidatorOutcome.DeletePartialMatch(prometheus.Labels{"assessment_name": assessmentName, "validator": validator})
+	ValidatorOutcome.WithLabelValues(assessmentName, validator, outcome).Set(1)
+	ValidatorDuration.WithLabelValues(assessmentName, validator).Set(durationSeconds)
 }
 
 // RecordCategoryMetrics records findings for a category
-func RecordCategoryMetrics(assessmentName, category string, passCount, warnCount, failCount, infoCount int) {
+func RecordCategoryMetrics(assessmentName, category string, passCount, warnCount, failCount, infoCount, notApplicableCount, errorCount, skippedCount int) {
 	FindingsByCategory.WithLabelValues(assessmentName, category, "PASS").Set(float64(passCount))
 	FindingsByCategory.WithLabelValues(assessmentName, category, "WARN").Set(float64(warnCount))
 	FindingsByCategory.WithLabelValues(assessmentName, category, "FAIL").Set(float64(failCount))
 	FindingsByCategory.WithLabelValues(assessmentName, category, "INFO").Set(float64(infoCount))
+	FindingsByCategory.WithLabelValues(assessmentName, category, "NOT_APPLICABLE").Set(float64(notApplicableCount))
+	FindingsByCategory.WithLabelValues(assessmentName, category, "ERROR").Set(float64(errorCount))
+	FindingsByCategory.WithLabelValues(assessmentName, category, "SKIPPED").Set(float64(skippedCount))
+}
+
+// RecordSeverityMetrics records the number of non-PASS findings per severity
+func RecordSeverityMetrics(assessmentName string, lowCount, mediumCount, highCount, criticalCount int) {
+	FindingsBySeverity.WithLabelValues(assessmentName, "low").Set(float64(lowCount))
+	FindingsBySeverity.WithLabelValues(assessmentName, "medium").Set(float64(mediumCount))
+	FindingsBySeverity.WithLabelValues(assessmentName, "high").Set(float64(highCount))
+	FindingsBySeverity.WithLabelValues(assessmentName, "critical").Set(float64(criticalCount))
 }
 
 // RecordTrendMetrics records trend/delta metrics from historical tracking
@@ -192,3 +283,16 @@ func RecordTrendMetrics(assessmentName string, scoreDelta *int, newFindings, res
 	ResolvedFindingsCount.WithLabelValues(assessmentName).Set(float64(resolvedFindings))
 	RegressionCount.WithLabelValues(assessmentName).Set(float64(regressions))
 }
+
+// RecordCollectionMetrics records how many objects a run listed per resource and how long it took
+func RecordCollectionMetrics(assessmentName string, objectsByResource map[string]int, durationSeconds float64) {
+	for resource, count := range objectsByResource {
+		ObjectsScanned.WithLabelValues(assessmentName, resource).Set(float64(count))
+	}
+	CollectionDuration.WithLabelValues(assessmentName).Set(durationSeconds)
+}
+
+// RecordSkippedRun counts a scheduled run that was skipped
+func RecordSkippedRun(assessmentName, reason string) {
+	SkippedRuns.WithLabelValues(assessmentName, reason).Inc()
+}
@@ -0,0 +1,294 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package mustgather loads the resources captured in a must-gather archive and
+// serves them through a read-only client, so validators can run offline.
+package mustgather
+
+import (
+	"bytes"
+	"context"
+	"errors"
+	"fmt"
+	"io"
+	"io/fs"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilyaml "k8s.io/apimachinery/pkg/util/yaml"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/apiutil"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/validator"
+)
+
+// ErrDataUnavailable is returned by the archive client for resource types
+// that are not present in the must-gather archive.
+var ErrDataUnavailable = errors.New("data unavailable in must-gather archive")
+
+// ErrReadOnly is returned by the archive client for any write operation.
+var ErrReadOnly = errors.New("must-gather archive client is read-only")
+
+// Archive holds the objects loaded from a must-gather directory.
+type Archive struct {
+	scheme  *runtime.Scheme
+	objects []client.Object
+	kinds   map[schema.GroupVersionKind]bool
+
+	// Skipped is the number of YAML files that could not be decoded as Kubernetes objects.
+	Skipped int
+}
+
+// Load walks a must-gather directory and decodes every YAML file it contains.
+// Both single objects and List documents (e.g. namespaces/<ns>/core/pods.yaml)
+// are supported. Files that are not Kubernetes objects are skipped.
+func Load(dir string, scheme *runtime.Scheme) (*Archive, error) {
+	info, err := os.Stat(dir)
+	if err != nil {
+		return nil, fmt.Errorf("failed to open must-gather directory: %w", err)
+	}
+	if !info.IsDir() {
+		return nil, fmt.Errorf("must-gather path %s is not a directory", dir)
+	}
+
+	a := &Archive{
+		scheme: scheme,
+		kinds:  make(map[schema.GroupVersionKind]bool),
+	}
+	seen := make(map[string]bool)
+
+	err = filepath.WalkDir(dir, func(path string, d fs.DirEntry, err error) error {
+		if err != nil {
+			return err
+		}
+		if d.IsDir() {
+			return nil
+		}
+		ext := strings.ToLower(filepath.Ext(path))
+		if ext != ".yaml" && ext != ".yml" {
+			return nil
+		}
+		data, err := os.ReadFile(path)
+		if err != nil {
+			return fmt.Errorf("failed to read %s: %w", path, err)
+		}
+		if err := a.addDocuments(data, seen); err != nil {
+			a.Skipped++
+		}
+		return nil
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// Keep the object order independent of the directory walk
+	sort.Slice(a.objects, func(i, j int) bool {
+		return objectKey(a.objects[i]) < objectKey(a.objects[j])
+	})
+	return a, nil
+}
+
+// addDocuments decodes every YAML document in data and records the objects.
+func (a *Archive) addDocuments(data []byte, seen map[string]bool) error {
+	decoder := utilyaml.NewYAMLOrJSONDecoder(bytes.NewReader(data), 4096)
+	for {
+		u := &unstructured.Unstructured{}
+		if err := decoder.Decode(&u.Object); err != nil {
+			if errors.Is(err, io.EOF) {
+				return nil
+			}
+			return err
+		}
+		if len(u.Object) == 0 {
+			continue
+		}
+		gvk := u.GroupVersionKind()
+		if gvk.Kind == "" || gvk.Version == "" {
+			return fmt.Errorf("document is not a Kubernetes object")
+		}
+
+		if !u.IsList() {
+			a.add(u, seen)
+			continue
+		}
+
+		// A List document also proves its item kind was collected, even when empty
+		if itemKind := strings.TrimSuffix(gvk.Kind, "List"); itemKind != gvk.Kind && itemKind != "" {
+			a.kinds[gvk.GroupVersion().WithKind(itemKind)] = true
+		}
+		list, err := u.ToList()
+		if err != nil {
+			return err
+		}
+		for i := range list.Items {
+			a.add(&list.Items[i], seen)
+		}
+	}
+}
+
+// add records a single object, ignoring duplicates captured in several files.
+func (a *Archive) add(u *unstructured.Unstructured, seen map[string]bool) {
+	gvk := u.GroupVersionKind()
+	if gvk.Kind == "" || u.GetName() == "" {
+		return
+	}
+	a.kinds[gvk] = true
+	key := objectKey(u)
+	if seen[key] {
+		return
+	}
+	seen[key] = true
+
+	// Drop fields that the fake client rejects on creation
+	u.SetResourceVersion("")
+	u.SetManagedFields(nil)
+	a.objects = append(a.objects, u)
+}
+
+// Objects returns the number of objects loaded from the archive.
+func (a *Archive) Objects() int {
+	return len(a.objects)
+}
+
+// HasKind reports whether the archive contains data for the given kind.
+// List kinds are matched against their item kind.
+func (a *Archive) HasKind(gvk schema.GroupVersionKind) bool {
+	gvk.Kind = strings.TrimSuffix(gvk.Kind, "List")
+	return a.kinds[gvk]
+}
+
+// Client returns a read-only client serving the archive contents.
+// Reads of resource types absent from the archive fail with ErrDataUnavailable
+// and are recorded per validator, see Client.Missing.
+func (a *Archive) Client() *Client {
+	objs := make([]client.Object, len(a.objects))
+	for i, o := range a.objects {
+		objs[i] = o.DeepCopyObject().(client.Object)
+	}
+	return &Client{
+		Client:  fake.NewClientBuilder().WithScheme(a.scheme).WithObjects(objs...).Build(),
+		archive: a,
+		missing: make(map[string]map[string]bool),
+	}
+}
+
+// Client is a read-only client backed by a must-gather archive.
+type Client struct {
+	client.Client
+
+	archive *Archive
+
+	mu      sync.Mutex
+	missing map[string]map[string]bool
+}
+
+// Get retrieves an object from the archive.
+func (c *Client) Get(ctx context.Context, key client.ObjectKey, obj client.Object, opts ...client.GetOption) error {
+	if err := c.checkAvailable(ctx, obj); err != nil {
+		return err
+	}
+	return c.Client.Get(ctx, key, obj, opts...)
+}
+
+// List retrieves a list of objects from the archive.
+func (c *Client) List(ctx context.Context, list client.ObjectList, opts ...client.ListOption) error {
+	if err := c.checkAvailable(ctx, list); err != nil {
+		return err
+	}
+	return c.Client.List(ctx, list, opts...)
+}
+
+// Create is not supported by the archive client.
+func (c *Client) Create(context.Context, client.Object, ...client.CreateOption) error {
+	return ErrReadOnly
+}
+
+// Update is not supported by the archive client.
+func (c *Client) Update(context.Context, client.Object, ...client.UpdateOption) error {
+	return ErrReadOnly
+}
+
+// Patch is not supported by the archive client.
+func (c *Client) Patch(context.Context, client.Object, client.Patch, ...client.PatchOption) error {
+	return ErrReadOnly
+}
+
+// Delete is not supported by the archive client.
+func (c *Client) Delete(context.Context, client.Object, ...client.DeleteOption) error {
+	return ErrReadOnly
+}
+
+// DeleteAllOf is not supported by the archive client.
+func (c *Client) DeleteAllOf(context.Context, client.Object, ...client.DeleteAllOfOption) error {
+	return ErrReadOnly
+}
+
+// Missing returns, per validator name, the sorted resource kinds that validator
+// requested but the archive does not contain.
+func (c *Client) Missing() map[string][]string {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	out := make(map[string][]string, len(c.missing))
+	for name, kinds := range c.missing {
+		list := make([]string, 0, len(kinds))
+		for k := range kinds {
+			list = append(list, k)
+		}
+		sort.Strings(list)
+		out[name] = list
+	}
+	return out
+}
+
+// checkAvailable returns ErrDataUnavailable if obj's kind is not in the archive.
+func (c *Client) checkAvailable(ctx context.Context, obj runtime.Object) error {
+	gvk, err := apiutil.GVKForObject(obj, c.archive.scheme)
+	if err != nil {
+		return err
+	}
+	if c.archive.HasKind(gvk) {
+		return nil
+	}
+
+	kind := strings.TrimSuffix(gvk.Kind, "List")
+	if gvk.Group != "" {
+		kind = kind + "." + gvk.Group
+	}
+
+	c.mu.Lock()
+	name := validator.ValidatorNameFromContext(ctx)
+	if c.missing[name] == nil {
+		c.missing[name] = make(map[string]bool)
+	}
+	c.missing[name][kind] = true
+	c.mu.Unlock()
+
+	return fmt.Errorf("%s: %w", kind, ErrDataUnavailable)
+}
+
+// objectKey identifies an object across files.
+func objectKey(o client.Object) string {
+	gvk := o.GetObjectKind().GroupVersionKind()
+	return gvk.String() + "/" + o.GetNamespace() + "/" + o.GetName()
+}
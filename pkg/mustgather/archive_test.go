@@ -0,0 +1,237 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mustgather
+
+import (
+	"context"
+	"errors"
+	"testing"
+
+	configv1 "github.com/openshift/api/config/v1"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	assessmentv1alpha1 "github.com/openshift-assessment/cluster-assessment-operator/api/v1alpha1"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/clusterinfo"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/profiles"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/validator"
+)
+
+func newTestScheme(t *testing.T) *runtime.Scheme {
+	t.Helper()
+	s := runtime.NewScheme()
+	if err := clientgoscheme.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	if err := configv1.AddToScheme(s); err != nil {
+		t.Fatal(err)
+	}
+	return s
+}
+
+func loadTestArchive(t *testing.T) *Archive {
+	t.Helper()
+	a, err := Load("testdata/must-gather", newTestScheme(t))
+	if err != nil {
+		t.Fatalf("Load returned error: %v", err)
+	}
+	return a
+}
+
+func TestLoad(t *testing.T) {
+	a := loadTestArchive(t)
+
+	// 2 nodes, 1 clusterversion, 1 namespace, 2 pods
+	if a.Objects() != 6 {
+		t.Errorf("Expected 6 objects, got %d", a.Objects())
+	}
+	if a.Skipped != 1 {
+		t.Errorf("Expected 1 skipped file, got %d", a.Skipped)
+	}
+	if !a.HasKind(schema.GroupVersionKind{Version: "v1", Kind: "Service"}) {
+		t.Error("Expected empty ServiceList to mark Service as collected")
+	}
+	if a.HasKind(schema.GroupVersionKind{Version: "v1", Kind: "Secret"}) {
+		t.Error("Expected Secret to be unavailable")
+	}
+}
+
+func TestLoad_MissingDirectory(t *testing.T) {
+	if _, err := Load("testdata/does-not-exist", newTestScheme(t)); err == nil {
+		t.Error("Expected error for missing directory")
+	}
+}
+
+func TestClient_ReadsArchiveObjects(t *testing.T) {
+	c := loadTestArchive(t).Client()
+	ctx := context.Background()
+
+	nodes := &corev1.NodeList{}
+	if err := c.List(ctx, nodes); err != nil {
+		t.Fatalf("List nodes: %v", err)
+	}
+	if len(nodes.Items) != 2 {
+		t.Errorf("Expected 2 nodes, got %d", len(nodes.Items))
+	}
+
+	pods := &corev1.PodList{}
+	if err := c.List(ctx, pods, client.InNamespace("openshift-etcd")); err != nil {
+		t.Fatalf("List pods: %v", err)
+	}
+	if len(pods.Items) != 2 {
+		t.Errorf("Expected 2 pods, got %d", len(pods.Items))
+	}
+
+	services := &corev1.ServiceList{}
+	if err := c.List(ctx, services); err != nil {
+		t.Errorf("Expected empty service list to be available, got %v", err)
+	}
+
+	cv := &configv1.ClusterVersion{}
+	if err := c.Get(ctx, client.ObjectKey{Name: "version"}, cv); err != nil {
+		t.Fatalf("Get clusterversion: %v", err)
+	}
+	if cv.Spec.Channel != "stable-4.16" {
+		t.Errorf("Expected channel stable-4.16, got %q", cv.Spec.Channel)
+	}
+
+	meta := &metav1.PartialObjectMetadataList{}
+	meta.SetGroupVersionKind(schema.GroupVersionKind{Version: "v1", Kind: "NodeList"})
+	if err := c.List(ctx, meta); err != nil {
+		t.Fatalf("List node metadata: %v", err)
+	}
+	if len(meta.Items) != 2 {
+		t.Errorf("Expected 2 node metadata items, got %d", len(meta.Items))
+	}
+}
+
+func TestClient_ClusterInfo(t *testing.T) {
+	info, _ := clusterinfo.Collect(context.Background(), loadTestArchive(t).Client())
+	if info.ClusterVersion != "4.16.8" {
+		t.Errorf("Expected version 4.16.8, got %q", info.ClusterVersion)
+	}
+	if info.NodeCount != 2 || info.WorkerNodes != 1 {
+		t.Errorf("Unexpected node counts: %+v", info)
+	}
+}
+
+func TestClient_RecordsMissingData(t *testing.T) {
+	c := loadTestArchive(t).Client()
+	ctx := validator.WithValidatorName(context.Background(), "oadpbackup")
+
+	schedules := &unstructured.UnstructuredList{}
+	schedules.SetGroupVersionKind(schema.GroupVersionKind{Group: "velero.io", Version: "v1", Kind: "ScheduleList"})
+	if err := c.List(ctx, schedules); !errors.Is(err, ErrDataUnavailable) {
+		t.Errorf("Expected ErrDataUnavailable, got %v", err)
+	}
+	if err := c.Get(ctx, client.ObjectKey{Name: "x", Namespace: "y"}, &corev1.Secret{}); !errors.Is(err, ErrDataUnavailable) {
+		t.Errorf("Expected ErrDataUnavailable, got %v", err)
+	}
+
+	missing := c.Missing()
+	want := []string{"Schedule.velero.io", "Secret"}
+	got := missing["oadpbackup"]
+	if len(got) != len(want) || got[0] != want[0] || got[1] != want[1] {
+		t.Errorf("Missing()[oadpbackup] = %v, want %v", got, want)
+	}
+}
+
+func TestClient_IsReadOnly(t *testing.T) {
+	c := loadTestArchive(t).Client()
+	ns := &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: "new"}}
+	if err := c.Create(context.Background(), ns); !errors.Is(err, ErrReadOnly) {
+		t.Errorf("Expected ErrReadOnly, got %v", err)
+	}
+}
+
+func TestMarkUnavailable(t *testing.T) {
+	findings := []assessmentv1alpha1.Finding{
+		{ID: "etcdbackup-error", Validator: "etcdbackup", Category: "Platform", Status: assessmentv1alpha1.FindingStatusFail},
+		{ID: "nodes-count", Validator: "nodes", Category: "Infrastructure", Status: assessmentv1alpha1.FindingStatusPass},
+		{ID: "storage-default", Validator: "storage", Category: "Storage", Status: assessmentv1alpha1.FindingStatusWarn},
+		{
+			ID: "networking-policies-error", Validator: "networking", Category: "Networking",
+			Status:      assessmentv1alpha1.FindingStatusError,
+			Description: "Could not list NetworkPolicies",
+		},
+		{ID: "security-pods-error", Validator: "security", Category: "Security", Status: assessmentv1alpha1.FindingStatusError},
+	}
+	missing := map[string][]string{
+		"etcdbackup": {"DataProtectionApplication.oadp.openshift.io"},
+		"storage":    {"StorageClass.storage.k8s.io"},
+		"networking": {"NetworkPolicy.networking.k8s.io"},
+	}
+
+	result := MarkUnavailable(findings, missing, validator.NewRegistry())
+
+	if len(result) != 6 {
+		t.Fatalf("Expected 6 findings, got %d", len(result))
+	}
+	if result[3].ID != "networking-policies-error" || result[3].Status != assessmentv1alpha1.FindingStatusInfo {
+		t.Errorf("Expected check error caused by missing data downgraded to INFO, got %s/%s", result[3].ID, result[3].Status)
+	}
+	if result[0].ID != "etcdbackup-data-unavailable" || result[0].Status != assessmentv1alpha1.FindingStatusInfo {
+		t.Errorf("Expected error finding replaced by INFO data-unavailable, got %s/%s", result[0].ID, result[0].Status)
+	}
+	if result[0].Category != "Platform" {
+		t.Errorf("Expected category preserved, got %q", result[0].Category)
+	}
+	if result[2].ID != "storage-default" {
+		t.Errorf("Expected completed findings preserved, got %q", result[2].ID)
+	}
+	if result[4].ID != "security-pods-error" || result[4].Status != assessmentv1alpha1.FindingStatusError {
+		t.Errorf("Expected check error of validator with complete data kept, got %s/%s", result[4].ID, result[4].Status)
+	}
+	if result[5].ID != "storage-data-unavailable" {
+		t.Errorf("Expected data-unavailable appended for partial validator, got %q", result[5].ID)
+	}
+}
+
+// storageValidator is a validator registered to check the catalog entry of
+// data-unavailable findings.
+type storageValidator struct{}
+
+func (storageValidator) Name() string        { return "storage" }
+func (storageValidator) Description() string { return "storage" }
+func (storageValidator) Category() string    { return "Storage" }
+func (storageValidator) Validate(context.Context, client.Client, profiles.Profile) ([]assessmentv1alpha1.Finding, error) {
+	return nil, nil
+}
+
+func TestMarkUnavailable_Cataloged(t *testing.T) {
+	registry := validator.NewRegistry()
+	if err := registry.Register(storageValidator{}); err != nil {
+		t.Fatal(err)
+	}
+
+	result := MarkUnavailable(nil, map[string][]string{"storage": {"StorageClass.storage.k8s.io"}}, registry)
+	if len(result) != 1 {
+		t.Fatalf("Expected a data-unavailable finding, got %+v", result)
+	}
+	check, ok := registry.LookupCheck(result[0].ID)
+	if !ok || !registry.KnownCheckID(result[0].ID) {
+		t.Fatalf("Expected %s to be declared in the catalog", result[0].ID)
+	}
+	if check.DefaultStatus != assessmentv1alpha1.FindingStatusInfo || check.Category != "Storage" {
+		t.Errorf("Unexpected catalog entry %+v", check)
+	}
+}
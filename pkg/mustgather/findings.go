@@ -0,0 +1,97 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package mustgather
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	assessmentv1alpha1 "github.com/openshift-assessment/cluster-assessment-operator/api/v1alpha1"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/validator"
+)
+
+// MarkUnavailable rewrites findings for validators whose inputs were missing
+// from the archive, as recorded by Client.Missing. A validator's "<name>-error"
+// finding is replaced by an INFO "<name>-data-unavailable" finding listing the
+// missing resource kinds, and its per-check ERROR findings are downgraded to
+// INFO: the archive client only fails reads of kinds it does not hold, so a
+// check of such a validator that could not read its input lacked archive data.
+// Validators that completed despite missing inputs get the
+// "<name>-data-unavailable" finding appended so the report shows that their
+// results may be partial.
+func MarkUnavailable(findings []assessmentv1alpha1.Finding, missing map[string][]string, registry *validator.Registry) []assessmentv1alpha1.Finding {
+	if len(missing) == 0 {
+		return findings
+	}
+
+	result := make([]assessmentv1alpha1.Finding, 0, len(findings)+len(missing))
+	reported := make(map[string]bool, len(missing))
+	for _, f := range findings {
+		kinds, ok := missing[f.Validator]
+		switch {
+		case ok && f.ID == f.Validator+"-error":
+			result = append(result, unavailableFinding(f.Validator, f.Category, kinds))
+			reported[f.Validator] = true
+		case ok && f.Status == assessmentv1alpha1.FindingStatusError:
+			f.Status = assessmentv1alpha1.FindingStatusInfo
+			f.Severity = assessmentv1alpha1.SeverityLow
+			f.Title = fmt.Sprintf("Data unavailable: %s", f.Title)
+			f.Impact = "This check was not evaluated because its input data is missing from the must-gather archive."
+			f.Recommendation = ""
+			f.Remediation = nil
+			result = append(result, f)
+			reported[f.Validator] = true
+		default:
+			result = append(result, f)
+		}
+	}
+
+	names := make([]string, 0, len(missing))
+	for name := range missing {
+		names = append(names, name)
+	}
+	sort.Strings(names)
+
+	for _, name := range names {
+		if reported[name] || name == "" {
+			continue
+		}
+		category := ""
+		if v, ok := registry.Get(name); ok {
+			category = v.Category()
+		}
+		result = append(result, unavailableFinding(name, category, missing[name]))
+	}
+	return result
+}
+
+// unavailableFinding builds the INFO finding for a validator lacking archive data.
+func unavailableFinding(name, category string, kinds []string) assessmentv1alpha1.Finding {
+	return assessmentv1alpha1.Finding{
+		ID:        fmt.Sprintf("%s-data-unavailable", name),
+		Validator: name,
+		Category:  category,
+		Status:    assessmentv1alpha1.FindingStatusInfo,
+		Severity:  assessmentv1alpha1.SeverityLow,
+		Title:     fmt.Sprintf("Data unavailable for %s", name),
+		Description: fmt.Sprintf("The must-gather archive does not contain %s. Checks depending on this data were not evaluated.",
+			strings.Join(kinds, ", ")),
+		Impact:         "Results for this validator may be incomplete.",
+		Recommendation: "Collect a must-gather that includes these resources, or run the assessment against the live cluster.",
+	}
+}
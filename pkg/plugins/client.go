@@ -0,0 +1,122 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugins
+
+import (
+	"context"
+	"crypto/tls"
+	"crypto/x509"
+	"fmt"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	assessmentv1alpha1 "github.com/openshift-assessment/cluster-assessment-operator/api/v1alpha1"
+)
+
+// maxMessageSize bounds the size of a plugin's response. Findings listing many
+// affected resources easily exceed gRPC's 4MiB default.
+const maxMessageSize = 32 << 20
+
+// Client calls a plugin's gRPC service.
+type Client struct {
+	conn grpc.ClientConnInterface
+}
+
+// NewClient creates a client calling the plugin served on conn.
+func NewClient(conn grpc.ClientConnInterface) *Client {
+	return &Client{conn: conn}
+}
+
+// Connect creates a client for the plugin's Service. The connection is
+// established lazily on the first call, and verified with the spec's CA
+// bundle. Plugins are only called without TLS if the spec opts in with
+// insecure.
+func Connect(spec assessmentv1alpha1.ValidatorPluginSpec) (*Client, error) {
+	host := fmt.Sprintf("%s.%s.svc", spec.Service.Name, spec.Service.Namespace)
+
+	var creds credentials.TransportCredentials
+	switch {
+	case len(spec.CABundle) > 0 && spec.Insecure:
+		return nil, fmt.Errorf("insecure cannot be combined with caBundle")
+	case spec.Insecure:
+		creds = insecure.NewCredentials()
+	case len(spec.CABundle) == 0:
+		return nil, fmt.Errorf("caBundle is required unless insecure is true")
+	default:
+		pool := x509.NewCertPool()
+		if !pool.AppendCertsFromPEM(spec.CABundle) {
+			return nil, fmt.Errorf("caBundle contains no valid PEM certificates")
+		}
+		creds = credentials.NewTLS(&tls.Config{
+			RootCAs:    pool,
+			ServerName: host,
+			MinVersion: tls.VersionTLS12,
+		})
+	}
+
+	conn, err := grpc.NewClient(fmt.Sprintf("dns:///%s:%d", host, spec.Service.Port),
+		grpc.WithTransportCredentials(creds),
+		grpc.WithDefaultCallOptions(grpc.MaxCallRecvMsgSize(maxMessageSize)))
+	if err != nil {
+		return nil, fmt.Errorf("failed to create plugin client: %w", err)
+	}
+	return NewClient(conn), nil
+}
+
+// Describe asks the plugin for its metadata and check catalog.
+func (c *Client) Describe(ctx context.Context) (*Description, error) {
+	out := &structpb.Struct{}
+	if err := c.conn.Invoke(ctx, DescribeMethod, &structpb.Struct{}, out); err != nil {
+		return nil, err
+	}
+	d := &Description{}
+	if err := fromStruct(out, d); err != nil {
+		return nil, fmt.Errorf("invalid Describe response: %w", err)
+	}
+	if err := d.validate(); err != nil {
+		return nil, err
+	}
+	return d, nil
+}
+
+// Validate asks the plugin to assess the cluster.
+func (c *Client) Validate(ctx context.Context, req *ValidateRequest) (*ValidateResponse, error) {
+	in, err := toStruct(req)
+	if err != nil {
+		return nil, fmt.Errorf("failed to encode Validate request: %w", err)
+	}
+	out := &structpb.Struct{}
+	if err := c.conn.Invoke(ctx, ValidateMethod, in, out); err != nil {
+		return nil, err
+	}
+	resp := &ValidateResponse{}
+	if err := fromStruct(out, resp); err != nil {
+		return nil, fmt.Errorf("invalid Validate response: %w", err)
+	}
+	return resp, nil
+}
+
+// Close closes the client's connection, if it owns one.
+func (c *Client) Close() error {
+	if closer, ok := c.conn.(interface{ Close() error }); ok {
+		return closer.Close()
+	}
+	return nil
+}
@@ -0,0 +1,262 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugins
+
+import (
+	"context"
+	"errors"
+	"net"
+	"strings"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	assessmentv1alpha1 "github.com/openshift-assessment/cluster-assessment-operator/api/v1alpha1"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/profiles"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/validator"
+)
+
+// fakeServer is a plugin returning fixed results.
+type fakeServer struct {
+	desc     *Description
+	findings []assessmentv1alpha1.Finding
+	err      error
+	profile  profiles.Profile
+}
+
+func (s *fakeServer) Describe(_ context.Context) (*Description, error) {
+	return s.desc, s.err
+}
+
+func (s *fakeServer) Validate(_ context.Context, req *ValidateRequest) (*ValidateResponse, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	s.profile = req.Profile
+	return &ValidateResponse{Findings: s.findings}, nil
+}
+
+// serve runs srv on an in-memory listener and returns a client for it.
+func serve(t *testing.T, srv Server) *Client {
+	t.Helper()
+	lis := bufconn.Listen(1 << 20)
+	s := grpc.NewServer()
+	RegisterServer(s, srv)
+	go func() { _ = s.Serve(lis) }()
+	t.Cleanup(s.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///plugin",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) { return lis.DialContext(ctx) }),
+		grpc.WithTransportCredentials(insecure.NewCredentials()))
+	if err != nil {
+		t.Fatalf("Failed to create client: %v", err)
+	}
+	c := NewClient(conn)
+	t.Cleanup(func() { _ = c.Close() })
+	return c
+}
+
+var acmeDescription = &Description{
+	Description: "Validates Acme operator configuration",
+	Checks: []assessmentv1alpha1.PluginCheck{
+		{ID: "acme-license-valid", Title: "Acme License Valid", DefaultStatus: assessmentv1alpha1.FindingStatusFail, Severity: assessmentv1alpha1.SeverityHigh},
+		{ID: "acme-instance-*", Title: "Acme Instance Health", DefaultStatus: assessmentv1alpha1.FindingStatusWarn},
+	},
+}
+
+func TestClient_Describe(t *testing.T) {
+	c := serve(t, &fakeServer{desc: acmeDescription})
+
+	desc, err := c.Describe(context.Background())
+	if err != nil {
+		t.Fatalf("Describe returned error: %v", err)
+	}
+	if desc.Description != acmeDescription.Description || len(desc.Checks) != 2 || desc.Checks[0].Severity != assessmentv1alpha1.SeverityHigh {
+		t.Errorf("Unexpected description %+v", desc)
+	}
+
+	empty := serve(t, &fakeServer{desc: &Description{Description: "no checks"}})
+	if _, err := empty.Describe(context.Background()); err == nil || !strings.Contains(err.Error(), "no checks") {
+		t.Errorf("Expected a plugin without checks to be rejected, got %v", err)
+	}
+}
+
+func TestValidator_Validate(t *testing.T) {
+	srv := &fakeServer{findings: []assessmentv1alpha1.Finding{
+		{ID: "acme-license-valid", Status: assessmentv1alpha1.FindingStatusPass, Title: "License valid"},
+		{ID: "acme-instance-prod", Status: assessmentv1alpha1.FindingStatusWarn, Category: "Acme", Validator: "spoofed",
+			AffectedResources: []assessmentv1alpha1.AffectedResource{{Kind: "AcmeInstance", Namespace: "prod", Name: "main"}}},
+	}}
+	v := NewValidator("acme", *acmeDescription, serve(t, srv))
+
+	profile := profiles.GetProfile("production")
+	findings, err := v.Validate(context.Background(), nil, profile)
+	if err != nil {
+		t.Fatalf("Validate returned error: %v", err)
+	}
+	if srv.profile.Name != profile.Name || srv.profile.Strictness != profile.Strictness {
+		t.Errorf("Expected the plugin to receive the profile, got %+v", srv.profile)
+	}
+	if len(findings) != 2 {
+		t.Fatalf("Expected 2 findings, got %d", len(findings))
+	}
+	if findings[0].Validator != "acme" || findings[0].Category != "Plugin" {
+		t.Errorf("Expected the plugin's name and default category, got %+v", findings[0])
+	}
+	if findings[1].Validator != "acme" || findings[1].Category != "Acme" || len(findings[1].AffectedResources) != 1 {
+		t.Errorf("Expected the finding to keep its category and resources, got %+v", findings[1])
+	}
+
+	srv.findings = []assessmentv1alpha1.Finding{{ID: "acme-license-valid", Status: "BROKEN"}}
+	if _, err := v.Validate(context.Background(), nil, profile); err == nil {
+		t.Error("Expected a finding with an unknown status to be rejected")
+	}
+
+	for _, id := range []string{"acme-unlisted", "security-privileged-pods"} {
+		srv.findings = []assessmentv1alpha1.Finding{{ID: id, Status: assessmentv1alpha1.FindingStatusFail}}
+		if _, err := v.Validate(context.Background(), nil, profile); err == nil || !strings.Contains(err.Error(), "not in its catalog") {
+			t.Errorf("Expected finding %s outside the catalog to be rejected, got %v", id, err)
+		}
+	}
+
+	srv.err = errors.New("cluster unreachable")
+	if _, err := v.Validate(context.Background(), nil, profile); err == nil || !strings.Contains(err.Error(), "cluster unreachable") {
+		t.Errorf("Expected the plugin error to be returned, got %v", err)
+	}
+}
+
+func TestDescription_ValidateFor(t *testing.T) {
+	if err := acmeDescription.ValidateFor("acme"); err != nil {
+		t.Errorf("Expected checks prefixed with the plugin name to be valid, got %v", err)
+	}
+	if err := acmeDescription.ValidateFor("acme-operator"); err == nil || !strings.Contains(err.Error(), "acme-license-valid") {
+		t.Errorf("Expected checks of another name to be rejected, got %v", err)
+	}
+
+	shadowing := &Description{Checks: []assessmentv1alpha1.PluginCheck{
+		{ID: "security-privileged-pods", Title: "Privileged Pods", DefaultStatus: assessmentv1alpha1.FindingStatusPass},
+	}}
+	if err := shadowing.ValidateFor("acme"); err == nil {
+		t.Error("Expected a plugin declaring a built-in check ID to be rejected")
+	}
+}
+
+func TestValidator_RegistersLikeBuiltIn(t *testing.T) {
+	reg := validator.NewRegistry()
+	if err := reg.Register(NewValidator("acme", *acmeDescription, NewClient(nil))); err != nil {
+		t.Fatalf("Register returned error: %v", err)
+	}
+
+	if _, ok := reg.LookupCheck("acme-instance-prod"); !ok {
+		t.Error("Expected the plugin's wildcard check to be in the catalog")
+	}
+	if _, ok := reg.LookupCheck("acme-error"); !ok {
+		t.Error("Expected the runner's synthetic checks to cover the plugin")
+	}
+	disabled := profiles.GetProfile("production")
+	disabled.DisabledValidators = []string{"acme"}
+	if len(reg.ForProfile(disabled)) != 0 {
+		t.Error("Expected profiles to disable the plugin by name")
+	}
+}
+
+func TestLoad(t *testing.T) {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(assessmentv1alpha1.AddToScheme(scheme))
+
+	plugin := func(name string, ready bool) *assessmentv1alpha1.ValidatorPlugin {
+		p := &assessmentv1alpha1.ValidatorPlugin{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Generation: 1},
+			Spec: assessmentv1alpha1.ValidatorPluginSpec{
+				Service:  assessmentv1alpha1.PluginServiceReference{Namespace: "acme-system", Name: name, Port: 8443},
+				Insecure: true,
+			},
+			Status: assessmentv1alpha1.ValidatorPluginStatus{
+				ObservedGeneration: 1,
+				Category:           "Acme",
+				Checks:             acmeDescription.Checks,
+			},
+		}
+		status := metav1.ConditionFalse
+		if ready {
+			status = metav1.ConditionTrue
+		}
+		p.Status.Conditions = []metav1.Condition{{Type: assessmentv1alpha1.ConditionReady, Status: status, Reason: "Test"}}
+		return p
+	}
+	// globex records checks that are not prefixed with its name
+	c := fake.NewClientBuilder().WithScheme(scheme).WithObjects(plugin("acme", true), plugin("broken", false), plugin("globex", true)).Build()
+
+	reg := validator.NewRegistry()
+	if err := Load(context.Background(), c, reg); err == nil || !strings.Contains(err.Error(), "plugin globex") {
+		t.Fatalf("Expected globex to be reported, got %v", err)
+	}
+	if _, ok := reg.Get("globex"); ok {
+		t.Error("Expected the plugin with foreign check IDs to be skipped")
+	}
+	v, ok := reg.Get("acme")
+	if !ok || v.Category() != "Acme" {
+		t.Errorf("Expected the ready plugin to be registered, got %v", v)
+	}
+	if _, ok := reg.Get("broken"); ok {
+		t.Error("Expected the plugin that is not ready to be skipped")
+	}
+	_ = v.(*Validator).Close()
+}
+
+func TestConnect_InvalidCABundle(t *testing.T) {
+	_, err := Connect(assessmentv1alpha1.ValidatorPluginSpec{
+		Service:  assessmentv1alpha1.PluginServiceReference{Namespace: "acme-system", Name: "acme", Port: 8443},
+		CABundle: []byte("not a certificate"),
+	})
+	if err == nil {
+		t.Error("Expected an invalid CA bundle to be rejected")
+	}
+}
+
+func TestConnect_RequiresCABundleOrInsecure(t *testing.T) {
+	service := assessmentv1alpha1.PluginServiceReference{Namespace: "acme-system", Name: "acme", Port: 8443}
+
+	if _, err := Connect(assessmentv1alpha1.ValidatorPluginSpec{Service: service}); err == nil || !strings.Contains(err.Error(), "caBundle is required") {
+		t.Errorf("Expected a plugin without caBundle to be rejected, got %v", err)
+	}
+	both := assessmentv1alpha1.ValidatorPluginSpec{Service: service, CABundle: []byte("ca"), Insecure: true}
+	if _, err := Connect(both); err == nil {
+		t.Error("Expected insecure combined with caBundle to be rejected")
+	}
+
+	c, err := Connect(assessmentv1alpha1.ValidatorPluginSpec{Service: service, Insecure: true})
+	if err != nil {
+		t.Fatalf("Expected an explicit insecure opt-in to connect, got %v", err)
+	}
+	_ = c.Close()
+}
+
+func TestValidateName(t *testing.T) {
+	if err := ValidateName("acme-operator"); err != nil {
+		t.Errorf("Expected a DNS label to be valid, got %v", err)
+	}
+	if err := ValidateName("acme.operator"); err == nil {
+		t.Error("Expected a name with dots to be rejected")
+	}
+}
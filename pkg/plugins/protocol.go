@@ -0,0 +1,198 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package plugins runs out-of-tree validators registered with ValidatorPlugin
+// resources. A plugin is a gRPC server implementing the ValidatorPlugin
+// service described in plugin.proto; the operator describes it once to learn
+// its check catalog, then calls it like a built-in validator on every run.
+//
+// Requests and responses are google.protobuf.Struct messages holding the JSON
+// form of the Go types in this package, so plugins can be written in any
+// language without generated code for the operator's API types.
+package plugins
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"strings"
+
+	"google.golang.org/grpc"
+	"google.golang.org/protobuf/types/known/structpb"
+
+	assessmentv1alpha1 "github.com/openshift-assessment/cluster-assessment-operator/api/v1alpha1"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/profiles"
+)
+
+const (
+	// ServiceName is the fully qualified name of the plugin gRPC service.
+	ServiceName = "assessment.plugin.v1alpha1.ValidatorPlugin"
+
+	// DescribeMethod is the full method name of the Describe call.
+	DescribeMethod = "/" + ServiceName + "/Describe"
+
+	// ValidateMethod is the full method name of the Validate call.
+	ValidateMethod = "/" + ServiceName + "/Validate"
+)
+
+// Description is what a plugin reports about itself from Describe.
+type Description struct {
+	// Description summarizes what the plugin validates.
+	Description string `json:"description,omitempty"`
+
+	// Category is the category of the plugin's findings.
+	Category string `json:"category,omitempty"`
+
+	// Checks is the catalog of checks the plugin can report.
+	Checks []assessmentv1alpha1.PluginCheck `json:"checks"`
+}
+
+// validate checks that the description declares a usable catalog.
+func (d *Description) validate() error {
+	if len(d.Checks) == 0 {
+		return fmt.Errorf("plugin declares no checks")
+	}
+	for _, c := range d.Checks {
+		if c.ID == "" || c.Title == "" || !knownStatus(c.DefaultStatus) {
+			return fmt.Errorf("plugin declares incomplete check %q", c.ID)
+		}
+	}
+	return nil
+}
+
+// ValidateFor checks that every check ID of the description starts with
+// "<name>-", so a plugin cannot report or shadow the checks of built-in
+// validators and other plugins.
+func (d *Description) ValidateFor(name string) error {
+	var foreign []string
+	for _, c := range d.Checks {
+		if !strings.HasPrefix(c.ID, name+"-") {
+			foreign = append(foreign, c.ID)
+		}
+	}
+	if len(foreign) > 0 {
+		return fmt.Errorf("check IDs must start with %q: %s", name+"-", strings.Join(foreign, ", "))
+	}
+	return nil
+}
+
+// ValidateRequest is the payload of a Validate call.
+type ValidateRequest struct {
+	// Profile is the resolved profile of the assessment.
+	Profile profiles.Profile `json:"profile"`
+}
+
+// ValidateResponse is the payload returned by a Validate call.
+type ValidateResponse struct {
+	// Findings are the plugin's findings. Their IDs must be declared in the
+	// plugin's catalog. The operator sets their validator.
+	Findings []assessmentv1alpha1.Finding `json:"findings"`
+}
+
+// Server is implemented by plugins written in Go.
+type Server interface {
+	// Describe reports the plugin's metadata and check catalog.
+	Describe(ctx context.Context) (*Description, error)
+
+	// Validate assesses the cluster under the given profile.
+	Validate(ctx context.Context, req *ValidateRequest) (*ValidateResponse, error)
+}
+
+// RegisterServer registers a plugin implementation with a gRPC server.
+func RegisterServer(s grpc.ServiceRegistrar, srv Server) {
+	s.RegisterService(&serviceDesc, srv)
+}
+
+// serviceDesc describes the ValidatorPlugin service to gRPC.
+var serviceDesc = grpc.ServiceDesc{
+	ServiceName: ServiceName,
+	HandlerType: (*Server)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Describe",
+			Handler: unaryHandler(DescribeMethod, func(ctx context.Context, srv Server, _ *structpb.Struct) (any, error) {
+				return srv.Describe(ctx)
+			}),
+		},
+		{
+			MethodName: "Validate",
+			Handler: unaryHandler(ValidateMethod, func(ctx context.Context, srv Server, in *structpb.Struct) (any, error) {
+				req := &ValidateRequest{}
+				if err := fromStruct(in, req); err != nil {
+					return nil, err
+				}
+				return srv.Validate(ctx, req)
+			}),
+		},
+	},
+	Metadata: "plugin.proto",
+}
+
+// unaryHandler adapts a Server method to a gRPC method handler, converting
+// its result to a Struct.
+func unaryHandler(method string, call func(context.Context, Server, *structpb.Struct) (any, error)) grpc.MethodHandler {
+	return func(srv any, ctx context.Context, dec func(any) error, interceptor grpc.UnaryServerInterceptor) (any, error) {
+		in := &structpb.Struct{}
+		if err := dec(in); err != nil {
+			return nil, err
+		}
+		handler := func(ctx context.Context, req any) (any, error) {
+			out, err := call(ctx, srv.(Server), req.(*structpb.Struct))
+			if err != nil {
+				return nil, err
+			}
+			return toStruct(out)
+		}
+		if interceptor == nil {
+			return handler(ctx, in)
+		}
+		return interceptor(ctx, in, &grpc.UnaryServerInfo{Server: srv, FullMethod: method}, handler)
+	}
+}
+
+// toStruct converts v to a Struct through its JSON form.
+func toStruct(v any) (*structpb.Struct, error) {
+	data, err := json.Marshal(v)
+	if err != nil {
+		return nil, err
+	}
+	fields := map[string]any{}
+	if err := json.Unmarshal(data, &fields); err != nil {
+		return nil, err
+	}
+	return structpb.NewStruct(fields)
+}
+
+// fromStruct decodes s into v through its JSON form.
+func fromStruct(s *structpb.Struct, v any) error {
+	data, err := json.Marshal(s.AsMap())
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(data, v)
+}
+
+// knownStatus reports whether status is a valid finding status.
+func knownStatus(status assessmentv1alpha1.FindingStatus) bool {
+	switch status {
+	case assessmentv1alpha1.FindingStatusPass, assessmentv1alpha1.FindingStatusWarn,
+		assessmentv1alpha1.FindingStatusFail, assessmentv1alpha1.FindingStatusInfo,
+		assessmentv1alpha1.FindingStatusSkipped, assessmentv1alpha1.FindingStatusNotApplicable,
+		assessmentv1alpha1.FindingStatusError:
+		return true
+	}
+	return false
+}
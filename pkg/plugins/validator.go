@@ -0,0 +1,177 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package plugins
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/meta"
+	"k8s.io/apimachinery/pkg/util/validation"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	assessmentv1alpha1 "github.com/openshift-assessment/cluster-assessment-operator/api/v1alpha1"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/profiles"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/validator"
+)
+
+// defaultCategory is the category of plugins that do not declare one.
+const defaultCategory = "Plugin"
+
+// Validator runs a plugin as a validator named after its ValidatorPlugin.
+type Validator struct {
+	name   string
+	desc   Description
+	client *Client
+}
+
+var (
+	_ validator.Validator     = &Validator{}
+	_ validator.CheckProvider = &Validator{}
+)
+
+// NewValidator creates the validator for a described plugin. The description
+// must have been checked with ValidateFor.
+func NewValidator(name string, desc Description, c *Client) *Validator {
+	return &Validator{name: name, desc: desc, client: c}
+}
+
+func (v *Validator) Name() string        { return v.name }
+func (v *Validator) Description() string { return v.desc.Description }
+
+// Category returns the plugin's category, or "Plugin" if it declares none.
+func (v *Validator) Category() string {
+	if v.desc.Category == "" {
+		return defaultCategory
+	}
+	return v.desc.Category
+}
+
+// Checks returns the catalog the plugin described.
+func (v *Validator) Checks() []validator.Check {
+	checks := make([]validator.Check, 0, len(v.desc.Checks))
+	for _, c := range v.desc.Checks {
+		checks = append(checks, validator.Check{
+			ID:            c.ID,
+			Title:         c.Title,
+			DefaultStatus: c.DefaultStatus,
+			Severity:      c.Severity,
+			References:    c.References,
+		})
+	}
+	return checks
+}
+
+// Validate calls the plugin with the resolved profile. The plugin reads the
+// cluster with its own credentials, so c is not used.
+func (v *Validator) Validate(ctx context.Context, _ client.Client, profile profiles.Profile) ([]assessmentv1alpha1.Finding, error) {
+	resp, err := v.client.Validate(ctx, &ValidateRequest{Profile: profile})
+	if err != nil {
+		return nil, fmt.Errorf("plugin call failed: %w", err)
+	}
+
+	for i := range resp.Findings {
+		f := &resp.Findings[i]
+		if f.ID == "" || !knownStatus(f.Status) {
+			return nil, fmt.Errorf("plugin returned invalid finding %q with status %q", f.ID, f.Status)
+		}
+		if !v.inCatalog(f.ID) {
+			return nil, fmt.Errorf("plugin returned finding %q that is not in its catalog", f.ID)
+		}
+		f.Validator = v.name
+		if f.Category == "" {
+			f.Category = v.Category()
+		}
+	}
+	return resp.Findings, nil
+}
+
+// inCatalog reports whether the plugin's catalog declares the finding ID.
+func (v *Validator) inCatalog(id string) bool {
+	for _, c := range v.Checks() {
+		if c.Matches(id) {
+			return true
+		}
+	}
+	return false
+}
+
+// Close closes the connection to the plugin.
+func (v *Validator) Close() error {
+	return v.client.Close()
+}
+
+// ValidateName checks that a ValidatorPlugin's name can be used as a
+// validator name, which also prefixes the runner's synthetic finding IDs.
+func ValidateName(name string) error {
+	if errs := validation.IsDNS1123Label(name); len(errs) > 0 {
+		return fmt.Errorf("invalid validator name %q: %s", name, strings.Join(errs, ", "))
+	}
+	return nil
+}
+
+// IsReady reports whether the plugin was described successfully for its
+// current spec.
+func IsReady(plugin *assessmentv1alpha1.ValidatorPlugin) bool {
+	return plugin.Status.ObservedGeneration == plugin.Generation &&
+		meta.IsStatusConditionTrue(plugin.Status.Conditions, assessmentv1alpha1.ConditionReady)
+}
+
+// Load registers every ready ValidatorPlugin with the registry, using the
+// description recorded in its status. It is used by processes that run
+// assessments outside the operator, such as assessment Jobs. Plugins that
+// cannot be registered are reported in the returned error; the others are
+// still registered.
+func Load(ctx context.Context, c client.Reader, registry *validator.Registry) error {
+	list := &assessmentv1alpha1.ValidatorPluginList{}
+	if err := c.List(ctx, list); err != nil {
+		if validator.IsNotInstalled(err) {
+			return nil
+		}
+		return fmt.Errorf("failed to list ValidatorPlugins: %w", err)
+	}
+
+	var errs []error
+	for i := range list.Items {
+		plugin := &list.Items[i]
+		if !IsReady(plugin) {
+			continue
+		}
+		desc := Description{
+			Description: plugin.Status.Description,
+			Category:    plugin.Status.Category,
+			Checks:      plugin.Status.Checks,
+		}
+		if err := desc.ValidateFor(plugin.Name); err != nil {
+			errs = append(errs, fmt.Errorf("plugin %s: %w", plugin.Name, err))
+			continue
+		}
+		pc, err := Connect(plugin.Spec)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("plugin %s: %w", plugin.Name, err))
+			continue
+		}
+		v := NewValidator(plugin.Name, desc, pc)
+		if err := registry.Register(v); err != nil {
+			_ = v.Close()
+			errs = append(errs, fmt.Errorf("plugin %s: %w", plugin.Name, err))
+		}
+	}
+	return errors.Join(errs...)
+}
@@ -42,11 +42,40 @@ type Profile struct {
 	// Empty means all validators are enabled.
 	EnabledValidators []string `json:"enabledValidators,omitempty"`
 
+	// DisabledValidators lists validators to skip.
+	// Ignored if EnabledValidators is set.
+	DisabledValidators []string `json:"disabledValidators,omitempty"`
+
 	// DisabledChecks lists specific checks to skip.
 	DisabledChecks []string `json:"disabledChecks,omitempty"`
 
 	// Thresholds configures check-specific thresholds.
 	Thresholds ProfileThresholds `json:"thresholds"`
+
+	// Parameters holds per-validator parameter overrides, keyed by validator
+	// name and then parameter name. Unset parameters use the validator default.
+	Parameters map[string]map[string]string `json:"parameters,omitempty"`
+
+	// Scoring configures the scoring model used for the assessment score.
+	Scoring ScoringConfig `json:"scoring,omitempty"`
+}
+
+// ScoringConfig selects and tunes the scoring model.
+type ScoringConfig struct {
+	// Model is the scoring model name. Empty means the simple model.
+	Model string `json:"model,omitempty"`
+
+	// CategoryWeights sets the relative weight of each category.
+	CategoryWeights map[string]int `json:"categoryWeights,omitempty"`
+
+	// CheckCriticality sets the relative weight of individual checks by ID.
+	CheckCriticality map[string]int `json:"checkCriticality,omitempty"`
+
+	// SeverityWeights sets the weight of checks by finding severity.
+	SeverityWeights map[string]int `json:"severityWeights,omitempty"`
+
+	// ExcludeInfo leaves INFO findings out of the score.
+	ExcludeInfo bool `json:"excludeInfo,omitempty"`
 }
 
 // ProfileThresholds contains configurable thresholds for various checks.
@@ -19,6 +19,7 @@ package profiles
 import (
 	"context"
 	"fmt"
+	"maps"
 
 	assessmentv1alpha1 "github.com/openshift-assessment/cluster-assessment-operator/api/v1alpha1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -58,6 +59,12 @@ func (r *Resolver) Resolve(ctx context.Context, profileName string) (Profile, er
 	return mergeProfile(customProfile), nil
 }
 
+// FromAssessmentProfile returns the effective Profile for an AssessmentProfile
+// object without looking it up in the cluster, e.g. one loaded from a local file.
+func FromAssessmentProfile(custom *assessmentv1alpha1.AssessmentProfile) Profile {
+	return mergeProfile(custom)
+}
+
 // mergeProfile creates a Profile by starting with the base profile and applying
 // overrides from the custom AssessmentProfile. Nil pointer fields in ThresholdOverrides
 // are left at base profile defaults.
@@ -112,9 +119,31 @@ func mergeProfile(custom *assessmentv1alpha1.AssessmentProfile) Profile {
 	if len(custom.Spec.EnabledValidators) > 0 {
 		base.EnabledValidators = custom.Spec.EnabledValidators
 	}
+	if len(custom.Spec.DisabledValidators) > 0 {
+		base.DisabledValidators = append(base.DisabledValidators, custom.Spec.DisabledValidators...)
+	}
 	if len(custom.Spec.DisabledChecks) > 0 {
 		base.DisabledChecks = append(base.DisabledChecks, custom.Spec.DisabledChecks...)
 	}
 
+	// Merge validator parameters (the base profiles define none)
+	if len(custom.Spec.Parameters) > 0 {
+		base.Parameters = make(map[string]map[string]string, len(custom.Spec.Parameters))
+		for name, params := range custom.Spec.Parameters {
+			base.Parameters[name] = maps.Clone(map[string]string(params))
+		}
+	}
+
+	// Scoring configuration replaces the base (the base profiles use the default model)
+	if s := custom.Spec.Scoring; s != nil {
+		base.Scoring = ScoringConfig{
+			Model:            s.Model,
+			CategoryWeights:  maps.Clone(s.CategoryWeights),
+			CheckCriticality: maps.Clone(s.CheckCriticality),
+			SeverityWeights:  maps.Clone(s.SeverityWeights),
+			ExcludeInfo:      s.ExcludeInfo,
+		}
+	}
+
 	return base
 }
@@ -273,3 +273,70 @@ func TestMergeProfile_DisabledChecks(t *testing.T) {
 		t.Errorf("Expected 2 disabled checks, got %d", len(result.DisabledChecks))
 	}
 }
+
+func TestMergeProfile_DisabledValidators(t *testing.T) {
+	custom := &assessmentv1alpha1.AssessmentProfile{
+		ObjectMeta: metav1.ObjectMeta{Name: "skip-validators"},
+		Spec: assessmentv1alpha1.AssessmentProfileSpec{
+			BasedOn:            "production",
+			DisabledValidators: []string{"costoptimization", "logging"},
+		},
+	}
+
+	result := mergeProfile(custom)
+
+	if len(result.DisabledValidators) != 2 {
+		t.Fatalf("Expected 2 disabled validators, got %d", len(result.DisabledValidators))
+	}
+	if result.DisabledValidators[0] != "costoptimization" || result.DisabledValidators[1] != "logging" {
+		t.Errorf("Expected [costoptimization, logging], got %v", result.DisabledValidators)
+	}
+}
+
+func TestMergeProfile_Parameters(t *testing.T) {
+	custom := &assessmentv1alpha1.AssessmentProfile{
+		ObjectMeta: metav1.ObjectMeta{Name: "tuned"},
+		Spec: assessmentv1alpha1.AssessmentProfileSpec{
+			Parameters: map[string]assessmentv1alpha1.ValidatorParameters{
+				"certificates": {"expiryWarningDays": "14"},
+			},
+		},
+	}
+
+	result := mergeProfile(custom)
+
+	if got := result.Parameters["certificates"]["expiryWarningDays"]; got != "14" {
+		t.Errorf("Expected expiryWarningDays=14, got %q", got)
+	}
+
+	// The merged profile must not alias the CR's maps
+	custom.Spec.Parameters["certificates"]["expiryWarningDays"] = "7"
+	if got := result.Parameters["certificates"]["expiryWarningDays"]; got != "14" {
+		t.Errorf("Expected merged parameters to be a copy, got %q", got)
+	}
+	if base := GetProfile("production"); base.Parameters != nil {
+		t.Error("Expected built-in profile to be unmodified")
+	}
+}
+
+func TestMergeProfile_Scoring(t *testing.T) {
+	custom := &assessmentv1alpha1.AssessmentProfile{
+		ObjectMeta: metav1.ObjectMeta{Name: "weighted"},
+		Spec: assessmentv1alpha1.AssessmentProfileSpec{
+			Scoring: &assessmentv1alpha1.ScoringSpec{
+				Model:           "weighted",
+				CategoryWeights: map[string]int{"Security": 3},
+				ExcludeInfo:     true,
+			},
+		},
+	}
+
+	result := mergeProfile(custom)
+
+	if result.Scoring.Model != "weighted" || !result.Scoring.ExcludeInfo {
+		t.Errorf("Expected weighted model excluding INFO, got %+v", result.Scoring)
+	}
+	if result.Scoring.CategoryWeights["Security"] != 3 {
+		t.Errorf("Expected Security weight 3, got %v", result.Scoring.CategoryWeights)
+	}
+}
@@ -45,6 +45,9 @@ type Report struct {
 
 	// FindingsByStatus groups findings by status
 	FindingsByStatus map[string][]assessmentv1alpha1.Finding `json:"findingsByStatus" yaml:"findingsByStatus"`
+
+	// Validators reports how each validator ran
+	Validators []assessmentv1alpha1.ValidatorRunStatus `json:"validators,omitempty" yaml:"validators,omitempty"`
 }
 
 // ReportMetadata contains report metadata.
@@ -88,6 +91,7 @@ func buildReport(assessment *assessmentv1alpha1.ClusterAssessment) Report {
 		Findings:           assessment.Status.Findings,
 		FindingsByCategory: make(map[string][]assessmentv1alpha1.Finding),
 		FindingsByStatus:   make(map[string][]assessmentv1alpha1.Finding),
+		Validators:         assessment.Status.Validators,
 	}
 
 	// Group findings by category
@@ -1,6 +1,7 @@
 package report
 
 import (
+	"strings"
 	"testing"
 
 	assessmentv1alpha1 "github.com/openshift-assessment/cluster-assessment-operator/api/v1alpha1"
@@ -32,3 +33,141 @@ func TestBuildReportPopulatesVersion(t *testing.T) {
 		t.Errorf("Expected OperatorVersion to be %q, got %q", testVersion, report.Metadata.OperatorVersion)
 	}
 }
+
+func TestGenerateHTMLIncludesSeverity(t *testing.T) {
+	assessment := &assessmentv1alpha1.ClusterAssessment{
+		Status: assessmentv1alpha1.ClusterAssessmentStatus{
+			Findings: []assessmentv1alpha1.Finding{
+				{ID: "etcd-degraded", Title: "etcd Degraded", Status: assessmentv1alpha1.FindingStatusFail, Severity: assessmentv1alpha1.SeverityCritical},
+				{ID: "version-channel", Title: "Channel", Status: assessmentv1alpha1.FindingStatusWarn, Severity: assessmentv1alpha1.SeverityLow},
+				{ID: "nodes-ready", Title: "Nodes Ready", Status: assessmentv1alpha1.FindingStatusPass, Severity: assessmentv1alpha1.SeverityCritical},
+			},
+		},
+	}
+
+	out, err := GenerateHTML(assessment)
+	if err != nil {
+		t.Fatalf("GenerateHTML failed: %v", err)
+	}
+	html := string(out)
+
+	if !strings.Contains(html, "Severity: critical | Category:") {
+		t.Error("Expected finding severity in finding metadata")
+	}
+	// PASS findings are not open issues and are left out of the breakdown
+	if !strings.Contains(html, "Open Findings by Severity: critical 1, high 0, medium 0, low 1") {
+		t.Error("Expected severity breakdown in summary")
+	}
+}
+
+func TestGenerateHTMLIncludesValidatorExecution(t *testing.T) {
+	assessment := &assessmentv1alpha1.ClusterAssessment{
+		Status: assessmentv1alpha1.ClusterAssessmentStatus{
+			Summary: assessmentv1alpha1.AssessmentSummary{UnscoredValidators: []string{"etcdbackup"}},
+			Validators: []assessmentv1alpha1.ValidatorRunStatus{
+				{Name: "etcdbackup", Outcome: assessmentv1alpha1.ValidatorFailed, Error: "<forbidden>"},
+				{Name: "security", Outcome: assessmentv1alpha1.ValidatorSucceeded, PassCount: 3, FailCount: 1},
+			},
+		},
+	}
+
+	out, err := GenerateHTML(assessment)
+	if err != nil {
+		t.Fatalf("GenerateHTML failed: %v", err)
+	}
+	html := string(out)
+
+	if !strings.Contains(html, "Not scored (validators could not run): etcdbackup") {
+		t.Error("Expected unscored validators in summary")
+	}
+	if !strings.Contains(html, "<td>security</td><td>Succeeded</td><td>0s</td><td>3</td><td>0</td><td>1</td><td>0</td>") {
+		t.Error("Expected validator execution table")
+	}
+	if !strings.Contains(html, "&lt;forbidden&gt;") {
+		t.Error("Expected escaped validator error")
+	}
+
+	if got := buildReport(assessment).Validators; len(got) != 2 {
+		t.Errorf("Expected validator statuses in the report, got %+v", got)
+	}
+}
+
+func TestGenerateHTMLIncludesSkippedValidators(t *testing.T) {
+	assessment := &assessmentv1alpha1.ClusterAssessment{
+		Status: assessmentv1alpha1.ClusterAssessmentStatus{
+			Findings: []assessmentv1alpha1.Finding{{
+				ID: "certificates-skipped", Validator: "certificates", Status: assessmentv1alpha1.FindingStatusSkipped,
+				Title: "Validator certificates skipped for missing permissions", Severity: assessmentv1alpha1.SeverityMedium,
+			}},
+			Validators: []assessmentv1alpha1.ValidatorRunStatus{{
+				Name:               "certificates",
+				Outcome:            assessmentv1alpha1.ValidatorSkipped,
+				Error:              "missing permissions: list secrets in openshift-ingress",
+				MissingPermissions: []string{"list secrets in openshift-ingress"},
+			}},
+		},
+	}
+
+	out, err := GenerateHTML(assessment)
+	if err != nil {
+		t.Fatalf("GenerateHTML failed: %v", err)
+	}
+	html := string(out)
+
+	if !strings.Contains(html, `<div class="finding status-SKIPPED">`) {
+		t.Error("Expected the SKIPPED finding in the detailed findings")
+	}
+	if !strings.Contains(html, "missing permissions: list secrets in openshift-ingress") {
+		t.Error("Expected the missing permissions in the validator table")
+	}
+	if !strings.Contains(html, "Open Findings by Severity: critical 0, high 0, medium 0, low 0") {
+		t.Error("Expected SKIPPED findings to be left out of the open findings")
+	}
+}
+
+func TestGenerateReportsSeparateInconclusiveFindings(t *testing.T) {
+	assessment := &assessmentv1alpha1.ClusterAssessment{
+		Status: assessmentv1alpha1.ClusterAssessmentStatus{
+			Summary: assessmentv1alpha1.AssessmentSummary{TotalChecks: 2, NotApplicableCount: 1, ErrorCount: 1},
+			Findings: []assessmentv1alpha1.Finding{
+				{
+					ID: "oadpbackup-not-installed", Validator: "oadpbackup", Status: assessmentv1alpha1.FindingStatusNotApplicable,
+					Title: "OADP Not Installed", Severity: assessmentv1alpha1.SeverityLow,
+				},
+				{
+					ID: "etcdbackup-inconclusive", Validator: "etcdbackup", Status: assessmentv1alpha1.FindingStatusError,
+					Title: "Backup Configuration Could Not Be Determined", Severity: assessmentv1alpha1.SeverityHigh,
+				},
+			},
+			Validators: []assessmentv1alpha1.ValidatorRunStatus{
+				{Name: "etcdbackup", Outcome: assessmentv1alpha1.ValidatorSucceeded, FindingCount: 1, ErrorCount: 1},
+				{Name: "oadpbackup", Outcome: assessmentv1alpha1.ValidatorSucceeded, FindingCount: 1, NotApplicableCount: 1},
+			},
+		},
+	}
+
+	out, err := GenerateHTML(assessment)
+	if err != nil {
+		t.Fatalf("GenerateHTML failed: %v", err)
+	}
+	html := string(out)
+
+	for _, want := range []string{
+		`<div class="summary-box na"><div class="count">1</div><div class="label">N/A</div></div>`,
+		`<div class="summary-box error"><div class="count">1</div><div class="label">ERROR</div></div>`,
+		`<div class="finding status-NOT_APPLICABLE">`,
+		`<div class="finding status-ERROR">`,
+		"Open Findings by Severity: critical 0, high 1, medium 0, low 0",
+	} {
+		if !strings.Contains(html, want) {
+			t.Errorf("Expected HTML report to contain %q", want)
+		}
+	}
+	if strings.Index(html, `class="finding status-ERROR"`) > strings.Index(html, `class="finding status-NOT_APPLICABLE"`) {
+		t.Error("Expected ERROR findings to be listed before NOT_APPLICABLE ones")
+	}
+
+	if _, err := GeneratePDF(assessment); err != nil {
+		t.Fatalf("GeneratePDF failed: %v", err)
+	}
+}
@@ -31,10 +31,13 @@ import (
 
 // Colors for status badges
 var (
-	colorPass = []int{34, 139, 34}  // Forest Green
-	colorWarn = []int{255, 165, 0}  // Orange
-	colorFail = []int{220, 20, 60}  // Crimson
-	colorInfo = []int{70, 130, 180} // Steel Blue
+	colorPass    = []int{34, 139, 34}   // Forest Green
+	colorWarn    = []int{255, 165, 0}   // Orange
+	colorFail    = []int{220, 20, 60}   // Crimson
+	colorInfo    = []int{70, 130, 180}  // Steel Blue
+	colorSkipped = []int{128, 128, 128} // Gray
+	colorNA      = []int{169, 169, 169} // Dark Gray
+	colorError   = []int{138, 43, 226}  // Blue Violet
 )
 
 // colorForStatus returns the color palette for a given FindingStatus.
@@ -48,6 +51,12 @@ func colorForStatus(status assessmentv1alpha1.FindingStatus) []int {
 		return colorFail
 	case assessmentv1alpha1.FindingStatusInfo:
 		return colorInfo
+	case assessmentv1alpha1.FindingStatusSkipped:
+		return colorSkipped
+	case assessmentv1alpha1.FindingStatusNotApplicable:
+		return colorNA
+	case assessmentv1alpha1.FindingStatusError:
+		return colorError
 	default:
 		return colorInfo
 	}
@@ -64,6 +73,8 @@ func labelForStatus(status assessmentv1alpha1.FindingStatus) string {
 		return "FAILED"
 	case assessmentv1alpha1.FindingStatusInfo:
 		return "INFO"
+	case assessmentv1alpha1.FindingStatusNotApplicable:
+		return "NOT APPLICABLE"
 	default:
 		return string(status)
 	}
@@ -120,6 +131,13 @@ func GeneratePDF(assessment *assessmentv1alpha1.ClusterAssessment) ([]byte, erro
 		pdf.Ln(10)
 	}
 
+	// Validator execution table
+	if len(assessment.Status.Validators) > 0 {
+		addSectionTitle(pdf, "Validator Execution")
+		addValidatorTable(pdf, assessment.Status.Validators)
+		pdf.Ln(10)
+	}
+
 	// Findings by Category (horizontal bar chart)
 	addSectionTitle(pdf, "Findings by Category")
 	addCategoryBarChart(pdf, assessment)
@@ -218,8 +236,8 @@ func addCoverPage(pdf *gofpdf.Fpdf, assessment *assessmentv1alpha1.ClusterAssess
 	// Summary counts on cover
 	summary := assessment.Status.Summary
 	pdf.SetY(pdf.GetY() + 5)
-	boxWidth := 35.0
-	totalWidth := boxWidth*4 + 5*3
+	boxWidth := 21.0
+	totalWidth := boxWidth*7 + 5*6
 	startX := (210 - totalWidth) / 2
 	y := pdf.GetY()
 
@@ -232,6 +250,9 @@ func addCoverPage(pdf *gofpdf.Fpdf, assessment *assessmentv1alpha1.ClusterAssess
 		{"WARN", summary.WarnCount, colorWarn},
 		{"FAIL", summary.FailCount, colorFail},
 		{"INFO", summary.InfoCount, colorInfo},
+		{"N/A", summary.NotApplicableCount, colorNA},
+		{"ERROR", summary.ErrorCount, colorError},
+		{"SKIPPED", summary.SkippedCount, colorSkipped},
 	}
 
 	for i, item := range summaryItems {
@@ -310,7 +331,7 @@ func addSummarySection(pdf *gofpdf.Fpdf, assessment *assessmentv1alpha1.ClusterA
 	summary := assessment.Status.Summary
 
 	// Summary boxes
-	boxWidth := 40.0
+	boxWidth := 21.0
 	boxHeight := 20.0
 	startX := leftMargin
 	y := pdf.GetY()
@@ -324,6 +345,9 @@ func addSummarySection(pdf *gofpdf.Fpdf, assessment *assessmentv1alpha1.ClusterA
 		{"WARN", summary.WarnCount, colorWarn},
 		{"FAIL", summary.FailCount, colorFail},
 		{"INFO", summary.InfoCount, colorInfo},
+		{"N/A", summary.NotApplicableCount, colorNA},
+		{"ERROR", summary.ErrorCount, colorError},
+		{"SKIPPED", summary.SkippedCount, colorSkipped},
 	}
 
 	for i, item := range summaryItems {
@@ -351,6 +375,71 @@ func addSummarySection(pdf *gofpdf.Fpdf, assessment *assessmentv1alpha1.ClusterA
 	// Total checks
 	pdf.SetFont("Helvetica", "", 10)
 	pdf.CellFormat(0, 6, fmt.Sprintf("Total Checks: %d", summary.TotalChecks), "", 1, "L", false, 0, "")
+	pdf.CellFormat(0, 6, "Open Findings by Severity: "+severityBreakdown(assessment.Status.Findings), "", 1, "L", false, 0, "")
+	if len(summary.UnscoredValidators) > 0 {
+		pdf.SetTextColor(colorWarn[0], colorWarn[1], colorWarn[2])
+		pdf.MultiCell(0, 6, "Not scored (validators could not run): "+strings.Join(summary.UnscoredValidators, ", "), "", "L", false)
+		pdf.SetTextColor(0, 0, 0)
+	}
+}
+
+// addValidatorTable lists the outcome, duration and finding counts of each validator.
+func addValidatorTable(pdf *gofpdf.Fpdf, validators []assessmentv1alpha1.ValidatorRunStatus) {
+	widths := []float64{40, 22, 20, 14, 14, 14, 14, 14, 14, 14}
+	headers := []string{"Validator", "Outcome", "Duration", "PASS", "WARN", "FAIL", "INFO", "N/A", "ERROR", "SKIPPED"}
+	rowHeight := 7.0
+
+	pdf.SetFont("Helvetica", "B", 9)
+	pdf.SetTextColor(0, 0, 0)
+	for i, h := range headers {
+		pdf.CellFormat(widths[i], rowHeight, h, "B", 0, "L", false, 0, "")
+	}
+	pdf.Ln(rowHeight)
+
+	pdf.SetFont("Helvetica", "", 9)
+	for _, v := range validators {
+		if v.Outcome != "" && v.Outcome != assessmentv1alpha1.ValidatorSucceeded {
+			pdf.SetTextColor(colorFail[0], colorFail[1], colorFail[2])
+		}
+		cells := []string{
+			v.Name,
+			string(v.Outcome),
+			v.Duration.Round(time.Millisecond).String(),
+			fmt.Sprintf("%d", v.PassCount),
+			fmt.Sprintf("%d", v.WarnCount),
+			fmt.Sprintf("%d", v.FailCount),
+			fmt.Sprintf("%d", v.InfoCount),
+			fmt.Sprintf("%d", v.NotApplicableCount),
+			fmt.Sprintf("%d", v.ErrorCount),
+			fmt.Sprintf("%d", v.SkippedCount),
+		}
+		for i, c := range cells {
+			pdf.CellFormat(widths[i], rowHeight, c, "", 0, "L", false, 0, "")
+		}
+		pdf.Ln(rowHeight)
+		if len(v.MissingPermissions) > 0 {
+			pdf.SetFont("Helvetica", "I", 8)
+			pdf.MultiCell(0, 5, "Missing permissions: "+strings.Join(v.MissingPermissions, ", "), "", "L", false)
+			pdf.SetFont("Helvetica", "", 9)
+		}
+		pdf.SetTextColor(0, 0, 0)
+	}
+}
+
+// severityBreakdown summarizes the open findings, those that are not PASS,
+// SKIPPED or NOT_APPLICABLE, by severity, most severe first.
+func severityBreakdown(findings []assessmentv1alpha1.Finding) string {
+	counts := make(map[assessmentv1alpha1.FindingSeverity]int)
+	for _, f := range findings {
+		switch f.Status {
+		case assessmentv1alpha1.FindingStatusPass, assessmentv1alpha1.FindingStatusSkipped, assessmentv1alpha1.FindingStatusNotApplicable:
+		default:
+			counts[f.Severity]++
+		}
+	}
+	return fmt.Sprintf("critical %d, high %d, medium %d, low %d",
+		counts[assessmentv1alpha1.SeverityCritical], counts[assessmentv1alpha1.SeverityHigh],
+		counts[assessmentv1alpha1.SeverityMedium], counts[assessmentv1alpha1.SeverityLow])
 }
 
 func addScoreVisualization(pdf *gofpdf.Fpdf, score int) {
@@ -489,6 +578,7 @@ func addCategoryBarChart(pdf *gofpdf.Fpdf, assessment *assessmentv1alpha1.Cluste
 	// Group findings by category
 	type categoryCounts struct {
 		pass, warn, fail, info int
+		notApplicable, errors  int
 		total                  int
 	}
 	categories := make(map[string]*categoryCounts)
@@ -508,6 +598,10 @@ func addCategoryBarChart(pdf *gofpdf.Fpdf, assessment *assessmentv1alpha1.Cluste
 			c.fail++
 		case assessmentv1alpha1.FindingStatusInfo:
 			c.info++
+		case assessmentv1alpha1.FindingStatusNotApplicable:
+			c.notApplicable++
+		case assessmentv1alpha1.FindingStatusError:
+			c.errors++
 		}
 	}
 
@@ -567,8 +661,10 @@ func addCategoryBarChart(pdf *gofpdf.Fpdf, assessment *assessmentv1alpha1.Cluste
 		}{
 			{c.fail, colorFail},
 			{c.warn, colorWarn},
+			{c.errors, colorError},
 			{c.info, colorInfo},
 			{c.pass, colorPass},
+			{c.notApplicable, colorNA},
 		}
 
 		currentX := barX
@@ -594,7 +690,7 @@ func addCategoryBarChart(pdf *gofpdf.Fpdf, assessment *assessmentv1alpha1.Cluste
 		pdf.SetY(y + rowHeight + 1)
 	}
 
-	// Legend — manual page check to keep all 4 items on one line
+	// Legend — manual page check to keep all items on one line
 	pdf.Ln(3)
 	if pdf.GetY()+10 > 280 {
 		pdf.AddPage()
@@ -606,8 +702,10 @@ func addCategoryBarChart(pdf *gofpdf.Fpdf, assessment *assessmentv1alpha1.Cluste
 	}{
 		{"Fail", colorFail},
 		{"Warn", colorWarn},
+		{"Error", colorError},
 		{"Info", colorInfo},
 		{"Pass", colorPass},
+		{"N/A", colorNA},
 	}
 	legendX := leftMargin + labelWidth + 3
 	for _, item := range legendItems {
@@ -616,8 +714,8 @@ func addCategoryBarChart(pdf *gofpdf.Fpdf, assessment *assessmentv1alpha1.Cluste
 		pdf.SetFont("Helvetica", "", 7)
 		pdf.SetTextColor(80, 80, 80)
 		pdf.SetXY(legendX+7, legendY)
-		pdf.CellFormat(20, 6, item.label, "", 0, "L", false, 0, "")
-		legendX += 28
+		pdf.CellFormat(13, 6, item.label, "", 0, "L", false, 0, "")
+		legendX += 20
 	}
 	pdf.SetY(legendY + 8)
 
@@ -630,8 +728,11 @@ func addDetailedFindings(pdf *gofpdf.Fpdf, assessment *assessmentv1alpha1.Cluste
 	statusOrder := []assessmentv1alpha1.FindingStatus{
 		assessmentv1alpha1.FindingStatusFail,
 		assessmentv1alpha1.FindingStatusWarn,
+		assessmentv1alpha1.FindingStatusError,
 		assessmentv1alpha1.FindingStatusInfo,
 		assessmentv1alpha1.FindingStatusPass,
+		assessmentv1alpha1.FindingStatusSkipped,
+		assessmentv1alpha1.FindingStatusNotApplicable,
 	}
 
 	// Group findings by status in a single pass
@@ -779,7 +880,11 @@ func addFindingCard(pdf *gofpdf.Fpdf, f assessmentv1alpha1.Finding) {
 	pdf.SetXY(leftMargin+13, currentY)
 	pdf.SetFont("Helvetica", "", 7)
 	pdf.SetTextColor(120, 120, 120)
-	pdf.CellFormat(0, 4, fmt.Sprintf("Category: %s  |  Validator: %s", f.Category, f.Validator), "", 1, "L", false, 0, "")
+	meta := fmt.Sprintf("Category: %s  |  Validator: %s", f.Category, f.Validator)
+	if f.Severity != "" {
+		meta = fmt.Sprintf("Severity: %s  |  %s", f.Severity, meta)
+	}
+	pdf.CellFormat(0, 4, meta, "", 1, "L", false, 0, "")
 	currentY += 5
 
 	// Impact (if present)
@@ -960,6 +1065,9 @@ func GenerateHTML(assessment *assessmentv1alpha1.ClusterAssessment) ([]byte, err
         .warn { background: #FFA500; }
         .fail { background: #DC143C; }
         .info { background: #4682B4; }
+        .na { background: #A9A9A9; }
+        .error { background: #8A2BE2; }
+        .skipped { background: #808080; }
         .count { font-size: 24px; font-weight: bold; }
         .label { font-size: 12px; }
         .finding { background: #f8f8fa; padding: 15px; margin: 10px 0; border-radius: 5px; border-left: 4px solid #ccc; }
@@ -967,6 +1075,9 @@ func GenerateHTML(assessment *assessmentv1alpha1.ClusterAssessment) ([]byte, err
         .finding.status-WARN { border-left-color: #FFA500; }
         .finding.status-PASS { border-left-color: #228B22; }
         .finding.status-INFO { border-left-color: #4682B4; }
+        .finding.status-SKIPPED { border-left-color: #808080; }
+        .finding.status-NOT_APPLICABLE { border-left-color: #A9A9A9; }
+        .finding.status-ERROR { border-left-color: #8A2BE2; }
         .finding-title { font-weight: bold; margin-bottom: 5px; }
         .finding-desc { color: #555; margin-bottom: 5px; }
         .finding-meta { font-size: 11px; color: #888; }
@@ -1034,8 +1145,15 @@ func GenerateHTML(assessment *assessmentv1alpha1.ClusterAssessment) ([]byte, err
 	buf.WriteString(fmt.Sprintf(`<div class="summary-box warn"><div class="count">%d</div><div class="label">WARN</div></div>`, summary.WarnCount))
 	buf.WriteString(fmt.Sprintf(`<div class="summary-box fail"><div class="count">%d</div><div class="label">FAIL</div></div>`, summary.FailCount))
 	buf.WriteString(fmt.Sprintf(`<div class="summary-box info"><div class="count">%d</div><div class="label">INFO</div></div>`, summary.InfoCount))
+	buf.WriteString(fmt.Sprintf(`<div class="summary-box na"><div class="count">%d</div><div class="label">N/A</div></div>`, summary.NotApplicableCount))
+	buf.WriteString(fmt.Sprintf(`<div class="summary-box error"><div class="count">%d</div><div class="label">ERROR</div></div>`, summary.ErrorCount))
+	buf.WriteString(fmt.Sprintf(`<div class="summary-box skipped"><div class="count">%d</div><div class="label">SKIPPED</div></div>`, summary.SkippedCount))
 	buf.WriteString(`</div>`)
 	buf.WriteString(fmt.Sprintf(`<p>Total Checks: %d</p>`, summary.TotalChecks))
+	buf.WriteString(fmt.Sprintf(`<p>Open Findings by Severity: %s</p>`, severityBreakdown(assessment.Status.Findings)))
+	if len(summary.UnscoredValidators) > 0 {
+		buf.WriteString(fmt.Sprintf(`<p>Not scored (validators could not run): %s</p>`, html.EscapeString(strings.Join(summary.UnscoredValidators, ", "))))
+	}
 
 	// Score bar
 	if summary.Score != nil {
@@ -1048,6 +1166,29 @@ func GenerateHTML(assessment *assessmentv1alpha1.ClusterAssessment) ([]byte, err
 		buf.WriteString(fmt.Sprintf(`<div class="score-bar"><div class="score-fill" style="width: %d%%; background: %s;">%d%%</div></div>`, *summary.Score, scoreColor, *summary.Score))
 	}
 
+	// Category sub-scores
+	if len(summary.CategoryScores) > 0 {
+		buf.WriteString(`<h3>Category Scores</h3><table><tr><th>Category</th><th>Score</th><th>Scored Checks</th></tr>`)
+		for _, c := range summary.CategoryScores {
+			buf.WriteString(fmt.Sprintf(`<tr><td>%s</td><td>%d</td><td>%d</td></tr>`, html.EscapeString(c.Category), c.Score, c.ScoredChecks))
+		}
+		buf.WriteString(`</table>`)
+		if summary.ScoringModel != "" {
+			buf.WriteString(fmt.Sprintf(`<p>Scoring model: %s (v%s)</p>`, html.EscapeString(summary.ScoringModel), html.EscapeString(summary.ScoringModelVersion)))
+		}
+	}
+
+	// Validator execution
+	if len(assessment.Status.Validators) > 0 {
+		buf.WriteString(`<h3>Validator Execution</h3><table><tr><th>Validator</th><th>Outcome</th><th>Duration</th><th>PASS</th><th>WARN</th><th>FAIL</th><th>INFO</th><th>N/A</th><th>ERROR</th><th>SKIPPED</th><th>Error</th></tr>`)
+		for _, v := range assessment.Status.Validators {
+			buf.WriteString(fmt.Sprintf(`<tr><td>%s</td><td>%s</td><td>%s</td><td>%d</td><td>%d</td><td>%d</td><td>%d</td><td>%d</td><td>%d</td><td>%d</td><td>%s</td></tr>`,
+				html.EscapeString(v.Name), html.EscapeString(string(v.Outcome)), v.Duration.Round(time.Millisecond),
+				v.PassCount, v.WarnCount, v.FailCount, v.InfoCount, v.NotApplicableCount, v.ErrorCount, v.SkippedCount, html.EscapeString(v.Error)))
+		}
+		buf.WriteString(`</table>`)
+	}
+
 	// Delta section in HTML
 	if assessment.Status.Delta != nil {
 		delta := assessment.Status.Delta
@@ -1072,8 +1213,11 @@ func GenerateHTML(assessment *assessmentv1alpha1.ClusterAssessment) ([]byte, err
 	statusOrder := []assessmentv1alpha1.FindingStatus{
 		assessmentv1alpha1.FindingStatusFail,
 		assessmentv1alpha1.FindingStatusWarn,
+		assessmentv1alpha1.FindingStatusError,
 		assessmentv1alpha1.FindingStatusInfo,
 		assessmentv1alpha1.FindingStatusPass,
+		assessmentv1alpha1.FindingStatusSkipped,
+		assessmentv1alpha1.FindingStatusNotApplicable,
 	}
 
 	// Group findings by status
@@ -1097,7 +1241,11 @@ func GenerateHTML(assessment *assessmentv1alpha1.ClusterAssessment) ([]byte, err
 				buf.WriteString(fmt.Sprintf(`<div class="finding-meta">Resource: %s</div>`, html.EscapeString(resourceStr)))
 			}
 
-			buf.WriteString(fmt.Sprintf(`<div class="finding-meta">Category: %s | Validator: %s</div>`, html.EscapeString(f.Category), html.EscapeString(f.Validator)))
+			severity := ""
+			if f.Severity != "" {
+				severity = fmt.Sprintf("Severity: %s | ", html.EscapeString(string(f.Severity)))
+			}
+			buf.WriteString(fmt.Sprintf(`<div class="finding-meta">%sCategory: %s | Validator: %s</div>`, severity, html.EscapeString(f.Category), html.EscapeString(f.Validator)))
 
 			// Impact
 			if f.Impact != "" {
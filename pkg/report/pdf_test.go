@@ -23,12 +23,17 @@ func TestGeneratePDFWithManyFindings(t *testing.T) {
 				NodeCount:      6,
 			},
 			Summary: assessmentv1alpha1.AssessmentSummary{
-				Score:       &score,
-				TotalChecks: 60,
-				PassCount:   40,
-				WarnCount:   10,
-				FailCount:   8,
-				InfoCount:   2,
+				Score:              &score,
+				TotalChecks:        60,
+				PassCount:          40,
+				WarnCount:          10,
+				FailCount:          8,
+				InfoCount:          2,
+				UnscoredValidators: []string{"nodes"},
+			},
+			Validators: []assessmentv1alpha1.ValidatorRunStatus{
+				{Name: "nodes", Outcome: assessmentv1alpha1.ValidatorTimedOut, Error: "timed out after 2m0s"},
+				{Name: "security", Outcome: assessmentv1alpha1.ValidatorSucceeded, PassCount: 1, FailCount: 1},
 			},
 			Findings: []assessmentv1alpha1.Finding{
 				{Title: "Security Finding 1", Description: "A security issue found", Category: "Security", Validator: "security", Status: assessmentv1alpha1.FindingStatusFail, Recommendation: "Fix this"},
@@ -0,0 +1,262 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package schedule decides when a scheduled ClusterAssessment runs, applying
+// its time zone, jitter, starting deadline and blackout windows.
+package schedule
+
+import (
+	"fmt"
+	"hash/fnv"
+	"strconv"
+	"strings"
+	"time"
+
+	// Time zones are resolved without relying on the image's zoneinfo files
+	_ "time/tzdata"
+
+	"github.com/robfig/cron/v3"
+
+	assessmentv1alpha1 "github.com/openshift-assessment/cluster-assessment-operator/api/v1alpha1"
+)
+
+// maxMissedActivations bounds the search for the latest missed activation
+// after a long outage of a frequent schedule.
+const maxMissedActivations = 1000
+
+// SkipReason explains why a scheduled run did not start.
+type SkipReason string
+
+const (
+	// SkipMissedDeadline is a run that could not start within the starting deadline.
+	SkipMissedDeadline SkipReason = "MissedDeadline"
+
+	// SkipBlackout is a run that fell into a blackout window.
+	SkipBlackout SkipReason = "BlackoutWindow"
+
+	// SkipConcurrency is a run that was due while the previous run was still
+	// executing under the Forbid concurrency policy.
+	SkipConcurrency SkipReason = "ConcurrencyForbidden"
+)
+
+// Decision is the outcome of evaluating a schedule at a point in time.
+type Decision struct {
+	// Due is true when a run should start now.
+	Due bool
+
+	// ScheduledTime is the activation that was due or skipped. It is recorded
+	// as the assessment's last schedule time. Zero when nothing was due.
+	ScheduledTime time.Time
+
+	// Skipped is set when the activation was due but must not run.
+	Skipped SkipReason
+
+	// Message describes why the activation was skipped.
+	Message string
+
+	// NextRunTime is when the next activation is due, including jitter. Zero
+	// when the schedule never fires again.
+	NextRunTime time.Time
+}
+
+// Schedule computes the run times of a scheduled assessment.
+type Schedule struct {
+	name             string
+	cron             cron.Schedule
+	location         *time.Location
+	jitter           time.Duration
+	startingDeadline time.Duration
+	blackouts        []blackout
+}
+
+// blackout is a parsed BlackoutWindow.
+type blackout struct {
+	name       string
+	cron       cron.Schedule
+	duration   time.Duration
+	start, end time.Time
+}
+
+// New parses the schedule, time zone, jitter and blackout windows of the
+// assessment.
+func New(assessment *assessmentv1alpha1.ClusterAssessment) (*Schedule, error) {
+	spec := assessment.Spec
+	s := &Schedule{name: assessment.Name, location: time.Local}
+
+	if spec.TimeZone != "" {
+		if hasTimeZonePrefix(spec.Schedule) {
+			return nil, fmt.Errorf("schedule must not set CRON_TZ or TZ when timeZone is set")
+		}
+		loc, err := time.LoadLocation(spec.TimeZone)
+		if err != nil {
+			return nil, fmt.Errorf("invalid time zone %q: %w", spec.TimeZone, err)
+		}
+		s.location = loc
+	}
+
+	sched, err := cron.ParseStandard(spec.Schedule)
+	if err != nil {
+		return nil, fmt.Errorf("invalid cron schedule: %w", err)
+	}
+	s.cron = sched
+
+	if spec.Jitter != nil {
+		if spec.Jitter.Duration < 0 {
+			return nil, fmt.Errorf("jitter must not be negative")
+		}
+		s.jitter = spec.Jitter.Duration
+	}
+	if spec.StartingDeadlineSeconds != nil {
+		s.startingDeadline = time.Duration(*spec.StartingDeadlineSeconds) * time.Second
+	}
+
+	for i, w := range spec.BlackoutWindows {
+		b := blackout{name: w.Name}
+		if b.name == "" {
+			b.name = fmt.Sprintf("blackoutWindows[%d]", i)
+		}
+		switch {
+		case w.Schedule != "":
+			if w.Duration == nil || w.Duration.Duration <= 0 {
+				return nil, fmt.Errorf("blackout window %s: a recurring window needs a positive duration", b.name)
+			}
+			if b.cron, err = cron.ParseStandard(w.Schedule); err != nil {
+				return nil, fmt.Errorf("blackout window %s: invalid cron schedule: %w", b.name, err)
+			}
+			b.duration = w.Duration.Duration
+		case w.Start != nil && w.End != nil:
+			if !w.End.After(w.Start.Time) {
+				return nil, fmt.Errorf("blackout window %s: end must be after start", b.name)
+			}
+			b.start, b.end = w.Start.Time, w.End.Time
+		default:
+			return nil, fmt.Errorf("blackout window %s: set either schedule and duration, or start and end", b.name)
+		}
+		s.blackouts = append(s.blackouts, b)
+	}
+
+	return s, nil
+}
+
+// hasTimeZonePrefix reports whether a cron expression selects its own time zone.
+func hasTimeZonePrefix(expr string) bool {
+	expr = strings.TrimSpace(expr)
+	return strings.HasPrefix(expr, "CRON_TZ=") || strings.HasPrefix(expr, "TZ=")
+}
+
+// Evaluate decides whether a run is due at now, given the last scheduled time.
+// A zero last time means the assessment never ran, and its first run starts
+// immediately. Activations missed while the operator was down collapse into
+// the latest one, which runs unless it is past the starting deadline.
+func (s *Schedule) Evaluate(last, now time.Time) Decision {
+	if last.IsZero() {
+		d := Decision{ScheduledTime: now, NextRunTime: s.nextRunTime(now)}
+		if window := s.blackout(now); window != "" {
+			d.Skipped = SkipBlackout
+			d.Message = fmt.Sprintf("Skipped initial run: blackout window %s is active", window)
+		} else {
+			d.Due = true
+		}
+		return d
+	}
+
+	latest := s.latestActivation(last, now)
+	if latest.IsZero() {
+		return Decision{NextRunTime: s.nextRunTime(last)}
+	}
+
+	d := Decision{ScheduledTime: latest, NextRunTime: s.nextRunTime(latest)}
+	runTime := s.RunTime(latest)
+	if window := s.blackout(runTime); window != "" {
+		d.Skipped = SkipBlackout
+		d.Message = fmt.Sprintf("Skipped run scheduled for %s: blackout window %s is active",
+			latest.Format(time.RFC3339), window)
+	} else if s.startingDeadline > 0 && now.Sub(runTime) > s.startingDeadline {
+		d.Skipped = SkipMissedDeadline
+		d.Message = fmt.Sprintf("Skipped run scheduled for %s: missed the starting deadline of %s",
+			latest.Format(time.RFC3339), s.startingDeadline)
+	} else {
+		d.Due = true
+	}
+	return d
+}
+
+// RunTime returns when the run for an activation starts, delaying it by the
+// assessment's jitter. The delay is derived from the assessment name and the
+// activation, so it does not change between reconciles.
+func (s *Schedule) RunTime(activation time.Time) time.Time {
+	if s.jitter <= 0 || activation.IsZero() {
+		return activation
+	}
+	h := fnv.New64a()
+	_, _ = h.Write([]byte(s.name))
+	_, _ = h.Write([]byte(strconv.FormatInt(activation.Unix(), 10)))
+	return activation.Add(time.Duration(h.Sum64() % uint64(s.jitter)))
+}
+
+// next returns the first activation after t in the schedule's time zone.
+func (s *Schedule) next(t time.Time) time.Time {
+	return s.cron.Next(t.In(s.location))
+}
+
+// nextRunTime returns the run time of the first activation after t.
+func (s *Schedule) nextRunTime(t time.Time) time.Time {
+	return s.RunTime(s.next(t))
+}
+
+// latestActivation returns the latest activation after last whose run time
+// is not after now, or zero if there is none.
+func (s *Schedule) latestActivation(last, now time.Time) time.Time {
+	var latest time.Time
+	t := s.next(last)
+	resumed := false
+	for i := 0; !t.IsZero() && !s.RunTime(t).After(now); i++ {
+		if i == maxMissedActivations {
+			if resumed {
+				break
+			}
+			// Resume the search shortly before now instead of walking every
+			// activation of a long outage
+			gap := t.Sub(latest)
+			t = s.next(now.Add(-2*gap - s.jitter))
+			resumed = true
+			i = 0
+			continue
+		}
+		latest = t
+		t = s.next(t)
+	}
+	return latest
+}
+
+// blackout returns the name of the blackout window containing t, or "".
+func (s *Schedule) blackout(t time.Time) string {
+	for _, b := range s.blackouts {
+		if b.cron == nil {
+			if !t.Before(b.start) && t.Before(b.end) {
+				return b.name
+			}
+			continue
+		}
+		// t is inside a recurring window if the window started less than its
+		// duration before t
+		start := b.cron.Next(t.Add(-b.duration).In(s.location))
+		if !start.IsZero() && !start.After(t) {
+			return b.name
+		}
+	}
+	return ""
+}
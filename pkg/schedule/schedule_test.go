@@ -0,0 +1,228 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package schedule
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	assessmentv1alpha1 "github.com/openshift-assessment/cluster-assessment-operator/api/v1alpha1"
+)
+
+func newAssessment(spec assessmentv1alpha1.ClusterAssessmentSpec) *assessmentv1alpha1.ClusterAssessment {
+	return &assessmentv1alpha1.ClusterAssessment{
+		ObjectMeta: metav1.ObjectMeta{Name: "nightly"},
+		Spec:       spec,
+	}
+}
+
+func mustNew(t *testing.T, spec assessmentv1alpha1.ClusterAssessmentSpec) *Schedule {
+	t.Helper()
+	s, err := New(newAssessment(spec))
+	if err != nil {
+		t.Fatalf("New returned error: %v", err)
+	}
+	return s
+}
+
+func utc(value string) time.Time {
+	t, err := time.Parse(time.RFC3339, value)
+	if err != nil {
+		panic(err)
+	}
+	return t
+}
+
+func TestNew_Errors(t *testing.T) {
+	tests := []struct {
+		name    string
+		spec    assessmentv1alpha1.ClusterAssessmentSpec
+		wantErr string
+	}{
+		{name: "invalid cron", spec: assessmentv1alpha1.ClusterAssessmentSpec{Schedule: "not a schedule"}, wantErr: "invalid cron schedule"},
+		{name: "invalid time zone", spec: assessmentv1alpha1.ClusterAssessmentSpec{Schedule: "0 2 * * *", TimeZone: "Mars/Olympus"}, wantErr: "invalid time zone"},
+		{name: "time zone twice", spec: assessmentv1alpha1.ClusterAssessmentSpec{Schedule: "CRON_TZ=UTC 0 2 * * *", TimeZone: "UTC"}, wantErr: "CRON_TZ"},
+		{
+			name: "window without duration",
+			spec: assessmentv1alpha1.ClusterAssessmentSpec{
+				Schedule:        "0 2 * * *",
+				BlackoutWindows: []assessmentv1alpha1.BlackoutWindow{{Name: "upgrades", Schedule: "0 0 * * 6"}},
+			},
+			wantErr: "upgrades",
+		},
+		{
+			name: "window ending before start",
+			spec: assessmentv1alpha1.ClusterAssessmentSpec{
+				Schedule: "0 2 * * *",
+				BlackoutWindows: []assessmentv1alpha1.BlackoutWindow{{
+					Start: &metav1.Time{Time: utc("2026-03-02T00:00:00Z")},
+					End:   &metav1.Time{Time: utc("2026-03-01T00:00:00Z")},
+				}},
+			},
+			wantErr: "blackoutWindows[0]",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			_, err := New(newAssessment(tt.spec))
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestEvaluate_FirstRunIsImmediate(t *testing.T) {
+	s := mustNew(t, assessmentv1alpha1.ClusterAssessmentSpec{Schedule: "0 2 * * *", TimeZone: "UTC"})
+	now := utc("2026-03-01T10:00:00Z")
+
+	d := s.Evaluate(time.Time{}, now)
+	if !d.Due || !d.ScheduledTime.Equal(now) {
+		t.Errorf("Expected first run due now, got %+v", d)
+	}
+	if want := utc("2026-03-02T02:00:00Z"); !d.NextRunTime.Equal(want) {
+		t.Errorf("Expected next run at %s, got %s", want, d.NextRunTime)
+	}
+}
+
+func TestEvaluate_TimeZone(t *testing.T) {
+	s := mustNew(t, assessmentv1alpha1.ClusterAssessmentSpec{Schedule: "0 2 * * *", TimeZone: "Europe/Berlin"})
+	last := utc("2026-03-01T12:00:00Z")
+
+	// 02:00 in Berlin is 01:00 UTC in winter
+	d := s.Evaluate(last, utc("2026-03-02T00:30:00Z"))
+	if d.Due {
+		t.Errorf("Expected run not to be due before 02:00 Berlin time, got %+v", d)
+	}
+	if want := utc("2026-03-02T01:00:00Z"); !d.NextRunTime.Equal(want) {
+		t.Errorf("Expected next run at %s, got %s", want, d.NextRunTime)
+	}
+
+	d = s.Evaluate(last, utc("2026-03-02T01:00:30Z"))
+	if !d.Due || !d.ScheduledTime.Equal(utc("2026-03-02T01:00:00Z")) {
+		t.Errorf("Expected run due for 02:00 Berlin time, got %+v", d)
+	}
+}
+
+func TestEvaluate_MissedRuns(t *testing.T) {
+	last := utc("2026-03-01T02:00:00Z")
+	now := utc("2026-03-05T09:00:00Z")
+
+	t.Run("latest missed run starts", func(t *testing.T) {
+		s := mustNew(t, assessmentv1alpha1.ClusterAssessmentSpec{Schedule: "0 2 * * *", TimeZone: "UTC"})
+		d := s.Evaluate(last, now)
+		if !d.Due || !d.ScheduledTime.Equal(utc("2026-03-05T02:00:00Z")) {
+			t.Errorf("Expected the latest missed run to be due, got %+v", d)
+		}
+		if want := utc("2026-03-06T02:00:00Z"); !d.NextRunTime.Equal(want) {
+			t.Errorf("Expected next run at %s, got %s", want, d.NextRunTime)
+		}
+	})
+
+	t.Run("past starting deadline", func(t *testing.T) {
+		deadline := int64(3600)
+		s := mustNew(t, assessmentv1alpha1.ClusterAssessmentSpec{Schedule: "0 2 * * *", TimeZone: "UTC", StartingDeadlineSeconds: &deadline})
+		d := s.Evaluate(last, now)
+		if d.Due || d.Skipped != SkipMissedDeadline || !d.ScheduledTime.Equal(utc("2026-03-05T02:00:00Z")) {
+			t.Errorf("Expected the missed run to be skipped, got %+v", d)
+		}
+
+		d = s.Evaluate(last, utc("2026-03-05T02:30:00Z"))
+		if !d.Due {
+			t.Errorf("Expected a run within the deadline to be due, got %+v", d)
+		}
+	})
+
+	t.Run("long outage of a frequent schedule", func(t *testing.T) {
+		s := mustNew(t, assessmentv1alpha1.ClusterAssessmentSpec{Schedule: "* * * * *", TimeZone: "UTC"})
+		d := s.Evaluate(last, now.Add(30*time.Second))
+		if !d.Due || !d.ScheduledTime.Equal(now) {
+			t.Errorf("Expected the latest activation %s to be due, got %+v", now, d)
+		}
+	})
+}
+
+func TestRunTime_Jitter(t *testing.T) {
+	jitter := &metav1.Duration{Duration: 10 * time.Minute}
+	spec := assessmentv1alpha1.ClusterAssessmentSpec{Schedule: "0 2 * * *", TimeZone: "UTC", Jitter: jitter}
+	s := mustNew(t, spec)
+	activation := utc("2026-03-02T02:00:00Z")
+
+	run := s.RunTime(activation)
+	if run.Before(activation) || !run.Before(activation.Add(jitter.Duration)) {
+		t.Errorf("Expected run time within the jitter, got %s", run)
+	}
+	if !s.RunTime(activation).Equal(run) {
+		t.Error("Expected the jitter to be stable for an activation")
+	}
+
+	other, err := New(&assessmentv1alpha1.ClusterAssessment{
+		ObjectMeta: metav1.ObjectMeta{Name: "weekly"},
+		Spec:       spec,
+	})
+	if err != nil {
+		t.Fatalf("New returned error: %v", err)
+	}
+	if other.RunTime(activation).Equal(run) {
+		t.Error("Expected assessments sharing a schedule to be spread out")
+	}
+
+	// The run is not due before its jittered run time
+	if d := s.Evaluate(utc("2026-03-01T12:00:00Z"), run.Add(-time.Second)); d.Due {
+		t.Errorf("Expected run not to be due before its jittered time, got %+v", d)
+	}
+	if d := s.Evaluate(utc("2026-03-01T12:00:00Z"), run); !d.Due {
+		t.Errorf("Expected run to be due at its jittered time, got %+v", d)
+	}
+}
+
+func TestEvaluate_BlackoutWindows(t *testing.T) {
+	last := utc("2026-03-06T12:00:00Z")
+
+	s := mustNew(t, assessmentv1alpha1.ClusterAssessmentSpec{
+		Schedule: "0 2 * * *",
+		TimeZone: "UTC",
+		BlackoutWindows: []assessmentv1alpha1.BlackoutWindow{
+			{Name: "weekend-upgrades", Schedule: "0 0 * * 6", Duration: &metav1.Duration{Duration: 48 * time.Hour}},
+			{
+				Name:  "freeze",
+				Start: &metav1.Time{Time: utc("2026-03-10T00:00:00Z")},
+				End:   &metav1.Time{Time: utc("2026-03-11T00:00:00Z")},
+			},
+		},
+	})
+
+	// 2026-03-07 is a Saturday
+	d := s.Evaluate(last, utc("2026-03-07T02:00:30Z"))
+	if d.Due || d.Skipped != SkipBlackout || !strings.Contains(d.Message, "weekend-upgrades") {
+		t.Errorf("Expected Saturday run to be skipped by the recurring window, got %+v", d)
+	}
+
+	d = s.Evaluate(utc("2026-03-08T02:00:00Z"), utc("2026-03-09T02:00:30Z"))
+	if !d.Due {
+		t.Errorf("Expected Monday run after the recurring window to be due, got %+v", d)
+	}
+
+	d = s.Evaluate(utc("2026-03-09T02:00:00Z"), utc("2026-03-10T02:00:30Z"))
+	if d.Due || d.Skipped != SkipBlackout || !strings.Contains(d.Message, "freeze") {
+		t.Errorf("Expected run to be skipped by the one-off window, got %+v", d)
+	}
+}
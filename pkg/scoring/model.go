@@ -0,0 +1,277 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scoring
+
+import (
+	"math"
+	"sort"
+	"strings"
+
+	assessmentv1alpha1 "github.com/openshift-assessment/cluster-assessment-operator/api/v1alpha1"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/profiles"
+)
+
+// Scoring model names. Bump a model's version whenever its output changes for
+// the same findings, so scores from different versions are not compared.
+const (
+	// ModelSimple averages fixed status weights over all findings.
+	ModelSimple = "simple"
+	// ModelSimpleVersion is the current version of the simple model.
+	// Version 2 leaves out validators that could not run and SKIPPED,
+	// NOT_APPLICABLE and ERROR findings.
+	ModelSimpleVersion = "2"
+
+	// ModelWeighted scores each category separately, weighting findings by
+	// criticality or severity, and combines the category scores using
+	// per-category weights.
+	ModelWeighted = "weighted"
+	// ModelWeightedVersion is the current version of the weighted model.
+	// Version 2 weights findings by severity; version 3 leaves out validators
+	// that could not run and SKIPPED, NOT_APPLICABLE and ERROR findings.
+	ModelWeightedVersion = "3"
+)
+
+// Model computes the overall score and per-category sub-scores of a set of findings.
+type Model interface {
+	// Name returns the model name recorded in the summary.
+	Name() string
+
+	// Version returns the model version recorded in the summary.
+	Version() string
+
+	// Score returns the overall score, or nil if no findings were scored,
+	// and the category sub-scores sorted by category.
+	Score(findings []assessmentv1alpha1.Finding) (*int, []assessmentv1alpha1.CategoryScore)
+}
+
+// ModelFor returns the scoring model selected by the profile configuration.
+// Unknown or empty model names select the simple model.
+func ModelFor(cfg profiles.ScoringConfig) Model {
+	if cfg.Model == ModelWeighted {
+		return &weightedModel{cfg: cfg}
+	}
+	return simpleModel{}
+}
+
+// legacyModelVersion is the version of the simple model that computed the
+// summaries recorded before the model was tracked.
+const legacyModelVersion = "1"
+
+// Comparable reports whether the scores of two summaries were computed by the
+// same model and version. Summaries recorded before the model was tracked
+// were computed by version 1 of the simple model.
+func Comparable(a, b assessmentv1alpha1.AssessmentSummary) bool {
+	return modelKey(a) == modelKey(b)
+}
+
+func modelKey(s assessmentv1alpha1.AssessmentSummary) string {
+	if s.ScoringModel == "" {
+		return ModelSimple + "/" + legacyModelVersion
+	}
+	return s.ScoringModel + "/" + s.ScoringModelVersion
+}
+
+// statusPoints returns the points a finding status contributes to a score.
+func statusPoints(status assessmentv1alpha1.FindingStatus) int {
+	switch status {
+	case assessmentv1alpha1.FindingStatusPass:
+		return 100
+	case assessmentv1alpha1.FindingStatusInfo:
+		return 80
+	case assessmentv1alpha1.FindingStatusWarn:
+		return 50
+	default:
+		return 0
+	}
+}
+
+// scored reports whether a finding counts towards a score. Suppressed findings
+// never do, nor do findings that say nothing about the cluster's health:
+// SKIPPED and ERROR checks could not tell, and NOT_APPLICABLE checks do not apply.
+func scored(f assessmentv1alpha1.Finding) bool {
+	if f.Suppressed {
+		return false
+	}
+	switch f.Status {
+	case assessmentv1alpha1.FindingStatusSkipped,
+		assessmentv1alpha1.FindingStatusNotApplicable,
+		assessmentv1alpha1.FindingStatusError:
+		return false
+	}
+	return true
+}
+
+// simpleModel averages status points over all unsuppressed findings, so every
+// finding counts equally regardless of category.
+type simpleModel struct{}
+
+func (simpleModel) Name() string    { return ModelSimple }
+func (simpleModel) Version() string { return ModelSimpleVersion }
+
+func (simpleModel) Score(findings []assessmentv1alpha1.Finding) (*int, []assessmentv1alpha1.CategoryScore) {
+	type tally struct{ checks, points int }
+	total := tally{}
+	byCategory := make(map[string]*tally)
+
+	for _, f := range findings {
+		if !scored(f) {
+			continue
+		}
+		points := statusPoints(f.Status)
+		total.checks++
+		total.points += points
+
+		t, ok := byCategory[f.Category]
+		if !ok {
+			t = &tally{}
+			byCategory[f.Category] = t
+		}
+		t.checks++
+		t.points += points
+	}
+
+	categories := make([]assessmentv1alpha1.CategoryScore, 0, len(byCategory))
+	for category, t := range byCategory {
+		categories = append(categories, assessmentv1alpha1.CategoryScore{
+			Category:     category,
+			Score:        t.points / t.checks,
+			ScoredChecks: t.checks,
+		})
+	}
+	sortCategories(categories)
+
+	if total.checks == 0 {
+		return nil, categories
+	}
+	score := total.points / total.checks
+	return &score, categories
+}
+
+// weightedModel scores each category as the criticality-weighted average of
+// its findings, then combines the category scores by category weight. A
+// category with many low-impact findings therefore cannot drown out a
+// failure in another category.
+type weightedModel struct {
+	cfg profiles.ScoringConfig
+}
+
+func (m *weightedModel) Name() string    { return ModelWeighted }
+func (m *weightedModel) Version() string { return ModelWeightedVersion }
+
+func (m *weightedModel) Score(findings []assessmentv1alpha1.Finding) (*int, []assessmentv1alpha1.CategoryScore) {
+	type tally struct {
+		checks         int
+		weight, points float64
+	}
+	byCategory := make(map[string]*tally)
+
+	for _, f := range findings {
+		if !scored(f) {
+			continue
+		}
+		if m.cfg.ExcludeInfo && f.Status == assessmentv1alpha1.FindingStatusInfo {
+			continue
+		}
+		w := m.criticality(f)
+		if w <= 0 {
+			continue
+		}
+
+		t, ok := byCategory[f.Category]
+		if !ok {
+			t = &tally{}
+			byCategory[f.Category] = t
+		}
+		t.checks++
+		t.weight += float64(w)
+		t.points += float64(w * statusPoints(f.Status))
+	}
+
+	categories := make([]assessmentv1alpha1.CategoryScore, 0, len(byCategory))
+	var totalWeight, totalPoints float64
+	for category, t := range byCategory {
+		categoryScore := t.points / t.weight
+		weight := m.categoryWeight(category)
+		categories = append(categories, assessmentv1alpha1.CategoryScore{
+			Category:     category,
+			Score:        int(math.Round(categoryScore)),
+			Weight:       weight,
+			ScoredChecks: t.checks,
+		})
+		if weight > 0 {
+			totalWeight += float64(weight)
+			totalPoints += float64(weight) * categoryScore
+		}
+	}
+	sortCategories(categories)
+
+	if totalWeight == 0 {
+		return nil, categories
+	}
+	score := int(math.Round(totalPoints / totalWeight))
+	return &score, categories
+}
+
+// categoryWeight returns the configured weight of a category, defaulting to 1.
+func (m *weightedModel) categoryWeight(category string) int {
+	if w, ok := m.cfg.CategoryWeights[category]; ok {
+		return w
+	}
+	return 1
+}
+
+// defaultSeverityWeights weight findings by severity when a check has no
+// configured criticality.
+var defaultSeverityWeights = map[assessmentv1alpha1.FindingSeverity]int{
+	assessmentv1alpha1.SeverityLow:      1,
+	assessmentv1alpha1.SeverityMedium:   2,
+	assessmentv1alpha1.SeverityHigh:     4,
+	assessmentv1alpha1.SeverityCritical: 8,
+}
+
+// criticality returns the weight of a finding. A CheckCriticality entry for the
+// exact ID wins over wildcards, and among wildcards the longest prefix wins.
+// Otherwise the weight comes from the finding severity, defaulting to 1.
+func (m *weightedModel) criticality(f assessmentv1alpha1.Finding) int {
+	if w, ok := m.cfg.CheckCriticality[f.ID]; ok {
+		return w
+	}
+	weight, longest := 0, -1
+	for pattern, w := range m.cfg.CheckCriticality {
+		prefix, ok := strings.CutSuffix(pattern, "*")
+		if ok && strings.HasPrefix(f.ID, prefix) && len(prefix) > longest {
+			weight, longest = w, len(prefix)
+		}
+	}
+	if longest >= 0 {
+		return weight
+	}
+
+	if w, ok := m.cfg.SeverityWeights[string(f.Severity)]; ok {
+		return w
+	}
+	if w, ok := defaultSeverityWeights[f.Severity]; ok {
+		return w
+	}
+	return 1
+}
+
+func sortCategories(categories []assessmentv1alpha1.CategoryScore) {
+	sort.Slice(categories, func(i, j int) bool {
+		return categories[i].Category < categories[j].Category
+	})
+}
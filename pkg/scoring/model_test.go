@@ -0,0 +1,280 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package scoring
+
+import (
+	"testing"
+
+	assessmentv1alpha1 "github.com/openshift-assessment/cluster-assessment-operator/api/v1alpha1"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/profiles"
+)
+
+// infoHeavyFindings has ten networking INFO findings and one etcd FAIL.
+func infoHeavyFindings() []assessmentv1alpha1.Finding {
+	var findings []assessmentv1alpha1.Finding
+	for i := 0; i < 10; i++ {
+		findings = append(findings, assessmentv1alpha1.Finding{
+			ID: "networking-info", Category: "Networking", Status: assessmentv1alpha1.FindingStatusInfo,
+		})
+	}
+	return append(findings, assessmentv1alpha1.Finding{
+		ID: "etcdbackup-missing", Category: "Platform", Status: assessmentv1alpha1.FindingStatusFail,
+	})
+}
+
+func weightedProfile(cfg profiles.ScoringConfig) profiles.Profile {
+	p := profiles.GetProfile("production")
+	cfg.Model = ModelWeighted
+	p.Scoring = cfg
+	return p
+}
+
+func TestCalculateSummary_DefaultModel(t *testing.T) {
+	summary := CalculateSummary(infoHeavyFindings(), profiles.GetProfile("production"))
+
+	if summary.ScoringModel != ModelSimple || summary.ScoringModelVersion != ModelSimpleVersion {
+		t.Errorf("Expected simple/%s model, got %s/%s", ModelSimpleVersion, summary.ScoringModel, summary.ScoringModelVersion)
+	}
+	// (10*80 + 0) / 11 = 72
+	if summary.Score == nil || *summary.Score != 72 {
+		t.Errorf("Expected simple score 72, got %v", summary.Score)
+	}
+	if len(summary.CategoryScores) != 2 {
+		t.Fatalf("Expected 2 category scores, got %+v", summary.CategoryScores)
+	}
+	if c := summary.CategoryScores[0]; c.Category != "Networking" || c.Score != 80 || c.ScoredChecks != 10 {
+		t.Errorf("Unexpected Networking score %+v", c)
+	}
+	if c := summary.CategoryScores[1]; c.Category != "Platform" || c.Score != 0 {
+		t.Errorf("Unexpected Platform score %+v", c)
+	}
+}
+
+func TestCalculateSummaryWithStatus_ExcludesValidatorsThatDidNotRun(t *testing.T) {
+	findings := []assessmentv1alpha1.Finding{
+		{ID: "security-pass", Validator: "security", Category: "Security", Status: assessmentv1alpha1.FindingStatusPass},
+		{ID: "etcdbackup-error", Validator: "etcdbackup", Category: "Platform", Status: assessmentv1alpha1.FindingStatusFail},
+		{ID: "nodes-timeout", Validator: "nodes", Category: "Infrastructure", Status: assessmentv1alpha1.FindingStatusWarn},
+	}
+	validators := []assessmentv1alpha1.ValidatorRunStatus{
+		{Name: "etcdbackup", Outcome: assessmentv1alpha1.ValidatorFailed, Error: "forbidden"},
+		{Name: "nodes", Outcome: assessmentv1alpha1.ValidatorTimedOut, Error: "timed out after 2m0s"},
+		{Name: "security", Outcome: assessmentv1alpha1.ValidatorSucceeded},
+		{Name: "legacy", Error: "recorded before outcomes"},
+	}
+
+	summary := CalculateSummaryWithStatus(findings, profiles.GetProfile("production"), validators)
+
+	if summary.Score == nil || *summary.Score != 100 {
+		t.Errorf("Expected only the security findings to be scored, got %v", summary.Score)
+	}
+	if len(summary.CategoryScores) != 1 || summary.CategoryScores[0].Category != "Security" {
+		t.Errorf("Expected only the Security category to be scored, got %+v", summary.CategoryScores)
+	}
+	if summary.FailCount != 1 || summary.WarnCount != 1 || summary.TotalChecks != 3 {
+		t.Errorf("Expected unscored findings to be counted, got %+v", summary)
+	}
+	want := []string{"etcdbackup", "nodes", "legacy"}
+	if len(summary.UnscoredValidators) != len(want) {
+		t.Fatalf("Expected unscored validators %v, got %v", want, summary.UnscoredValidators)
+	}
+	for i := range want {
+		if summary.UnscoredValidators[i] != want[i] {
+			t.Errorf("Expected unscored validators %v, got %v", want, summary.UnscoredValidators)
+		}
+	}
+}
+
+func TestModels_ExcludeSkippedFindings(t *testing.T) {
+	// SKIPPED findings score nothing even when their validator is not
+	// reported as unscored, e.g. in results recorded without statuses
+	findings := []assessmentv1alpha1.Finding{
+		{ID: "security-pass", Category: "Security", Status: assessmentv1alpha1.FindingStatusPass},
+		{ID: "nodes-skipped", Category: "Infrastructure", Status: assessmentv1alpha1.FindingStatusSkipped},
+	}
+
+	for _, profile := range []profiles.Profile{profiles.GetProfile("production"), weightedProfile(profiles.ScoringConfig{})} {
+		summary := CalculateSummary(findings, profile)
+		if summary.Score == nil || *summary.Score != 100 {
+			t.Errorf("%s: expected the SKIPPED finding to be left out of the score, got %v", summary.ScoringModel, summary.Score)
+		}
+		if len(summary.CategoryScores) != 1 || summary.TotalChecks != 2 {
+			t.Errorf("%s: expected one scored category of two checks, got %+v", summary.ScoringModel, summary)
+		}
+	}
+}
+
+func TestCalculateSummary_CountsSumToTotalChecks(t *testing.T) {
+	var findings []assessmentv1alpha1.Finding
+	for i, status := range []assessmentv1alpha1.FindingStatus{
+		assessmentv1alpha1.FindingStatusPass,
+		assessmentv1alpha1.FindingStatusWarn,
+		assessmentv1alpha1.FindingStatusFail,
+		assessmentv1alpha1.FindingStatusInfo,
+		assessmentv1alpha1.FindingStatusSkipped,
+		assessmentv1alpha1.FindingStatusNotApplicable,
+		assessmentv1alpha1.FindingStatusError,
+	} {
+		// One more finding of each status than of the previous one
+		for j := 0; j <= i; j++ {
+			findings = append(findings, assessmentv1alpha1.Finding{ID: "check", Category: "Security", Status: status})
+		}
+	}
+
+	s := CalculateSummary(findings, profiles.GetProfile("production"))
+	sum := s.PassCount + s.WarnCount + s.FailCount + s.InfoCount + s.SkippedCount + s.NotApplicableCount + s.ErrorCount
+	if sum != s.TotalChecks || s.TotalChecks != len(findings) {
+		t.Errorf("Expected the counts by status to sum to %d checks, got %d of %d: %+v", len(findings), sum, s.TotalChecks, s)
+	}
+	if s.SkippedCount != 5 {
+		t.Errorf("Expected 5 SKIPPED findings to be counted, got %d", s.SkippedCount)
+	}
+}
+
+func TestModels_ExcludeNotApplicableAndErrorFindings(t *testing.T) {
+	findings := []assessmentv1alpha1.Finding{
+		{ID: "security-pass", Category: "Security", Status: assessmentv1alpha1.FindingStatusPass},
+		{ID: "security-error", Category: "Security", Status: assessmentv1alpha1.FindingStatusError},
+		{ID: "oadpbackup-not-installed", Category: "Platform", Status: assessmentv1alpha1.FindingStatusNotApplicable},
+	}
+
+	for _, profile := range []profiles.Profile{profiles.GetProfile("production"), weightedProfile(profiles.ScoringConfig{})} {
+		summary := CalculateSummary(findings, profile)
+		if summary.Score == nil || *summary.Score != 100 {
+			t.Errorf("%s: expected NOT_APPLICABLE and ERROR findings to be left out of the score, got %v", summary.ScoringModel, summary.Score)
+		}
+		if len(summary.CategoryScores) != 1 || summary.CategoryScores[0].ScoredChecks != 1 {
+			t.Errorf("%s: expected one scored check, got %+v", summary.ScoringModel, summary.CategoryScores)
+		}
+		if summary.NotApplicableCount != 1 || summary.ErrorCount != 1 || summary.TotalChecks != 3 {
+			t.Errorf("%s: expected the findings to be counted by status, got %+v", summary.ScoringModel, summary)
+		}
+	}
+}
+
+func TestWeightedModel(t *testing.T) {
+	tests := []struct {
+		name string
+		cfg  profiles.ScoringConfig
+		want int
+	}{
+		// Each category counts once: (80 + 0) / 2
+		{name: "equal category weights", want: 40},
+		// (80*1 + 0*3) / 4
+		{name: "category weights", cfg: profiles.ScoringConfig{CategoryWeights: map[string]int{"Platform": 3}}, want: 20},
+		// Networking is left out entirely, leaving only the FAIL
+		{name: "exclude info", cfg: profiles.ScoringConfig{ExcludeInfo: true}, want: 0},
+		// A zero-weight category does not contribute
+		{name: "zero category weight", cfg: profiles.ScoringConfig{CategoryWeights: map[string]int{"Platform": 0}}, want: 80},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			summary := CalculateSummary(infoHeavyFindings(), weightedProfile(tt.cfg))
+			if summary.ScoringModel != ModelWeighted {
+				t.Errorf("Expected weighted model, got %q", summary.ScoringModel)
+			}
+			if summary.Score == nil || *summary.Score != tt.want {
+				t.Errorf("Expected score %d, got %v", tt.want, summary.Score)
+			}
+		})
+	}
+}
+
+func TestWeightedModel_CheckCriticality(t *testing.T) {
+	findings := []assessmentv1alpha1.Finding{
+		{ID: "certificates-expired-router", Category: "Security", Status: assessmentv1alpha1.FindingStatusFail},
+		{ID: "security-scc-ok", Category: "Security", Status: assessmentv1alpha1.FindingStatusPass},
+		{ID: "security-rbac-ok", Category: "Security", Status: assessmentv1alpha1.FindingStatusPass},
+		{ID: "security-ignored", Category: "Security", Status: assessmentv1alpha1.FindingStatusFail, Suppressed: true},
+	}
+	profile := weightedProfile(profiles.ScoringConfig{
+		CheckCriticality: map[string]int{
+			"certificates-*":         2,
+			"certificates-expired-*": 6,
+		},
+	})
+
+	summary := CalculateSummary(findings, profile)
+
+	// Longest wildcard wins: (0*6 + 100 + 100) / 8 = 25
+	if summary.Score == nil || *summary.Score != 25 {
+		t.Errorf("Expected score 25, got %v", summary.Score)
+	}
+	if len(summary.CategoryScores) != 1 || summary.CategoryScores[0].ScoredChecks != 3 {
+		t.Errorf("Expected 3 scored Security checks, got %+v", summary.CategoryScores)
+	}
+}
+
+func TestWeightedModel_NothingScored(t *testing.T) {
+	findings := []assessmentv1alpha1.Finding{
+		{ID: "networking-info", Category: "Networking", Status: assessmentv1alpha1.FindingStatusInfo},
+	}
+	summary := CalculateSummary(findings, weightedProfile(profiles.ScoringConfig{ExcludeInfo: true}))
+	if summary.Score != nil {
+		t.Errorf("Expected nil score, got %d", *summary.Score)
+	}
+}
+
+func TestComparable(t *testing.T) {
+	legacy := assessmentv1alpha1.AssessmentSummary{}
+	simple := assessmentv1alpha1.AssessmentSummary{ScoringModel: ModelSimple, ScoringModelVersion: ModelSimpleVersion}
+	weighted := assessmentv1alpha1.AssessmentSummary{ScoringModel: ModelWeighted, ScoringModelVersion: ModelWeightedVersion}
+
+	if !Comparable(legacy, assessmentv1alpha1.AssessmentSummary{ScoringModel: ModelSimple, ScoringModelVersion: "1"}) {
+		t.Error("Expected summaries without a model to compare with version 1 of the simple model")
+	}
+	if Comparable(legacy, simple) {
+		t.Error("Expected summaries without a model not to compare with the current simple model")
+	}
+	if Comparable(simple, weighted) {
+		t.Error("Expected different models not to be comparable")
+	}
+	if Comparable(weighted, assessmentv1alpha1.AssessmentSummary{ScoringModel: ModelWeighted, ScoringModelVersion: "0"}) {
+		t.Error("Expected different model versions not to be comparable")
+	}
+}
+
+func TestWeightedModel_SeverityWeights(t *testing.T) {
+	findings := []assessmentv1alpha1.Finding{
+		{ID: "etcd-degraded", Category: "Platform", Status: assessmentv1alpha1.FindingStatusFail, Severity: assessmentv1alpha1.SeverityCritical},
+		{ID: "version-channel", Category: "Platform", Status: assessmentv1alpha1.FindingStatusPass, Severity: assessmentv1alpha1.SeverityLow},
+		{ID: "nodes-no-role", Category: "Platform", Status: assessmentv1alpha1.FindingStatusPass, Severity: assessmentv1alpha1.SeverityLow},
+	}
+
+	// Default weights: (0*8 + 100 + 100) / 10 = 20
+	summary := CalculateSummary(findings, weightedProfile(profiles.ScoringConfig{}))
+	if summary.Score == nil || *summary.Score != 20 {
+		t.Errorf("Expected score 20 with default severity weights, got %v", summary.Score)
+	}
+
+	// Configured weights: (0*2 + 100 + 100) / 4 = 50
+	summary = CalculateSummary(findings, weightedProfile(profiles.ScoringConfig{
+		SeverityWeights: map[string]int{"critical": 2},
+	}))
+	if summary.Score == nil || *summary.Score != 50 {
+		t.Errorf("Expected score 50 with configured severity weights, got %v", summary.Score)
+	}
+
+	// CheckCriticality takes precedence over severity: (0*1 + 100 + 100) / 3 = 67
+	summary = CalculateSummary(findings, weightedProfile(profiles.ScoringConfig{
+		CheckCriticality: map[string]int{"etcd-*": 1},
+	}))
+	if summary.Score == nil || *summary.Score != 67 {
+		t.Errorf("Expected score 67 with check criticality, got %v", summary.Score)
+	}
+}
@@ -0,0 +1,93 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package scoring computes assessment summaries and scores from findings.
+package scoring
+
+import (
+	assessmentv1alpha1 "github.com/openshift-assessment/cluster-assessment-operator/api/v1alpha1"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/profiles"
+)
+
+// CalculateSummary computes the assessment summary from findings, scoring
+// them with the model selected by the profile.
+func CalculateSummary(findings []assessmentv1alpha1.Finding, profile profiles.Profile) assessmentv1alpha1.AssessmentSummary {
+	return CalculateSummaryWithStatus(findings, profile, nil)
+}
+
+// CalculateSummaryWithStatus is like CalculateSummary, and excludes the
+// findings of validators that could not run from the score, so an
+// infrastructure error does not count as a cluster failure. Their findings
+// are still counted by status.
+func CalculateSummaryWithStatus(findings []assessmentv1alpha1.Finding, profile profiles.Profile, validators []assessmentv1alpha1.ValidatorRunStatus) assessmentv1alpha1.AssessmentSummary {
+	model := ModelFor(profile.Scoring)
+	summary := assessmentv1alpha1.AssessmentSummary{
+		TotalChecks:         len(findings),
+		ProfileUsed:         string(profile.Name),
+		ScoringModel:        model.Name(),
+		ScoringModelVersion: model.Version(),
+		UnscoredValidators:  UnscoredValidators(validators),
+	}
+
+	for _, f := range findings {
+		switch f.Status {
+		case assessmentv1alpha1.FindingStatusPass:
+			summary.PassCount++
+		case assessmentv1alpha1.FindingStatusWarn:
+			summary.WarnCount++
+		case assessmentv1alpha1.FindingStatusFail:
+			summary.FailCount++
+		case assessmentv1alpha1.FindingStatusInfo:
+			summary.InfoCount++
+		case assessmentv1alpha1.FindingStatusNotApplicable:
+			summary.NotApplicableCount++
+		case assessmentv1alpha1.FindingStatusError:
+			summary.ErrorCount++
+		case assessmentv1alpha1.FindingStatusSkipped:
+			summary.SkippedCount++
+		}
+	}
+
+	scored := findings
+	if len(summary.UnscoredValidators) > 0 {
+		unscored := make(map[string]bool, len(summary.UnscoredValidators))
+		for _, name := range summary.UnscoredValidators {
+			unscored[name] = true
+		}
+		scored = make([]assessmentv1alpha1.Finding, 0, len(findings))
+		for _, f := range findings {
+			if !unscored[f.Validator] {
+				scored = append(scored, f)
+			}
+		}
+	}
+
+	// Suppressed and SKIPPED findings are excluded from score calculation
+	summary.Score, summary.CategoryScores = model.Score(scored)
+
+	return summary
+}
+
+// UnscoredValidators returns the names of the validators that could not run.
+func UnscoredValidators(validators []assessmentv1alpha1.ValidatorRunStatus) []string {
+	var names []string
+	for _, v := range validators {
+		if !v.Ran() {
+			names = append(names, v.Name)
+		}
+	}
+	return names
+}
@@ -0,0 +1,277 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package suppression matches findings against suppression rules and
+// FindingExceptions and reports how each rule was applied.
+package suppression
+
+import (
+	"fmt"
+	"path"
+	"slices"
+	"time"
+
+	assessmentv1alpha1 "github.com/openshift-assessment/cluster-assessment-operator/api/v1alpha1"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/validator"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/labels"
+)
+
+// ExpiryWarning is how long before its expiry a rule is reported as Expiring.
+const ExpiryWarning = 7 * 24 * time.Hour
+
+// Rule is a suppression rule together with where it was declared.
+type Rule struct {
+	assessmentv1alpha1.SuppressionRule
+
+	// Source identifies the rule in status, e.g. "spec.suppressions[0]".
+	Source string
+
+	// PendingApproval, when set, explains why the rule is not applied: it comes
+	// from a FindingException without an approval of its current spec.
+	PendingApproval string
+}
+
+// FromSpec returns the suppression rules declared in a ClusterAssessment spec.
+func FromSpec(rules []assessmentv1alpha1.SuppressionRule) []Rule {
+	result := make([]Rule, 0, len(rules))
+	for i, rule := range rules {
+		result = append(result, Rule{
+			SuppressionRule: rule,
+			Source:          fmt.Sprintf("spec.suppressions[%d]", i),
+		})
+	}
+	return result
+}
+
+// ExceptionSource returns the status source of the named FindingException.
+func ExceptionSource(name string) string {
+	return "FindingException/" + name
+}
+
+// FromExceptions returns the rules of the exceptions that apply to the named
+// assessment. Exceptions only take effect once status.approval approves their
+// current generation; ApprovedBy is taken from the approval, never the spec.
+func FromExceptions(exceptions []assessmentv1alpha1.FindingException, assessmentName string) []Rule {
+	var result []Rule
+	for i := range exceptions {
+		e := &exceptions[i]
+		if !e.Spec.AppliesTo(assessmentName) {
+			continue
+		}
+		rule := Rule{
+			SuppressionRule: e.Spec.SuppressionRule,
+			Source:          ExceptionSource(e.Name),
+		}
+		rule.ApprovedBy = ""
+		switch {
+		case e.Approved():
+			rule.ApprovedBy = e.Status.Approval.ApprovedBy
+		case e.Status.Approval != nil:
+			rule.PendingApproval = fmt.Sprintf("the exception changed after %s approved generation %d; it must be approved again",
+				e.Status.Approval.ApprovedBy, e.Status.Approval.Generation)
+		default:
+			rule.PendingApproval = "the exception has not been approved"
+		}
+		result = append(result, rule)
+	}
+	return result
+}
+
+// NeedsNamespaceLabels reports whether any rule selects namespaces by label.
+func NeedsNamespaceLabels(rules []Rule) bool {
+	for _, rule := range rules {
+		if rule.NamespaceSelector != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// Apply marks the findings matched by the rules as suppressed and returns the
+// outcome of each rule, in rule order. A finding matched by several rules takes
+// the reason of the first one, but counts towards every rule it matches.
+// A resource-scoped rule that covers only some of a finding's affected
+// resources removes those from AffectedResources instead, and the finding is
+// suppressed once no resource is left. namespaceLabels maps namespace names to
+// their labels and is only consulted by rules with a NamespaceSelector.
+func Apply(findings []assessmentv1alpha1.Finding, rules []Rule, namespaceLabels map[string]map[string]string, now time.Time) []assessmentv1alpha1.SuppressionStatus {
+	statuses := make([]assessmentv1alpha1.SuppressionStatus, len(rules))
+	matchers := make([]*matcher, len(rules))
+	for i, rule := range rules {
+		statuses[i] = assessmentv1alpha1.SuppressionStatus{
+			Source:    rule.Source,
+			ExpiresAt: rule.ExpiresAt,
+		}
+		switch {
+		case rule.PendingApproval != "":
+			statuses[i].State = assessmentv1alpha1.SuppressionPendingApproval
+			statuses[i].Message = rule.PendingApproval
+		case rule.ExpiresAt != nil && rule.ExpiresAt.Time.Before(now):
+			statuses[i].State = assessmentv1alpha1.SuppressionExpired
+		default:
+			m, err := newMatcher(rule.SuppressionRule, namespaceLabels)
+			if err != nil {
+				statuses[i].State = assessmentv1alpha1.SuppressionInvalid
+				statuses[i].Message = err.Error()
+				continue
+			}
+			matchers[i] = m
+		}
+	}
+
+	for j := range findings {
+		f := &findings[j]
+		for i, m := range matchers {
+			if m == nil || !m.selects(f) {
+				continue
+			}
+			if m.rule.HasResourceScope() {
+				remaining, matched := m.outOfScope(f)
+				if !matched {
+					continue
+				}
+				if len(remaining) > 0 && !f.Suppressed {
+					f.AffectedResources = remaining
+					statuses[i].MatchedFindings++
+					continue
+				}
+			}
+			statuses[i].MatchedFindings++
+			if !f.Suppressed {
+				f.Suppressed = true
+				f.SuppressionReason = rules[i].Reason
+			}
+		}
+	}
+
+	for i, m := range matchers {
+		if m == nil {
+			continue
+		}
+		switch {
+		case statuses[i].MatchedFindings == 0:
+			statuses[i].State = assessmentv1alpha1.SuppressionUnused
+			statuses[i].Message = "rule did not match any finding"
+		case rules[i].ExpiresAt != nil && rules[i].ExpiresAt.Time.Sub(now) < ExpiryWarning:
+			statuses[i].State = assessmentv1alpha1.SuppressionExpiring
+		default:
+			statuses[i].State = assessmentv1alpha1.SuppressionActive
+		}
+	}
+
+	return statuses
+}
+
+// Validate checks that a rule selects findings and that its resource name
+// pattern and namespace selector are valid.
+func Validate(rule assessmentv1alpha1.SuppressionRule) error {
+	_, err := newMatcher(rule, nil)
+	return err
+}
+
+// matcher evaluates a single validated suppression rule.
+type matcher struct {
+	rule            assessmentv1alpha1.SuppressionRule
+	selector        labels.Selector
+	namespaceLabels map[string]map[string]string
+}
+
+func newMatcher(rule assessmentv1alpha1.SuppressionRule, namespaceLabels map[string]map[string]string) (*matcher, error) {
+	if rule.FindingID == "" && rule.Validator == "" && rule.Category == "" {
+		return nil, fmt.Errorf("one of findingID, validator or category is required")
+	}
+	if rule.ResourceNamePattern != "" {
+		if _, err := path.Match(rule.ResourceNamePattern, ""); err != nil {
+			return nil, fmt.Errorf("invalid resourceNamePattern %q: %w", rule.ResourceNamePattern, err)
+		}
+	}
+
+	m := &matcher{rule: rule, namespaceLabels: namespaceLabels}
+	if rule.NamespaceSelector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(rule.NamespaceSelector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid namespaceSelector: %w", err)
+		}
+		m.selector = selector
+	}
+	return m, nil
+}
+
+// selects reports whether the finding matches the rule's finding ID,
+// validator and category selectors.
+func (m *matcher) selects(f *assessmentv1alpha1.Finding) bool {
+	if m.rule.FindingID != "" && !(validator.Check{ID: m.rule.FindingID}).Matches(f.ID) {
+		return false
+	}
+	if m.rule.Validator != "" && f.Validator != m.rule.Validator {
+		return false
+	}
+	if m.rule.Category != "" && f.Category != m.rule.Category {
+		return false
+	}
+	return true
+}
+
+// outOfScope returns the finding's resources outside the rule's resource
+// scope, and whether any resource was in scope.
+func (m *matcher) outOfScope(f *assessmentv1alpha1.Finding) ([]assessmentv1alpha1.AffectedResource, bool) {
+	resources := findingResources(f)
+	var remaining []assessmentv1alpha1.AffectedResource
+	for _, r := range resources {
+		if !m.inScope(r) {
+			remaining = append(remaining, r)
+		}
+	}
+	return remaining, len(remaining) < len(resources)
+}
+
+// inScope reports whether a resource satisfies the rule's resource selectors.
+func (m *matcher) inScope(r assessmentv1alpha1.AffectedResource) bool {
+	namespace := r.Namespace
+	if namespace == "" && r.Kind == "Namespace" {
+		namespace = r.Name
+	}
+
+	if len(m.rule.Namespaces) > 0 && !slices.Contains(m.rule.Namespaces, namespace) {
+		return false
+	}
+	if m.selector != nil {
+		nsLabels, ok := m.namespaceLabels[namespace]
+		if !ok || !m.selector.Matches(labels.Set(nsLabels)) {
+			return false
+		}
+	}
+	if m.rule.ResourceNamePattern != "" {
+		if ok, _ := path.Match(m.rule.ResourceNamePattern, r.Name); !ok {
+			return false
+		}
+	}
+	return true
+}
+
+// findingResources returns the resources a finding applies to, falling back
+// to its Resource and Namespace fields for validators that do not report
+// AffectedResources.
+func findingResources(f *assessmentv1alpha1.Finding) []assessmentv1alpha1.AffectedResource {
+	if len(f.AffectedResources) > 0 {
+		return f.AffectedResources
+	}
+	if f.Resource == "" {
+		return nil
+	}
+	return []assessmentv1alpha1.AffectedResource{{Namespace: f.Namespace, Name: f.Resource}}
+}
@@ -0,0 +1,313 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package suppression
+
+import (
+	"strings"
+	"testing"
+	"time"
+
+	assessmentv1alpha1 "github.com/openshift-assessment/cluster-assessment-operator/api/v1alpha1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+var now = time.Date(2024, 6, 1, 12, 0, 0, 0, time.UTC)
+
+func pod(namespace, name string) assessmentv1alpha1.AffectedResource {
+	return assessmentv1alpha1.AffectedResource{Kind: "Pod", Namespace: namespace, Name: name}
+}
+
+func testFindings() []assessmentv1alpha1.Finding {
+	return []assessmentv1alpha1.Finding{
+		{
+			ID: "security-privileged-pods", Validator: "security", Category: "Security",
+			Status:            assessmentv1alpha1.FindingStatusWarn,
+			AffectedResources: []assessmentv1alpha1.AffectedResource{pod("team-a", "debug-1"), pod("team-a", "debug-2")},
+		},
+		{
+			ID: "security-host-network", Validator: "security", Category: "Security",
+			Status:            assessmentv1alpha1.FindingStatusWarn,
+			AffectedResources: []assessmentv1alpha1.AffectedResource{pod("team-a", "agent"), pod("team-b", "agent")},
+		},
+		{
+			ID: "storage-no-default", Validator: "storage", Category: "Storage",
+			Status: assessmentv1alpha1.FindingStatusFail,
+		},
+		{
+			ID: "networkpolicy-missing", Validator: "networkpolicyaudit", Category: "Networking",
+			Status:   assessmentv1alpha1.FindingStatusWarn,
+			Resource: "sandbox", Namespace: "sandbox",
+		},
+	}
+}
+
+func suppressedIDs(findings []assessmentv1alpha1.Finding) []string {
+	var ids []string
+	for _, f := range findings {
+		if f.Suppressed {
+			ids = append(ids, f.ID)
+		}
+	}
+	return ids
+}
+
+func TestApply_Matching(t *testing.T) {
+	namespaceLabels := map[string]map[string]string{
+		"team-a":  {"tenant": "a"},
+		"team-b":  {"tenant": "b"},
+		"sandbox": {"env": "sandbox"},
+	}
+
+	tests := []struct {
+		name string
+		rule assessmentv1alpha1.SuppressionRule
+		want []string
+		// partial is the number of findings the rule only partly covers
+		partial int
+	}{
+		{
+			name: "exact finding ID",
+			rule: assessmentv1alpha1.SuppressionRule{FindingID: "storage-no-default"},
+			want: []string{"storage-no-default"},
+		},
+		{
+			name: "finding ID wildcard",
+			rule: assessmentv1alpha1.SuppressionRule{FindingID: "security-*"},
+			want: []string{"security-privileged-pods", "security-host-network"},
+		},
+		{
+			name: "validator",
+			rule: assessmentv1alpha1.SuppressionRule{Validator: "storage"},
+			want: []string{"storage-no-default"},
+		},
+		{
+			name: "category",
+			rule: assessmentv1alpha1.SuppressionRule{Category: "Networking"},
+			want: []string{"networkpolicy-missing"},
+		},
+		{
+			name:    "namespace suppresses findings with every resource in scope",
+			rule:    assessmentv1alpha1.SuppressionRule{Category: "Security", Namespaces: []string{"team-a"}},
+			want:    []string{"security-privileged-pods"},
+			partial: 1,
+		},
+		{
+			name: "namespace selector",
+			rule: assessmentv1alpha1.SuppressionRule{
+				Category:          "Security",
+				NamespaceSelector: &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{{Key: "tenant", Operator: metav1.LabelSelectorOpExists}}},
+			},
+			want: []string{"security-privileged-pods", "security-host-network"},
+		},
+		{
+			name: "resource name pattern",
+			rule: assessmentv1alpha1.SuppressionRule{Validator: "security", ResourceNamePattern: "debug-*"},
+			want: []string{"security-privileged-pods"},
+		},
+		{
+			name: "resource scope falls back to resource field",
+			rule: assessmentv1alpha1.SuppressionRule{
+				Validator:         "networkpolicyaudit",
+				NamespaceSelector: &metav1.LabelSelector{MatchLabels: map[string]string{"env": "sandbox"}},
+			},
+			want: []string{"networkpolicy-missing"},
+		},
+		{
+			name: "resource scope does not match findings without resources",
+			rule: assessmentv1alpha1.SuppressionRule{Validator: "storage", Namespaces: []string{"team-a"}},
+			want: nil,
+		},
+		{
+			name: "all selectors must match",
+			rule: assessmentv1alpha1.SuppressionRule{FindingID: "security-*", Validator: "storage"},
+			want: nil,
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			findings := testFindings()
+			statuses := Apply(findings, FromSpec([]assessmentv1alpha1.SuppressionRule{tt.rule}), namespaceLabels, now)
+
+			got := suppressedIDs(findings)
+			if len(got) != len(tt.want) {
+				t.Fatalf("Expected suppressed %v, got %v", tt.want, got)
+			}
+			for i := range got {
+				if got[i] != tt.want[i] {
+					t.Errorf("Expected suppressed %v, got %v", tt.want, got)
+				}
+			}
+			if statuses[0].MatchedFindings != len(tt.want)+tt.partial {
+				t.Errorf("Expected %d matched findings, got %d", len(tt.want)+tt.partial, statuses[0].MatchedFindings)
+			}
+		})
+	}
+}
+
+func TestApply_PartialScope(t *testing.T) {
+	findings := []assessmentv1alpha1.Finding{{
+		ID: "security-privileged-pods", Validator: "security", Category: "Security",
+		Status: assessmentv1alpha1.FindingStatusWarn,
+		AffectedResources: []assessmentv1alpha1.AffectedResource{
+			pod("team-a", "debug-1"), pod("team-b", "agent"), pod("team-a", "debug-2"), pod("team-c", "agent"),
+		},
+	}}
+
+	statuses := Apply(findings, FromSpec([]assessmentv1alpha1.SuppressionRule{
+		{FindingID: "security-privileged-pods", Namespaces: []string{"team-a"}, Reason: "team-a debug pods"},
+	}), nil, now)
+
+	f := findings[0]
+	if f.Suppressed {
+		t.Fatal("Expected finding with resources outside the scope to stay open")
+	}
+	if len(f.AffectedResources) != 2 || f.AffectedResources[0] != pod("team-b", "agent") || f.AffectedResources[1] != pod("team-c", "agent") {
+		t.Errorf("Expected only out-of-scope resources to remain, got %+v", f.AffectedResources)
+	}
+	if statuses[0].State != assessmentv1alpha1.SuppressionActive || statuses[0].MatchedFindings != 1 {
+		t.Errorf("Expected rule to be active with 1 match, got %s with %d", statuses[0].State, statuses[0].MatchedFindings)
+	}
+
+	// Rules covering the remaining namespaces suppress the finding entirely
+	findings = []assessmentv1alpha1.Finding{f}
+	Apply(findings, FromSpec([]assessmentv1alpha1.SuppressionRule{
+		{FindingID: "security-privileged-pods", Namespaces: []string{"team-b"}, Reason: "team-b agent"},
+		{FindingID: "security-privileged-pods", Namespaces: []string{"team-c"}, Reason: "team-c agent"},
+	}), nil, now)
+	if !findings[0].Suppressed || findings[0].SuppressionReason != "team-c agent" {
+		t.Errorf("Expected finding suppressed by the rule covering its last resource, got %v (%q)",
+			findings[0].Suppressed, findings[0].SuppressionReason)
+	}
+}
+
+func TestApply_States(t *testing.T) {
+	expired := metav1.NewTime(now.Add(-time.Hour))
+	soon := metav1.NewTime(now.Add(48 * time.Hour))
+	later := metav1.NewTime(now.Add(30 * 24 * time.Hour))
+
+	rules := FromSpec([]assessmentv1alpha1.SuppressionRule{
+		{FindingID: "storage-no-default", Reason: "active", ExpiresAt: &later},
+		{FindingID: "security-host-network", Reason: "expiring", ExpiresAt: &soon},
+		{FindingID: "security-privileged-pods", Reason: "expired", ExpiresAt: &expired},
+		{FindingID: "does-not-exist", Reason: "unused"},
+		{Validator: "security", ResourceNamePattern: "[", Reason: "invalid"},
+	})
+	rules = append(rules, Rule{
+		SuppressionRule: assessmentv1alpha1.SuppressionRule{Category: "Networking", Reason: "pending"},
+		Source:          ExceptionSource("sandbox"),
+		PendingApproval: "the exception has not been approved",
+	})
+
+	findings := testFindings()
+	statuses := Apply(findings, rules, nil, now)
+
+	want := []assessmentv1alpha1.SuppressionState{
+		assessmentv1alpha1.SuppressionActive,
+		assessmentv1alpha1.SuppressionExpiring,
+		assessmentv1alpha1.SuppressionExpired,
+		assessmentv1alpha1.SuppressionUnused,
+		assessmentv1alpha1.SuppressionInvalid,
+		assessmentv1alpha1.SuppressionPendingApproval,
+	}
+	for i, state := range want {
+		if statuses[i].State != state {
+			t.Errorf("Rule %s: expected state %s, got %s (%s)", statuses[i].Source, state, statuses[i].State, statuses[i].Message)
+		}
+	}
+	if statuses[5].Source != "FindingException/sandbox" {
+		t.Errorf("Expected exception source, got %q", statuses[5].Source)
+	}
+
+	got := suppressedIDs(findings)
+	if len(got) != 2 || got[0] != "security-host-network" || got[1] != "storage-no-default" {
+		t.Errorf("Expected only active and expiring rules to suppress findings, got %v", got)
+	}
+}
+
+func TestApply_FirstRuleReasonWins(t *testing.T) {
+	findings := testFindings()
+	statuses := Apply(findings, FromSpec([]assessmentv1alpha1.SuppressionRule{
+		{FindingID: "storage-no-default", Reason: "first"},
+		{Validator: "storage", Reason: "second"},
+	}), nil, now)
+
+	if findings[2].SuppressionReason != "first" {
+		t.Errorf("Expected reason of first matching rule, got %q", findings[2].SuppressionReason)
+	}
+	if statuses[1].State != assessmentv1alpha1.SuppressionActive || statuses[1].MatchedFindings != 1 {
+		t.Errorf("Expected overlapping rule to be reported active, got %s with %d matches",
+			statuses[1].State, statuses[1].MatchedFindings)
+	}
+}
+
+func TestFromExceptions(t *testing.T) {
+	exceptions := []assessmentv1alpha1.FindingException{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "all"},
+			Spec:       assessmentv1alpha1.FindingExceptionSpec{SuppressionRule: assessmentv1alpha1.SuppressionRule{FindingID: "a"}},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "prod-only"},
+			Spec: assessmentv1alpha1.FindingExceptionSpec{
+				Assessments:     []string{"prod"},
+				SuppressionRule: assessmentv1alpha1.SuppressionRule{FindingID: "b"},
+			},
+		},
+	}
+
+	rules := FromExceptions(exceptions, "dev")
+	if len(rules) != 1 || rules[0].Source != "FindingException/all" || rules[0].PendingApproval == "" {
+		t.Errorf("Expected only the unapproved unrestricted exception for dev, got %+v", rules)
+	}
+	if rules := FromExceptions(exceptions, "prod"); len(rules) != 2 {
+		t.Errorf("Expected both exceptions for prod, got %d", len(rules))
+	}
+}
+
+func TestFromExceptions_Approval(t *testing.T) {
+	approval := &assessmentv1alpha1.ExceptionApproval{ApprovedBy: "alice", Generation: 2}
+	exceptions := []assessmentv1alpha1.FindingException{
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "approved", Generation: 2},
+			Spec:       assessmentv1alpha1.FindingExceptionSpec{SuppressionRule: assessmentv1alpha1.SuppressionRule{FindingID: "a"}},
+			Status:     assessmentv1alpha1.FindingExceptionStatus{Approval: approval},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "changed", Generation: 3},
+			Spec:       assessmentv1alpha1.FindingExceptionSpec{SuppressionRule: assessmentv1alpha1.SuppressionRule{FindingID: "a"}},
+			Status:     assessmentv1alpha1.FindingExceptionStatus{Approval: approval},
+		},
+		{
+			ObjectMeta: metav1.ObjectMeta{Name: "self-approved", Generation: 1},
+			Spec: assessmentv1alpha1.FindingExceptionSpec{
+				SuppressionRule: assessmentv1alpha1.SuppressionRule{FindingID: "a", ApprovedBy: "mallory"},
+			},
+		},
+	}
+
+	rules := FromExceptions(exceptions, "prod")
+	if rules[0].PendingApproval != "" || rules[0].ApprovedBy != "alice" {
+		t.Errorf("Expected approved exception to apply, got %+v", rules[0])
+	}
+	if !strings.Contains(rules[1].PendingApproval, "changed after alice approved") {
+		t.Errorf("Expected exception changed since approval to be pending, got %q", rules[1].PendingApproval)
+	}
+	if rules[2].PendingApproval == "" || rules[2].ApprovedBy != "" {
+		t.Errorf("Expected spec approvedBy to be ignored, got %+v", rules[2])
+	}
+}
@@ -0,0 +1,220 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package trigger collects the cluster events that re-run an assessment and
+// debounces them, so a burst of changes causes a single run.
+package trigger
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	assessmentv1alpha1 "github.com/openshift-assessment/cluster-assessment-operator/api/v1alpha1"
+)
+
+const (
+	// DefaultDebounce is the quiet period used when the assessment does not set one.
+	DefaultDebounce = 2 * time.Minute
+
+	// DefaultMinInterval is the minimum time between runs used when the
+	// assessment does not set one.
+	DefaultMinInterval = 15 * time.Minute
+
+	// maxDelayFactor bounds how long a steady stream of events postpones a run,
+	// as a multiple of the debounce period.
+	maxDelayFactor = 5
+
+	// maxSources bounds the number of event descriptions kept for the reason
+	// of the triggered run.
+	maxSources = 5
+)
+
+// Event is a cluster change matching a trigger of an assessment.
+type Event struct {
+	// Type is the kind of change.
+	Type assessmentv1alpha1.TriggerEventType
+
+	// Source describes the change, such as the object that changed.
+	Source string
+
+	// Validators are the validators the trigger runs. Empty runs all of the
+	// assessment's validators.
+	Validators []string
+
+	// Time is when the change was observed.
+	Time time.Time
+}
+
+// Intervals returns the debounce period and minimum run interval of the
+// triggers, applying the defaults.
+func Intervals(spec *assessmentv1alpha1.TriggerSpec) (debounce, minInterval time.Duration) {
+	debounce, minInterval = DefaultDebounce, DefaultMinInterval
+	if spec == nil {
+		return debounce, minInterval
+	}
+	if spec.Debounce != nil {
+		debounce = spec.Debounce.Duration
+	}
+	if spec.MinInterval != nil {
+		minInterval = spec.MinInterval.Duration
+	}
+	return debounce, minInterval
+}
+
+// Pending is the debounced set of events recorded for an assessment.
+type Pending struct {
+	// Types are the kinds of changes that were observed, in first-seen order.
+	Types []assessmentv1alpha1.TriggerEventType
+
+	// Sources describe the first observed changes.
+	Sources []string
+
+	// Count is the number of recorded events.
+	Count int
+
+	// Validators are the validators to run, sorted. Empty runs all of the
+	// assessment's validators.
+	Validators []string
+
+	// First and Last are when the first and the last event were observed.
+	First, Last time.Time
+
+	allValidators bool
+}
+
+// RunAt returns when the pending events may start a run: once no event was
+// observed for the debounce period, but no later than maxDelayFactor debounce
+// periods after the first event, and not before minInterval has passed since
+// the assessment's last run. A zero lastRun means the assessment never ran.
+func (p *Pending) RunAt(debounce, minInterval time.Duration, lastRun time.Time) time.Time {
+	runAt := p.Last.Add(debounce)
+	if limit := p.First.Add(maxDelayFactor * debounce); runAt.After(limit) {
+		runAt = limit
+	}
+	if !lastRun.IsZero() {
+		if earliest := lastRun.Add(minInterval); runAt.Before(earliest) {
+			runAt = earliest
+		}
+	}
+	return runAt
+}
+
+// Reason describes the pending events for the triggered AssessmentRun.
+func (p *Pending) Reason() string {
+	reason := strings.Join(p.Sources, "; ")
+	if more := p.Count - len(p.Sources); more > 0 {
+		reason += fmt.Sprintf(" (and %d more)", more)
+	}
+	return reason
+}
+
+// Tracker records the pending trigger events of each assessment. It is safe
+// for concurrent use by event handlers and reconciles.
+type Tracker struct {
+	mu      sync.Mutex
+	started time.Time
+	pending map[string]*Pending
+}
+
+// NewTracker returns an empty tracker.
+func NewTracker() *Tracker {
+	return &Tracker{
+		started: time.Now(),
+		pending: map[string]*Pending{},
+	}
+}
+
+// Started returns when the tracker was created. Objects created before that
+// are reported by the informers' initial list and are not new.
+func (t *Tracker) Started() time.Time {
+	return t.started
+}
+
+// Record adds an event to the pending events of the assessment.
+func (t *Tracker) Record(assessmentName string, event Event) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	p, ok := t.pending[assessmentName]
+	if !ok {
+		p = &Pending{First: event.Time}
+		t.pending[assessmentName] = p
+	}
+	if event.Time.After(p.Last) {
+		p.Last = event.Time
+	}
+	p.Count++
+	if !containsType(p.Types, event.Type) {
+		p.Types = append(p.Types, event.Type)
+	}
+	if len(p.Sources) < maxSources && event.Source != "" {
+		p.Sources = append(p.Sources, event.Source)
+	}
+
+	if len(event.Validators) == 0 {
+		p.allValidators = true
+		p.Validators = nil
+	}
+	if p.allValidators {
+		return
+	}
+	for _, v := range event.Validators {
+		i := sort.SearchStrings(p.Validators, v)
+		if i < len(p.Validators) && p.Validators[i] == v {
+			continue
+		}
+		p.Validators = append(p.Validators, "")
+		copy(p.Validators[i+1:], p.Validators[i:])
+		p.Validators[i] = v
+	}
+}
+
+// Pending returns a copy of the pending events of the assessment, or nil if
+// there are none.
+func (t *Tracker) Pending(assessmentName string) *Pending {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+
+	p, ok := t.pending[assessmentName]
+	if !ok {
+		return nil
+	}
+	out := *p
+	out.Types = append([]assessmentv1alpha1.TriggerEventType(nil), p.Types...)
+	out.Sources = append([]string(nil), p.Sources...)
+	out.Validators = append([]string(nil), p.Validators...)
+	return &out
+}
+
+// Forget drops the pending events of the assessment, once they started a run
+// or the assessment no longer handles them.
+func (t *Tracker) Forget(assessmentName string) {
+	t.mu.Lock()
+	defer t.mu.Unlock()
+	delete(t.pending, assessmentName)
+}
+
+func containsType(types []assessmentv1alpha1.TriggerEventType, eventType assessmentv1alpha1.TriggerEventType) bool {
+	for _, t := range types {
+		if t == eventType {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,162 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package trigger
+
+import (
+	"fmt"
+	"testing"
+	"time"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	assessmentv1alpha1 "github.com/openshift-assessment/cluster-assessment-operator/api/v1alpha1"
+)
+
+func TestTracker_Record(t *testing.T) {
+	base := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	tracker := NewTracker()
+
+	if tracker.Pending("nightly") != nil {
+		t.Fatal("Expected no pending events for a new tracker")
+	}
+
+	tracker.Record("nightly", Event{
+		Type:       assessmentv1alpha1.TriggerNamespaceCreated,
+		Source:     "Namespace team-a created",
+		Validators: []string{"networkpolicyaudit", "resourcequotas"},
+		Time:       base,
+	})
+	tracker.Record("nightly", Event{
+		Type:       assessmentv1alpha1.TriggerNamespaceCreated,
+		Source:     "Namespace team-b created",
+		Validators: []string{"networkpolicyaudit", "podsecurityadmission"},
+		Time:       base.Add(time.Minute),
+	})
+
+	p := tracker.Pending("nightly")
+	if p.Count != 2 || !p.First.Equal(base) || !p.Last.Equal(base.Add(time.Minute)) {
+		t.Errorf("Expected two events over one minute, got %+v", p)
+	}
+	want := []string{"networkpolicyaudit", "podsecurityadmission", "resourcequotas"}
+	if fmt.Sprint(p.Validators) != fmt.Sprint(want) {
+		t.Errorf("Expected validators %v, got %v", want, p.Validators)
+	}
+
+	// An event running all validators widens the run
+	tracker.Record("nightly", Event{Type: assessmentv1alpha1.TriggerClusterUpgrade, Time: base.Add(2 * time.Minute)})
+	tracker.Record("nightly", Event{
+		Type:       assessmentv1alpha1.TriggerNamespaceCreated,
+		Validators: []string{"resourcequotas"},
+		Time:       base.Add(3 * time.Minute),
+	})
+	p = tracker.Pending("nightly")
+	if len(p.Validators) != 0 {
+		t.Errorf("Expected all validators to run, got %v", p.Validators)
+	}
+	if len(p.Types) != 2 {
+		t.Errorf("Expected two event types, got %v", p.Types)
+	}
+
+	tracker.Forget("nightly")
+	if tracker.Pending("nightly") != nil {
+		t.Error("Expected pending events to be forgotten")
+	}
+}
+
+func TestPending_RunAt(t *testing.T) {
+	base := time.Date(2026, 3, 1, 10, 0, 0, 0, time.UTC)
+	debounce := 2 * time.Minute
+
+	tests := []struct {
+		name        string
+		first, last time.Time
+		minInterval time.Duration
+		lastRun     time.Time
+		want        time.Time
+	}{
+		{
+			name:  "single event waits for the debounce period",
+			first: base, last: base,
+			want: base.Add(2 * time.Minute),
+		},
+		{
+			name:  "burst waits for the last event",
+			first: base, last: base.Add(3 * time.Minute),
+			want: base.Add(5 * time.Minute),
+		},
+		{
+			name:  "steady stream is bounded",
+			first: base, last: base.Add(time.Hour),
+			want: base.Add(10 * time.Minute),
+		},
+		{
+			name:  "minimum interval after the last run",
+			first: base, last: base,
+			minInterval: 15 * time.Minute,
+			lastRun:     base.Add(-5 * time.Minute),
+			want:        base.Add(10 * time.Minute),
+		},
+		{
+			name:  "last run long ago",
+			first: base, last: base,
+			minInterval: 15 * time.Minute,
+			lastRun:     base.Add(-time.Hour),
+			want:        base.Add(2 * time.Minute),
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			p := &Pending{First: tt.first, Last: tt.last}
+			if got := p.RunAt(debounce, tt.minInterval, tt.lastRun); !got.Equal(tt.want) {
+				t.Errorf("Expected run at %s, got %s", tt.want, got)
+			}
+		})
+	}
+}
+
+func TestPending_Reason(t *testing.T) {
+	tracker := NewTracker()
+	for i := 0; i < maxSources+2; i++ {
+		tracker.Record("nightly", Event{
+			Type:   assessmentv1alpha1.TriggerNamespaceCreated,
+			Source: fmt.Sprintf("Namespace ns-%d created", i),
+			Time:   time.Now(),
+		})
+	}
+
+	want := "Namespace ns-0 created; Namespace ns-1 created; Namespace ns-2 created; " +
+		"Namespace ns-3 created; Namespace ns-4 created (and 2 more)"
+	if got := tracker.Pending("nightly").Reason(); got != want {
+		t.Errorf("Expected reason %q, got %q", want, got)
+	}
+}
+
+func TestIntervals(t *testing.T) {
+	debounce, minInterval := Intervals(&assessmentv1alpha1.TriggerSpec{})
+	if debounce != DefaultDebounce || minInterval != DefaultMinInterval {
+		t.Errorf("Expected defaults, got %s and %s", debounce, minInterval)
+	}
+
+	debounce, minInterval = Intervals(&assessmentv1alpha1.TriggerSpec{
+		Debounce:    &metav1.Duration{Duration: 30 * time.Second},
+		MinInterval: &metav1.Duration{},
+	})
+	if debounce != 30*time.Second || minInterval != 0 {
+		t.Errorf("Expected configured intervals, got %s and %s", debounce, minInterval)
+	}
+}
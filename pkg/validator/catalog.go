@@ -0,0 +1,78 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validator
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+)
+
+// CatalogConfigMapName is the name of the ConfigMap the check catalog is published to.
+const CatalogConfigMapName = "validator-catalog"
+
+// CatalogDataKey is the ConfigMap data key holding the JSON-encoded catalog.
+const CatalogDataKey = "catalog.json"
+
+// PublishCatalog writes the registry's check catalog to the validator-catalog
+// ConfigMap in the given namespace, creating or updating it as needed.
+func PublishCatalog(ctx context.Context, c client.Client, registry *Registry, namespace string) error {
+	logger := log.FromContext(ctx)
+
+	catalog := registry.Catalog()
+	data, err := json.MarshalIndent(catalog, "", "  ")
+	if err != nil {
+		return fmt.Errorf("failed to encode validator catalog: %w", err)
+	}
+
+	cm := &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{
+			Name:      CatalogConfigMapName,
+			Namespace: namespace,
+			Labels: map[string]string{
+				"app.kubernetes.io/name":       "cluster-assessment-operator",
+				"app.kubernetes.io/managed-by": "cluster-assessment-operator",
+			},
+		},
+		Data: map[string]string{CatalogDataKey: string(data)},
+	}
+
+	existing := &corev1.ConfigMap{}
+	err = c.Get(ctx, client.ObjectKeyFromObject(cm), existing)
+	if errors.IsNotFound(err) {
+		if err := c.Create(ctx, cm); err != nil {
+			return fmt.Errorf("failed to create validator catalog ConfigMap: %w", err)
+		}
+	} else if err != nil {
+		return fmt.Errorf("failed to get validator catalog ConfigMap: %w", err)
+	} else {
+		existing.Data = cm.Data
+		existing.Labels = cm.Labels
+		if err := c.Update(ctx, existing); err != nil {
+			return fmt.Errorf("failed to update validator catalog ConfigMap: %w", err)
+		}
+	}
+
+	logger.Info("Published validator catalog", "configMap", CatalogConfigMapName, "namespace", namespace, "validators", len(catalog))
+	return nil
+}
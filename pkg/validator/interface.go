@@ -18,6 +18,7 @@ package validator
 
 import (
 	"context"
+	"strings"
 
 	assessmentv1alpha1 "github.com/openshift-assessment/cluster-assessment-operator/api/v1alpha1"
 	"github.com/openshift-assessment/cluster-assessment-operator/pkg/profiles"
@@ -38,37 +39,74 @@ type Validator interface {
 	Category() string
 
 	// Validate performs the validation checks and returns findings.
-	// The implementation must be strictly read-only.
+	// The implementation must be strictly read-only, and must pass ctx to
+	// every API call and return once it is cancelled: the Runner cancels it
+	// when the validator exceeds its timeout and stops waiting for it.
 	Validate(ctx context.Context, client client.Client, profile profiles.Profile) ([]assessmentv1alpha1.Finding, error)
 }
 
-// ValidatorConfig provides configuration options for validators.
-type ValidatorConfig struct {
-	// Profile is the baseline profile being used for the assessment.
-	Profile profiles.Profile
+// Check describes a single check that a validator can report a finding for.
+type Check struct {
+	// ID is the finding ID. A trailing "*" marks a family of IDs generated
+	// per resource, e.g. "certificates-expired-*".
+	ID string `json:"id"`
 
-	// EnabledChecks specifies which specific checks within a validator to run.
-	// If empty, all checks are run.
-	EnabledChecks []string
+	// Title is the human-readable title of the finding.
+	Title string `json:"title"`
 
-	// Parameters provides validator-specific configuration.
-	Parameters map[string]interface{}
+	// Category is the finding category. Defaults to the validator's category.
+	Category string `json:"category,omitempty"`
+
+	// DefaultStatus is the most severe status this check reports.
+	DefaultStatus assessmentv1alpha1.FindingStatus `json:"defaultStatus"`
+
+	// Severity is the risk of the issue this check detects. Findings that do
+	// not set a severity inherit it from their check.
+	Severity assessmentv1alpha1.FindingSeverity `json:"severity,omitempty"`
+
+	// References provides links to relevant documentation.
+	References []string `json:"references,omitempty"`
+}
+
+// Matches reports whether a finding ID belongs to this check.
+func (c Check) Matches(id string) bool {
+	if prefix, ok := strings.CutSuffix(c.ID, "*"); ok {
+		return strings.HasPrefix(id, prefix)
+	}
+	return c.ID == id
+}
+
+// CheckProvider is implemented by validators that publish the catalog of
+// checks they can report. All built-in validators implement it.
+type CheckProvider interface {
+	// Checks returns every check the validator can emit a finding for.
+	Checks() []Check
 }
 
 // ValidatorMetadata provides metadata about a validator for registration and discovery.
 type ValidatorMetadata struct {
 	// Name is the unique identifier.
-	Name string
+	Name string `json:"name"`
 
 	// Description explains what the validator checks.
-	Description string
+	Description string `json:"description"`
 
 	// Category is the finding category.
-	Category string
+	Category string `json:"category"`
 
 	// SupportedProfiles lists which profiles this validator supports.
-	SupportedProfiles []string
+	SupportedProfiles []string `json:"supportedProfiles"`
 
 	// CheckCount is the number of individual checks this validator performs.
-	CheckCount int
+	CheckCount int `json:"checkCount"`
+
+	// Checks is the catalog of checks this validator can report, including
+	// the error and timeout findings produced by the Runner.
+	Checks []Check `json:"checks"`
+
+	// Parameters is the schema of the parameters the validator accepts.
+	Parameters []Parameter `json:"parameters,omitempty"`
+
+	// Permissions are the API permissions the validator needs to run.
+	Permissions []Permission `json:"permissions,omitempty"`
 }
@@ -0,0 +1,174 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validator
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"time"
+
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/profiles"
+)
+
+// ParameterType is the type of a validator parameter value.
+type ParameterType string
+
+const (
+	// ParameterInteger is a non-negative whole number, e.g. "30".
+	ParameterInteger ParameterType = "integer"
+
+	// ParameterPercent is a whole-number percentage between 0 and 100, e.g. "80".
+	ParameterPercent ParameterType = "percent"
+
+	// ParameterDuration is a Go duration string, e.g. "168h".
+	ParameterDuration ParameterType = "duration"
+
+	// ParameterBoolean is "true" or "false".
+	ParameterBoolean ParameterType = "boolean"
+)
+
+// Parameter describes a tunable value a validator reads from the profile.
+type Parameter struct {
+	// Name is the parameter key within the validator's parameters map.
+	Name string `json:"name"`
+
+	// Type determines how the value is parsed and validated.
+	Type ParameterType `json:"type"`
+
+	// Default is the built-in value used when the profile does not set one.
+	Default string `json:"default"`
+
+	// Description explains what the parameter controls.
+	Description string `json:"description"`
+}
+
+// ParameterProvider is implemented by validators that accept parameters
+// through the profile's parameters map.
+type ParameterProvider interface {
+	// Parameters returns the schema of every parameter the validator reads.
+	Parameters() []Parameter
+}
+
+// Parse validates a raw value against the parameter type and returns the
+// typed value: int64 for integer and percent, time.Duration for duration,
+// and bool for boolean.
+func (p Parameter) Parse(raw string) (any, error) {
+	switch p.Type {
+	case ParameterInteger, ParameterPercent:
+		n, err := strconv.ParseInt(raw, 10, 64)
+		if err != nil {
+			return nil, fmt.Errorf("parameter %q: %q is not an integer", p.Name, raw)
+		}
+		if n < 0 {
+			return nil, fmt.Errorf("parameter %q: %d must not be negative", p.Name, n)
+		}
+		if p.Type == ParameterPercent && n > 100 {
+			return nil, fmt.Errorf("parameter %q: %d is not a percentage between 0 and 100", p.Name, n)
+		}
+		return n, nil
+	case ParameterDuration:
+		d, err := time.ParseDuration(raw)
+		if err != nil {
+			return nil, fmt.Errorf("parameter %q: %q is not a duration", p.Name, raw)
+		}
+		if d <= 0 {
+			return nil, fmt.Errorf("parameter %q: %s must be positive", p.Name, d)
+		}
+		return d, nil
+	case ParameterBoolean:
+		b, err := strconv.ParseBool(raw)
+		if err != nil {
+			return nil, fmt.Errorf("parameter %q: %q is not a boolean", p.Name, raw)
+		}
+		return b, nil
+	default:
+		return nil, fmt.Errorf("parameter %q: unsupported type %q", p.Name, p.Type)
+	}
+}
+
+// value returns the typed value for p from the profile, falling back to the
+// built-in default when the profile does not set it or sets an invalid value.
+func (p Parameter) value(profile profiles.Profile, validatorName string) any {
+	if raw, ok := profile.Parameters[validatorName][p.Name]; ok {
+		if v, err := p.Parse(raw); err == nil {
+			return v
+		}
+	}
+	v, _ := p.Parse(p.Default)
+	return v
+}
+
+// IntParameter returns an integer or percent parameter for the validator.
+func IntParameter(profile profiles.Profile, validatorName string, p Parameter) int {
+	n, _ := p.value(profile, validatorName).(int64)
+	return int(n)
+}
+
+// DurationParameter returns a duration parameter for the validator.
+func DurationParameter(profile profiles.Profile, validatorName string, p Parameter) time.Duration {
+	d, _ := p.value(profile, validatorName).(time.Duration)
+	return d
+}
+
+// BoolParameter returns a boolean parameter for the validator.
+func BoolParameter(profile profiles.Profile, validatorName string, p Parameter) bool {
+	b, _ := p.value(profile, validatorName).(bool)
+	return b
+}
+
+// ValidateParameters checks a profile's parameters map against the schemas
+// declared by the registered validators. It reports unknown validators,
+// unknown parameter names and values that do not parse.
+func (r *Registry) ValidateParameters(params map[string]map[string]string) error {
+	validatorNames := make([]string, 0, len(params))
+	for name := range params {
+		validatorNames = append(validatorNames, name)
+	}
+	sort.Strings(validatorNames)
+
+	for _, name := range validatorNames {
+		v, ok := r.Get(name)
+		if !ok {
+			return fmt.Errorf("unknown validator %q in parameters", name)
+		}
+
+		schema := make(map[string]Parameter)
+		if pp, ok := v.(ParameterProvider); ok {
+			for _, p := range pp.Parameters() {
+				schema[p.Name] = p
+			}
+		}
+
+		keys := make([]string, 0, len(params[name]))
+		for key := range params[name] {
+			keys = append(keys, key)
+		}
+		sort.Strings(keys)
+
+		for _, key := range keys {
+			p, ok := schema[key]
+			if !ok {
+				return fmt.Errorf("validator %q has no parameter %q", name, key)
+			}
+			if _, err := p.Parse(params[name][key]); err != nil {
+				return fmt.Errorf("validator %q: %w", name, err)
+			}
+		}
+	}
+	return nil
+}
@@ -0,0 +1,81 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validator
+
+import (
+	"testing"
+	"time"
+
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/profiles"
+)
+
+func TestParameter_Parse(t *testing.T) {
+	tests := []struct {
+		param   Parameter
+		raw     string
+		wantErr bool
+	}{
+		{Parameter{Name: "n", Type: ParameterInteger}, "30", false},
+		{Parameter{Name: "n", Type: ParameterInteger}, "-1", true},
+		{Parameter{Name: "n", Type: ParameterInteger}, "thirty", true},
+		{Parameter{Name: "p", Type: ParameterPercent}, "100", false},
+		{Parameter{Name: "p", Type: ParameterPercent}, "101", true},
+		{Parameter{Name: "d", Type: ParameterDuration}, "168h", false},
+		{Parameter{Name: "d", Type: ParameterDuration}, "0s", true},
+		{Parameter{Name: "d", Type: ParameterDuration}, "7 days", true},
+		{Parameter{Name: "b", Type: ParameterBoolean}, "true", false},
+		{Parameter{Name: "b", Type: ParameterBoolean}, "yes", true},
+		{Parameter{Name: "x", Type: "float"}, "1.5", true},
+	}
+
+	for _, tt := range tests {
+		_, err := tt.param.Parse(tt.raw)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("Parse(%s %q) error = %v, wantErr %v", tt.param.Type, tt.raw, err, tt.wantErr)
+		}
+	}
+}
+
+func TestParameterHelpers_FallBackToDefault(t *testing.T) {
+	days := Parameter{Name: "days", Type: ParameterInteger, Default: "30"}
+	age := Parameter{Name: "age", Type: ParameterDuration, Default: "168h"}
+	strict := Parameter{Name: "strict", Type: ParameterBoolean, Default: "false"}
+
+	profile := profiles.GetProfile("production")
+	if got := IntParameter(profile, "certs", days); got != 30 {
+		t.Errorf("Expected default 30, got %d", got)
+	}
+	if got := DurationParameter(profile, "certs", age); got != 168*time.Hour {
+		t.Errorf("Expected default 168h, got %s", got)
+	}
+
+	profile.Parameters = map[string]map[string]string{
+		"certs": {"days": "14", "age": "not-a-duration", "strict": "true"},
+	}
+	if got := IntParameter(profile, "certs", days); got != 14 {
+		t.Errorf("Expected override 14, got %d", got)
+	}
+	if got := DurationParameter(profile, "certs", age); got != 168*time.Hour {
+		t.Errorf("Expected invalid override to fall back to 168h, got %s", got)
+	}
+	if !BoolParameter(profile, "certs", strict) {
+		t.Error("Expected override true")
+	}
+	if got := IntParameter(profile, "other", days); got != 30 {
+		t.Errorf("Expected other validators to keep default 30, got %d", got)
+	}
+}
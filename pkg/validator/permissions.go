@@ -0,0 +1,184 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validator
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	assessmentv1alpha1 "github.com/openshift-assessment/cluster-assessment-operator/api/v1alpha1"
+)
+
+// Permission is an API access a validator needs to produce its findings.
+type Permission struct {
+	// Verb is the API verb, e.g. "get" or "list".
+	Verb string `json:"verb"`
+
+	// Group is the API group. Empty for the core group.
+	Group string `json:"group,omitempty"`
+
+	// Resource is the plural resource name, e.g. "secrets".
+	Resource string `json:"resource"`
+
+	// Namespace restricts the permission to a single namespace. Empty means
+	// all namespaces, or a cluster-scoped resource.
+	Namespace string `json:"namespace,omitempty"`
+}
+
+// String describes the permission the way it is reported, e.g.
+// "list secrets in openshift-ingress" or "get clusterversions.config.openshift.io".
+func (p Permission) String() string {
+	resource := p.Resource
+	if p.Group != "" {
+		resource += "." + p.Group
+	}
+	if p.Namespace != "" {
+		return fmt.Sprintf("%s %s in %s", p.Verb, resource, p.Namespace)
+	}
+	return p.Verb + " " + resource
+}
+
+// PermissionProvider is implemented by validators that declare the API
+// access they need. The Runner checks these permissions before running the
+// validator and skips it when any are missing. All built-in validators
+// implement it.
+type PermissionProvider interface {
+	// RequiredPermissions returns every permission the validator relies on.
+	RequiredPermissions() []Permission
+}
+
+// PermissionChecker reports which permissions the caller lacks.
+type PermissionChecker interface {
+	// Missing returns the permissions in perms that are not granted, in order.
+	Missing(ctx context.Context, perms []Permission) ([]Permission, error)
+}
+
+// AccessReviewChecker checks permissions with SelfSubjectAccessReviews. Each
+// permission is reviewed once, so a checker should live no longer than a
+// single run to pick up RBAC changes.
+type AccessReviewChecker struct {
+	client client.Client
+
+	mu      sync.Mutex
+	allowed map[Permission]bool
+}
+
+// NewAccessReviewChecker creates a checker that reviews permissions through c.
+func NewAccessReviewChecker(c client.Client) *AccessReviewChecker {
+	return &AccessReviewChecker{
+		client:  c,
+		allowed: make(map[Permission]bool),
+	}
+}
+
+// Missing implements PermissionChecker.
+func (a *AccessReviewChecker) Missing(ctx context.Context, perms []Permission) ([]Permission, error) {
+	var missing []Permission
+	for _, p := range perms {
+		allowed, err := a.isAllowed(ctx, p)
+		if err != nil {
+			return nil, fmt.Errorf("failed to review permission %q: %w", p, err)
+		}
+		if !allowed {
+			missing = append(missing, p)
+		}
+	}
+	return missing, nil
+}
+
+// isAllowed reviews a single permission, reusing earlier answers.
+func (a *AccessReviewChecker) isAllowed(ctx context.Context, p Permission) (bool, error) {
+	a.mu.Lock()
+	allowed, ok := a.allowed[p]
+	a.mu.Unlock()
+	if ok {
+		return allowed, nil
+	}
+
+	review := &authorizationv1.SelfSubjectAccessReview{
+		Spec: authorizationv1.SelfSubjectAccessReviewSpec{
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Namespace: p.Namespace,
+				Verb:      p.Verb,
+				Group:     p.Group,
+				Resource:  p.Resource,
+			},
+		},
+	}
+	if err := a.client.Create(ctx, review); err != nil {
+		return false, err
+	}
+
+	a.mu.Lock()
+	a.allowed[p] = review.Status.Allowed
+	a.mu.Unlock()
+	return review.Status.Allowed, nil
+}
+
+// WithPermissionChecker makes the Runner check each validator's declared
+// permissions before running it. Validators lacking any are skipped.
+func WithPermissionChecker(checker PermissionChecker) RunnerOption {
+	return func(r *Runner) {
+		r.permissions = checker
+	}
+}
+
+// missingPermissions returns the declared permissions of v that the checker
+// reports as missing. Validators that declare none are never skipped.
+func (r *Runner) missingPermissions(ctx context.Context, v Validator) ([]Permission, error) {
+	if r.permissions == nil {
+		return nil, nil
+	}
+	p, ok := v.(PermissionProvider)
+	if !ok {
+		return nil, nil
+	}
+	perms := p.RequiredPermissions()
+	if len(perms) == 0 {
+		return nil, nil
+	}
+	return r.permissions.Missing(ctx, perms)
+}
+
+// permissionStrings describes each permission with Permission.String.
+func permissionStrings(perms []Permission) []string {
+	out := make([]string, len(perms))
+	for i, p := range perms {
+		out[i] = p.String()
+	}
+	return out
+}
+
+// skippedFinding builds the finding reported for a validator that was not run
+// because the operator lacks some of the permissions it needs.
+func skippedFinding(v Validator, missing []string) assessmentv1alpha1.Finding {
+	return assessmentv1alpha1.Finding{
+		ID:             fmt.Sprintf("%s-skipped", v.Name()),
+		Validator:      v.Name(),
+		Category:       v.Category(),
+		Status:         assessmentv1alpha1.FindingStatusSkipped,
+		Title:          fmt.Sprintf("Validator %s skipped for missing permissions", v.Name()),
+		Description:    fmt.Sprintf("The operator is missing permissions this validator needs: %s.", strings.Join(missing, ", ")),
+		Impact:         "Assessment results for this validator are missing and are not scored.",
+		Recommendation: "Grant the missing permissions to the operator's service account, or disable the validator in the profile.",
+	}
+}
@@ -0,0 +1,162 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validator
+
+import (
+	"context"
+	"errors"
+	"reflect"
+	"testing"
+
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	assessmentv1alpha1 "github.com/openshift-assessment/cluster-assessment-operator/api/v1alpha1"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/profiles"
+)
+
+// permissionValidator is a fakeValidator that declares permissions.
+type permissionValidator struct {
+	fakeValidator
+	perms  []Permission
+	called bool
+}
+
+func (p *permissionValidator) RequiredPermissions() []Permission {
+	return p.perms
+}
+
+func (p *permissionValidator) Validate(ctx context.Context, c client.Client, profile profiles.Profile) ([]assessmentv1alpha1.Finding, error) {
+	p.called = true
+	return p.fakeValidator.Validate(ctx, c, profile)
+}
+
+// staticChecker reports the permissions in denied as missing.
+type staticChecker struct {
+	denied map[Permission]bool
+	err    error
+}
+
+func (s *staticChecker) Missing(_ context.Context, perms []Permission) ([]Permission, error) {
+	if s.err != nil {
+		return nil, s.err
+	}
+	var missing []Permission
+	for _, p := range perms {
+		if s.denied[p] {
+			missing = append(missing, p)
+		}
+	}
+	return missing, nil
+}
+
+var (
+	listSecrets = Permission{Verb: "list", Resource: "secrets", Namespace: "openshift-ingress"}
+	getVersions = Permission{Verb: "get", Group: "config.openshift.io", Resource: "clusterversions"}
+)
+
+func TestPermission_String(t *testing.T) {
+	if got := listSecrets.String(); got != "list secrets in openshift-ingress" {
+		t.Errorf("Unexpected namespaced permission string %q", got)
+	}
+	if got := getVersions.String(); got != "get clusterversions.config.openshift.io" {
+		t.Errorf("Unexpected cluster-wide permission string %q", got)
+	}
+}
+
+func TestAccessReviewChecker_Missing(t *testing.T) {
+	scheme := runtime.NewScheme()
+	_ = clientgoscheme.AddToScheme(scheme)
+
+	reviews := 0
+	c := fake.NewClientBuilder().WithScheme(scheme).WithInterceptorFuncs(interceptor.Funcs{
+		Create: func(_ context.Context, _ client.WithWatch, obj client.Object, _ ...client.CreateOption) error {
+			review, ok := obj.(*authorizationv1.SelfSubjectAccessReview)
+			if !ok {
+				t.Fatalf("Unexpected create of %T", obj)
+			}
+			reviews++
+			attrs := review.Spec.ResourceAttributes
+			review.Status.Allowed = attrs.Resource == "clusterversions" && attrs.Group == "config.openshift.io"
+			return nil
+		},
+	}).Build()
+	checker := NewAccessReviewChecker(c)
+
+	for i := 0; i < 2; i++ {
+		missing, err := checker.Missing(context.Background(), []Permission{getVersions, listSecrets})
+		if err != nil {
+			t.Fatalf("Missing returned error: %v", err)
+		}
+		if !reflect.DeepEqual(missing, []Permission{listSecrets}) {
+			t.Errorf("Expected only %s to be missing, got %v", listSecrets, missing)
+		}
+	}
+	if reviews != 2 {
+		t.Errorf("Expected each permission to be reviewed once, got %d reviews", reviews)
+	}
+}
+
+func TestRunner_SkipsValidatorsMissingPermissions(t *testing.T) {
+	denied := &permissionValidator{fakeValidator: fakeValidator{name: "denied"}, perms: []Permission{getVersions, listSecrets}}
+	granted := &permissionValidator{fakeValidator: fakeValidator{name: "granted"}, perms: []Permission{getVersions}}
+	reg := newTestRegistry(t, denied, granted, &fakeValidator{name: "undeclared"})
+	runner := NewRunner(reg, nil, WithPermissionChecker(&staticChecker{denied: map[Permission]bool{listSecrets: true}}))
+
+	findings, statuses, err := runner.RunWithStatus(context.Background(), profiles.GetProfile("production"), nil)
+	if err != nil {
+		t.Fatalf("RunWithStatus returned error: %v", err)
+	}
+	if denied.called || !granted.called {
+		t.Errorf("Expected only the validator lacking permissions to be skipped")
+	}
+	if len(findings) != 3 || len(statuses) != 3 {
+		t.Fatalf("Expected 3 findings and 3 statuses, got %d and %d", len(findings), len(statuses))
+	}
+
+	skipped := findings[0]
+	if skipped.ID != "denied-skipped" || skipped.Status != assessmentv1alpha1.FindingStatusSkipped || skipped.Severity == "" {
+		t.Errorf("Expected a SKIPPED finding for denied, got %+v", skipped)
+	}
+	s := statuses[0]
+	if s.Outcome != assessmentv1alpha1.ValidatorSkipped || s.Ran() || s.FindingCount != 1 || s.SkippedCount != 1 {
+		t.Errorf("Expected denied to be skipped, got %+v", s)
+	}
+	if !reflect.DeepEqual(s.MissingPermissions, []string{"list secrets in openshift-ingress"}) {
+		t.Errorf("Expected the exact missing permission, got %v", s.MissingPermissions)
+	}
+	if statuses[1].Outcome != assessmentv1alpha1.ValidatorSucceeded || statuses[2].Outcome != assessmentv1alpha1.ValidatorSucceeded {
+		t.Errorf("Expected granted and undeclared to succeed, got %+v", statuses[1:])
+	}
+}
+
+func TestRunner_RunsValidatorWhenPermissionCheckFails(t *testing.T) {
+	v := &permissionValidator{fakeValidator: fakeValidator{name: "checked"}, perms: []Permission{listSecrets}}
+	runner := NewRunner(newTestRegistry(t, v), nil, WithPermissionChecker(&staticChecker{err: errors.New("review unavailable")}))
+
+	_, statuses, err := runner.RunWithStatus(context.Background(), profiles.GetProfile("production"), nil)
+	if err != nil {
+		t.Fatalf("RunWithStatus returned error: %v", err)
+	}
+	if !v.called || statuses[0].Outcome != assessmentv1alpha1.ValidatorSucceeded {
+		t.Errorf("Expected the validator to run when permissions cannot be checked, got %+v", statuses[0])
+	}
+}
@@ -0,0 +1,123 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validator
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	assessmentv1alpha1 "github.com/openshift-assessment/cluster-assessment-operator/api/v1alpha1"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/profiles"
+)
+
+// ValidateProfile checks an AssessmentProfile against the registered
+// validators, their check catalog and parameter schemas.
+func (r *Registry) ValidateProfile(profile *assessmentv1alpha1.AssessmentProfile) error {
+	// Validate basedOn
+	basedOn := profile.Spec.BasedOn
+	if basedOn == "" {
+		basedOn = "production"
+	}
+	if basedOn != string(profiles.ProfileProduction) && basedOn != string(profiles.ProfileDevelopment) {
+		return fmt.Errorf("invalid basedOn value %q: must be \"production\" or \"development\"", basedOn)
+	}
+
+	// Validate enabledValidators and disabledValidators, reporting every unknown name
+	if unknown := r.UnknownValidators(profile.Spec.EnabledValidators); len(unknown) > 0 {
+		return fmt.Errorf("unknown validator(s) %s in enabledValidators", strings.Join(unknown, ", "))
+	}
+	if unknown := r.UnknownValidators(profile.Spec.DisabledValidators); len(unknown) > 0 {
+		return fmt.Errorf("unknown validator(s) %s in disabledValidators", strings.Join(unknown, ", "))
+	}
+
+	// Validate disabledChecks against the check catalog
+	for _, id := range profile.Spec.DisabledChecks {
+		if _, ok := r.LookupCheck(id); !ok {
+			return fmt.Errorf("unknown check ID %q in disabledChecks", id)
+		}
+	}
+
+	// Validate parameters against the schemas declared by the validators
+	resolved := profiles.FromAssessmentProfile(profile)
+	if err := r.ValidateParameters(resolved.Parameters); err != nil {
+		return fmt.Errorf("invalid parameters: %w", err)
+	}
+
+	// Validate scoring weights
+	if s := profile.Spec.Scoring; s != nil {
+		if err := negativeWeight("categoryWeights", s.CategoryWeights); err != nil {
+			return err
+		}
+		if err := negativeWeight("checkCriticality", s.CheckCriticality); err != nil {
+			return err
+		}
+		if err := negativeWeight("severityWeights", s.SeverityWeights); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+// UnknownValidators returns the quoted names that are not registered.
+func (r *Registry) UnknownValidators(names []string) []string {
+	var unknown []string
+	for _, name := range names {
+		if _, ok := r.Get(name); !ok {
+			unknown = append(unknown, strconv.Quote(name))
+		}
+	}
+	return unknown
+}
+
+// KnownCheckID reports whether a finding ID, possibly ending in "*", matches
+// a check in the catalog.
+func (r *Registry) KnownCheckID(id string) bool {
+	prefix, wildcard := strings.CutSuffix(id, "*")
+	if !wildcard {
+		_, ok := r.LookupCheck(id)
+		return ok
+	}
+	if _, ok := r.LookupCheck(prefix); ok {
+		return true
+	}
+	for _, meta := range r.Catalog() {
+		for _, c := range meta.Checks {
+			if strings.HasPrefix(c.ID, prefix) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// negativeWeight returns an error for the first negative weight in the map,
+// in key order.
+func negativeWeight(field string, weights map[string]int) error {
+	keys := make([]string, 0, len(weights))
+	for k := range weights {
+		keys = append(keys, k)
+	}
+	sort.Strings(keys)
+	for _, k := range keys {
+		if weights[k] < 0 {
+			return fmt.Errorf("scoring.%s[%q] must not be negative, got %d", field, k, weights[k])
+		}
+	}
+	return nil
+}
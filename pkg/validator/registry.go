@@ -18,11 +18,17 @@ package validator
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"sort"
+	"strings"
 	"sync"
+	"time"
 
 	assessmentv1alpha1 "github.com/openshift-assessment/cluster-assessment-operator/api/v1alpha1"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/clusterstate"
 	"github.com/openshift-assessment/cluster-assessment-operator/pkg/profiles"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 )
@@ -54,6 +60,18 @@ func (r *Registry) Register(v Validator) error {
 	return nil
 }
 
+// Unregister removes a validator from the registry and returns it, if it was
+// registered. It is used for validators that come and go at runtime, such as
+// plugins.
+func (r *Registry) Unregister(name string) (Validator, bool) {
+	r.mu.Lock()
+	defer r.mu.Unlock()
+
+	v, ok := r.validators[name]
+	delete(r.validators, name)
+	return v, ok
+}
+
 // Get retrieves a validator by name.
 func (r *Registry) Get(name string) (Validator, bool) {
 	r.mu.RLock()
@@ -87,18 +105,176 @@ func (r *Registry) Names() []string {
 	return names
 }
 
+// ForProfile returns the registered validators that run under the given
+// profile, after EnabledValidators and DisabledValidators are applied.
+func (r *Registry) ForProfile(profile profiles.Profile) []Validator {
+	return filterValidators(r.List(), profile)
+}
+
+// Metadata returns the metadata and check catalog of a registered validator.
+func (r *Registry) Metadata(name string) (ValidatorMetadata, bool) {
+	v, ok := r.Get(name)
+	if !ok {
+		return ValidatorMetadata{}, false
+	}
+	return metadataFor(v), true
+}
+
+// Catalog returns the metadata of all registered validators, sorted by name.
+func (r *Registry) Catalog() []ValidatorMetadata {
+	validators := r.List()
+	sort.Slice(validators, func(i, j int) bool {
+		return validators[i].Name() < validators[j].Name()
+	})
+
+	catalog := make([]ValidatorMetadata, 0, len(validators))
+	for _, v := range validators {
+		catalog = append(catalog, metadataFor(v))
+	}
+	return catalog
+}
+
+// LookupCheck finds the catalog entry for a finding ID across all validators.
+func (r *Registry) LookupCheck(id string) (Check, bool) {
+	for _, meta := range r.Catalog() {
+		for _, c := range meta.Checks {
+			if c.Matches(id) {
+				return c, true
+			}
+		}
+	}
+	return Check{}, false
+}
+
+// metadataFor builds the metadata for a validator. The Runner's synthetic
+// error, timeout and skipped checks, and the data-unavailable check reported
+// for must-gather archives lacking the validator's inputs, are always included.
+func metadataFor(v Validator) ValidatorMetadata {
+	var checks []Check
+	if p, ok := v.(CheckProvider); ok {
+		checks = append(checks, p.Checks()...)
+	}
+	checks = append(checks,
+		Check{
+			ID:            fmt.Sprintf("%s-error", v.Name()),
+			Title:         fmt.Sprintf("Validator %s encountered an error", v.Name()),
+			DefaultStatus: assessmentv1alpha1.FindingStatusError,
+			Severity:      assessmentv1alpha1.SeverityMedium,
+		},
+		Check{
+			ID:            fmt.Sprintf("%s-timeout", v.Name()),
+			Title:         fmt.Sprintf("Validator %s timed out", v.Name()),
+			DefaultStatus: assessmentv1alpha1.FindingStatusError,
+			Severity:      assessmentv1alpha1.SeverityMedium,
+		},
+		Check{
+			ID:            fmt.Sprintf("%s-skipped", v.Name()),
+			Title:         fmt.Sprintf("Validator %s skipped for missing permissions", v.Name()),
+			DefaultStatus: assessmentv1alpha1.FindingStatusSkipped,
+			Severity:      assessmentv1alpha1.SeverityMedium,
+		},
+		Check{
+			ID:            fmt.Sprintf("%s-data-unavailable", v.Name()),
+			Title:         fmt.Sprintf("Data unavailable for %s", v.Name()),
+			DefaultStatus: assessmentv1alpha1.FindingStatusInfo,
+			Severity:      assessmentv1alpha1.SeverityLow,
+		},
+	)
+	for i := range checks {
+		if checks[i].Category == "" {
+			checks[i].Category = v.Category()
+		}
+	}
+
+	supported := make([]string, 0, len(profiles.ListProfiles()))
+	for _, p := range profiles.ListProfiles() {
+		supported = append(supported, string(p))
+	}
+
+	var params []Parameter
+	if p, ok := v.(ParameterProvider); ok {
+		params = p.Parameters()
+	}
+
+	var perms []Permission
+	if p, ok := v.(PermissionProvider); ok {
+		perms = p.RequiredPermissions()
+	}
+
+	return ValidatorMetadata{
+		Name:              v.Name(),
+		Description:       v.Description(),
+		Category:          v.Category(),
+		SupportedProfiles: supported,
+		CheckCount:        len(checks),
+		Checks:            checks,
+		Parameters:        params,
+		Permissions:       perms,
+	}
+}
+
+// Default runner settings, used when the corresponding RunnerOption is not given.
+const (
+	// DefaultConcurrency is the number of validators executed in parallel.
+	DefaultConcurrency = 4
+
+	// DefaultValidatorTimeout bounds the runtime of a single validator.
+	DefaultValidatorTimeout = 2 * time.Minute
+)
+
 // Runner executes validators and collects findings.
 type Runner struct {
-	registry *Registry
-	client   client.Client
+	registry    *Registry
+	client      client.Client
+	concurrency int
+	timeout     time.Duration
+	state       *clusterstate.State
+	permissions PermissionChecker
+}
+
+// RunnerOption configures a Runner.
+type RunnerOption func(*Runner)
+
+// WithConcurrency sets the maximum number of validators running at the same time.
+// Values below 1 are ignored.
+func WithConcurrency(n int) RunnerOption {
+	return func(r *Runner) {
+		if n > 0 {
+			r.concurrency = n
+		}
+	}
+}
+
+// WithValidatorTimeout sets the deadline applied to each individual validator.
+// Values of zero or below are ignored.
+func WithValidatorTimeout(d time.Duration) RunnerOption {
+	return func(r *Runner) {
+		if d > 0 {
+			r.timeout = d
+		}
+	}
+}
+
+// WithClusterState sets the shared cluster state handed to validators. By
+// default each Run collects a new state through the runner's client.
+func WithClusterState(s *clusterstate.State) RunnerOption {
+	return func(r *Runner) {
+		r.state = s
+	}
 }
 
 // NewRunner creates a new validator runner.
-func NewRunner(registry *Registry, client client.Client) *Runner {
-	return &Runner{
-		registry: registry,
-		client:   client,
+func NewRunner(registry *Registry, client client.Client, opts ...RunnerOption) *Runner {
+	r := &Runner{
+		registry:    registry,
+		client:      client,
+		concurrency: DefaultConcurrency,
+		timeout:     DefaultValidatorTimeout,
+	}
+	for _, opt := range opts {
+		opt(r)
 	}
+	return r
 }
 
 // RunAll executes all registered validators.
@@ -107,11 +283,24 @@ func (r *Runner) RunAll(ctx context.Context, profile profiles.Profile) ([]assess
 }
 
 // Run executes the specified validators (or all if validatorNames is empty).
-// Validators are further filtered by the profile's EnabledValidators and DisabledChecks.
+// Validators are further filtered by the profile's EnabledValidators,
+// DisabledValidators and DisabledChecks.
+//
+// Validators run on a bounded worker pool, each under its own deadline. Findings
+// are returned grouped by validator in name order, regardless of completion order.
 func (r *Runner) Run(ctx context.Context, profile profiles.Profile, validatorNames []string) ([]assessmentv1alpha1.Finding, error) {
+	findings, _, err := r.RunWithStatus(ctx, profile, validatorNames)
+	return findings, err
+}
+
+// RunWithStatus is like Run, and also reports the outcome, duration, error and
+// finding counts of each validator, in name order. Requested validators that
+// are not registered are reported as Skipped.
+func (r *Runner) RunWithStatus(ctx context.Context, profile profiles.Profile, validatorNames []string) ([]assessmentv1alpha1.Finding, []assessmentv1alpha1.ValidatorRunStatus, error) {
 	logger := log.FromContext(ctx)
 
 	var validators []Validator
+	var skipped []assessmentv1alpha1.ValidatorRunStatus
 	if len(validatorNames) == 0 {
 		validators = r.registry.List()
 	} else {
@@ -119,6 +308,11 @@ func (r *Runner) Run(ctx context.Context, profile profiles.Profile, validatorNam
 			v, ok := r.registry.Get(name)
 			if !ok {
 				logger.Info("Validator not found, skipping", "validator", name)
+				skipped = append(skipped, assessmentv1alpha1.ValidatorRunStatus{
+					Name:    name,
+					Outcome: assessmentv1alpha1.ValidatorSkipped,
+					Error:   "validator is not registered",
+				})
 				continue
 			}
 			validators = append(validators, v)
@@ -128,67 +322,286 @@ func (r *Runner) Run(ctx context.Context, profile profiles.Profile, validatorNam
 	// Apply profile-level validator filtering
 	validators = filterValidators(validators, profile)
 
+	// Sort by name so the output order is stable across runs
+	sort.Slice(validators, func(i, j int) bool {
+		return validators[i].Name() < validators[j].Name()
+	})
+
 	// Build disabled checks set for finding-level filtering
 	disabledChecks := make(map[string]bool, len(profile.DisabledChecks))
 	for _, id := range profile.DisabledChecks {
 		disabledChecks[id] = true
 	}
 
+	// Validators share one cluster state so common resource types are listed once
+	state := r.state
+	if state == nil {
+		state = clusterstate.New(r.client)
+	}
+	ctx = clusterstate.NewContext(ctx, state)
+
+	// Each worker writes only to its own slot, so no locking is needed
+	results := make([][]assessmentv1alpha1.Finding, len(validators))
+	statuses := make([]assessmentv1alpha1.ValidatorRunStatus, len(validators))
+	sem := make(chan struct{}, r.concurrency)
+	var wg sync.WaitGroup
+
+	for i, v := range validators {
+		select {
+		case sem <- struct{}{}:
+		case <-ctx.Done():
+			wg.Wait()
+			return nil, nil, ctx.Err()
+		}
+
+		wg.Add(1)
+		go func(i int, v Validator) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			findings, status := r.runValidator(ctx, v, profile)
+			findings = assignSeverity(v, findings)
+
+			// Filter out disabled checks
+			if len(disabledChecks) > 0 {
+				filtered := findings[:0]
+				for _, f := range findings {
+					if !disabledChecks[f.ID] {
+						filtered = append(filtered, f)
+					}
+				}
+				findings = filtered
+			}
+
+			results[i] = findings
+			countFindings(&status, findings)
+			statuses[i] = status
+		}(i, v)
+	}
+	wg.Wait()
+
+	if err := ctx.Err(); err != nil {
+		return nil, nil, err
+	}
+
 	var allFindings []assessmentv1alpha1.Finding
+	for _, findings := range results {
+		allFindings = append(allFindings, findings...)
+	}
 
-	for _, v := range validators {
-		logger.Info("Running validator", "validator", v.Name(), "category", v.Category())
-
-		findings, err := v.Validate(ctx, r.client, profile)
-		if err != nil {
-			// Log error but continue with other validators
-			logger.Error(err, "Validator failed", "validator", v.Name())
-			// Add a finding for the failed validator
-			allFindings = append(allFindings, assessmentv1alpha1.Finding{
-				ID:          fmt.Sprintf("%s-error", v.Name()),
-				Validator:   v.Name(),
-				Category:    v.Category(),
-				Status:      assessmentv1alpha1.FindingStatusFail,
-				Title:       fmt.Sprintf("Validator %s encountered an error", v.Name()),
-				Description: fmt.Sprintf("The validator failed to complete: %v", err),
-				Impact:      "Assessment results for this validator are incomplete.",
-			})
-			continue
+	if len(skipped) > 0 {
+		statuses = append(statuses, skipped...)
+		sort.SliceStable(statuses, func(i, j int) bool {
+			return statuses[i].Name < statuses[j].Name
+		})
+	}
+
+	return allFindings, statuses, nil
+}
+
+// countFindings records the number of findings by status in the validator status.
+func countFindings(status *assessmentv1alpha1.ValidatorRunStatus, findings []assessmentv1alpha1.Finding) {
+	status.FindingCount = len(findings)
+	for _, f := range findings {
+		switch f.Status {
+		case assessmentv1alpha1.FindingStatusPass:
+			status.PassCount++
+		case assessmentv1alpha1.FindingStatusWarn:
+			status.WarnCount++
+		case assessmentv1alpha1.FindingStatusFail:
+			status.FailCount++
+		case assessmentv1alpha1.FindingStatusInfo:
+			status.InfoCount++
+		case assessmentv1alpha1.FindingStatusNotApplicable:
+			status.NotApplicableCount++
+		case assessmentv1alpha1.FindingStatusError:
+			status.ErrorCount++
+		case assessmentv1alpha1.FindingStatusSkipped:
+			status.SkippedCount++
 		}
+	}
+}
 
-		// Filter out disabled checks
-		if len(disabledChecks) > 0 {
-			filtered := findings[:0]
-			for _, f := range findings {
-				if !disabledChecks[f.ID] {
-					filtered = append(filtered, f)
-				}
+// assignSeverity sets the severity of findings that do not carry one, using
+// the validator's check catalog and falling back to the finding status.
+func assignSeverity(v Validator, findings []assessmentv1alpha1.Finding) []assessmentv1alpha1.Finding {
+	var checks []Check
+	for i := range findings {
+		if findings[i].Severity != "" {
+			continue
+		}
+		if checks == nil {
+			checks = metadataFor(v).Checks
+		}
+		findings[i].Severity = DefaultSeverity(findings[i].Status)
+		for _, c := range checks {
+			if c.Severity != "" && c.Matches(findings[i].ID) {
+				findings[i].Severity = c.Severity
+				break
 			}
-			findings = filtered
 		}
+	}
+	return findings
+}
 
-		allFindings = append(allFindings, findings...)
-		logger.Info("Validator completed", "validator", v.Name(), "findings", len(findings))
+// DefaultSeverity returns the severity used for findings whose check does not
+// declare one: high for FAIL, medium for WARN and low otherwise.
+func DefaultSeverity(status assessmentv1alpha1.FindingStatus) assessmentv1alpha1.FindingSeverity {
+	switch status {
+	case assessmentv1alpha1.FindingStatusFail:
+		return assessmentv1alpha1.SeverityHigh
+	case assessmentv1alpha1.FindingStatusWarn:
+		return assessmentv1alpha1.SeverityMedium
+	default:
+		return assessmentv1alpha1.SeverityLow
 	}
+}
+
+// validatorNameKey is the context key under which the running validator's name is stored.
+type validatorNameKey struct{}
 
-	return allFindings, nil
+// WithValidatorName returns a context carrying the name of the validator using it.
+// The Runner sets it for every Validate call so clients can attribute API requests.
+func WithValidatorName(ctx context.Context, name string) context.Context {
+	return context.WithValue(ctx, validatorNameKey{}, name)
 }
 
-// filterValidators applies profile-level EnabledValidators filtering.
-// If EnabledValidators is set, only those validators run.
+// ValidatorNameFromContext returns the validator name stored by WithValidatorName, if any.
+func ValidatorNameFromContext(ctx context.Context) string {
+	name, _ := ctx.Value(validatorNameKey{}).(string)
+	return name
+}
+
+// validatorResult carries the outcome of a single Validate call.
+type validatorResult struct {
+	findings []assessmentv1alpha1.Finding
+	err      error
+}
+
+// runValidator executes one validator under the runner's per-validator timeout.
+// Errors and timeouts are converted into synthetic findings so that a single
+// misbehaving validator never aborts or blocks the whole assessment, and a
+// validator lacking permissions is skipped with a SKIPPED finding. The
+// returned status records the outcome, runtime and error; its finding counts
+// are left to the caller.
+func (r *Runner) runValidator(ctx context.Context, v Validator, profile profiles.Profile) ([]assessmentv1alpha1.Finding, assessmentv1alpha1.ValidatorRunStatus) {
+	logger := log.FromContext(ctx)
+	logger.Info("Running validator", "validator", v.Name(), "category", v.Category())
+	start := time.Now()
+	status := assessmentv1alpha1.ValidatorRunStatus{Name: v.Name()}
+
+	missing, err := r.missingPermissions(ctx, v)
+	if err != nil {
+		// Run the validator anyway; denied requests surface as its own errors
+		logger.Error(err, "Failed to check validator permissions", "validator", v.Name())
+	} else if len(missing) > 0 {
+		names := permissionStrings(missing)
+		logger.Info("Validator lacks permissions, skipping", "validator", v.Name(), "missing", names)
+		status.Duration = metav1.Duration{Duration: time.Since(start)}
+		status.Outcome = assessmentv1alpha1.ValidatorSkipped
+		status.Error = "missing permissions: " + strings.Join(names, ", ")
+		status.MissingPermissions = names
+		return []assessmentv1alpha1.Finding{skippedFinding(v, names)}, status
+	}
+
+	vctx, cancel := context.WithTimeout(WithValidatorName(ctx, v.Name()), r.timeout)
+	defer cancel()
+
+	// Buffered so the goroutine can finish and exit even after we stop
+	// waiting; cancelling vctx aborts the API calls it is blocked on
+	done := make(chan validatorResult, 1)
+	go func() {
+		findings, err := v.Validate(vctx, r.client, profile)
+		done <- validatorResult{findings: findings, err: err}
+	}()
+
+	var res validatorResult
+	select {
+	case res = <-done:
+	case <-vctx.Done():
+		res = validatorResult{err: vctx.Err()}
+	}
+
+	status.Duration = metav1.Duration{Duration: time.Since(start)}
+
+	if res.err != nil && errors.Is(vctx.Err(), context.DeadlineExceeded) {
+		logger.Info("Validator timed out", "validator", v.Name(), "timeout", r.timeout)
+		status.Outcome = assessmentv1alpha1.ValidatorTimedOut
+		status.Error = fmt.Sprintf("timed out after %s", r.timeout)
+		return []assessmentv1alpha1.Finding{timeoutFinding(v, r.timeout)}, status
+	}
+
+	if res.err != nil {
+		// Log error but continue with other validators
+		logger.Error(res.err, "Validator failed", "validator", v.Name())
+		status.Outcome = assessmentv1alpha1.ValidatorFailed
+		status.Error = res.err.Error()
+		return []assessmentv1alpha1.Finding{errorFinding(v, res.err)}, status
+	}
+
+	logger.Info("Validator completed", "validator", v.Name(), "findings", len(res.findings))
+	status.Outcome = assessmentv1alpha1.ValidatorSucceeded
+	return res.findings, status
+}
+
+// errorFinding builds the finding reported for a validator that returned an error.
+func errorFinding(v Validator, err error) assessmentv1alpha1.Finding {
+	return assessmentv1alpha1.Finding{
+		ID:          fmt.Sprintf("%s-error", v.Name()),
+		Validator:   v.Name(),
+		Category:    v.Category(),
+		Status:      assessmentv1alpha1.FindingStatusError,
+		Title:       fmt.Sprintf("Validator %s encountered an error", v.Name()),
+		Description: fmt.Sprintf("The validator failed to complete: %v", err),
+		Impact:      "Assessment results for this validator are incomplete.",
+	}
+}
+
+// timeoutFinding builds the finding reported for a validator that exceeded its deadline.
+func timeoutFinding(v Validator, timeout time.Duration) assessmentv1alpha1.Finding {
+	return assessmentv1alpha1.Finding{
+		ID:             fmt.Sprintf("%s-timeout", v.Name()),
+		Validator:      v.Name(),
+		Category:       v.Category(),
+		Status:         assessmentv1alpha1.FindingStatusError,
+		Title:          fmt.Sprintf("Validator %s timed out", v.Name()),
+		Description:    fmt.Sprintf("The validator did not complete within %s and was cancelled.", timeout),
+		Impact:         "Assessment results for this validator are missing.",
+		Recommendation: "Check API server responsiveness or increase the operator's --validator-timeout setting.",
+	}
+}
+
+// filterValidators applies profile-level validator filtering.
+// If EnabledValidators is set, only those validators run and DisabledValidators
+// is ignored; otherwise every validator not in DisabledValidators runs.
 func filterValidators(validators []Validator, profile profiles.Profile) []Validator {
-	if len(profile.EnabledValidators) == 0 {
+	if len(profile.EnabledValidators) > 0 {
+		enabledSet := make(map[string]bool, len(profile.EnabledValidators))
+		for _, name := range profile.EnabledValidators {
+			enabledSet[name] = true
+		}
+
+		filtered := validators[:0]
+		for _, v := range validators {
+			if enabledSet[v.Name()] {
+				filtered = append(filtered, v)
+			}
+		}
+		return filtered
+	}
+
+	if len(profile.DisabledValidators) == 0 {
 		return validators
 	}
 
-	enabledSet := make(map[string]bool, len(profile.EnabledValidators))
-	for _, name := range profile.EnabledValidators {
-		enabledSet[name] = true
+	disabledSet := make(map[string]bool, len(profile.DisabledValidators))
+	for _, name := range profile.DisabledValidators {
+		disabledSet[name] = true
 	}
 
 	filtered := validators[:0]
 	for _, v := range validators {
-		if enabledSet[v.Name()] {
+		if !disabledSet[v.Name()] {
 			filtered = append(filtered, v)
 		}
 	}
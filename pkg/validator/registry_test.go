@@ -0,0 +1,470 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validator
+
+import (
+	"context"
+	"errors"
+	"sync/atomic"
+	"testing"
+	"time"
+
+	corev1 "k8s.io/api/core/v1"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	assessmentv1alpha1 "github.com/openshift-assessment/cluster-assessment-operator/api/v1alpha1"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/profiles"
+)
+
+// fakeValidator is a configurable Validator for runner tests.
+type fakeValidator struct {
+	name      string
+	delay     time.Duration
+	err       error
+	ignoreCtx bool // keep sleeping even after the context is cancelled
+
+	running *int32
+	peak    *int32
+}
+
+func (f *fakeValidator) Name() string        { return f.name }
+func (f *fakeValidator) Description() string { return "fake validator " + f.name }
+func (f *fakeValidator) Category() string    { return "Test" }
+
+func (f *fakeValidator) Validate(ctx context.Context, _ client.Client, _ profiles.Profile) ([]assessmentv1alpha1.Finding, error) {
+	if f.running != nil {
+		n := atomic.AddInt32(f.running, 1)
+		defer atomic.AddInt32(f.running, -1)
+		for {
+			p := atomic.LoadInt32(f.peak)
+			if n <= p || atomic.CompareAndSwapInt32(f.peak, p, n) {
+				break
+			}
+		}
+	}
+
+	if f.delay > 0 {
+		if f.ignoreCtx {
+			time.Sleep(f.delay)
+		} else {
+			select {
+			case <-time.After(f.delay):
+			case <-ctx.Done():
+				return nil, ctx.Err()
+			}
+		}
+	}
+
+	if f.err != nil {
+		return nil, f.err
+	}
+	return []assessmentv1alpha1.Finding{
+		{ID: f.name + "-check", Validator: f.name, Status: assessmentv1alpha1.FindingStatusPass},
+	}, nil
+}
+
+func newTestRegistry(t *testing.T, validators ...Validator) *Registry {
+	t.Helper()
+	reg := NewRegistry()
+	for _, v := range validators {
+		if err := reg.Register(v); err != nil {
+			t.Fatalf("Register(%s): %v", v.Name(), err)
+		}
+	}
+	return reg
+}
+
+func TestRunner_DeterministicOrder(t *testing.T) {
+	// Later names finish first so completion order differs from name order
+	reg := newTestRegistry(t,
+		&fakeValidator{name: "alpha", delay: 30 * time.Millisecond},
+		&fakeValidator{name: "bravo", delay: 20 * time.Millisecond},
+		&fakeValidator{name: "charlie", delay: 10 * time.Millisecond},
+		&fakeValidator{name: "delta"},
+	)
+	runner := NewRunner(reg, nil, WithConcurrency(4))
+
+	findings, err := runner.RunAll(context.Background(), profiles.GetProfile("production"))
+	if err != nil {
+		t.Fatalf("RunAll returned error: %v", err)
+	}
+
+	want := []string{"alpha-check", "bravo-check", "charlie-check", "delta-check"}
+	if len(findings) != len(want) {
+		t.Fatalf("Expected %d findings, got %d", len(want), len(findings))
+	}
+	for i, id := range want {
+		if findings[i].ID != id {
+			t.Errorf("findings[%d].ID = %q, want %q", i, findings[i].ID, id)
+		}
+	}
+}
+
+func TestRunner_RespectsConcurrencyLimit(t *testing.T) {
+	var running, peak int32
+	var validators []Validator
+	for _, name := range []string{"a", "b", "c", "d", "e", "f"} {
+		validators = append(validators, &fakeValidator{
+			name: name, delay: 20 * time.Millisecond, running: &running, peak: &peak,
+		})
+	}
+	runner := NewRunner(newTestRegistry(t, validators...), nil, WithConcurrency(2))
+
+	if _, err := runner.RunAll(context.Background(), profiles.GetProfile("production")); err != nil {
+		t.Fatalf("RunAll returned error: %v", err)
+	}
+	if peak > 2 {
+		t.Errorf("Expected at most 2 concurrent validators, observed %d", peak)
+	}
+}
+
+func TestRunner_TimeoutProducesTimeoutFinding(t *testing.T) {
+	tests := []struct {
+		name      string
+		ignoreCtx bool
+	}{
+		{name: "validator honours context", ignoreCtx: false},
+		{name: "validator ignores context", ignoreCtx: true},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := newTestRegistry(t,
+				&fakeValidator{name: "fast"},
+				&fakeValidator{name: "slow", delay: time.Second, ignoreCtx: tt.ignoreCtx},
+			)
+			runner := NewRunner(reg, nil, WithValidatorTimeout(50*time.Millisecond))
+
+			start := time.Now()
+			findings, err := runner.RunAll(context.Background(), profiles.GetProfile("production"))
+			if err != nil {
+				t.Fatalf("RunAll returned error: %v", err)
+			}
+			if elapsed := time.Since(start); elapsed > 500*time.Millisecond {
+				t.Errorf("Runner blocked on slow validator for %s", elapsed)
+			}
+
+			if len(findings) != 2 {
+				t.Fatalf("Expected 2 findings, got %d", len(findings))
+			}
+			if findings[0].ID != "fast-check" {
+				t.Errorf("Expected fast-check first, got %q", findings[0].ID)
+			}
+			if findings[1].ID != "slow-timeout" {
+				t.Errorf("Expected slow-timeout finding, got %q", findings[1].ID)
+			}
+		})
+	}
+}
+
+// listingValidator lists Namespaces and closes exited once Validate returns.
+type listingValidator struct {
+	exited chan error
+}
+
+func (v *listingValidator) Name() string        { return "listing" }
+func (v *listingValidator) Description() string { return "lists namespaces" }
+func (v *listingValidator) Category() string    { return "Test" }
+
+func (v *listingValidator) Validate(ctx context.Context, c client.Client, _ profiles.Profile) ([]assessmentv1alpha1.Finding, error) {
+	err := c.List(ctx, &corev1.NamespaceList{})
+	v.exited <- err
+	return nil, err
+}
+
+func TestRunner_TimeoutCancelsValidatorCalls(t *testing.T) {
+	// The API call blocks until its context is cancelled, like a request to
+	// an unresponsive API server
+	c := fake.NewClientBuilder().WithInterceptorFuncs(interceptor.Funcs{
+		List: func(ctx context.Context, _ client.WithWatch, _ client.ObjectList, _ ...client.ListOption) error {
+			<-ctx.Done()
+			return ctx.Err()
+		},
+	}).Build()
+	v := &listingValidator{exited: make(chan error, 1)}
+	runner := NewRunner(newTestRegistry(t, v), c, WithValidatorTimeout(50*time.Millisecond))
+
+	findings, err := runner.RunAll(context.Background(), profiles.GetProfile("production"))
+	if err != nil {
+		t.Fatalf("RunAll returned error: %v", err)
+	}
+	if len(findings) != 1 || findings[0].ID != "listing-timeout" {
+		t.Fatalf("Expected a listing-timeout finding, got %+v", findings)
+	}
+
+	select {
+	case err := <-v.exited:
+		if !errors.Is(err, context.DeadlineExceeded) {
+			t.Errorf("Expected the API call to fail with the deadline, got %v", err)
+		}
+	case <-time.After(time.Second):
+		t.Fatal("Validator goroutine still running after the timeout")
+	}
+}
+
+func TestRunner_ErrorProducesErrorFinding(t *testing.T) {
+	reg := newTestRegistry(t, &fakeValidator{name: "broken", err: errors.New("boom")})
+	runner := NewRunner(reg, nil)
+
+	findings, err := runner.RunAll(context.Background(), profiles.GetProfile("production"))
+	if err != nil {
+		t.Fatalf("RunAll returned error: %v", err)
+	}
+	if len(findings) != 1 || findings[0].ID != "broken-error" {
+		t.Fatalf("Expected a single broken-error finding, got %+v", findings)
+	}
+	if findings[0].Status != assessmentv1alpha1.FindingStatusError {
+		t.Errorf("Expected ERROR status, got %s", findings[0].Status)
+	}
+}
+
+func TestRunner_RunWithStatus(t *testing.T) {
+	reg := newTestRegistry(t,
+		&fakeValidator{name: "broken", err: errors.New("boom")},
+		&fakeValidator{name: "fast"},
+		&fakeValidator{name: "slow", delay: time.Second},
+	)
+	runner := NewRunner(reg, nil, WithValidatorTimeout(50*time.Millisecond))
+
+	findings, statuses, err := runner.RunWithStatus(context.Background(), profiles.GetProfile("production"), nil)
+	if err != nil {
+		t.Fatalf("RunWithStatus returned error: %v", err)
+	}
+	if len(findings) != 3 || len(statuses) != 3 {
+		t.Fatalf("Expected 3 findings and 3 statuses, got %d and %d", len(findings), len(statuses))
+	}
+
+	for i, want := range []struct {
+		name    string
+		outcome assessmentv1alpha1.ValidatorOutcome
+		error   string
+	}{
+		{name: "broken", outcome: assessmentv1alpha1.ValidatorFailed, error: "boom"},
+		{name: "fast", outcome: assessmentv1alpha1.ValidatorSucceeded},
+		{name: "slow", outcome: assessmentv1alpha1.ValidatorTimedOut, error: "timed out after 50ms"},
+	} {
+		s := statuses[i]
+		if s.Name != want.name || s.Outcome != want.outcome || s.Error != want.error || s.FindingCount != 1 {
+			t.Errorf("Status %d: expected %s %s with error %q and 1 finding, got %+v", i, want.name, want.outcome, want.error, s)
+		}
+	}
+	if statuses[0].ErrorCount != 1 || statuses[1].PassCount != 1 || statuses[2].ErrorCount != 1 {
+		t.Errorf("Expected finding counts by status, got %+v", statuses)
+	}
+	if statuses[2].Duration.Duration < 50*time.Millisecond {
+		t.Errorf("Expected slow validator duration to cover the timeout, got %s", statuses[2].Duration.Duration)
+	}
+}
+
+func TestRunner_RunWithStatus_SkipsUnknownValidators(t *testing.T) {
+	reg := newTestRegistry(t, &fakeValidator{name: "fast"})
+	runner := NewRunner(reg, nil)
+
+	findings, statuses, err := runner.RunWithStatus(context.Background(), profiles.GetProfile("production"), []string{"missing", "fast"})
+	if err != nil {
+		t.Fatalf("RunWithStatus returned error: %v", err)
+	}
+	if len(findings) != 1 || len(statuses) != 2 {
+		t.Fatalf("Expected 1 finding and 2 statuses, got %d and %d", len(findings), len(statuses))
+	}
+	if statuses[0].Name != "fast" || statuses[0].Outcome != assessmentv1alpha1.ValidatorSucceeded {
+		t.Errorf("Expected fast to succeed, got %+v", statuses[0])
+	}
+	if statuses[1].Name != "missing" || statuses[1].Outcome != assessmentv1alpha1.ValidatorSkipped || statuses[1].Ran() {
+		t.Errorf("Expected missing to be skipped, got %+v", statuses[1])
+	}
+}
+
+func TestRunner_ParentContextCancelled(t *testing.T) {
+	reg := newTestRegistry(t, &fakeValidator{name: "slow", delay: time.Second})
+	runner := NewRunner(reg, nil)
+
+	ctx, cancel := context.WithCancel(context.Background())
+	cancel()
+
+	if _, err := runner.RunAll(ctx, profiles.GetProfile("production")); !errors.Is(err, context.Canceled) {
+		t.Errorf("Expected context.Canceled, got %v", err)
+	}
+}
+
+// catalogValidator is a fakeValidator that publishes a check catalog.
+type catalogValidator struct {
+	fakeValidator
+}
+
+func (c *catalogValidator) Checks() []Check {
+	return []Check{
+		{ID: c.name + "-ok", Title: "OK", DefaultStatus: assessmentv1alpha1.FindingStatusPass},
+		{ID: c.name + "-expired-*", Title: "Expired", DefaultStatus: assessmentv1alpha1.FindingStatusFail},
+	}
+}
+
+func TestCheck_Matches(t *testing.T) {
+	exact := Check{ID: "nodes-ready"}
+	if !exact.Matches("nodes-ready") || exact.Matches("nodes-ready-2") {
+		t.Error("Exact check ID should match only itself")
+	}
+
+	family := Check{ID: "certificates-expired-*"}
+	if !family.Matches("certificates-expired-router-certs") {
+		t.Error("Wildcard check ID should match IDs with its prefix")
+	}
+	if family.Matches("certificates-expiring-router-certs") {
+		t.Error("Wildcard check ID should not match other prefixes")
+	}
+}
+
+func TestRegistry_Catalog(t *testing.T) {
+	reg := newTestRegistry(t,
+		&catalogValidator{fakeValidator{name: "zulu"}},
+		&fakeValidator{name: "alpha"},
+	)
+
+	catalog := reg.Catalog()
+	if len(catalog) != 2 || catalog[0].Name != "alpha" || catalog[1].Name != "zulu" {
+		t.Fatalf("Expected catalog sorted by name, got %+v", catalog)
+	}
+
+	// Validators without a catalog still expose the runner's synthetic checks
+	if catalog[0].CheckCount != 4 {
+		t.Errorf("Expected 4 synthetic checks for alpha, got %d", catalog[0].CheckCount)
+	}
+	if catalog[1].CheckCount != 6 {
+		t.Errorf("Expected 6 checks for zulu, got %d", catalog[1].CheckCount)
+	}
+	for _, c := range catalog[1].Checks {
+		if c.Category != "Test" {
+			t.Errorf("Expected check %s to inherit validator category, got %q", c.ID, c.Category)
+		}
+	}
+	if len(catalog[0].SupportedProfiles) == 0 {
+		t.Error("Expected supported profiles to be populated")
+	}
+}
+
+func TestRegistry_LookupCheck(t *testing.T) {
+	reg := newTestRegistry(t, &catalogValidator{fakeValidator{name: "certs"}})
+
+	for _, id := range []string{"certs-ok", "certs-expired-router", "certs-error", "certs-timeout", "certs-skipped", "certs-data-unavailable"} {
+		if _, ok := reg.LookupCheck(id); !ok {
+			t.Errorf("Expected check %q to be found", id)
+		}
+	}
+	if _, ok := reg.LookupCheck("certs-unknown"); ok {
+		t.Error("Expected unknown check ID not to be found")
+	}
+}
+
+func TestRegistry_Unregister(t *testing.T) {
+	reg := newTestRegistry(t, &fakeValidator{name: "plugin"})
+
+	if v, ok := reg.Unregister("plugin"); !ok || v.Name() != "plugin" {
+		t.Fatalf("Expected the registered validator to be returned, got %v, %v", v, ok)
+	}
+	if _, ok := reg.Get("plugin"); ok {
+		t.Error("Expected the validator to be removed")
+	}
+	if _, ok := reg.Unregister("plugin"); ok {
+		t.Error("Expected unregistering an unknown validator to report false")
+	}
+	if err := reg.Register(&fakeValidator{name: "plugin"}); err != nil {
+		t.Errorf("Expected the name to be reusable, got %v", err)
+	}
+}
+
+func TestRunner_ProfileValidatorFiltering(t *testing.T) {
+	tests := []struct {
+		name    string
+		enabled []string
+		disable []string
+		want    []string
+	}{
+		{name: "no filter", want: []string{"alpha-check", "bravo-check", "charlie-check"}},
+		{name: "disabled validators", disable: []string{"bravo"}, want: []string{"alpha-check", "charlie-check"}},
+		{name: "enabled takes precedence", enabled: []string{"bravo"}, disable: []string{"bravo"}, want: []string{"bravo-check"}},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			reg := newTestRegistry(t,
+				&fakeValidator{name: "alpha"},
+				&fakeValidator{name: "bravo"},
+				&fakeValidator{name: "charlie"},
+			)
+			profile := profiles.GetProfile("production")
+			profile.EnabledValidators = tt.enabled
+			profile.DisabledValidators = tt.disable
+
+			findings, err := NewRunner(reg, nil).RunAll(context.Background(), profile)
+			if err != nil {
+				t.Fatalf("RunAll returned error: %v", err)
+			}
+			if len(findings) != len(tt.want) {
+				t.Fatalf("Expected %d findings, got %+v", len(tt.want), findings)
+			}
+			for i, id := range tt.want {
+				if findings[i].ID != id {
+					t.Errorf("findings[%d].ID = %q, want %q", i, findings[i].ID, id)
+				}
+			}
+			if got := len(reg.ForProfile(profile)); got != len(tt.want) {
+				t.Errorf("ForProfile returned %d validators, want %d", got, len(tt.want))
+			}
+		})
+	}
+}
+
+// severityValidator emits findings covered by its catalog and one that is not.
+type severityValidator struct {
+	fakeValidator
+}
+
+func (s *severityValidator) Checks() []Check {
+	return []Check{
+		{ID: "sev-expired-*", Title: "Expired", DefaultStatus: assessmentv1alpha1.FindingStatusFail, Severity: assessmentv1alpha1.SeverityCritical},
+	}
+}
+
+func (s *severityValidator) Validate(_ context.Context, _ client.Client, _ profiles.Profile) ([]assessmentv1alpha1.Finding, error) {
+	return []assessmentv1alpha1.Finding{
+		{ID: "sev-expired-router", Status: assessmentv1alpha1.FindingStatusFail},
+		{ID: "sev-uncatalogued", Status: assessmentv1alpha1.FindingStatusWarn},
+		{ID: "sev-explicit", Status: assessmentv1alpha1.FindingStatusFail, Severity: assessmentv1alpha1.SeverityLow},
+	}, nil
+}
+
+func TestRunner_AssignsSeverity(t *testing.T) {
+	reg := newTestRegistry(t, &severityValidator{fakeValidator{name: "sev"}})
+
+	findings, err := NewRunner(reg, nil).RunAll(context.Background(), profiles.GetProfile("production"))
+	if err != nil {
+		t.Fatalf("RunAll returned error: %v", err)
+	}
+
+	want := []assessmentv1alpha1.FindingSeverity{
+		assessmentv1alpha1.SeverityCritical, // from the catalog
+		assessmentv1alpha1.SeverityMedium,   // derived from WARN status
+		assessmentv1alpha1.SeverityLow,      // set by the validator
+	}
+	for i, sev := range want {
+		if findings[i].Severity != sev {
+			t.Errorf("%s: severity = %q, want %q", findings[i].ID, findings[i].Severity, sev)
+		}
+	}
+}
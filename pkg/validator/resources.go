@@ -0,0 +1,44 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package validator
+
+import (
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	assessmentv1alpha1 "github.com/openshift-assessment/cluster-assessment-operator/api/v1alpha1"
+)
+
+// ResourceRef returns an AffectedResource referencing obj. The kind is passed
+// explicitly because objects read through a typed client have no TypeMeta.
+func ResourceRef(kind string, obj metav1.Object) assessmentv1alpha1.AffectedResource {
+	return assessmentv1alpha1.AffectedResource{
+		Kind:      kind,
+		Namespace: obj.GetNamespace(),
+		Name:      obj.GetName(),
+		UID:       string(obj.GetUID()),
+	}
+}
+
+// IsNotInstalled reports whether err, returned when reading a resource, means
+// the cluster does not serve its API, typically because the operator that
+// provides the CRD is not installed. Checks that depend on such an API are
+// NOT_APPLICABLE rather than failing; other errors leave them inconclusive.
+func IsNotInstalled(err error) bool {
+	return meta.IsNoMatchError(err) || apierrors.IsNotFound(err)
+}
@@ -0,0 +1,47 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package all imports every built-in validator so that each registers itself
+// with the default validator registry.
+package all
+
+import (
+	_ "github.com/openshift-assessment/cluster-assessment-operator/pkg/validators/apiserver"
+	_ "github.com/openshift-assessment/cluster-assessment-operator/pkg/validators/certificates"
+	_ "github.com/openshift-assessment/cluster-assessment-operator/pkg/validators/clusterautoscaler"
+	_ "github.com/openshift-assessment/cluster-assessment-operator/pkg/validators/compliance"
+	_ "github.com/openshift-assessment/cluster-assessment-operator/pkg/validators/costoptimization"
+	_ "github.com/openshift-assessment/cluster-assessment-operator/pkg/validators/customchecks"
+	_ "github.com/openshift-assessment/cluster-assessment-operator/pkg/validators/deprecation"
+	_ "github.com/openshift-assessment/cluster-assessment-operator/pkg/validators/etcdbackup"
+	_ "github.com/openshift-assessment/cluster-assessment-operator/pkg/validators/imageregistry"
+	_ "github.com/openshift-assessment/cluster-assessment-operator/pkg/validators/ingresstls"
+	_ "github.com/openshift-assessment/cluster-assessment-operator/pkg/validators/logging"
+	_ "github.com/openshift-assessment/cluster-assessment-operator/pkg/validators/machineconfig"
+	_ "github.com/openshift-assessment/cluster-assessment-operator/pkg/validators/monitoring"
+	_ "github.com/openshift-assessment/cluster-assessment-operator/pkg/validators/networking"
+	_ "github.com/openshift-assessment/cluster-assessment-operator/pkg/validators/networkpolicyaudit"
+	_ "github.com/openshift-assessment/cluster-assessment-operator/pkg/validators/nodes"
+	_ "github.com/openshift-assessment/cluster-assessment-operator/pkg/validators/oadpbackup"
+	_ "github.com/openshift-assessment/cluster-assessment-operator/pkg/validators/operators"
+	_ "github.com/openshift-assessment/cluster-assessment-operator/pkg/validators/podsecurityadmission"
+	_ "github.com/openshift-assessment/cluster-assessment-operator/pkg/validators/rbacaudit"
+	_ "github.com/openshift-assessment/cluster-assessment-operator/pkg/validators/rego"
+	_ "github.com/openshift-assessment/cluster-assessment-operator/pkg/validators/resourcequotas"
+	_ "github.com/openshift-assessment/cluster-assessment-operator/pkg/validators/security"
+	_ "github.com/openshift-assessment/cluster-assessment-operator/pkg/validators/storage"
+	_ "github.com/openshift-assessment/cluster-assessment-operator/pkg/validators/version"
+)
@@ -0,0 +1,293 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package all
+
+import (
+	"context"
+	"errors"
+	"go/ast"
+	"go/parser"
+	"go/token"
+	"os"
+	"path/filepath"
+	"slices"
+	"strconv"
+	"strings"
+	"testing"
+	"time"
+
+	configv1 "github.com/openshift/api/config/v1"
+	rbacv1 "k8s.io/api/rbac/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/yaml"
+
+	assessmentv1alpha1 "github.com/openshift-assessment/cluster-assessment-operator/api/v1alpha1"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/machineconfig"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/profiles"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/validator"
+)
+
+// TestValidatorsPublishCheckCatalog ensures every built-in validator declares
+// its checks, so profiles and suppressions can be validated against real IDs.
+func TestValidatorsPublishCheckCatalog(t *testing.T) {
+	registry := validator.DefaultRegistry()
+	seen := make(map[string]string)
+
+	for _, v := range registry.List() {
+		provider, ok := v.(validator.CheckProvider)
+		if !ok {
+			t.Errorf("Validator %s does not implement validator.CheckProvider", v.Name())
+			continue
+		}
+
+		checks := provider.Checks()
+		if len(checks) == 0 {
+			t.Errorf("Validator %s declares no checks", v.Name())
+		}
+		for _, c := range checks {
+			if c.ID == "" || c.Title == "" || c.DefaultStatus == "" || c.Severity == "" {
+				t.Errorf("Validator %s has incomplete check %+v", v.Name(), c)
+			}
+			if strings.Contains(strings.TrimSuffix(c.ID, "*"), "*") {
+				t.Errorf("Check %s may only use a trailing wildcard", c.ID)
+			}
+			if owner, dup := seen[c.ID]; dup {
+				t.Errorf("Check %s declared by both %s and %s", c.ID, owner, v.Name())
+			}
+			seen[c.ID] = v.Name()
+		}
+	}
+}
+
+// TestEmittedFindingsAreCataloged runs every validator against an empty
+// cluster and a cluster whose API calls all fail, and checks that each
+// finding ID it reports is declared in the catalog and that read errors are
+// reported as ERROR rather than as a cluster problem.
+func TestEmittedFindingsAreCataloged(t *testing.T) {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(assessmentv1alpha1.AddToScheme(scheme))
+	utilruntime.Must(configv1.AddToScheme(scheme))
+	utilruntime.Must(machineconfig.AddToScheme(scheme))
+
+	errUnavailable := errors.New("api unavailable")
+	clients := map[string]client.Client{
+		"empty": fake.NewClientBuilder().WithScheme(scheme).Build(),
+		"failing": fake.NewClientBuilder().WithScheme(scheme).WithInterceptorFuncs(interceptor.Funcs{
+			Get: func(context.Context, client.WithWatch, client.ObjectKey, client.Object, ...client.GetOption) error {
+				return errUnavailable
+			},
+			List: func(context.Context, client.WithWatch, client.ObjectList, ...client.ListOption) error {
+				return errUnavailable
+			},
+		}).Build(),
+	}
+
+	registry := validator.DefaultRegistry()
+	for name, c := range clients {
+		for _, p := range profiles.ListProfiles() {
+			findings, err := validator.NewRunner(registry, c).RunAll(context.Background(), profiles.GetProfile(string(p)))
+			if err != nil {
+				t.Fatalf("%s cluster, %s profile: %v", name, p, err)
+			}
+			for _, f := range findings {
+				if _, ok := registry.LookupCheck(f.ID); !ok {
+					t.Errorf("%s cluster, %s profile: validator %s emitted undeclared check %s", name, p, f.Validator, f.ID)
+				}
+				if name == "failing" && strings.HasSuffix(f.ID, "-error") && f.Status != assessmentv1alpha1.FindingStatusError {
+					t.Errorf("%s cluster, %s profile: read error %s reported as %s, want ERROR", name, p, f.ID, f.Status)
+				}
+			}
+		}
+	}
+}
+
+// TestValidatorsHonorCancellation runs every validator against a cluster
+// whose API calls block until their context is cancelled, and checks that
+// each returns soon after its deadline, so a validator the Runner times out
+// does not keep running.
+func TestValidatorsHonorCancellation(t *testing.T) {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(assessmentv1alpha1.AddToScheme(scheme))
+	utilruntime.Must(configv1.AddToScheme(scheme))
+	utilruntime.Must(machineconfig.AddToScheme(scheme))
+
+	c := fake.NewClientBuilder().WithScheme(scheme).WithInterceptorFuncs(interceptor.Funcs{
+		Get: func(ctx context.Context, _ client.WithWatch, _ client.ObjectKey, _ client.Object, _ ...client.GetOption) error {
+			<-ctx.Done()
+			return ctx.Err()
+		},
+		List: func(ctx context.Context, _ client.WithWatch, _ client.ObjectList, _ ...client.ListOption) error {
+			<-ctx.Done()
+			return ctx.Err()
+		},
+	}).Build()
+
+	for _, v := range validator.DefaultRegistry().List() {
+		t.Run(v.Name(), func(t *testing.T) {
+			t.Parallel()
+			ctx, cancel := context.WithTimeout(context.Background(), 20*time.Millisecond)
+			defer cancel()
+
+			done := make(chan struct{})
+			go func() {
+				defer close(done)
+				_, _ = v.Validate(ctx, c, profiles.GetProfile("production"))
+			}()
+			select {
+			case <-done:
+			case <-time.After(5 * time.Second):
+				t.Errorf("Validator %s still running 5s after its context was cancelled", v.Name())
+			}
+		})
+	}
+}
+
+// TestFindingIDLiteralsAreCataloged scans the validator sources for finding
+// IDs, including those only reported for specific cluster states, and checks
+// that each is declared in the catalog. IDs built with fmt.Sprintf are
+// checked through the constant prefix of their format string.
+func TestFindingIDLiteralsAreCataloged(t *testing.T) {
+	registry := validator.DefaultRegistry()
+	files, err := filepath.Glob("../*/*.go")
+	if err != nil {
+		t.Fatal(err)
+	}
+
+	fset := token.NewFileSet()
+	for _, path := range files {
+		if strings.HasSuffix(path, "_test.go") || filepath.Base(path) == "checks.go" {
+			continue
+		}
+		file, err := parser.ParseFile(fset, path, nil, 0)
+		if err != nil {
+			t.Fatalf("parse %s: %v", path, err)
+		}
+		ast.Inspect(file, func(n ast.Node) bool {
+			kv, ok := n.(*ast.KeyValueExpr)
+			if !ok {
+				return true
+			}
+			if key, ok := kv.Key.(*ast.Ident); !ok || key.Name != "ID" {
+				return true
+			}
+			id, ok := findingIDLiteral(kv.Value)
+			if ok && !registry.KnownCheckID(id) {
+				t.Errorf("%s: finding ID %q is not declared in the catalog", fset.Position(kv.Pos()), id)
+			}
+			return true
+		})
+	}
+}
+
+// findingIDLiteral returns the ID set by a string literal, or the wildcard
+// pattern of an fmt.Sprintf call, e.g. "certificates-expired-*".
+func findingIDLiteral(expr ast.Expr) (string, bool) {
+	if call, ok := expr.(*ast.CallExpr); ok {
+		sel, ok := call.Fun.(*ast.SelectorExpr)
+		if !ok || sel.Sel.Name != "Sprintf" || len(call.Args) == 0 {
+			return "", false
+		}
+		format, ok := findingIDLiteral(call.Args[0])
+		if !ok {
+			return "", false
+		}
+		prefix, _, _ := strings.Cut(format, "%")
+		if prefix == "" {
+			return "", false
+		}
+		return prefix + "*", true
+	}
+	lit, ok := expr.(*ast.BasicLit)
+	if !ok || lit.Kind != token.STRING {
+		return "", false
+	}
+	id, err := strconv.Unquote(lit.Value)
+	return id, err == nil
+}
+
+// TestValidatorParameterDefaults ensures every declared parameter has a
+// default that parses against its own type.
+func TestValidatorParameterDefaults(t *testing.T) {
+	for _, v := range validator.DefaultRegistry().List() {
+		provider, ok := v.(validator.ParameterProvider)
+		if !ok {
+			continue
+		}
+		for _, p := range provider.Parameters() {
+			if p.Name == "" || p.Description == "" {
+				t.Errorf("Validator %s has incomplete parameter %+v", v.Name(), p)
+			}
+			if _, err := p.Parse(p.Default); err != nil {
+				t.Errorf("Validator %s: invalid default: %v", v.Name(), err)
+			}
+		}
+	}
+}
+
+// TestValidatorsDeclarePermissions ensures every built-in validator declares
+// the API access it needs, and that the operator's ClusterRole grants it, so
+// no validator is skipped on a default installation.
+func TestValidatorsDeclarePermissions(t *testing.T) {
+	data, err := os.ReadFile("../../../config/rbac/role.yaml")
+	if err != nil {
+		t.Fatalf("Failed to read ClusterRole: %v", err)
+	}
+	role := &rbacv1.ClusterRole{}
+	if err := yaml.Unmarshal(data, role); err != nil {
+		t.Fatalf("Failed to parse ClusterRole: %v", err)
+	}
+
+	for _, v := range validator.DefaultRegistry().List() {
+		provider, ok := v.(validator.PermissionProvider)
+		if !ok {
+			t.Errorf("Validator %s does not implement validator.PermissionProvider", v.Name())
+			continue
+		}
+		perms := provider.RequiredPermissions()
+		if len(perms) == 0 {
+			t.Errorf("Validator %s declares no permissions", v.Name())
+		}
+		for _, p := range perms {
+			if p.Verb == "" || p.Resource == "" {
+				t.Errorf("Validator %s has incomplete permission %+v", v.Name(), p)
+			}
+			if !grants(role.Rules, p) {
+				t.Errorf("Validator %s needs %q, which the ClusterRole does not grant", v.Name(), p)
+			}
+		}
+	}
+}
+
+// grants reports whether any of the rules allows the permission.
+func grants(rules []rbacv1.PolicyRule, p validator.Permission) bool {
+	matches := func(values []string, value string) bool {
+		return slices.Contains(values, value) || slices.Contains(values, rbacv1.ResourceAll)
+	}
+	for _, r := range rules {
+		if matches(r.APIGroups, p.Group) && matches(r.Resources, p.Resource) && matches(r.Verbs, p.Verb) {
+			return true
+		}
+	}
+	return false
+}
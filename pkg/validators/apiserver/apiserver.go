@@ -56,6 +56,14 @@ func (v *APIServerValidator) Category() string {
 	return validatorCategory
 }
 
+// RequiredPermissions returns the API access this validator needs.
+func (v *APIServerValidator) RequiredPermissions() []validator.Permission {
+	return []validator.Permission{
+		{Verb: "get", Group: "config.openshift.io", Resource: "clusteroperators"},
+		{Verb: "get", Group: "config.openshift.io", Resource: "apiservers"},
+	}
+}
+
 // Validate performs API server and etcd checks.
 func (v *APIServerValidator) Validate(ctx context.Context, c client.Client, profile profiles.Profile) ([]assessmentv1alpha1.Finding, error) {
 	var findings []assessmentv1alpha1.Finding
@@ -86,7 +94,7 @@ func (v *APIServerValidator) checkAPIServer(ctx context.Context, c client.Client
 			ID:          "apiserver-operator-error",
 			Validator:   validatorName,
 			Category:    validatorCategory,
-			Status:      assessmentv1alpha1.FindingStatusFail,
+			Status:      assessmentv1alpha1.FindingStatusError,
 			Title:       "Unable to Check API Server Operator",
 			Description: fmt.Sprintf("Failed to get kube-apiserver ClusterOperator: %v", err),
 		}}
@@ -170,7 +178,7 @@ func (v *APIServerValidator) checkEtcd(ctx context.Context, c client.Client) []a
 			ID:          "etcd-operator-error",
 			Validator:   validatorName,
 			Category:    validatorCategory,
-			Status:      assessmentv1alpha1.FindingStatusFail,
+			Status:      assessmentv1alpha1.FindingStatusError,
 			Title:       "Unable to Check etcd Operator",
 			Description: fmt.Sprintf("Failed to get etcd ClusterOperator: %v", err),
 		}}
@@ -254,7 +262,7 @@ func (v *APIServerValidator) checkEncryption(ctx context.Context, c client.Clien
 			ID:          "apiserver-encryption-error",
 			Validator:   validatorName,
 			Category:    validatorCategory,
-			Status:      assessmentv1alpha1.FindingStatusInfo,
+			Status:      assessmentv1alpha1.FindingStatusError,
 			Title:       "Unable to Check Encryption Configuration",
 			Description: fmt.Sprintf("Failed to get APIServer configuration: %v", err),
 		}}
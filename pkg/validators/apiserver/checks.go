@@ -0,0 +1,129 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package apiserver
+
+import (
+	assessmentv1alpha1 "github.com/openshift-assessment/cluster-assessment-operator/api/v1alpha1"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/validator"
+)
+
+// Checks returns the catalog of checks this validator can report.
+func (v *APIServerValidator) Checks() []validator.Check {
+	return checks
+}
+
+// checks lists every finding ID emitted by this validator.
+var checks = []validator.Check{
+	{
+		ID:            "apiserver-degraded",
+		Title:         "API Server Degraded",
+		DefaultStatus: assessmentv1alpha1.FindingStatusFail,
+		Severity:      assessmentv1alpha1.SeverityCritical,
+	},
+	{
+		ID:            "apiserver-unavailable",
+		Title:         "API Server Not Available",
+		DefaultStatus: assessmentv1alpha1.FindingStatusFail,
+		Severity:      assessmentv1alpha1.SeverityCritical,
+	},
+	{
+		ID:            "apiserver-progressing",
+		Title:         "API Server Updating",
+		DefaultStatus: assessmentv1alpha1.FindingStatusInfo,
+		Severity:      assessmentv1alpha1.SeverityLow,
+	},
+	{
+		ID:            "apiserver-healthy",
+		Title:         "API Server Healthy",
+		DefaultStatus: assessmentv1alpha1.FindingStatusPass,
+		Severity:      assessmentv1alpha1.SeverityLow,
+	},
+	{
+		ID:            "etcd-degraded",
+		Title:         "etcd Degraded",
+		DefaultStatus: assessmentv1alpha1.FindingStatusFail,
+		Severity:      assessmentv1alpha1.SeverityCritical,
+	},
+	{
+		ID:            "etcd-unavailable",
+		Title:         "etcd Not Available",
+		DefaultStatus: assessmentv1alpha1.FindingStatusFail,
+		Severity:      assessmentv1alpha1.SeverityCritical,
+	},
+	{
+		ID:            "etcd-progressing",
+		Title:         "etcd Updating",
+		DefaultStatus: assessmentv1alpha1.FindingStatusInfo,
+		Severity:      assessmentv1alpha1.SeverityLow,
+	},
+	{
+		ID:            "etcd-healthy",
+		Title:         "etcd Healthy",
+		DefaultStatus: assessmentv1alpha1.FindingStatusPass,
+		Severity:      assessmentv1alpha1.SeverityLow,
+	},
+	{
+		ID:            "apiserver-no-encryption",
+		Title:         "etcd Encryption Not Enabled",
+		DefaultStatus: assessmentv1alpha1.FindingStatusWarn,
+		Severity:      assessmentv1alpha1.SeverityHigh,
+		References:    []string{"https://docs.openshift.com/container-platform/latest/security/encrypting-etcd.html"},
+	},
+	{
+		ID:            "apiserver-encryption-enabled",
+		Title:         "etcd Encryption Enabled",
+		DefaultStatus: assessmentv1alpha1.FindingStatusPass,
+		Severity:      assessmentv1alpha1.SeverityLow,
+	},
+	{
+		ID:            "apiserver-audit-disabled",
+		Title:         "Audit Logging Disabled",
+		DefaultStatus: assessmentv1alpha1.FindingStatusWarn,
+		Severity:      assessmentv1alpha1.SeverityHigh,
+		References:    []string{"https://docs.openshift.com/container-platform/latest/security/audit-log-policy-config.html"},
+	},
+	{
+		ID:            "apiserver-audit-enabled",
+		Title:         "Audit Logging Enabled",
+		DefaultStatus: assessmentv1alpha1.FindingStatusPass,
+		Severity:      assessmentv1alpha1.SeverityLow,
+	},
+	{
+		ID:            "apiserver-audit-custom",
+		Title:         "Custom Audit Profile",
+		DefaultStatus: assessmentv1alpha1.FindingStatusInfo,
+		Severity:      assessmentv1alpha1.SeverityLow,
+	},
+	{
+		ID:            "apiserver-operator-error",
+		Title:         "Unable to Check API Server Operator",
+		DefaultStatus: assessmentv1alpha1.FindingStatusError,
+		Severity:      assessmentv1alpha1.SeverityLow,
+	},
+	{
+		ID:            "etcd-operator-error",
+		Title:         "Unable to Check etcd Operator",
+		DefaultStatus: assessmentv1alpha1.FindingStatusError,
+		Severity:      assessmentv1alpha1.SeverityLow,
+	},
+	{
+		ID:            "apiserver-encryption-error",
+		Title:         "Unable to Check Encryption Configuration",
+		DefaultStatus: assessmentv1alpha1.FindingStatusError,
+		Severity:      assessmentv1alpha1.SeverityLow,
+	},
+}
@@ -22,6 +22,7 @@ import (
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	assessmentv1alpha1 "github.com/openshift-assessment/cluster-assessment-operator/api/v1alpha1"
@@ -35,6 +36,14 @@ const (
 	validatorCategory    = "Security"
 )
 
+// paramExpiryWarningDays is how many days before expiry a certificate is reported as expiring.
+var paramExpiryWarningDays = validator.Parameter{
+	Name:        "expiryWarningDays",
+	Type:        validator.ParameterInteger,
+	Default:     "30",
+	Description: "Days before expiry at which a certificate is reported as expiring soon.",
+}
+
 func init() {
 	_ = validator.Register(&CertificatesValidator{})
 }
@@ -57,6 +66,20 @@ func (v *CertificatesValidator) Category() string {
 	return validatorCategory
 }
 
+// RequiredPermissions returns the API access this validator needs.
+func (v *CertificatesValidator) RequiredPermissions() []validator.Permission {
+	return []validator.Permission{
+		{Verb: "get", Resource: "secrets", Namespace: "openshift-ingress"},
+		{Verb: "list", Resource: "secrets", Namespace: "openshift-ingress"},
+		{Verb: "get", Resource: "secrets", Namespace: "openshift-config"},
+	}
+}
+
+// Parameters returns the parameters this validator accepts.
+func (v *CertificatesValidator) Parameters() []validator.Parameter {
+	return []validator.Parameter{paramExpiryWarningDays}
+}
+
 // Validate performs certificate expiration checks.
 func (v *CertificatesValidator) Validate(ctx context.Context, c client.Client, profile profiles.Profile) ([]assessmentv1alpha1.Finding, error) {
 	var findings []assessmentv1alpha1.Finding
@@ -68,7 +91,7 @@ func (v *CertificatesValidator) Validate(ctx context.Context, c client.Client, p
 	findings = append(findings, v.checkAPIServerCerts(ctx, c)...)
 
 	// Check ingress certificates
-	findings = append(findings, v.checkIngressCerts(ctx, c)...)
+	findings = append(findings, v.checkIngressCerts(ctx, c, profile)...)
 
 	// Summary finding if all checks pass
 	if len(findings) == 0 {
@@ -97,11 +120,16 @@ func (v *CertificatesValidator) checkRouterCerts(ctx context.Context, c client.C
 	}, secret)
 
 	if err != nil {
+		// Without the default secret the router serves a custom certificate
+		status := assessmentv1alpha1.FindingStatusError
+		if apierrors.IsNotFound(err) {
+			status = assessmentv1alpha1.FindingStatusNotApplicable
+		}
 		findings = append(findings, assessmentv1alpha1.Finding{
 			ID:          "certificates-router-error",
 			Validator:   validatorName,
 			Category:    validatorCategory,
-			Status:      assessmentv1alpha1.FindingStatusInfo,
+			Status:      status,
 			Title:       "Unable to Check Router Certificates",
 			Description: fmt.Sprintf("Could not access router certificates: %v", err),
 		})
@@ -152,12 +180,20 @@ func (v *CertificatesValidator) checkAPIServerCerts(ctx context.Context, c clien
 }
 
 // checkIngressCerts checks for ingress certificate configuration.
-func (v *CertificatesValidator) checkIngressCerts(ctx context.Context, c client.Client) []assessmentv1alpha1.Finding {
+func (v *CertificatesValidator) checkIngressCerts(ctx context.Context, c client.Client, profile profiles.Profile) []assessmentv1alpha1.Finding {
 	var findings []assessmentv1alpha1.Finding
 
 	// List secrets in openshift-ingress namespace with tls type
 	secretList := &corev1.SecretList{}
 	if err := c.List(ctx, secretList, client.InNamespace("openshift-ingress")); err != nil {
+		findings = append(findings, assessmentv1alpha1.Finding{
+			ID:          "certificates-ingress-error",
+			Validator:   validatorName,
+			Category:    validatorCategory,
+			Status:      assessmentv1alpha1.FindingStatusError,
+			Title:       "Unable to Check Ingress Certificates",
+			Description: fmt.Sprintf("Could not list secrets in openshift-ingress: %v", err),
+		})
 		return findings
 	}
 
@@ -181,7 +217,8 @@ func (v *CertificatesValidator) checkIngressCerts(ctx context.Context, c client.
 
 	// Check cert expiry using annotations (if cert-manager is used)
 	now := time.Now()
-	warningThreshold := now.Add(30 * 24 * time.Hour) // 30 days
+	warningDays := validator.IntParameter(profile, validatorName, paramExpiryWarningDays)
+	warningThreshold := now.Add(time.Duration(warningDays) * 24 * time.Hour)
 
 	for _, secret := range secretList.Items {
 		if secret.Type != corev1.SecretTypeTLS {
@@ -194,13 +231,14 @@ func (v *CertificatesValidator) checkIngressCerts(ctx context.Context, c client.
 			if err == nil {
 				if expiryTime.Before(now) {
 					findings = append(findings, assessmentv1alpha1.Finding{
-						ID:             fmt.Sprintf("certificates-expired-%s", secret.Name),
-						Validator:      validatorName,
-						Category:       validatorCategory,
-						Status:         assessmentv1alpha1.FindingStatusFail,
-						Title:          "Expired Certificate",
-						Description:    fmt.Sprintf("Certificate secret %s has expired on %s", secret.Name, expiry),
-						Recommendation: "Renew the certificate immediately.",
+						ID:                fmt.Sprintf("certificates-expired-%s", secret.Name),
+						Validator:         validatorName,
+						Category:          validatorCategory,
+						Status:            assessmentv1alpha1.FindingStatusFail,
+						Title:             "Expired Certificate",
+						Description:       fmt.Sprintf("Certificate secret %s has expired on %s", secret.Name, expiry),
+						AffectedResources: []assessmentv1alpha1.AffectedResource{validator.ResourceRef("Secret", &secret)},
+						Recommendation:    "Renew the certificate immediately.",
 						Remediation: &assessmentv1alpha1.RemediationGuidance{
 							Safety: assessmentv1alpha1.RemediationRequiresReview,
 							Commands: []assessmentv1alpha1.RemediationCommand{
@@ -212,13 +250,14 @@ func (v *CertificatesValidator) checkIngressCerts(ctx context.Context, c client.
 					})
 				} else if expiryTime.Before(warningThreshold) {
 					findings = append(findings, assessmentv1alpha1.Finding{
-						ID:             fmt.Sprintf("certificates-expiring-%s", secret.Name),
-						Validator:      validatorName,
-						Category:       validatorCategory,
-						Status:         assessmentv1alpha1.FindingStatusWarn,
-						Title:          "Certificate Expiring Soon",
-						Description:    fmt.Sprintf("Certificate secret %s expires on %s", secret.Name, expiry),
-						Recommendation: "Plan certificate renewal before expiration.",
+						ID:                fmt.Sprintf("certificates-expiring-%s", secret.Name),
+						Validator:         validatorName,
+						Category:          validatorCategory,
+						Status:            assessmentv1alpha1.FindingStatusWarn,
+						Title:             "Certificate Expiring Soon",
+						Description:       fmt.Sprintf("Certificate secret %s expires on %s", secret.Name, expiry),
+						AffectedResources: []assessmentv1alpha1.AffectedResource{validator.ResourceRef("Secret", &secret)},
+						Recommendation:    "Plan certificate renewal before expiration.",
 						Remediation: &assessmentv1alpha1.RemediationGuidance{
 							Safety: assessmentv1alpha1.RemediationSafeApply,
 							Commands: []assessmentv1alpha1.RemediationCommand{
@@ -0,0 +1,79 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package certificates
+
+import (
+	assessmentv1alpha1 "github.com/openshift-assessment/cluster-assessment-operator/api/v1alpha1"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/validator"
+)
+
+// Checks returns the catalog of checks this validator can report.
+func (v *CertificatesValidator) Checks() []validator.Check {
+	return checks
+}
+
+// checks lists every finding ID emitted by this validator.
+var checks = []validator.Check{
+	{
+		ID:            "certificates-all-valid",
+		Title:         "Certificates Valid",
+		DefaultStatus: assessmentv1alpha1.FindingStatusPass,
+		Severity:      assessmentv1alpha1.SeverityLow,
+	},
+	{
+		ID:            "certificates-router-error",
+		Title:         "Unable to Check Router Certificates",
+		DefaultStatus: assessmentv1alpha1.FindingStatusError,
+		Severity:      assessmentv1alpha1.SeverityLow,
+	},
+	{
+		ID:            "certificates-router-custom",
+		Title:         "Custom Router Certificate Configured",
+		DefaultStatus: assessmentv1alpha1.FindingStatusInfo,
+		Severity:      assessmentv1alpha1.SeverityLow,
+	},
+	{
+		ID:            "certificates-apiserver-custom",
+		Title:         "Custom API Server Certificate",
+		DefaultStatus: assessmentv1alpha1.FindingStatusInfo,
+		Severity:      assessmentv1alpha1.SeverityLow,
+	},
+	{
+		ID:            "certificates-ingress-error",
+		Title:         "Unable to Check Ingress Certificates",
+		DefaultStatus: assessmentv1alpha1.FindingStatusError,
+		Severity:      assessmentv1alpha1.SeverityLow,
+	},
+	{
+		ID:            "certificates-ingress-found",
+		Title:         "Ingress TLS Secrets Present",
+		DefaultStatus: assessmentv1alpha1.FindingStatusPass,
+		Severity:      assessmentv1alpha1.SeverityLow,
+	},
+	{
+		ID:            "certificates-expired-*",
+		Title:         "Expired Certificate",
+		DefaultStatus: assessmentv1alpha1.FindingStatusFail,
+		Severity:      assessmentv1alpha1.SeverityCritical,
+	},
+	{
+		ID:            "certificates-expiring-*",
+		Title:         "Certificate Expiring Soon",
+		DefaultStatus: assessmentv1alpha1.FindingStatusWarn,
+		Severity:      assessmentv1alpha1.SeverityHigh,
+	},
+}
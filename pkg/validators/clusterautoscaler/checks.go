@@ -0,0 +1,68 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package clusterautoscaler
+
+import (
+	assessmentv1alpha1 "github.com/openshift-assessment/cluster-assessment-operator/api/v1alpha1"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/validator"
+)
+
+// Checks returns the catalog of checks this validator can report.
+func (v *ClusterAutoscalerValidator) Checks() []validator.Check {
+	return checks
+}
+
+// checks lists every finding ID emitted by this validator.
+var checks = []validator.Check{
+	{
+		ID:            "autoscaler-not-available",
+		Title:         "Cluster Autoscaling Not Available",
+		DefaultStatus: assessmentv1alpha1.FindingStatusNotApplicable,
+		Severity:      assessmentv1alpha1.SeverityLow,
+	},
+	{
+		ID:            "autoscaler-no-cluster-autoscaler",
+		Title:         "No ClusterAutoscaler Configured",
+		DefaultStatus: assessmentv1alpha1.FindingStatusWarn,
+		Severity:      assessmentv1alpha1.SeverityLow,
+		References:    []string{"https://docs.openshift.com/container-platform/latest/machine_management/applying-autoscaling.html"},
+	},
+	{
+		ID:            "autoscaler-cluster-autoscaler-found",
+		Title:         "ClusterAutoscaler Configured",
+		DefaultStatus: assessmentv1alpha1.FindingStatusPass,
+		Severity:      assessmentv1alpha1.SeverityLow,
+	},
+	{
+		ID:            "autoscaler-no-machine-autoscaler",
+		Title:         "No MachineAutoscalers Found",
+		DefaultStatus: assessmentv1alpha1.FindingStatusInfo,
+		Severity:      assessmentv1alpha1.SeverityLow,
+	},
+	{
+		ID:            "autoscaler-machine-autoscalers-found",
+		Title:         "MachineAutoscalers Configured",
+		DefaultStatus: assessmentv1alpha1.FindingStatusPass,
+		Severity:      assessmentv1alpha1.SeverityLow,
+	},
+	{
+		ID:            "autoscaler-machinesets-zero-replicas",
+		Title:         "MachineSets With Zero Replicas",
+		DefaultStatus: assessmentv1alpha1.FindingStatusInfo,
+		Severity:      assessmentv1alpha1.SeverityLow,
+	},
+}
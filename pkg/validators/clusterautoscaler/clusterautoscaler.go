@@ -46,12 +46,35 @@ func (v *ClusterAutoscalerValidator) Name() string        { return validatorName
 func (v *ClusterAutoscalerValidator) Description() string { return validatorDescription }
 func (v *ClusterAutoscalerValidator) Category() string    { return validatorCategory }
 
+// RequiredPermissions returns the API access this validator needs.
+func (v *ClusterAutoscalerValidator) RequiredPermissions() []validator.Permission {
+	return []validator.Permission{
+		{Verb: "list", Group: "autoscaling.openshift.io", Resource: "clusterautoscalers"},
+		{Verb: "list", Group: "autoscaling.openshift.io", Resource: "machineautoscalers"},
+		{Verb: "list", Group: "machine.openshift.io", Resource: "machinesets"},
+	}
+}
+
 // Validate checks for ClusterAutoscaler and MachineAutoscaler presence.
 func (v *ClusterAutoscalerValidator) Validate(ctx context.Context, c client.Client, profile profiles.Profile) ([]assessmentv1alpha1.Finding, error) {
 	var findings []assessmentv1alpha1.Finding
 
 	// Check ClusterAutoscaler
-	findings = append(findings, v.checkClusterAutoscaler(ctx, c, profile)...)
+	autoscalerFindings, err := v.checkClusterAutoscaler(ctx, c, profile)
+	if validator.IsNotInstalled(err) {
+		return []assessmentv1alpha1.Finding{{
+			ID:          "autoscaler-not-available",
+			Validator:   validatorName,
+			Category:    validatorCategory,
+			Status:      assessmentv1alpha1.FindingStatusNotApplicable,
+			Title:       "Cluster Autoscaling Not Available",
+			Description: "The cluster does not serve the autoscaling.openshift.io API, e.g. because it has no Machine API. Autoscaling checks were not run.",
+		}}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	findings = append(findings, autoscalerFindings...)
 
 	// Check MachineAutoscalers
 	findings = append(findings, v.checkMachineAutoscalers(ctx, c)...)
@@ -62,7 +85,7 @@ func (v *ClusterAutoscalerValidator) Validate(ctx context.Context, c client.Clie
 	return findings, nil
 }
 
-func (v *ClusterAutoscalerValidator) checkClusterAutoscaler(ctx context.Context, c client.Client, profile profiles.Profile) []assessmentv1alpha1.Finding {
+func (v *ClusterAutoscalerValidator) checkClusterAutoscaler(ctx context.Context, c client.Client, profile profiles.Profile) ([]assessmentv1alpha1.Finding, error) {
 	var findings []assessmentv1alpha1.Finding
 
 	caList := &unstructured.UnstructuredList{}
@@ -73,8 +96,7 @@ func (v *ClusterAutoscalerValidator) checkClusterAutoscaler(ctx context.Context,
 	})
 
 	if err := c.List(ctx, caList); err != nil {
-		// API not available — autoscaling not installed, skip gracefully
-		return nil
+		return nil, fmt.Errorf("failed to list ClusterAutoscalers: %w", err)
 	}
 
 	if len(caList.Items) == 0 {
@@ -135,7 +157,7 @@ func (v *ClusterAutoscalerValidator) checkClusterAutoscaler(ctx context.Context,
 		})
 	}
 
-	return findings
+	return findings, nil
 }
 
 func (v *ClusterAutoscalerValidator) checkMachineAutoscalers(ctx context.Context, c client.Client) []assessmentv1alpha1.Finding {
@@ -190,23 +212,25 @@ func (v *ClusterAutoscalerValidator) checkMachineSets(ctx context.Context, c cli
 		return nil
 	}
 
-	zeroReplicas := 0
-	for _, ms := range msList.Items {
+	var zeroReplicas []assessmentv1alpha1.AffectedResource
+	for i := range msList.Items {
+		ms := &msList.Items[i]
 		replicas, found, _ := unstructured.NestedInt64(ms.Object, "spec", "replicas")
 		if found && replicas == 0 {
-			zeroReplicas++
+			zeroReplicas = append(zeroReplicas, validator.ResourceRef("MachineSet", ms))
 		}
 	}
 
-	if zeroReplicas > 0 {
+	if len(zeroReplicas) > 0 {
 		findings = append(findings, assessmentv1alpha1.Finding{
-			ID:             "autoscaler-machinesets-zero-replicas",
-			Validator:      validatorName,
-			Category:       validatorCategory,
-			Status:         assessmentv1alpha1.FindingStatusInfo,
-			Title:          "MachineSets With Zero Replicas",
-			Description:    fmt.Sprintf("%d MachineSet(s) have 0 replicas. These may be unused or waiting for autoscaling.", zeroReplicas),
-			Recommendation: "Review if zero-replica MachineSets are intentional or should be cleaned up.",
+			ID:                "autoscaler-machinesets-zero-replicas",
+			Validator:         validatorName,
+			Category:          validatorCategory,
+			Status:            assessmentv1alpha1.FindingStatusInfo,
+			Title:             "MachineSets With Zero Replicas",
+			Description:       fmt.Sprintf("%d MachineSet(s) have 0 replicas. These may be unused or waiting for autoscaling.", len(zeroReplicas)),
+			Recommendation:    "Review if zero-replica MachineSets are intentional or should be cleaned up.",
+			AffectedResources: zeroReplicas,
 		})
 	}
 
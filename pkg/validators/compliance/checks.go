@@ -0,0 +1,94 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package compliance
+
+import (
+	assessmentv1alpha1 "github.com/openshift-assessment/cluster-assessment-operator/api/v1alpha1"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/validator"
+)
+
+// Checks returns the catalog of checks this validator can report.
+func (v *ComplianceValidator) Checks() []validator.Check {
+	return checks
+}
+
+// checks lists every finding ID emitted by this validator.
+var checks = []validator.Check{
+	{
+		ID:            "compliance-psa-enforce",
+		Title:         "Pod Security Admission Enforced",
+		DefaultStatus: assessmentv1alpha1.FindingStatusPass,
+		Severity:      assessmentv1alpha1.SeverityLow,
+	},
+	{
+		ID:            "compliance-psa-missing",
+		Title:         "Namespaces Without Pod Security Admission",
+		DefaultStatus: assessmentv1alpha1.FindingStatusWarn,
+		Severity:      assessmentv1alpha1.SeverityMedium,
+		References:    []string{"https://kubernetes.io/docs/concepts/security/pod-security-admission/"},
+	},
+	{
+		ID:            "compliance-oauth-no-idp",
+		Title:         "No Identity Providers Configured",
+		DefaultStatus: assessmentv1alpha1.FindingStatusWarn,
+		Severity:      assessmentv1alpha1.SeverityHigh,
+		References:    []string{"https://docs.openshift.com/container-platform/latest/authentication/understanding-identity-provider.html"},
+	},
+	{
+		ID:            "compliance-oauth-idp-configured",
+		Title:         "Identity Providers Configured",
+		DefaultStatus: assessmentv1alpha1.FindingStatusPass,
+		Severity:      assessmentv1alpha1.SeverityLow,
+	},
+	{
+		ID:            "compliance-oauth-htpasswd",
+		Title:         "HTPasswd Identity Provider in Use",
+		DefaultStatus: assessmentv1alpha1.FindingStatusInfo,
+		Severity:      assessmentv1alpha1.SeverityLow,
+	},
+	{
+		ID:            "compliance-oauth-token-age",
+		Title:         "Long Access Token Lifetime",
+		DefaultStatus: assessmentv1alpha1.FindingStatusInfo,
+		Severity:      assessmentv1alpha1.SeverityLow,
+	},
+	{
+		ID:            "compliance-kubeadmin-exists",
+		Title:         "Kubeadmin User Still Exists",
+		DefaultStatus: assessmentv1alpha1.FindingStatusWarn,
+		Severity:      assessmentv1alpha1.SeverityHigh,
+		References:    []string{"https://docs.openshift.com/container-platform/latest/authentication/remove-kubeadmin.html"},
+	},
+	{
+		ID:            "compliance-kubeadmin-removed",
+		Title:         "Kubeadmin User Removed",
+		DefaultStatus: assessmentv1alpha1.FindingStatusPass,
+		Severity:      assessmentv1alpha1.SeverityLow,
+	},
+	{
+		ID:            "compliance-psa-error",
+		Title:         "Unable to Check Namespaces",
+		DefaultStatus: assessmentv1alpha1.FindingStatusError,
+		Severity:      assessmentv1alpha1.SeverityLow,
+	},
+	{
+		ID:            "compliance-oauth-error",
+		Title:         "Unable to Check OAuth Configuration",
+		DefaultStatus: assessmentv1alpha1.FindingStatusError,
+		Severity:      assessmentv1alpha1.SeverityLow,
+	},
+}
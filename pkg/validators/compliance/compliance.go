@@ -27,6 +27,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	assessmentv1alpha1 "github.com/openshift-assessment/cluster-assessment-operator/api/v1alpha1"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/clusterstate"
 	"github.com/openshift-assessment/cluster-assessment-operator/pkg/profiles"
 	"github.com/openshift-assessment/cluster-assessment-operator/pkg/validator"
 )
@@ -59,12 +60,22 @@ func (v *ComplianceValidator) Category() string {
 	return validatorCategory
 }
 
+// RequiredPermissions returns the API access this validator needs.
+func (v *ComplianceValidator) RequiredPermissions() []validator.Permission {
+	return []validator.Permission{
+		{Verb: "list", Resource: "namespaces"},
+		{Verb: "get", Group: "config.openshift.io", Resource: "oauths"},
+		{Verb: "get", Resource: "secrets", Namespace: "kube-system"},
+	}
+}
+
 // Validate performs compliance checks.
 func (v *ComplianceValidator) Validate(ctx context.Context, c client.Client, profile profiles.Profile) ([]assessmentv1alpha1.Finding, error) {
 	var findings []assessmentv1alpha1.Finding
+	state := clusterstate.FromContext(ctx, c)
 
 	// Check 1: Pod Security Admission labels
-	findings = append(findings, v.checkPodSecurityAdmission(ctx, c, profile)...)
+	findings = append(findings, v.checkPodSecurityAdmission(ctx, state, profile)...)
 
 	// Check 2: OAuth configuration
 	findings = append(findings, v.checkOAuthConfiguration(ctx, c)...)
@@ -76,16 +87,16 @@ func (v *ComplianceValidator) Validate(ctx context.Context, c client.Client, pro
 }
 
 // checkPodSecurityAdmission checks for Pod Security Admission labels on namespaces.
-func (v *ComplianceValidator) checkPodSecurityAdmission(ctx context.Context, c client.Client, profile profiles.Profile) []assessmentv1alpha1.Finding {
+func (v *ComplianceValidator) checkPodSecurityAdmission(ctx context.Context, state *clusterstate.State, profile profiles.Profile) []assessmentv1alpha1.Finding {
 	var findings []assessmentv1alpha1.Finding
 
-	namespaces := &corev1.NamespaceList{}
-	if err := c.List(ctx, namespaces); err != nil {
+	namespaces, err := state.Namespaces(ctx)
+	if err != nil {
 		return []assessmentv1alpha1.Finding{{
 			ID:          "compliance-psa-error",
 			Validator:   validatorName,
 			Category:    validatorCategory,
-			Status:      assessmentv1alpha1.FindingStatusFail,
+			Status:      assessmentv1alpha1.FindingStatusError,
 			Title:       "Unable to Check Namespaces",
 			Description: fmt.Sprintf("Failed to list namespaces: %v", err),
 		}}
@@ -93,8 +104,9 @@ func (v *ComplianceValidator) checkPodSecurityAdmission(ctx context.Context, c c
 
 	var namespacesWithEnforce []string
 	var userNamespacesWithoutPSA []string
+	var noPSARefs []assessmentv1alpha1.AffectedResource
 
-	for _, ns := range namespaces.Items {
+	for _, ns := range namespaces {
 		// Skip system namespaces
 		if strings.HasPrefix(ns.Name, "openshift-") || strings.HasPrefix(ns.Name, "kube-") || ns.Name == "default" {
 			continue
@@ -115,6 +127,7 @@ func (v *ComplianceValidator) checkPodSecurityAdmission(ctx context.Context, c c
 
 		if !hasEnforce && !hasAudit && !hasWarn {
 			userNamespacesWithoutPSA = append(userNamespacesWithoutPSA, ns.Name)
+			noPSARefs = append(noPSARefs, validator.ResourceRef("Namespace", &ns))
 		}
 	}
 
@@ -143,14 +156,15 @@ func (v *ComplianceValidator) checkPodSecurityAdmission(ctx context.Context, c c
 		}
 
 		findings = append(findings, assessmentv1alpha1.Finding{
-			ID:             "compliance-psa-missing",
-			Validator:      validatorName,
-			Category:       validatorCategory,
-			Status:         status,
-			Title:          "Namespaces Without Pod Security Admission",
-			Description:    fmt.Sprintf("%d user namespace(s) have no PSA labels: %s...", len(userNamespacesWithoutPSA), strings.Join(sample, ", ")),
-			Impact:         "Namespaces without PSA labels use the cluster-wide default policy.",
-			Recommendation: "Consider adding pod-security.kubernetes.io/enforce labels to user namespaces.",
+			ID:                "compliance-psa-missing",
+			Validator:         validatorName,
+			Category:          validatorCategory,
+			Status:            status,
+			Title:             "Namespaces Without Pod Security Admission",
+			Description:       fmt.Sprintf("%d user namespace(s) have no PSA labels: %s...", len(userNamespacesWithoutPSA), strings.Join(sample, ", ")),
+			AffectedResources: noPSARefs,
+			Impact:            "Namespaces without PSA labels use the cluster-wide default policy.",
+			Recommendation:    "Consider adding pod-security.kubernetes.io/enforce labels to user namespaces.",
 			References: []string{
 				"https://kubernetes.io/docs/concepts/security/pod-security-admission/",
 			},
@@ -187,7 +201,7 @@ func (v *ComplianceValidator) checkOAuthConfiguration(ctx context.Context, c cli
 			ID:          "compliance-oauth-error",
 			Validator:   validatorName,
 			Category:    validatorCategory,
-			Status:      assessmentv1alpha1.FindingStatusInfo,
+			Status:      assessmentv1alpha1.FindingStatusError,
 			Title:       "Unable to Check OAuth Configuration",
 			Description: fmt.Sprintf("Failed to get OAuth config: %v", err),
 		}}
@@ -0,0 +1,74 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package costoptimization
+
+import (
+	assessmentv1alpha1 "github.com/openshift-assessment/cluster-assessment-operator/api/v1alpha1"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/validator"
+)
+
+// Checks returns the catalog of checks this validator can report.
+func (v *CostOptimizationValidator) Checks() []validator.Check {
+	return checks
+}
+
+// checks lists every finding ID emitted by this validator.
+var checks = []validator.Check{
+	{
+		ID:            "costoptimization-orphan-pvcs",
+		Title:         "Orphan PVCs Detected",
+		DefaultStatus: assessmentv1alpha1.FindingStatusWarn,
+		Severity:      assessmentv1alpha1.SeverityLow,
+	},
+	{
+		ID:            "costoptimization-no-orphan-pvcs",
+		Title:         "No Orphan PVCs",
+		DefaultStatus: assessmentv1alpha1.FindingStatusPass,
+		Severity:      assessmentv1alpha1.SeverityLow,
+	},
+	{
+		ID:            "costoptimization-idle-deployments",
+		Title:         "Idle Deployments",
+		DefaultStatus: assessmentv1alpha1.FindingStatusInfo,
+		Severity:      assessmentv1alpha1.SeverityLow,
+	},
+	{
+		ID:            "costoptimization-no-requests",
+		Title:         "Pods Without Resource Requests",
+		DefaultStatus: assessmentv1alpha1.FindingStatusWarn,
+		Severity:      assessmentv1alpha1.SeverityLow,
+		References:    []string{"https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/"},
+	},
+	{
+		ID:            "costoptimization-requests-defined",
+		Title:         "All Pods Have Resource Requests",
+		DefaultStatus: assessmentv1alpha1.FindingStatusPass,
+		Severity:      assessmentv1alpha1.SeverityLow,
+	},
+	{
+		ID:            "costoptimization-no-limits",
+		Title:         "Pods Without Resource Limits",
+		DefaultStatus: assessmentv1alpha1.FindingStatusInfo,
+		Severity:      assessmentv1alpha1.SeverityLow,
+	},
+	{
+		ID:            "costoptimization-pvc-error",
+		Title:         "Unable to Check PVCs",
+		DefaultStatus: assessmentv1alpha1.FindingStatusError,
+		Severity:      assessmentv1alpha1.SeverityLow,
+	},
+}
@@ -21,12 +21,12 @@ import (
 	"fmt"
 	"strings"
 
-	appsv1 "k8s.io/api/apps/v1"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	assessmentv1alpha1 "github.com/openshift-assessment/cluster-assessment-operator/api/v1alpha1"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/clusterstate"
 	"github.com/openshift-assessment/cluster-assessment-operator/pkg/profiles"
 	"github.com/openshift-assessment/cluster-assessment-operator/pkg/validator"
 )
@@ -59,24 +59,34 @@ func (v *CostOptimizationValidator) Category() string {
 	return validatorCategory
 }
 
+// RequiredPermissions returns the API access this validator needs.
+func (v *CostOptimizationValidator) RequiredPermissions() []validator.Permission {
+	return []validator.Permission{
+		{Verb: "list", Resource: "persistentvolumeclaims"},
+		{Verb: "list", Resource: "pods"},
+		{Verb: "list", Group: "apps", Resource: "deployments"},
+	}
+}
+
 // Validate performs cost optimization checks.
 func (v *CostOptimizationValidator) Validate(ctx context.Context, c client.Client, profile profiles.Profile) ([]assessmentv1alpha1.Finding, error) {
 	var findings []assessmentv1alpha1.Finding
+	state := clusterstate.FromContext(ctx, c)
 
 	// Check 1: Orphan PVCs
-	findings = append(findings, v.checkOrphanPVCs(ctx, c)...)
+	findings = append(findings, v.checkOrphanPVCs(ctx, c, state)...)
 
 	// Check 2: Idle deployments
-	findings = append(findings, v.checkIdleDeployments(ctx, c)...)
+	findings = append(findings, v.checkIdleDeployments(ctx, state)...)
 
 	// Check 3: Pods without resource specifications
-	findings = append(findings, v.checkResourceSpecifications(ctx, c)...)
+	findings = append(findings, v.checkResourceSpecifications(ctx, state)...)
 
 	return findings, nil
 }
 
 // checkOrphanPVCs finds PVCs not bound to any pod.
-func (v *CostOptimizationValidator) checkOrphanPVCs(ctx context.Context, c client.Client) []assessmentv1alpha1.Finding {
+func (v *CostOptimizationValidator) checkOrphanPVCs(ctx context.Context, c client.Client, state *clusterstate.State) []assessmentv1alpha1.Finding {
 	var findings []assessmentv1alpha1.Finding
 
 	// Get all PVCs
@@ -86,21 +96,21 @@ func (v *CostOptimizationValidator) checkOrphanPVCs(ctx context.Context, c clien
 			ID:          "costoptimization-pvc-error",
 			Validator:   validatorName,
 			Category:    validatorCategory,
-			Status:      assessmentv1alpha1.FindingStatusFail,
+			Status:      assessmentv1alpha1.FindingStatusError,
 			Title:       "Unable to Check PVCs",
 			Description: fmt.Sprintf("Failed to list PVCs: %v", err),
 		}}
 	}
 
 	// Get all pods to find which PVCs are in use
-	pods := &corev1.PodList{}
-	if err := c.List(ctx, pods); err != nil {
+	pods, err := state.Pods(ctx)
+	if err != nil {
 		return findings
 	}
 
 	// Build map of PVCs in use
 	pvcInUse := make(map[string]bool)
-	for _, pod := range pods.Items {
+	for _, pod := range pods {
 		for _, volume := range pod.Spec.Volumes {
 			if volume.PersistentVolumeClaim != nil {
 				key := fmt.Sprintf("%s/%s", pod.Namespace, volume.PersistentVolumeClaim.ClaimName)
@@ -111,6 +121,7 @@ func (v *CostOptimizationValidator) checkOrphanPVCs(ctx context.Context, c clien
 
 	// Find orphan PVCs in user namespaces
 	var orphanPVCs []string
+	var orphanRefs []assessmentv1alpha1.AffectedResource
 	var totalOrphanSize resource.Quantity
 
 	for _, pvc := range pvcs.Items {
@@ -127,6 +138,7 @@ func (v *CostOptimizationValidator) checkOrphanPVCs(ctx context.Context, c clien
 		key := fmt.Sprintf("%s/%s", pvc.Namespace, pvc.Name)
 		if !pvcInUse[key] {
 			orphanPVCs = append(orphanPVCs, key)
+			orphanRefs = append(orphanRefs, validator.ResourceRef("PersistentVolumeClaim", &pvc))
 			if pvc.Status.Capacity != nil {
 				if storage, ok := pvc.Status.Capacity[corev1.ResourceStorage]; ok {
 					totalOrphanSize.Add(storage)
@@ -142,14 +154,15 @@ func (v *CostOptimizationValidator) checkOrphanPVCs(ctx context.Context, c clien
 		}
 
 		findings = append(findings, assessmentv1alpha1.Finding{
-			ID:             "costoptimization-orphan-pvcs",
-			Validator:      validatorName,
-			Category:       validatorCategory,
-			Status:         assessmentv1alpha1.FindingStatusWarn,
-			Title:          "Orphan PVCs Detected",
-			Description:    fmt.Sprintf("Found %d bound PVC(s) not attached to any pod (total size: %s): %s...", len(orphanPVCs), totalOrphanSize.String(), strings.Join(sample, ", ")),
-			Impact:         "Orphan PVCs consume storage resources without being used.",
-			Recommendation: "Review orphan PVCs and delete those no longer needed.",
+			ID:                "costoptimization-orphan-pvcs",
+			Validator:         validatorName,
+			Category:          validatorCategory,
+			Status:            assessmentv1alpha1.FindingStatusWarn,
+			Title:             "Orphan PVCs Detected",
+			Description:       fmt.Sprintf("Found %d bound PVC(s) not attached to any pod (total size: %s): %s...", len(orphanPVCs), totalOrphanSize.String(), strings.Join(sample, ", ")),
+			AffectedResources: orphanRefs,
+			Impact:            "Orphan PVCs consume storage resources without being used.",
+			Recommendation:    "Review orphan PVCs and delete those no longer needed.",
 			Remediation: &assessmentv1alpha1.RemediationGuidance{
 				Safety: assessmentv1alpha1.RemediationRequiresReview,
 				Commands: []assessmentv1alpha1.RemediationCommand{
@@ -175,17 +188,18 @@ func (v *CostOptimizationValidator) checkOrphanPVCs(ctx context.Context, c clien
 }
 
 // checkIdleDeployments finds deployments scaled to 0.
-func (v *CostOptimizationValidator) checkIdleDeployments(ctx context.Context, c client.Client) []assessmentv1alpha1.Finding {
+func (v *CostOptimizationValidator) checkIdleDeployments(ctx context.Context, state *clusterstate.State) []assessmentv1alpha1.Finding {
 	var findings []assessmentv1alpha1.Finding
 
-	deployments := &appsv1.DeploymentList{}
-	if err := c.List(ctx, deployments); err != nil {
+	deployments, err := state.Deployments(ctx)
+	if err != nil {
 		return findings
 	}
 
 	var idleDeployments []string
+	var idleRefs []assessmentv1alpha1.AffectedResource
 
-	for _, deploy := range deployments.Items {
+	for _, deploy := range deployments {
 		// Skip system namespaces
 		if strings.HasPrefix(deploy.Namespace, "openshift-") || strings.HasPrefix(deploy.Namespace, "kube-") {
 			continue
@@ -194,6 +208,7 @@ func (v *CostOptimizationValidator) checkIdleDeployments(ctx context.Context, c
 		// Check if scaled to 0
 		if deploy.Spec.Replicas != nil && *deploy.Spec.Replicas == 0 {
 			idleDeployments = append(idleDeployments, fmt.Sprintf("%s/%s", deploy.Namespace, deploy.Name))
+			idleRefs = append(idleRefs, validator.ResourceRef("Deployment", &deploy))
 		}
 	}
 
@@ -204,14 +219,15 @@ func (v *CostOptimizationValidator) checkIdleDeployments(ctx context.Context, c
 		}
 
 		findings = append(findings, assessmentv1alpha1.Finding{
-			ID:             "costoptimization-idle-deployments",
-			Validator:      validatorName,
-			Category:       validatorCategory,
-			Status:         assessmentv1alpha1.FindingStatusInfo,
-			Title:          "Idle Deployments",
-			Description:    fmt.Sprintf("Found %d deployment(s) scaled to 0 replicas: %s...", len(idleDeployments), strings.Join(sample, ", ")),
-			Impact:         "Idle deployments may indicate unused applications or forgotten test resources.",
-			Recommendation: "Review idle deployments and delete those no longer needed.",
+			ID:                "costoptimization-idle-deployments",
+			Validator:         validatorName,
+			Category:          validatorCategory,
+			Status:            assessmentv1alpha1.FindingStatusInfo,
+			Title:             "Idle Deployments",
+			Description:       fmt.Sprintf("Found %d deployment(s) scaled to 0 replicas: %s...", len(idleDeployments), strings.Join(sample, ", ")),
+			AffectedResources: idleRefs,
+			Impact:            "Idle deployments may indicate unused applications or forgotten test resources.",
+			Recommendation:    "Review idle deployments and delete those no longer needed.",
 		})
 	}
 
@@ -219,18 +235,19 @@ func (v *CostOptimizationValidator) checkIdleDeployments(ctx context.Context, c
 }
 
 // checkResourceSpecifications finds pods without resource requests/limits.
-func (v *CostOptimizationValidator) checkResourceSpecifications(ctx context.Context, c client.Client) []assessmentv1alpha1.Finding {
+func (v *CostOptimizationValidator) checkResourceSpecifications(ctx context.Context, state *clusterstate.State) []assessmentv1alpha1.Finding {
 	var findings []assessmentv1alpha1.Finding
 
-	pods := &corev1.PodList{}
-	if err := c.List(ctx, pods); err != nil {
+	pods, err := state.Pods(ctx)
+	if err != nil {
 		return findings
 	}
 
 	var podsWithoutRequests []string
 	var podsWithoutLimits []string
+	var noRequestsRefs, noLimitsRefs []assessmentv1alpha1.AffectedResource
 
-	for _, pod := range pods.Items {
+	for _, pod := range pods {
 		// Skip system namespaces
 		if strings.HasPrefix(pod.Namespace, "openshift-") || strings.HasPrefix(pod.Namespace, "kube-") {
 			continue
@@ -259,9 +276,11 @@ func (v *CostOptimizationValidator) checkResourceSpecifications(ctx context.Cont
 
 		if !hasRequests {
 			podsWithoutRequests = append(podsWithoutRequests, fmt.Sprintf("%s/%s", pod.Namespace, pod.Name))
+			noRequestsRefs = append(noRequestsRefs, validator.ResourceRef("Pod", &pod))
 		}
 		if !hasLimits {
 			podsWithoutLimits = append(podsWithoutLimits, fmt.Sprintf("%s/%s", pod.Namespace, pod.Name))
+			noLimitsRefs = append(noLimitsRefs, validator.ResourceRef("Pod", &pod))
 		}
 	}
 
@@ -273,14 +292,15 @@ func (v *CostOptimizationValidator) checkResourceSpecifications(ctx context.Cont
 		}
 
 		findings = append(findings, assessmentv1alpha1.Finding{
-			ID:             "costoptimization-no-requests",
-			Validator:      validatorName,
-			Category:       validatorCategory,
-			Status:         assessmentv1alpha1.FindingStatusWarn,
-			Title:          "Pods Without Resource Requests",
-			Description:    fmt.Sprintf("Found %d pod(s) without CPU/memory requests: %s...", len(podsWithoutRequests), strings.Join(sample, ", ")),
-			Impact:         "Pods without resource requests may cause scheduling and resource management issues.",
-			Recommendation: "Define resource requests for all production workloads.",
+			ID:                "costoptimization-no-requests",
+			Validator:         validatorName,
+			Category:          validatorCategory,
+			Status:            assessmentv1alpha1.FindingStatusWarn,
+			Title:             "Pods Without Resource Requests",
+			Description:       fmt.Sprintf("Found %d pod(s) without CPU/memory requests: %s...", len(podsWithoutRequests), strings.Join(sample, ", ")),
+			AffectedResources: noRequestsRefs,
+			Impact:            "Pods without resource requests may cause scheduling and resource management issues.",
+			Recommendation:    "Define resource requests for all production workloads.",
 			References: []string{
 				"https://kubernetes.io/docs/concepts/configuration/manage-resources-containers/",
 			},
@@ -312,14 +332,15 @@ func (v *CostOptimizationValidator) checkResourceSpecifications(ctx context.Cont
 		}
 
 		findings = append(findings, assessmentv1alpha1.Finding{
-			ID:             "costoptimization-no-limits",
-			Validator:      validatorName,
-			Category:       validatorCategory,
-			Status:         assessmentv1alpha1.FindingStatusInfo,
-			Title:          "Pods Without Resource Limits",
-			Description:    fmt.Sprintf("Found %d pod(s) without CPU/memory limits: %s...", len(podsWithoutLimits), strings.Join(sample, ", ")),
-			Impact:         "Pods without limits can consume all available node resources.",
-			Recommendation: "Consider defining resource limits or using LimitRanges.",
+			ID:                "costoptimization-no-limits",
+			Validator:         validatorName,
+			Category:          validatorCategory,
+			Status:            assessmentv1alpha1.FindingStatusInfo,
+			Title:             "Pods Without Resource Limits",
+			Description:       fmt.Sprintf("Found %d pod(s) without CPU/memory limits: %s...", len(podsWithoutLimits), strings.Join(sample, ", ")),
+			AffectedResources: noLimitsRefs,
+			Impact:            "Pods without limits can consume all available node resources.",
+			Recommendation:    "Consider defining resource limits or using LimitRanges.",
 		})
 	}
 
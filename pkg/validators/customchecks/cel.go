@@ -0,0 +1,76 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package customchecks
+
+import (
+	"context"
+	"fmt"
+	"sync"
+
+	"github.com/google/cel-go/cel"
+)
+
+const (
+	// objectVariable is the name the target object is bound to in expressions.
+	objectVariable = "object"
+
+	// costLimit bounds the work a single evaluation may do, so that an
+	// expensive expression cannot stall an assessment.
+	costLimit = 1000000
+
+	// interruptFrequency is how many comprehension iterations run between
+	// checks for context cancellation.
+	interruptFrequency = 100
+)
+
+// celEnv is shared by all expressions; environments are safe for concurrent use.
+var celEnv = sync.OnceValues(func() (*cel.Env, error) {
+	return cel.NewEnv(cel.Variable(objectVariable, cel.DynType))
+})
+
+// Compile parses and type-checks a CustomCheck expression. The expression
+// must evaluate to a bool.
+func Compile(expression string) (cel.Program, error) {
+	env, err := celEnv()
+	if err != nil {
+		return nil, fmt.Errorf("failed to create CEL environment: %w", err)
+	}
+
+	ast, issues := env.Compile(expression)
+	if issues.Err() != nil {
+		return nil, issues.Err()
+	}
+	if t := ast.OutputType(); !t.IsExactType(cel.BoolType) && !t.IsExactType(cel.DynType) {
+		return nil, fmt.Errorf("expression must evaluate to bool, not %s", t)
+	}
+
+	return env.Program(ast, cel.CostLimit(costLimit), cel.InterruptCheckFrequency(interruptFrequency))
+}
+
+// evaluate runs program against a single object and reports whether the
+// object satisfies it.
+func evaluate(ctx context.Context, program cel.Program, object map[string]any) (bool, error) {
+	val, _, err := program.ContextEval(ctx, map[string]any{objectVariable: object})
+	if err != nil {
+		return false, err
+	}
+	result, ok := val.Value().(bool)
+	if !ok {
+		return false, fmt.Errorf("expression returned %s, not bool", val.Type().TypeName())
+	}
+	return result, nil
+}
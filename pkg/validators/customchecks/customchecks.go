@@ -0,0 +1,229 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package customchecks evaluates the declarative checks defined by CustomCheck
+// resources. Each CustomCheck reports a single finding: PASS when every target
+// object satisfies its CEL expression, and its configured status otherwise.
+package customchecks
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	assessmentv1alpha1 "github.com/openshift-assessment/cluster-assessment-operator/api/v1alpha1"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/profiles"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/validator"
+)
+
+const (
+	validatorName        = "customchecks"
+	validatorDescription = "Evaluates the CEL expressions defined by CustomCheck resources"
+	validatorCategory    = "Custom"
+
+	// IDPrefix is the prefix every CustomCheck finding ID must carry, so custom
+	// findings never collide with those of built-in validators.
+	IDPrefix = "custom-"
+)
+
+func init() {
+	_ = validator.Register(&CustomChecksValidator{})
+}
+
+// CustomChecksValidator evaluates every CustomCheck in the cluster.
+type CustomChecksValidator struct{}
+
+func (v *CustomChecksValidator) Name() string        { return validatorName }
+func (v *CustomChecksValidator) Description() string { return validatorDescription }
+func (v *CustomChecksValidator) Category() string    { return validatorCategory }
+
+// RequiredPermissions returns the API access this validator needs. Reading the
+// kinds targeted by CustomChecks needs further grants, which depend on the
+// checks; a check whose kind cannot be listed reports ERROR.
+func (v *CustomChecksValidator) RequiredPermissions() []validator.Permission {
+	return []validator.Permission{
+		{Verb: "list", Group: assessmentv1alpha1.GroupVersion.Group, Resource: "customchecks"},
+	}
+}
+
+// Validate evaluates each CustomCheck, in name order.
+func (v *CustomChecksValidator) Validate(ctx context.Context, c client.Client, profile profiles.Profile) ([]assessmentv1alpha1.Finding, error) {
+	list := &assessmentv1alpha1.CustomCheckList{}
+	if err := c.List(ctx, list); err != nil {
+		if validator.IsNotInstalled(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to list CustomChecks: %w", err)
+	}
+
+	sort.Slice(list.Items, func(i, j int) bool {
+		return list.Items[i].Name < list.Items[j].Name
+	})
+
+	findings := make([]assessmentv1alpha1.Finding, 0, len(list.Items))
+	for i := range list.Items {
+		findings = append(findings, v.evaluateCheck(ctx, c, &list.Items[i]))
+	}
+	return findings, nil
+}
+
+// evaluateCheck lists the objects targeted by check and evaluates its
+// expression against each of them.
+func (v *CustomChecksValidator) evaluateCheck(ctx context.Context, c client.Client, check *assessmentv1alpha1.CustomCheck) assessmentv1alpha1.Finding {
+	spec := check.Spec
+	target := spec.Target
+
+	program, err := Compile(spec.Expression)
+	if err != nil {
+		return inconclusive(check, fmt.Sprintf("The expression of CustomCheck %s is invalid: %v.", check.Name, err))
+	}
+
+	opts, err := listOptions(target)
+	if err != nil {
+		return inconclusive(check, fmt.Sprintf("The target of CustomCheck %s is invalid: %v.", check.Name, err))
+	}
+	gv, err := schema.ParseGroupVersion(target.APIVersion)
+	if err != nil {
+		return inconclusive(check, fmt.Sprintf("The target of CustomCheck %s is invalid: %v.", check.Name, err))
+	}
+
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(gv.WithKind(target.Kind + "List"))
+	if err := c.List(ctx, list, opts...); err != nil {
+		if validator.IsNotInstalled(err) {
+			f := newFinding(check, assessmentv1alpha1.FindingStatusNotApplicable)
+			f.Description = fmt.Sprintf("The cluster does not serve %s %s, so CustomCheck %s has nothing to evaluate.", target.APIVersion, target.Kind, check.Name)
+			f.Recommendation = ""
+			return f
+		}
+		return inconclusive(check, fmt.Sprintf("Failed to list %s objects for CustomCheck %s: %v.", target.Kind, check.Name, err))
+	}
+
+	var violations []assessmentv1alpha1.AffectedResource
+	var evalErrors []string
+	for i := range list.Items {
+		obj := &list.Items[i]
+		ok, err := evaluate(ctx, program, obj.Object)
+		switch {
+		case err != nil:
+			evalErrors = append(evalErrors, fmt.Sprintf("%s: %v", objectName(obj), err))
+		case !ok:
+			violations = append(violations, validator.ResourceRef(target.Kind, obj))
+		}
+	}
+
+	if len(violations) == 0 && len(evalErrors) > 0 {
+		return inconclusive(check, fmt.Sprintf("The expression of CustomCheck %s could not be evaluated for %d %s objects: %s.",
+			check.Name, len(evalErrors), target.Kind, strings.Join(evalErrors, "; ")))
+	}
+
+	if len(violations) == 0 {
+		f := newFinding(check, assessmentv1alpha1.FindingStatusPass)
+		f.Description = joinSentences(spec.Finding.Description,
+			fmt.Sprintf("All %d matching %s objects satisfy the check.", len(list.Items), target.Kind))
+		f.Recommendation = ""
+		return f
+	}
+
+	status := spec.Finding.Status
+	if status == "" {
+		status = assessmentv1alpha1.FindingStatusWarn
+	}
+	f := newFinding(check, status)
+	summary := fmt.Sprintf("%d of %d matching %s objects do not satisfy the check.", len(violations), len(list.Items), target.Kind)
+	if len(evalErrors) > 0 {
+		summary += fmt.Sprintf(" The expression could not be evaluated for %d other objects.", len(evalErrors))
+	}
+	f.Description = joinSentences(spec.Finding.Description, summary)
+	f.AffectedResources = violations
+	return f
+}
+
+// listOptions restricts the list of target objects to the target's namespace
+// and label selector.
+func listOptions(target assessmentv1alpha1.CustomCheckTarget) ([]client.ListOption, error) {
+	var opts []client.ListOption
+	if target.Namespace != "" {
+		opts = append(opts, client.InNamespace(target.Namespace))
+	}
+	if target.LabelSelector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(target.LabelSelector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid label selector: %w", err)
+		}
+		opts = append(opts, client.MatchingLabelsSelector{Selector: selector})
+	}
+	return opts, nil
+}
+
+// newFinding builds the finding for check with the fields it declares.
+func newFinding(check *assessmentv1alpha1.CustomCheck, status assessmentv1alpha1.FindingStatus) assessmentv1alpha1.Finding {
+	spec := check.Spec.Finding
+
+	category := spec.Category
+	if category == "" {
+		category = validatorCategory
+	}
+	severity := spec.Severity
+	if severity == "" || status == assessmentv1alpha1.FindingStatusError || status == assessmentv1alpha1.FindingStatusNotApplicable {
+		severity = validator.DefaultSeverity(status)
+	}
+
+	return assessmentv1alpha1.Finding{
+		ID:             spec.ID,
+		Validator:      validatorName,
+		Category:       category,
+		Status:         status,
+		Severity:       severity,
+		Title:          spec.Title,
+		Description:    spec.Description,
+		Recommendation: spec.Recommendation,
+		References:     spec.References,
+	}
+}
+
+// inconclusive builds the ERROR finding for a check that could not be evaluated.
+func inconclusive(check *assessmentv1alpha1.CustomCheck, description string) assessmentv1alpha1.Finding {
+	f := newFinding(check, assessmentv1alpha1.FindingStatusError)
+	f.Description = description
+	f.Recommendation = fmt.Sprintf("Fix CustomCheck %s, or grant the operator permission to list its target kind.", check.Name)
+	return f
+}
+
+// objectName returns the namespace/name of obj.
+func objectName(obj *unstructured.Unstructured) string {
+	if obj.GetNamespace() == "" {
+		return obj.GetName()
+	}
+	return obj.GetNamespace() + "/" + obj.GetName()
+}
+
+// joinSentences joins the non-empty sentences with a space.
+func joinSentences(sentences ...string) string {
+	var parts []string
+	for _, s := range sentences {
+		if s = strings.TrimSpace(s); s != "" {
+			parts = append(parts, s)
+		}
+	}
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,181 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package customchecks
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	assessmentv1alpha1 "github.com/openshift-assessment/cluster-assessment-operator/api/v1alpha1"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/profiles"
+)
+
+const costCenterExpression = "has(object.metadata.labels) && 'cost-center' in object.metadata.labels"
+
+func newTestScheme() *runtime.Scheme {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(clientgoscheme.AddToScheme(scheme))
+	utilruntime.Must(assessmentv1alpha1.AddToScheme(scheme))
+	return scheme
+}
+
+func namespace(name string, labels map[string]string) *corev1.Namespace {
+	return &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels}}
+}
+
+func customCheck(name, id, apiVersion, kind, expression string) *assessmentv1alpha1.CustomCheck {
+	return &assessmentv1alpha1.CustomCheck{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: assessmentv1alpha1.CustomCheckSpec{
+			Target:     assessmentv1alpha1.CustomCheckTarget{APIVersion: apiVersion, Kind: kind},
+			Expression: expression,
+			Finding: assessmentv1alpha1.CustomCheckFinding{
+				ID:             id,
+				Status:         assessmentv1alpha1.FindingStatusFail,
+				Title:          "Namespaces Without Cost Center",
+				Recommendation: "Add a cost-center label.",
+			},
+		},
+	}
+}
+
+func validate(t *testing.T, c client.Client) []assessmentv1alpha1.Finding {
+	t.Helper()
+	findings, err := (&CustomChecksValidator{}).Validate(context.Background(), c, profiles.GetProfile("production"))
+	if err != nil {
+		t.Fatalf("Validate returned error: %v", err)
+	}
+	return findings
+}
+
+func TestValidate_ReportsViolations(t *testing.T) {
+	check := customCheck("cost-center", "custom-cost-center", "v1", "Namespace", costCenterExpression)
+	check.Spec.Target.LabelSelector = &metav1.LabelSelector{MatchLabels: map[string]string{"team": "a"}}
+	c := fake.NewClientBuilder().WithScheme(newTestScheme()).WithObjects(
+		check,
+		namespace("labelled", map[string]string{"team": "a", "cost-center": "42"}),
+		namespace("unlabelled", map[string]string{"team": "a"}),
+		namespace("other-team", map[string]string{"team": "b"}),
+	).Build()
+
+	findings := validate(t, c)
+	if len(findings) != 1 {
+		t.Fatalf("Expected 1 finding, got %d", len(findings))
+	}
+	f := findings[0]
+	if f.ID != "custom-cost-center" || f.Status != assessmentv1alpha1.FindingStatusFail || f.Category != "Custom" {
+		t.Errorf("Unexpected finding %+v", f)
+	}
+	if f.Severity != assessmentv1alpha1.SeverityHigh {
+		t.Errorf("Expected the severity to default from FAIL, got %q", f.Severity)
+	}
+	if len(f.AffectedResources) != 1 || f.AffectedResources[0].Name != "unlabelled" || f.AffectedResources[0].Kind != "Namespace" {
+		t.Errorf("Expected only the unlabelled namespace to be affected, got %+v", f.AffectedResources)
+	}
+	if !strings.Contains(f.Description, "1 of 2") {
+		t.Errorf("Expected the description to count violations, got %q", f.Description)
+	}
+}
+
+func TestValidate_Pass(t *testing.T) {
+	c := fake.NewClientBuilder().WithScheme(newTestScheme()).WithObjects(
+		customCheck("cost-center", "custom-cost-center", "v1", "Namespace", costCenterExpression),
+		namespace("labelled", map[string]string{"cost-center": "42"}),
+	).Build()
+
+	findings := validate(t, c)
+	if len(findings) != 1 || findings[0].Status != assessmentv1alpha1.FindingStatusPass {
+		t.Fatalf("Expected a single PASS finding, got %+v", findings)
+	}
+	if findings[0].Recommendation != "" || len(findings[0].AffectedResources) != 0 {
+		t.Errorf("Expected a PASS finding without recommendation or resources, got %+v", findings[0])
+	}
+}
+
+func TestValidate_InconclusiveAndNotApplicable(t *testing.T) {
+	c := fake.NewClientBuilder().WithScheme(newTestScheme()).WithObjects(
+		customCheck("a-invalid", "custom-invalid", "v1", "Namespace", "object.metadata.name +"),
+		customCheck("b-not-bool", "custom-not-bool", "v1", "Namespace", "object.metadata.name"),
+		customCheck("c-missing-api", "custom-routes", "route.openshift.io/v1", "Route", "true"),
+		customCheck("d-forbidden", "custom-secrets", "v1", "Secret", "true"),
+		namespace("default", nil),
+	).WithInterceptorFuncs(interceptor.Funcs{
+		List: func(ctx context.Context, c client.WithWatch, list client.ObjectList, opts ...client.ListOption) error {
+			if u, ok := list.(*unstructured.UnstructuredList); ok {
+				switch u.GetKind() {
+				case "RouteList":
+					return &meta.NoKindMatchError{GroupKind: schema.GroupKind{Group: "route.openshift.io", Kind: "Route"}}
+				case "SecretList":
+					return apierrors.NewForbidden(schema.GroupResource{Resource: "secrets"}, "", nil)
+				}
+			}
+			return c.List(ctx, list, opts...)
+		},
+	}).Build()
+
+	findings := validate(t, c)
+	want := []struct {
+		id     string
+		status assessmentv1alpha1.FindingStatus
+	}{
+		{"custom-invalid", assessmentv1alpha1.FindingStatusError},
+		{"custom-not-bool", assessmentv1alpha1.FindingStatusError},
+		{"custom-routes", assessmentv1alpha1.FindingStatusNotApplicable},
+		{"custom-secrets", assessmentv1alpha1.FindingStatusError},
+	}
+	if len(findings) != len(want) {
+		t.Fatalf("Expected %d findings, got %+v", len(want), findings)
+	}
+	for i, w := range want {
+		if findings[i].ID != w.id || findings[i].Status != w.status {
+			t.Errorf("Expected %s to be %s, got %s %s: %s", w.id, w.status, findings[i].ID, findings[i].Status, findings[i].Description)
+		}
+	}
+}
+
+func TestValidate_NoCustomChecks(t *testing.T) {
+	c := fake.NewClientBuilder().WithScheme(newTestScheme()).Build()
+	if findings := validate(t, c); len(findings) != 0 {
+		t.Errorf("Expected no findings without CustomChecks, got %+v", findings)
+	}
+}
+
+func TestCompile(t *testing.T) {
+	if _, err := Compile(costCenterExpression); err != nil {
+		t.Errorf("Expected the expression to compile, got %v", err)
+	}
+	if _, err := Compile("size(object.metadata.name)"); err == nil || !strings.Contains(err.Error(), "bool") {
+		t.Errorf("Expected a non-bool expression to be rejected, got %v", err)
+	}
+	if _, err := Compile("object.metadata.("); err == nil {
+		t.Error("Expected a syntax error")
+	}
+}
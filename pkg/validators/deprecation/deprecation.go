@@ -21,13 +21,13 @@ import (
 	"fmt"
 	"strings"
 
-	appsv1 "k8s.io/api/apps/v1"
 	batchv1 "k8s.io/api/batch/v1"
 	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	assessmentv1alpha1 "github.com/openshift-assessment/cluster-assessment-operator/api/v1alpha1"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/clusterstate"
 	"github.com/openshift-assessment/cluster-assessment-operator/pkg/profiles"
 	"github.com/openshift-assessment/cluster-assessment-operator/pkg/validator"
 )
@@ -68,30 +68,43 @@ func (v *DeprecationValidator) Category() string {
 	return validatorCategory
 }
 
+// RequiredPermissions returns the API access this validator needs.
+func (v *DeprecationValidator) RequiredPermissions() []validator.Permission {
+	return []validator.Permission{
+		{Verb: "list", Group: "networking.k8s.io", Resource: "ingresses"},
+		{Verb: "list", Group: "apps", Resource: "deployments"},
+		{Verb: "list", Resource: "pods"},
+		{Verb: "list", Group: "batch", Resource: "cronjobs"},
+	}
+}
+
 // Validate performs deprecation checks.
 func (v *DeprecationValidator) Validate(ctx context.Context, c client.Client, profile profiles.Profile) ([]assessmentv1alpha1.Finding, error) {
 	var findings []assessmentv1alpha1.Finding
+	state := clusterstate.FromContext(ctx, c)
 
 	// Check 1: Deprecated workload patterns
-	findings = append(findings, v.checkDeprecatedPatterns(ctx, c)...)
+	findings = append(findings, v.checkDeprecatedPatterns(ctx, c, state)...)
 
 	// Check 2: Resources without recommended fields
-	findings = append(findings, v.checkMissingRecommendedFields(ctx, c)...)
+	findings = append(findings, v.checkMissingRecommendedFields(ctx, c, state)...)
 
 	return findings, nil
 }
 
 // checkDeprecatedPatterns checks for deprecated configuration patterns.
-func (v *DeprecationValidator) checkDeprecatedPatterns(ctx context.Context, c client.Client) []assessmentv1alpha1.Finding {
+func (v *DeprecationValidator) checkDeprecatedPatterns(ctx context.Context, c client.Client, state *clusterstate.State) []assessmentv1alpha1.Finding {
 	var findings []assessmentv1alpha1.Finding
 
 	// Check for Ingresses without IngressClassName (deprecated pattern)
 	ingresses := &networkingv1.IngressList{}
 	if err := c.List(ctx, ingresses); err == nil {
 		var noClassName []string
+		var noClassNameRefs []assessmentv1alpha1.AffectedResource
 		for _, ing := range ingresses.Items {
 			if ing.Spec.IngressClassName == nil && ing.Annotations["kubernetes.io/ingress.class"] == "" {
 				noClassName = append(noClassName, fmt.Sprintf("%s/%s", ing.Namespace, ing.Name))
+				noClassNameRefs = append(noClassNameRefs, validator.ResourceRef("Ingress", &ing))
 			}
 		}
 		if len(noClassName) > 0 {
@@ -100,14 +113,15 @@ func (v *DeprecationValidator) checkDeprecatedPatterns(ctx context.Context, c cl
 				sample = sample[:5]
 			}
 			findings = append(findings, assessmentv1alpha1.Finding{
-				ID:             "deprecation-ingress-no-class",
-				Validator:      validatorName,
-				Category:       validatorCategory,
-				Status:         assessmentv1alpha1.FindingStatusWarn,
-				Title:          "Ingresses Without IngressClassName",
-				Description:    fmt.Sprintf("Found %d Ingress(es) without IngressClassName: %s", len(noClassName), strings.Join(sample, ", ")),
-				Impact:         "Ingresses without IngressClassName may not be processed correctly in future versions.",
-				Recommendation: "Set spec.ingressClassName on all Ingresses.",
+				ID:                "deprecation-ingress-no-class",
+				Validator:         validatorName,
+				Category:          validatorCategory,
+				Status:            assessmentv1alpha1.FindingStatusWarn,
+				Title:             "Ingresses Without IngressClassName",
+				Description:       fmt.Sprintf("Found %d Ingress(es) without IngressClassName: %s", len(noClassName), strings.Join(sample, ", ")),
+				AffectedResources: noClassNameRefs,
+				Impact:            "Ingresses without IngressClassName may not be processed correctly in future versions.",
+				Recommendation:    "Set spec.ingressClassName on all Ingresses.",
 				References: []string{
 					"https://kubernetes.io/docs/concepts/services-networking/ingress/",
 				},
@@ -124,25 +138,35 @@ func (v *DeprecationValidator) checkDeprecatedPatterns(ctx context.Context, c cl
 	}
 
 	// Check for Deployments with deprecated fields
-	deployments := &appsv1.DeploymentList{}
-	if err := c.List(ctx, deployments); err == nil {
+	deployments, err := state.Deployments(ctx)
+	if err == nil {
 		var noProbes []string
 		var noResources []string
+		var noProbesRefs, noResourcesRefs []assessmentv1alpha1.AffectedResource
 
-		for _, deploy := range deployments.Items {
+		for _, deploy := range deployments {
 			// Skip system namespaces
 			if strings.HasPrefix(deploy.Namespace, "openshift-") || strings.HasPrefix(deploy.Namespace, "kube-") {
 				continue
 			}
 
+			missingProbes, missingResources := false, false
 			for _, container := range deploy.Spec.Template.Spec.Containers {
 				if container.LivenessProbe == nil && container.ReadinessProbe == nil {
 					noProbes = append(noProbes, fmt.Sprintf("%s/%s:%s", deploy.Namespace, deploy.Name, container.Name))
+					missingProbes = true
 				}
 				if container.Resources.Requests == nil && container.Resources.Limits == nil {
 					noResources = append(noResources, fmt.Sprintf("%s/%s:%s", deploy.Namespace, deploy.Name, container.Name))
+					missingResources = true
 				}
 			}
+			if missingProbes {
+				noProbesRefs = append(noProbesRefs, validator.ResourceRef("Deployment", &deploy))
+			}
+			if missingResources {
+				noResourcesRefs = append(noResourcesRefs, validator.ResourceRef("Deployment", &deploy))
+			}
 		}
 
 		if len(noProbes) > 0 {
@@ -151,14 +175,15 @@ func (v *DeprecationValidator) checkDeprecatedPatterns(ctx context.Context, c cl
 				sample = sample[:5]
 			}
 			findings = append(findings, assessmentv1alpha1.Finding{
-				ID:             "deprecation-no-probes",
-				Validator:      validatorName,
-				Category:       validatorCategory,
-				Status:         assessmentv1alpha1.FindingStatusWarn,
-				Title:          "Containers Without Health Probes",
-				Description:    fmt.Sprintf("Found %d container(s) without liveness or readiness probes: %s...", len(noProbes), strings.Join(sample, ", ")),
-				Impact:         "Containers without probes may not be properly managed during failures or updates.",
-				Recommendation: "Configure appropriate liveness and readiness probes for all containers.",
+				ID:                "deprecation-no-probes",
+				Validator:         validatorName,
+				Category:          validatorCategory,
+				Status:            assessmentv1alpha1.FindingStatusWarn,
+				Title:             "Containers Without Health Probes",
+				Description:       fmt.Sprintf("Found %d container(s) without liveness or readiness probes: %s...", len(noProbes), strings.Join(sample, ", ")),
+				AffectedResources: noProbesRefs,
+				Impact:            "Containers without probes may not be properly managed during failures or updates.",
+				Recommendation:    "Configure appropriate liveness and readiness probes for all containers.",
 			})
 		}
 
@@ -168,14 +193,15 @@ func (v *DeprecationValidator) checkDeprecatedPatterns(ctx context.Context, c cl
 				sample = sample[:5]
 			}
 			findings = append(findings, assessmentv1alpha1.Finding{
-				ID:             "deprecation-no-resources",
-				Validator:      validatorName,
-				Category:       validatorCategory,
-				Status:         assessmentv1alpha1.FindingStatusWarn,
-				Title:          "Containers Without Resource Requests/Limits",
-				Description:    fmt.Sprintf("Found %d container(s) without resource requests or limits: %s...", len(noResources), strings.Join(sample, ", ")),
-				Impact:         "Containers without resource specifications may cause resource contention.",
-				Recommendation: "Configure appropriate resource requests and limits for all containers.",
+				ID:                "deprecation-no-resources",
+				Validator:         validatorName,
+				Category:          validatorCategory,
+				Status:            assessmentv1alpha1.FindingStatusWarn,
+				Title:             "Containers Without Resource Requests/Limits",
+				Description:       fmt.Sprintf("Found %d container(s) without resource requests or limits: %s...", len(noResources), strings.Join(sample, ", ")),
+				AffectedResources: noResourcesRefs,
+				Impact:            "Containers without resource specifications may cause resource contention.",
+				Recommendation:    "Configure appropriate resource requests and limits for all containers.",
 			})
 		}
 	}
@@ -184,14 +210,15 @@ func (v *DeprecationValidator) checkDeprecatedPatterns(ctx context.Context, c cl
 }
 
 // checkMissingRecommendedFields checks for resources missing recommended fields.
-func (v *DeprecationValidator) checkMissingRecommendedFields(ctx context.Context, c client.Client) []assessmentv1alpha1.Finding {
+func (v *DeprecationValidator) checkMissingRecommendedFields(ctx context.Context, c client.Client, state *clusterstate.State) []assessmentv1alpha1.Finding {
 	var findings []assessmentv1alpha1.Finding
 
 	// Check for pods without proper labels
-	pods := &corev1.PodList{}
-	if err := c.List(ctx, pods); err == nil {
+	pods, err := state.Pods(ctx)
+	if err == nil {
 		var noAppLabel []string
-		for _, pod := range pods.Items {
+		var noAppLabelRefs []assessmentv1alpha1.AffectedResource
+		for _, pod := range pods {
 			// Skip system namespaces
 			if strings.HasPrefix(pod.Namespace, "openshift-") || strings.HasPrefix(pod.Namespace, "kube-") {
 				continue
@@ -210,6 +237,7 @@ func (v *DeprecationValidator) checkMissingRecommendedFields(ctx context.Context
 			}
 			if !hasAppLabel {
 				noAppLabel = append(noAppLabel, fmt.Sprintf("%s/%s", pod.Namespace, pod.Name))
+				noAppLabelRefs = append(noAppLabelRefs, validator.ResourceRef("Pod", &pod))
 			}
 		}
 
@@ -219,13 +247,14 @@ func (v *DeprecationValidator) checkMissingRecommendedFields(ctx context.Context
 				sample = sample[:5]
 			}
 			findings = append(findings, assessmentv1alpha1.Finding{
-				ID:             "deprecation-no-app-label",
-				Validator:      validatorName,
-				Category:       validatorCategory,
-				Status:         assessmentv1alpha1.FindingStatusInfo,
-				Title:          "Pods Without App Labels",
-				Description:    fmt.Sprintf("Found %d pod(s) without app-related labels: %s...", len(noAppLabel), strings.Join(sample, ", ")),
-				Recommendation: "Use consistent labeling (app.kubernetes.io/name, app.kubernetes.io/component) for better observability.",
+				ID:                "deprecation-no-app-label",
+				Validator:         validatorName,
+				Category:          validatorCategory,
+				Status:            assessmentv1alpha1.FindingStatusInfo,
+				Title:             "Pods Without App Labels",
+				Description:       fmt.Sprintf("Found %d pod(s) without app-related labels: %s...", len(noAppLabel), strings.Join(sample, ", ")),
+				AffectedResources: noAppLabelRefs,
+				Recommendation:    "Use consistent labeling (app.kubernetes.io/name, app.kubernetes.io/component) for better observability.",
 			})
 		}
 	}
@@ -235,6 +264,7 @@ func (v *DeprecationValidator) checkMissingRecommendedFields(ctx context.Context
 	if err := c.List(ctx, cronJobs); err == nil {
 		var noSuccessLimit []string
 		var noFailedLimit []string
+		var historyRefs []assessmentv1alpha1.AffectedResource
 
 		for _, cj := range cronJobs.Items {
 			if strings.HasPrefix(cj.Namespace, "openshift-") || strings.HasPrefix(cj.Namespace, "kube-") {
@@ -247,19 +277,24 @@ func (v *DeprecationValidator) checkMissingRecommendedFields(ctx context.Context
 			if cj.Spec.FailedJobsHistoryLimit == nil || *cj.Spec.FailedJobsHistoryLimit > 5 {
 				noFailedLimit = append(noFailedLimit, fmt.Sprintf("%s/%s", cj.Namespace, cj.Name))
 			}
+			if cj.Spec.SuccessfulJobsHistoryLimit == nil || *cj.Spec.SuccessfulJobsHistoryLimit > 5 ||
+				cj.Spec.FailedJobsHistoryLimit == nil || *cj.Spec.FailedJobsHistoryLimit > 5 {
+				historyRefs = append(historyRefs, validator.ResourceRef("CronJob", &cj))
+			}
 		}
 
 		if len(noSuccessLimit) > 0 || len(noFailedLimit) > 0 {
 			totalCount := len(noSuccessLimit) + len(noFailedLimit)
 			findings = append(findings, assessmentv1alpha1.Finding{
-				ID:             "deprecation-cronjob-history",
-				Validator:      validatorName,
-				Category:       validatorCategory,
-				Status:         assessmentv1alpha1.FindingStatusInfo,
-				Title:          "CronJobs Without History Limits",
-				Description:    fmt.Sprintf("Found %d CronJob(s) without optimal history retention limits.", totalCount),
-				Impact:         "CronJobs without history limits may accumulate many completed job resources.",
-				Recommendation: "Set successfulJobsHistoryLimit and failedJobsHistoryLimit to reasonable values (e.g., 3-5).",
+				ID:                "deprecation-cronjob-history",
+				Validator:         validatorName,
+				Category:          validatorCategory,
+				Status:            assessmentv1alpha1.FindingStatusInfo,
+				Title:             "CronJobs Without History Limits",
+				Description:       fmt.Sprintf("Found %d CronJob(s) without optimal history retention limits.", totalCount),
+				AffectedResources: historyRefs,
+				Impact:            "CronJobs without history limits may accumulate many completed job resources.",
+				Recommendation:    "Set successfulJobsHistoryLimit and failedJobsHistoryLimit to reasonable values (e.g., 3-5).",
 			})
 		}
 	}
@@ -0,0 +1,80 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package etcdbackup
+
+import (
+	assessmentv1alpha1 "github.com/openshift-assessment/cluster-assessment-operator/api/v1alpha1"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/validator"
+)
+
+// Checks returns the catalog of checks this validator can report.
+func (v *EtcdBackupValidator) Checks() []validator.Check {
+	return checks
+}
+
+// checks lists every finding ID emitted by this validator.
+var checks = []validator.Check{
+	{
+		ID:            "etcdbackup-inconclusive",
+		Title:         "Backup Configuration Could Not Be Determined",
+		DefaultStatus: assessmentv1alpha1.FindingStatusError,
+		Severity:      assessmentv1alpha1.SeverityHigh,
+	},
+	{
+		ID:            "etcdbackup-not-configured",
+		Title:         "No Backup Solution Detected",
+		DefaultStatus: assessmentv1alpha1.FindingStatusWarn,
+		Severity:      assessmentv1alpha1.SeverityCritical,
+		References:    []string{"https://docs.openshift.com/container-platform/latest/backup_and_restore/control_plane_backup_and_restore/backing-up-etcd.html"},
+	},
+	{
+		ID:            "etcdbackup-oadp-*",
+		Title:         "OADP Configured",
+		DefaultStatus: assessmentv1alpha1.FindingStatusPass,
+		Severity:      assessmentv1alpha1.SeverityLow,
+	},
+	{
+		ID:            "etcdbackup-oadp-issue-*",
+		Title:         "OADP Configuration Issue",
+		DefaultStatus: assessmentv1alpha1.FindingStatusWarn,
+		Severity:      assessmentv1alpha1.SeverityMedium,
+	},
+	{
+		ID:            "etcdbackup-config-found",
+		Title:         "Etcd Backup Configuration Found",
+		DefaultStatus: assessmentv1alpha1.FindingStatusPass,
+		Severity:      assessmentv1alpha1.SeverityLow,
+	},
+	{
+		ID:            "etcdbackup-cronjob-*",
+		Title:         "Backup CronJob Detected",
+		DefaultStatus: assessmentv1alpha1.FindingStatusWarn,
+		Severity:      assessmentv1alpha1.SeverityLow,
+	},
+	{
+		ID:            "etcdbackup-velero",
+		Title:         "Velero Namespace Found",
+		DefaultStatus: assessmentv1alpha1.FindingStatusPass,
+		Severity:      assessmentv1alpha1.SeverityLow,
+	},
+	{
+		ID:            "etcdbackup-oadp-namespace",
+		Title:         "OADP Namespace Present",
+		DefaultStatus: assessmentv1alpha1.FindingStatusPass,
+		Severity:      assessmentv1alpha1.SeverityLow,
+	},
+}
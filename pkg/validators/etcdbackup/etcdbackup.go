@@ -18,11 +18,13 @@ package etcdbackup
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"strings"
 	"time"
 
 	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
@@ -61,18 +63,47 @@ func (v *EtcdBackupValidator) Category() string {
 	return validatorCategory
 }
 
+// RequiredPermissions returns the API access this validator needs.
+func (v *EtcdBackupValidator) RequiredPermissions() []validator.Permission {
+	return []validator.Permission{
+		{Verb: "list", Group: "oadp.openshift.io", Resource: "dataprotectionapplications"},
+		{Verb: "list", Resource: "configmaps", Namespace: "openshift-etcd"},
+		{Verb: "list", Group: "batch", Resource: "cronjobs"},
+		{Verb: "get", Group: "batch", Resource: "cronjobs"},
+		{Verb: "get", Resource: "namespaces"},
+	}
+}
+
 // Validate performs etcd backup configuration checks.
 func (v *EtcdBackupValidator) Validate(ctx context.Context, c client.Client, profile profiles.Profile) ([]assessmentv1alpha1.Finding, error) {
 	var findings []assessmentv1alpha1.Finding
 
 	// Check for OADP (OpenShift API for Data Protection)
-	findings = append(findings, v.checkOADP(ctx, c)...)
+	oadpFindings, oadpErr := v.checkOADP(ctx, c)
+	findings = append(findings, oadpFindings...)
 
 	// Check for etcd backup CronJobs
-	findings = append(findings, v.checkBackupCronJobs(ctx, c)...)
+	cronJobFindings, cronJobErr := v.checkBackupCronJobs(ctx, c)
+	findings = append(findings, cronJobFindings...)
 
 	// Check for Velero configuration
-	findings = append(findings, v.checkVelero(ctx, c)...)
+	veleroFindings, veleroErr := v.checkVelero(ctx, c)
+	findings = append(findings, veleroFindings...)
+
+	// If no backup mechanism was found but some lookups failed, one may exist
+	if err := errors.Join(oadpErr, cronJobErr, veleroErr); err != nil && len(findings) == 0 {
+		findings = append(findings, assessmentv1alpha1.Finding{
+			ID:             "etcdbackup-inconclusive",
+			Validator:      validatorName,
+			Category:       validatorCategory,
+			Status:         assessmentv1alpha1.FindingStatusError,
+			Title:          "Backup Configuration Could Not Be Determined",
+			Description:    fmt.Sprintf("No backup solution was found, but some backup resources could not be read: %v", err),
+			Impact:         "The cluster may have no backups, or a backup solution may have been missed.",
+			Recommendation: "Check API server health and the operator's permissions, then re-run the assessment.",
+		})
+		return findings, nil
+	}
 
 	// If no backup mechanism found, warn
 	if len(findings) == 0 {
@@ -103,8 +134,9 @@ func (v *EtcdBackupValidator) Validate(ctx context.Context, c client.Client, pro
 	return findings, nil
 }
 
-// checkOADP checks for OpenShift API for Data Protection installation.
-func (v *EtcdBackupValidator) checkOADP(ctx context.Context, c client.Client) []assessmentv1alpha1.Finding {
+// checkOADP checks for OpenShift API for Data Protection installation. It
+// returns an error if OADP may be installed but could not be read.
+func (v *EtcdBackupValidator) checkOADP(ctx context.Context, c client.Client) ([]assessmentv1alpha1.Finding, error) {
 	var findings []assessmentv1alpha1.Finding
 
 	// Check for OADP DataProtectionApplication CR
@@ -117,7 +149,13 @@ func (v *EtcdBackupValidator) checkOADP(ctx context.Context, c client.Client) []
 	dpaList := &unstructured.UnstructuredList{}
 	dpaList.SetGroupVersionKind(dpaGVK)
 
-	if err := c.List(ctx, dpaList); err == nil && len(dpaList.Items) > 0 {
+	if err := c.List(ctx, dpaList); err != nil {
+		if validator.IsNotInstalled(err) {
+			return nil, nil
+		}
+		return nil, fmt.Errorf("failed to list DataProtectionApplications: %w", err)
+	}
+	if len(dpaList.Items) > 0 {
 		for _, dpa := range dpaList.Items {
 			name, _, _ := unstructured.NestedString(dpa.Object, "metadata", "name")
 			namespace, _, _ := unstructured.NestedString(dpa.Object, "metadata", "namespace")
@@ -127,46 +165,53 @@ func (v *EtcdBackupValidator) checkOADP(ctx context.Context, c client.Client) []
 
 			if phase == "Reconciled" || phase == "" {
 				findings = append(findings, assessmentv1alpha1.Finding{
-					ID:          fmt.Sprintf("etcdbackup-oadp-%s", name),
-					Validator:   validatorName,
-					Category:    validatorCategory,
-					Status:      assessmentv1alpha1.FindingStatusPass,
-					Title:       "OADP Configured",
-					Description: fmt.Sprintf("OpenShift API for Data Protection is configured: %s/%s", namespace, name),
+					ID:                fmt.Sprintf("etcdbackup-oadp-%s", name),
+					Validator:         validatorName,
+					Category:          validatorCategory,
+					Status:            assessmentv1alpha1.FindingStatusPass,
+					Title:             "OADP Configured",
+					Description:       fmt.Sprintf("OpenShift API for Data Protection is configured: %s/%s", namespace, name),
+					AffectedResources: []assessmentv1alpha1.AffectedResource{validator.ResourceRef("DataProtectionApplication", &dpa)},
 				})
 			} else {
 				findings = append(findings, assessmentv1alpha1.Finding{
-					ID:             fmt.Sprintf("etcdbackup-oadp-issue-%s", name),
-					Validator:      validatorName,
-					Category:       validatorCategory,
-					Status:         assessmentv1alpha1.FindingStatusWarn,
-					Title:          "OADP Configuration Issue",
-					Description:    fmt.Sprintf("OADP %s/%s is in phase: %s", namespace, name, phase),
-					Recommendation: "Check the OADP operator logs and DataProtectionApplication status.",
+					ID:                fmt.Sprintf("etcdbackup-oadp-issue-%s", name),
+					Validator:         validatorName,
+					Category:          validatorCategory,
+					Status:            assessmentv1alpha1.FindingStatusWarn,
+					Title:             "OADP Configuration Issue",
+					Description:       fmt.Sprintf("OADP %s/%s is in phase: %s", namespace, name, phase),
+					AffectedResources: []assessmentv1alpha1.AffectedResource{validator.ResourceRef("DataProtectionApplication", &dpa)},
+					Recommendation:    "Check the OADP operator logs and DataProtectionApplication status.",
 				})
 			}
 		}
 	}
 
-	return findings
+	return findings, nil
 }
 
-// checkBackupCronJobs checks for etcd backup CronJobs.
-func (v *EtcdBackupValidator) checkBackupCronJobs(ctx context.Context, c client.Client) []assessmentv1alpha1.Finding {
+// checkBackupCronJobs checks for etcd backup CronJobs. It returns an error if
+// backup ConfigMaps or CronJobs could not be listed.
+func (v *EtcdBackupValidator) checkBackupCronJobs(ctx context.Context, c client.Client) ([]assessmentv1alpha1.Finding, error) {
 	var findings []assessmentv1alpha1.Finding
+	var errs []error
 
 	// Check for backup-related ConfigMaps or CronJobs in openshift-etcd namespace
 	cmList := &corev1.ConfigMapList{}
-	if err := c.List(ctx, cmList, client.InNamespace("openshift-etcd")); err == nil {
+	if err := c.List(ctx, cmList, client.InNamespace("openshift-etcd")); err != nil {
+		errs = append(errs, fmt.Errorf("failed to list ConfigMaps in openshift-etcd: %w", err))
+	} else {
 		for _, cm := range cmList.Items {
 			if cm.Name == "etcd-backup-config" || cm.Name == "cluster-backup-config" {
 				findings = append(findings, assessmentv1alpha1.Finding{
-					ID:          "etcdbackup-config-found",
-					Validator:   validatorName,
-					Category:    validatorCategory,
-					Status:      assessmentv1alpha1.FindingStatusPass,
-					Title:       "Etcd Backup Configuration Found",
-					Description: fmt.Sprintf("Found backup configuration: %s", cm.Name),
+					ID:                "etcdbackup-config-found",
+					Validator:         validatorName,
+					Category:          validatorCategory,
+					Status:            assessmentv1alpha1.FindingStatusPass,
+					Title:             "Etcd Backup Configuration Found",
+					Description:       fmt.Sprintf("Found backup configuration: %s", cm.Name),
+					AffectedResources: []assessmentv1alpha1.AffectedResource{validator.ResourceRef("ConfigMap", &cm)},
 				})
 			}
 		}
@@ -183,7 +228,9 @@ func (v *EtcdBackupValidator) checkBackupCronJobs(ctx context.Context, c client.
 	cronJobList := &metav1.PartialObjectMetadataList{}
 	cronJobList.SetGroupVersionKind(cronJobGVK)
 
-	if err := c.List(ctx, cronJobList); err == nil {
+	if err := c.List(ctx, cronJobList); err != nil {
+		errs = append(errs, fmt.Errorf("failed to list CronJobs: %w", err))
+	} else {
 		for _, item := range cronJobList.Items {
 			name := item.Name
 			namespace := item.Namespace
@@ -215,28 +262,34 @@ func (v *EtcdBackupValidator) checkBackupCronJobs(ctx context.Context, c client.
 					}
 
 					findings = append(findings, assessmentv1alpha1.Finding{
-						ID:          fmt.Sprintf("etcdbackup-cronjob-%s-%s", namespace, name),
-						Validator:   validatorName,
-						Category:    validatorCategory,
-						Status:      status,
-						Title:       "Backup CronJob Detected",
-						Description: desc,
+						ID:                fmt.Sprintf("etcdbackup-cronjob-%s-%s", namespace, name),
+						Validator:         validatorName,
+						Category:          validatorCategory,
+						Status:            status,
+						Title:             "Backup CronJob Detected",
+						Description:       desc,
+						AffectedResources: []assessmentv1alpha1.AffectedResource{validator.ResourceRef("CronJob", &item)},
 					})
 				}
 			}
 		}
 	}
 
-	return findings
+	return findings, errors.Join(errs...)
 }
 
-// checkVelero checks for Velero installation.
-func (v *EtcdBackupValidator) checkVelero(ctx context.Context, c client.Client) []assessmentv1alpha1.Finding {
+// checkVelero checks for Velero installation. It returns an error if the
+// Velero namespaces could not be read.
+func (v *EtcdBackupValidator) checkVelero(ctx context.Context, c client.Client) ([]assessmentv1alpha1.Finding, error) {
 	var findings []assessmentv1alpha1.Finding
+	var errs []error
 
 	// Check for Velero namespace
 	ns := &corev1.Namespace{}
 	err := c.Get(ctx, client.ObjectKey{Name: "velero"}, ns)
+	if err != nil && !apierrors.IsNotFound(err) {
+		errs = append(errs, fmt.Errorf("failed to get namespace velero: %w", err))
+	}
 	if err == nil {
 		findings = append(findings, assessmentv1alpha1.Finding{
 			ID:          "etcdbackup-velero",
@@ -250,6 +303,9 @@ func (v *EtcdBackupValidator) checkVelero(ctx context.Context, c client.Client)
 
 	// Also check openshift-adp namespace (OADP uses this)
 	err = c.Get(ctx, client.ObjectKey{Name: "openshift-adp"}, ns)
+	if err != nil && !apierrors.IsNotFound(err) {
+		errs = append(errs, fmt.Errorf("failed to get namespace openshift-adp: %w", err))
+	}
 	if err == nil {
 		findings = append(findings, assessmentv1alpha1.Finding{
 			ID:          "etcdbackup-oadp-namespace",
@@ -261,7 +317,7 @@ func (v *EtcdBackupValidator) checkVelero(ctx context.Context, c client.Client)
 		})
 	}
 
-	return findings
+	return findings, errors.Join(errs...)
 }
 
 func containsBackupKeyword(name string) bool {
@@ -2,16 +2,21 @@ package etcdbackup
 
 import (
 	"context"
+	"errors"
 	"fmt"
 	"testing"
 	"time"
 
 	assessmentv1alpha1 "github.com/openshift-assessment/cluster-assessment-operator/api/v1alpha1"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/profiles"
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/meta"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
 	"k8s.io/apimachinery/pkg/runtime/schema"
 	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
 )
 
 type mockClient struct {
@@ -118,7 +123,10 @@ func TestCheckBackupCronJobs_Logic(t *testing.T) {
 	c := &mockClient{}
 	v := &EtcdBackupValidator{}
 
-	findings := v.checkBackupCronJobs(context.Background(), c)
+	findings, err := v.checkBackupCronJobs(context.Background(), c)
+	if err != nil {
+		t.Fatalf("checkBackupCronJobs returned error: %v", err)
+	}
 
 	found := false
 	for _, f := range findings {
@@ -127,6 +135,10 @@ func TestCheckBackupCronJobs_Logic(t *testing.T) {
 			if f.Status != assessmentv1alpha1.FindingStatusPass {
 				t.Errorf("Expected Pass, got %s", f.Status)
 			}
+			if len(f.AffectedResources) != 1 || f.AffectedResources[0].Kind != "CronJob" ||
+				f.AffectedResources[0].Namespace != "default" || f.AffectedResources[0].Name != "etcd-backup-job" {
+				t.Errorf("Expected the CronJob as affected resource, got %+v", f.AffectedResources)
+			}
 		}
 	}
 	if !found {
@@ -147,3 +159,39 @@ func TestCheckBackupCronJobs_Logic(t *testing.T) {
 		t.Errorf("Expected 1 Get call, got %d", c.getCalls)
 	}
 }
+
+// listErrorClient fails every List with err, except for OADP, which is not installed.
+func listErrorClient(err error) client.Client {
+	return fake.NewClientBuilder().WithInterceptorFuncs(interceptor.Funcs{
+		List: func(_ context.Context, _ client.WithWatch, list client.ObjectList, _ ...client.ListOption) error {
+			if u, ok := list.(*unstructured.UnstructuredList); ok && u.GroupVersionKind().Group == "oadp.openshift.io" {
+				return &meta.NoKindMatchError{GroupKind: schema.GroupKind{Group: "oadp.openshift.io", Kind: "DataProtectionApplication"}}
+			}
+			return err
+		},
+	}).Build()
+}
+
+func TestValidate_NoBackupFound(t *testing.T) {
+	tests := []struct {
+		name    string
+		listErr error
+		wantID  string
+		want    assessmentv1alpha1.FindingStatus
+	}{
+		{name: "lookups succeed", wantID: "etcdbackup-not-configured", want: assessmentv1alpha1.FindingStatusWarn},
+		{name: "lookups fail", listErr: errors.New("connection refused"), wantID: "etcdbackup-inconclusive", want: assessmentv1alpha1.FindingStatusError},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			findings, err := (&EtcdBackupValidator{}).Validate(context.Background(), listErrorClient(tt.listErr), profiles.GetProfile("production"))
+			if err != nil {
+				t.Fatalf("Validate returned error: %v", err)
+			}
+			if len(findings) != 1 || findings[0].ID != tt.wantID || findings[0].Status != tt.want {
+				t.Errorf("Expected a single %s %s finding, got %+v", tt.want, tt.wantID, findings)
+			}
+		})
+	}
+}
@@ -0,0 +1,106 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package imageregistry
+
+import (
+	assessmentv1alpha1 "github.com/openshift-assessment/cluster-assessment-operator/api/v1alpha1"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/validator"
+)
+
+// Checks returns the catalog of checks this validator can report.
+func (v *ImageRegistryValidator) Checks() []validator.Check {
+	return checks
+}
+
+// checks lists every finding ID emitted by this validator.
+var checks = []validator.Check{
+	{
+		ID:            "imageregistry-removed",
+		Title:         "Image Registry Removed",
+		DefaultStatus: assessmentv1alpha1.FindingStatusWarn,
+		Severity:      assessmentv1alpha1.SeverityLow,
+		References:    []string{"https://docs.openshift.com/container-platform/latest/registry/configuring-registry-operator.html"},
+	},
+	{
+		ID:            "imageregistry-managed",
+		Title:         "Image Registry Managed",
+		DefaultStatus: assessmentv1alpha1.FindingStatusPass,
+		Severity:      assessmentv1alpha1.SeverityLow,
+	},
+	{
+		ID:            "imageregistry-unmanaged",
+		Title:         "Image Registry Unmanaged",
+		DefaultStatus: assessmentv1alpha1.FindingStatusInfo,
+		Severity:      assessmentv1alpha1.SeverityLow,
+	},
+	{
+		ID:            "imageregistry-no-storage",
+		Title:         "Image Registry Storage Not Configured",
+		DefaultStatus: assessmentv1alpha1.FindingStatusWarn,
+		Severity:      assessmentv1alpha1.SeverityMedium,
+		References:    []string{"https://docs.openshift.com/container-platform/latest/registry/configuring_registry_storage/configuring-registry-storage-baremetal.html"},
+	},
+	{
+		ID:            "imageregistry-emptydir",
+		Title:         "Image Registry Using EmptyDir Storage",
+		DefaultStatus: assessmentv1alpha1.FindingStatusWarn,
+		Severity:      assessmentv1alpha1.SeverityHigh,
+	},
+	{
+		ID:            "imageregistry-storage-configured",
+		Title:         "Image Registry Storage Configured",
+		DefaultStatus: assessmentv1alpha1.FindingStatusPass,
+		Severity:      assessmentv1alpha1.SeverityLow,
+	},
+	{
+		ID:            "imageregistry-single-replica",
+		Title:         "Image Registry Single Replica",
+		DefaultStatus: assessmentv1alpha1.FindingStatusWarn,
+		Severity:      assessmentv1alpha1.SeverityMedium,
+	},
+	{
+		ID:            "imageregistry-ha",
+		Title:         "Image Registry High Availability",
+		DefaultStatus: assessmentv1alpha1.FindingStatusPass,
+		Severity:      assessmentv1alpha1.SeverityLow,
+	},
+	{
+		ID:            "imageregistry-pruner-missing",
+		Title:         "Image Pruner Not Configured",
+		DefaultStatus: assessmentv1alpha1.FindingStatusInfo,
+		Severity:      assessmentv1alpha1.SeverityLow,
+		References:    []string{"https://docs.openshift.com/container-platform/latest/applications/pruning-objects.html"},
+	},
+	{
+		ID:            "imageregistry-pruner-suspended",
+		Title:         "Image Pruner Suspended",
+		DefaultStatus: assessmentv1alpha1.FindingStatusInfo,
+		Severity:      assessmentv1alpha1.SeverityLow,
+	},
+	{
+		ID:            "imageregistry-pruner-active",
+		Title:         "Image Pruner Active",
+		DefaultStatus: assessmentv1alpha1.FindingStatusPass,
+		Severity:      assessmentv1alpha1.SeverityLow,
+	},
+	{
+		ID:            "imageregistry-config-error",
+		Title:         "Unable to Check Image Registry",
+		DefaultStatus: assessmentv1alpha1.FindingStatusError,
+		Severity:      assessmentv1alpha1.SeverityLow,
+	},
+}
@@ -57,6 +57,14 @@ func (v *ImageRegistryValidator) Category() string {
 	return validatorCategory
 }
 
+// RequiredPermissions returns the API access this validator needs.
+func (v *ImageRegistryValidator) RequiredPermissions() []validator.Permission {
+	return []validator.Permission{
+		{Verb: "get", Group: "imageregistry.operator.openshift.io", Resource: "configs"},
+		{Verb: "get", Group: "imageregistry.operator.openshift.io", Resource: "imagepruners"},
+	}
+}
+
 // Validate performs image registry checks.
 func (v *ImageRegistryValidator) Validate(ctx context.Context, c client.Client, profile profiles.Profile) ([]assessmentv1alpha1.Finding, error) {
 	var findings []assessmentv1alpha1.Finding
@@ -87,7 +95,7 @@ func (v *ImageRegistryValidator) checkRegistryConfig(ctx context.Context, c clie
 			ID:          "imageregistry-config-error",
 			Validator:   validatorName,
 			Category:    validatorCategory,
-			Status:      assessmentv1alpha1.FindingStatusFail,
+			Status:      assessmentv1alpha1.FindingStatusError,
 			Title:       "Unable to Check Image Registry",
 			Description: fmt.Sprintf("Failed to get image registry config: %v", err),
 		}}
@@ -0,0 +1,57 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package ingresstls
+
+import (
+	assessmentv1alpha1 "github.com/openshift-assessment/cluster-assessment-operator/api/v1alpha1"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/validator"
+)
+
+// Checks returns the catalog of checks this validator can report.
+func (v *IngressTLSValidator) Checks() []validator.Check {
+	return checks
+}
+
+// checks lists every finding ID emitted by this validator.
+var checks = []validator.Check{
+	{
+		ID:            "ingresstls-routes-no-tls",
+		Title:         "Routes Without TLS",
+		DefaultStatus: assessmentv1alpha1.FindingStatusFail,
+		Severity:      assessmentv1alpha1.SeverityHigh,
+		References:    []string{"https://docs.openshift.com/container-platform/latest/networking/routes/secured-routes.html"},
+	},
+	{
+		ID:            "ingresstls-routes-all-tls",
+		Title:         "All Routes Have TLS Configured",
+		DefaultStatus: assessmentv1alpha1.FindingStatusPass,
+		Severity:      assessmentv1alpha1.SeverityLow,
+	},
+	{
+		ID:            "ingresstls-ingress-no-tls",
+		Title:         "Ingresses Without TLS",
+		DefaultStatus: assessmentv1alpha1.FindingStatusWarn,
+		Severity:      assessmentv1alpha1.SeverityMedium,
+		References:    []string{"https://kubernetes.io/docs/concepts/services-networking/ingress/#tls"},
+	},
+	{
+		ID:            "ingresstls-ingress-all-tls",
+		Title:         "All Ingresses Have TLS Configured",
+		DefaultStatus: assessmentv1alpha1.FindingStatusPass,
+		Severity:      assessmentv1alpha1.SeverityLow,
+	},
+}
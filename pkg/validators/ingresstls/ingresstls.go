@@ -47,6 +47,14 @@ func (v *IngressTLSValidator) Name() string        { return validatorName }
 func (v *IngressTLSValidator) Description() string { return validatorDescription }
 func (v *IngressTLSValidator) Category() string    { return validatorCategory }
 
+// RequiredPermissions returns the API access this validator needs.
+func (v *IngressTLSValidator) RequiredPermissions() []validator.Permission {
+	return []validator.Permission{
+		{Verb: "list", Group: "route.openshift.io", Resource: "routes"},
+		{Verb: "list", Group: "networking.k8s.io", Resource: "ingresses"},
+	}
+}
+
 // Validate performs Ingress/Route TLS checks.
 func (v *IngressTLSValidator) Validate(ctx context.Context, c client.Client, profile profiles.Profile) ([]assessmentv1alpha1.Finding, error) {
 	var findings []assessmentv1alpha1.Finding
@@ -77,6 +85,7 @@ func (v *IngressTLSValidator) checkRoutes(ctx context.Context, c client.Client,
 	}
 
 	var noTLSRoutes []string
+	var noTLSRouteRefs []assessmentv1alpha1.AffectedResource
 	var edgeRoutes int
 	var passthroughRoutes int
 	var reencryptRoutes int
@@ -96,6 +105,7 @@ func (v *IngressTLSValidator) checkRoutes(ctx context.Context, c client.Client,
 		tls, found, _ := unstructured.NestedMap(route.Object, "spec", "tls")
 		if !found || tls == nil {
 			noTLSRoutes = append(noTLSRoutes, fmt.Sprintf("%s/%s", ns, name))
+			noTLSRouteRefs = append(noTLSRouteRefs, validator.ResourceRef("Route", &route))
 			continue
 		}
 
@@ -127,14 +137,15 @@ func (v *IngressTLSValidator) checkRoutes(ctx context.Context, c client.Client,
 		}
 
 		findings = append(findings, assessmentv1alpha1.Finding{
-			ID:             "ingresstls-routes-no-tls",
-			Validator:      validatorName,
-			Category:       validatorCategory,
-			Status:         status,
-			Title:          "Routes Without TLS",
-			Description:    fmt.Sprintf("%d of %d user Routes have no TLS configuration: %s", len(noTLSRoutes), totalUserRoutes, strings.Join(sample, ", ")),
-			Impact:         "Traffic to these routes is unencrypted, exposing data in transit.",
-			Recommendation: "Enable TLS termination (edge, passthrough, or re-encrypt) on all Routes.",
+			ID:                "ingresstls-routes-no-tls",
+			Validator:         validatorName,
+			Category:          validatorCategory,
+			Status:            status,
+			Title:             "Routes Without TLS",
+			Description:       fmt.Sprintf("%d of %d user Routes have no TLS configuration: %s", len(noTLSRoutes), totalUserRoutes, strings.Join(sample, ", ")),
+			AffectedResources: noTLSRouteRefs,
+			Impact:            "Traffic to these routes is unencrypted, exposing data in transit.",
+			Recommendation:    "Enable TLS termination (edge, passthrough, or re-encrypt) on all Routes.",
 			References: []string{
 				"https://docs.openshift.com/container-platform/latest/networking/routes/secured-routes.html",
 			},
@@ -178,6 +189,7 @@ func (v *IngressTLSValidator) checkIngresses(ctx context.Context, c client.Clien
 	}
 
 	var noTLSIngresses []string
+	var noTLSIngressRefs []assessmentv1alpha1.AffectedResource
 	totalUserIngresses := 0
 
 	for _, ingress := range ingressList.Items {
@@ -193,6 +205,7 @@ func (v *IngressTLSValidator) checkIngresses(ctx context.Context, c client.Clien
 		tlsList, found, _ := unstructured.NestedSlice(ingress.Object, "spec", "tls")
 		if !found || len(tlsList) == 0 {
 			noTLSIngresses = append(noTLSIngresses, fmt.Sprintf("%s/%s", ns, name))
+			noTLSIngressRefs = append(noTLSIngressRefs, validator.ResourceRef("Ingress", &ingress))
 		}
 	}
 
@@ -207,14 +220,15 @@ func (v *IngressTLSValidator) checkIngresses(ctx context.Context, c client.Clien
 		}
 
 		findings = append(findings, assessmentv1alpha1.Finding{
-			ID:             "ingresstls-ingress-no-tls",
-			Validator:      validatorName,
-			Category:       validatorCategory,
-			Status:         assessmentv1alpha1.FindingStatusWarn,
-			Title:          "Ingresses Without TLS",
-			Description:    fmt.Sprintf("%d of %d user Ingresses have no TLS configuration: %s", len(noTLSIngresses), totalUserIngresses, strings.Join(sample, ", ")),
-			Impact:         "Traffic through these Ingresses is unencrypted.",
-			Recommendation: "Configure TLS for all Ingresses with a valid certificate.",
+			ID:                "ingresstls-ingress-no-tls",
+			Validator:         validatorName,
+			Category:          validatorCategory,
+			Status:            assessmentv1alpha1.FindingStatusWarn,
+			Title:             "Ingresses Without TLS",
+			Description:       fmt.Sprintf("%d of %d user Ingresses have no TLS configuration: %s", len(noTLSIngresses), totalUserIngresses, strings.Join(sample, ", ")),
+			AffectedResources: noTLSIngressRefs,
+			Impact:            "Traffic through these Ingresses is unencrypted.",
+			Recommendation:    "Configure TLS for all Ingresses with a valid certificate.",
 			References: []string{
 				"https://kubernetes.io/docs/concepts/services-networking/ingress/#tls",
 			},
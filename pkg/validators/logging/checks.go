@@ -0,0 +1,98 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package logging
+
+import (
+	assessmentv1alpha1 "github.com/openshift-assessment/cluster-assessment-operator/api/v1alpha1"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/validator"
+)
+
+// Checks returns the catalog of checks this validator can report.
+func (v *LoggingValidator) Checks() []validator.Check {
+	return checks
+}
+
+// checks lists every finding ID emitted by this validator.
+var checks = []validator.Check{
+	{
+		ID:            "logging-operator-missing",
+		Title:         "Cluster Logging Operator Not Installed",
+		DefaultStatus: assessmentv1alpha1.FindingStatusNotApplicable,
+		Severity:      assessmentv1alpha1.SeverityLow,
+		References:    []string{"https://docs.openshift.com/container-platform/latest/logging/cluster-logging-deploying.html"},
+	},
+	{
+		ID:            "logging-operator-installed",
+		Title:         "Cluster Logging Operator Installed",
+		DefaultStatus: assessmentv1alpha1.FindingStatusPass,
+		Severity:      assessmentv1alpha1.SeverityLow,
+	},
+	{
+		ID:            "logging-operator-not-found",
+		Title:         "Cluster Logging Operator Not Found",
+		DefaultStatus: assessmentv1alpha1.FindingStatusNotApplicable,
+		Severity:      assessmentv1alpha1.SeverityLow,
+	},
+	{
+		ID:            "logging-unmanaged",
+		Title:         "ClusterLogging Unmanaged",
+		DefaultStatus: assessmentv1alpha1.FindingStatusInfo,
+		Severity:      assessmentv1alpha1.SeverityLow,
+	},
+	{
+		ID:            "logging-collection-type",
+		Title:         "Log Collection Type",
+		DefaultStatus: assessmentv1alpha1.FindingStatusInfo,
+		Severity:      assessmentv1alpha1.SeverityLow,
+	},
+	{
+		ID:            "logging-store-type",
+		Title:         "Log Store Configured",
+		DefaultStatus: assessmentv1alpha1.FindingStatusPass,
+		Severity:      assessmentv1alpha1.SeverityLow,
+	},
+	{
+		ID:            "logging-retention",
+		Title:         "Log Retention Policy",
+		DefaultStatus: assessmentv1alpha1.FindingStatusInfo,
+		Severity:      assessmentv1alpha1.SeverityLow,
+	},
+	{
+		ID:            "logging-forwarder-outputs",
+		Title:         "Log Forwarding Configured",
+		DefaultStatus: assessmentv1alpha1.FindingStatusPass,
+		Severity:      assessmentv1alpha1.SeverityLow,
+	},
+	{
+		ID:            "logging-forwarder-pipelines",
+		Title:         "Log Forwarding Pipelines",
+		DefaultStatus: assessmentv1alpha1.FindingStatusInfo,
+		Severity:      assessmentv1alpha1.SeverityLow,
+	},
+	{
+		ID:            "logging-collector-unhealthy",
+		Title:         "Log Collector Not Fully Ready",
+		DefaultStatus: assessmentv1alpha1.FindingStatusWarn,
+		Severity:      assessmentv1alpha1.SeverityMedium,
+	},
+	{
+		ID:            "logging-collector-healthy",
+		Title:         "Log Collector Healthy",
+		DefaultStatus: assessmentv1alpha1.FindingStatusPass,
+		Severity:      assessmentv1alpha1.SeverityLow,
+	},
+}
@@ -59,12 +59,26 @@ func (v *LoggingValidator) Category() string {
 	return validatorCategory
 }
 
+// RequiredPermissions returns the API access this validator needs.
+func (v *LoggingValidator) RequiredPermissions() []validator.Permission {
+	return []validator.Permission{
+		{Verb: "list", Group: "operators.coreos.com", Resource: "clusterserviceversions", Namespace: "openshift-logging"},
+		{Verb: "get", Group: "logging.openshift.io", Resource: "clusterloggings", Namespace: "openshift-logging"},
+		{Verb: "get", Group: "logging.openshift.io", Resource: "clusterlogforwarders", Namespace: "openshift-logging"},
+		{Verb: "list", Group: "apps", Resource: "daemonsets", Namespace: "openshift-logging"},
+	}
+}
+
 // Validate performs logging checks.
 func (v *LoggingValidator) Validate(ctx context.Context, c client.Client, profile profiles.Profile) ([]assessmentv1alpha1.Finding, error) {
 	var findings []assessmentv1alpha1.Finding
 
 	// Check 1: ClusterLogging operator installation
-	findings = append(findings, v.checkLoggingOperator(ctx, c)...)
+	operatorFindings, err := v.checkLoggingOperator(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	findings = append(findings, operatorFindings...)
 
 	// Check 2: ClusterLogging CR
 	findings = append(findings, v.checkClusterLogging(ctx, c)...)
@@ -79,7 +93,7 @@ func (v *LoggingValidator) Validate(ctx context.Context, c client.Client, profil
 }
 
 // checkLoggingOperator checks if the cluster-logging operator is installed.
-func (v *LoggingValidator) checkLoggingOperator(ctx context.Context, c client.Client) []assessmentv1alpha1.Finding {
+func (v *LoggingValidator) checkLoggingOperator(ctx context.Context, c client.Client) ([]assessmentv1alpha1.Finding, error) {
 	var findings []assessmentv1alpha1.Finding
 
 	// Check for CSV in openshift-logging namespace
@@ -91,21 +105,23 @@ func (v *LoggingValidator) checkLoggingOperator(ctx context.Context, c client.Cl
 	})
 
 	if err := c.List(ctx, csvList, client.InNamespace("openshift-logging")); err != nil {
-		// Namespace might not exist
+		if !validator.IsNotInstalled(err) {
+			return nil, fmt.Errorf("failed to list ClusterServiceVersions: %w", err)
+		}
 		findings = append(findings, assessmentv1alpha1.Finding{
 			ID:             "logging-operator-missing",
 			Validator:      validatorName,
 			Category:       validatorCategory,
-			Status:         assessmentv1alpha1.FindingStatusInfo,
+			Status:         assessmentv1alpha1.FindingStatusNotApplicable,
 			Title:          "Cluster Logging Operator Not Installed",
-			Description:    "The cluster-logging operator is not installed or the openshift-logging namespace does not exist.",
+			Description:    "The cluster-logging operator is not installed: the cluster does not serve the OLM ClusterServiceVersion API.",
 			Impact:         "Cluster logging is not configured. Application and infrastructure logs are not being collected centrally.",
 			Recommendation: "Consider installing the Red Hat OpenShift Logging operator for centralized log management.",
 			References: []string{
 				"https://docs.openshift.com/container-platform/latest/logging/cluster-logging-deploying.html",
 			},
 		})
-		return findings
+		return findings, nil
 	}
 
 	// Check if any logging-related CSV exists
@@ -134,7 +150,7 @@ func (v *LoggingValidator) checkLoggingOperator(ctx context.Context, c client.Cl
 			ID:             "logging-operator-not-found",
 			Validator:      validatorName,
 			Category:       validatorCategory,
-			Status:         assessmentv1alpha1.FindingStatusInfo,
+			Status:         assessmentv1alpha1.FindingStatusNotApplicable,
 			Title:          "Cluster Logging Operator Not Found",
 			Description:    "No cluster-logging or loki-operator CSV found in openshift-logging namespace.",
 			Impact:         "Centralized logging may not be configured.",
@@ -142,7 +158,7 @@ func (v *LoggingValidator) checkLoggingOperator(ctx context.Context, c client.Cl
 		})
 	}
 
-	return findings
+	return findings, nil
 }
 
 // checkClusterLogging checks for ClusterLogging CR configuration.
@@ -302,14 +318,15 @@ func (v *LoggingValidator) checkCollectorHealth(ctx context.Context, c client.Cl
 
 			if readyPods < desiredPods {
 				findings = append(findings, assessmentv1alpha1.Finding{
-					ID:             "logging-collector-unhealthy",
-					Validator:      validatorName,
-					Category:       validatorCategory,
-					Status:         assessmentv1alpha1.FindingStatusWarn,
-					Title:          "Log Collector Not Fully Ready",
-					Description:    fmt.Sprintf("Log collector %s has %d/%d pods ready.", ds.Name, readyPods, desiredPods),
-					Impact:         "Some nodes may not be collecting logs.",
-					Recommendation: "Check collector pod logs and events for errors.",
+					ID:                "logging-collector-unhealthy",
+					Validator:         validatorName,
+					Category:          validatorCategory,
+					Status:            assessmentv1alpha1.FindingStatusWarn,
+					Title:             "Log Collector Not Fully Ready",
+					Description:       fmt.Sprintf("Log collector %s has %d/%d pods ready.", ds.Name, readyPods, desiredPods),
+					AffectedResources: []assessmentv1alpha1.AffectedResource{validator.ResourceRef("DaemonSet", &ds)},
+					Impact:            "Some nodes may not be collecting logs.",
+					Recommendation:    "Check collector pod logs and events for errors.",
 					Remediation: &assessmentv1alpha1.RemediationGuidance{
 						Safety: assessmentv1alpha1.RemediationRequiresReview,
 						Commands: []assessmentv1alpha1.RemediationCommand{
@@ -0,0 +1,74 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package machineconfig
+
+import (
+	assessmentv1alpha1 "github.com/openshift-assessment/cluster-assessment-operator/api/v1alpha1"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/validator"
+)
+
+// Checks returns the catalog of checks this validator can report.
+func (v *MachineConfigValidator) Checks() []validator.Check {
+	return checks
+}
+
+// checks lists every finding ID emitted by this validator.
+var checks = []validator.Check{
+	{
+		ID:            "machineconfig-mcp-degraded",
+		Title:         "Degraded MachineConfigPools",
+		DefaultStatus: assessmentv1alpha1.FindingStatusFail,
+		Severity:      assessmentv1alpha1.SeverityHigh,
+	},
+	{
+		ID:            "machineconfig-mcp-updating",
+		Title:         "MachineConfigPools Updating",
+		DefaultStatus: assessmentv1alpha1.FindingStatusInfo,
+		Severity:      assessmentv1alpha1.SeverityLow,
+	},
+	{
+		ID:            "machineconfig-mcp-healthy",
+		Title:         "Healthy MachineConfigPools",
+		DefaultStatus: assessmentv1alpha1.FindingStatusPass,
+		Severity:      assessmentv1alpha1.SeverityLow,
+	},
+	{
+		ID:            "machineconfig-pending-*",
+		Title:         "Pending Updates in MachineConfigPool",
+		DefaultStatus: assessmentv1alpha1.FindingStatusInfo,
+		Severity:      assessmentv1alpha1.SeverityLow,
+	},
+	{
+		ID:            "machineconfig-custom",
+		Title:         "Custom MachineConfigs",
+		DefaultStatus: assessmentv1alpha1.FindingStatusInfo,
+		Severity:      assessmentv1alpha1.SeverityLow,
+		References:    []string{"https://docs.openshift.com/container-platform/latest/post_installation_configuration/machine-configuration-tasks.html"},
+	},
+	{
+		ID:            "machineconfig-no-custom",
+		Title:         "No Custom MachineConfigs",
+		DefaultStatus: assessmentv1alpha1.FindingStatusPass,
+		Severity:      assessmentv1alpha1.SeverityLow,
+	},
+	{
+		ID:            "machineconfig-mcp-error",
+		Title:         "Unable to Check MachineConfigPools",
+		DefaultStatus: assessmentv1alpha1.FindingStatusError,
+		Severity:      assessmentv1alpha1.SeverityLow,
+	},
+}
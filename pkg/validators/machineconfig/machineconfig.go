@@ -57,6 +57,14 @@ func (v *MachineConfigValidator) Category() string {
 	return validatorCategory
 }
 
+// RequiredPermissions returns the API access this validator needs.
+func (v *MachineConfigValidator) RequiredPermissions() []validator.Permission {
+	return []validator.Permission{
+		{Verb: "list", Group: "machineconfiguration.openshift.io", Resource: "machineconfigpools"},
+		{Verb: "list", Group: "machineconfiguration.openshift.io", Resource: "machineconfigs"},
+	}
+}
+
 // Validate performs MachineConfig checks.
 func (v *MachineConfigValidator) Validate(ctx context.Context, c client.Client, profile profiles.Profile) ([]assessmentv1alpha1.Finding, error) {
 	var findings []assessmentv1alpha1.Finding
@@ -80,7 +88,7 @@ func (v *MachineConfigValidator) checkMachineConfigPools(ctx context.Context, c
 			ID:          "machineconfig-mcp-error",
 			Validator:   validatorName,
 			Category:    validatorCategory,
-			Status:      assessmentv1alpha1.FindingStatusFail,
+			Status:      assessmentv1alpha1.FindingStatusError,
 			Title:       "Unable to Check MachineConfigPools",
 			Description: fmt.Sprintf("Failed to list MachineConfigPools: %v", err),
 		}}
@@ -89,6 +97,7 @@ func (v *MachineConfigValidator) checkMachineConfigPools(ctx context.Context, c
 	var degradedPools []string
 	var updatingPools []string
 	var healthyPools []string
+	var degradedRefs, updatingRefs []assessmentv1alpha1.AffectedResource
 
 	for _, mcp := range mcps.Items {
 		isDegraded := false
@@ -100,11 +109,13 @@ func (v *MachineConfigValidator) checkMachineConfigPools(ctx context.Context, c
 				if condition.Status == "True" {
 					isDegraded = true
 					degradedPools = append(degradedPools, fmt.Sprintf("%s (%s)", mcp.Name, condition.Message))
+					degradedRefs = append(degradedRefs, validator.ResourceRef("MachineConfigPool", &mcp))
 				}
 			case mcv1.MachineConfigPoolUpdating:
 				if condition.Status == "True" {
 					isUpdating = true
 					updatingPools = append(updatingPools, mcp.Name)
+					updatingRefs = append(updatingRefs, validator.ResourceRef("MachineConfigPool", &mcp))
 				}
 			}
 		}
@@ -117,14 +128,15 @@ func (v *MachineConfigValidator) checkMachineConfigPools(ctx context.Context, c
 	// Report degraded pools
 	if len(degradedPools) > 0 {
 		findings = append(findings, assessmentv1alpha1.Finding{
-			ID:             "machineconfig-mcp-degraded",
-			Validator:      validatorName,
-			Category:       validatorCategory,
-			Status:         assessmentv1alpha1.FindingStatusFail,
-			Title:          "Degraded MachineConfigPools",
-			Description:    fmt.Sprintf("%d MachineConfigPool(s) are degraded: %s", len(degradedPools), strings.Join(degradedPools, "; ")),
-			Impact:         "Degraded MachineConfigPools indicate nodes that failed to apply configuration and may be in an inconsistent state.",
-			Recommendation: "Investigate the degraded nodes. Check MachineConfigDaemon logs and node status.",
+			ID:                "machineconfig-mcp-degraded",
+			Validator:         validatorName,
+			Category:          validatorCategory,
+			Status:            assessmentv1alpha1.FindingStatusFail,
+			Title:             "Degraded MachineConfigPools",
+			Description:       fmt.Sprintf("%d MachineConfigPool(s) are degraded: %s", len(degradedPools), strings.Join(degradedPools, "; ")),
+			AffectedResources: degradedRefs,
+			Impact:            "Degraded MachineConfigPools indicate nodes that failed to apply configuration and may be in an inconsistent state.",
+			Recommendation:    "Investigate the degraded nodes. Check MachineConfigDaemon logs and node status.",
 			Remediation: &assessmentv1alpha1.RemediationGuidance{
 				Safety: assessmentv1alpha1.RemediationRequiresReview,
 				Commands: []assessmentv1alpha1.RemediationCommand{
@@ -142,12 +154,13 @@ func (v *MachineConfigValidator) checkMachineConfigPools(ctx context.Context, c
 	// Report updating pools
 	if len(updatingPools) > 0 {
 		findings = append(findings, assessmentv1alpha1.Finding{
-			ID:          "machineconfig-mcp-updating",
-			Validator:   validatorName,
-			Category:    validatorCategory,
-			Status:      assessmentv1alpha1.FindingStatusInfo,
-			Title:       "MachineConfigPools Updating",
-			Description: fmt.Sprintf("%d MachineConfigPool(s) are currently updating: %s", len(updatingPools), strings.Join(updatingPools, ", ")),
+			ID:                "machineconfig-mcp-updating",
+			Validator:         validatorName,
+			Category:          validatorCategory,
+			Status:            assessmentv1alpha1.FindingStatusInfo,
+			Title:             "MachineConfigPools Updating",
+			Description:       fmt.Sprintf("%d MachineConfigPool(s) are currently updating: %s", len(updatingPools), strings.Join(updatingPools, ", ")),
+			AffectedResources: updatingRefs,
 		})
 	}
 
@@ -168,12 +181,13 @@ func (v *MachineConfigValidator) checkMachineConfigPools(ctx context.Context, c
 		if mcp.Status.MachineCount != mcp.Status.UpdatedMachineCount {
 			pending := mcp.Status.MachineCount - mcp.Status.UpdatedMachineCount
 			findings = append(findings, assessmentv1alpha1.Finding{
-				ID:          fmt.Sprintf("machineconfig-pending-%s", mcp.Name),
-				Validator:   validatorName,
-				Category:    validatorCategory,
-				Status:      assessmentv1alpha1.FindingStatusInfo,
-				Title:       fmt.Sprintf("Pending Updates in %s", mcp.Name),
-				Description: fmt.Sprintf("%s has %d machine(s) pending update (%d/%d updated)", mcp.Name, pending, mcp.Status.UpdatedMachineCount, mcp.Status.MachineCount),
+				ID:                fmt.Sprintf("machineconfig-pending-%s", mcp.Name),
+				Validator:         validatorName,
+				Category:          validatorCategory,
+				Status:            assessmentv1alpha1.FindingStatusInfo,
+				Title:             fmt.Sprintf("Pending Updates in %s", mcp.Name),
+				Description:       fmt.Sprintf("%s has %d machine(s) pending update (%d/%d updated)", mcp.Name, pending, mcp.Status.UpdatedMachineCount, mcp.Status.MachineCount),
+				AffectedResources: []assessmentv1alpha1.AffectedResource{validator.ResourceRef("MachineConfigPool", &mcp)},
 			})
 		}
 	}
@@ -191,6 +205,7 @@ func (v *MachineConfigValidator) checkCustomMachineConfigs(ctx context.Context,
 	}
 
 	var customMCs []string
+	var customRefs []assessmentv1alpha1.AffectedResource
 	for _, mc := range mcs.Items {
 		// Skip rendered and system configs
 		if strings.HasPrefix(mc.Name, "rendered-") ||
@@ -199,18 +214,20 @@ func (v *MachineConfigValidator) checkCustomMachineConfigs(ctx context.Context,
 			continue
 		}
 		customMCs = append(customMCs, mc.Name)
+		customRefs = append(customRefs, validator.ResourceRef("MachineConfig", &mc))
 	}
 
 	if len(customMCs) > 0 {
 		findings = append(findings, assessmentv1alpha1.Finding{
-			ID:             "machineconfig-custom",
-			Validator:      validatorName,
-			Category:       validatorCategory,
-			Status:         assessmentv1alpha1.FindingStatusInfo,
-			Title:          "Custom MachineConfigs",
-			Description:    fmt.Sprintf("Found %d custom MachineConfig(s): %s", len(customMCs), strings.Join(customMCs, ", ")),
-			Impact:         "Custom MachineConfigs modify node configuration and should be reviewed for supportability.",
-			Recommendation: "Ensure custom MachineConfigs are documented and aligned with Red Hat support policies.",
+			ID:                "machineconfig-custom",
+			Validator:         validatorName,
+			Category:          validatorCategory,
+			Status:            assessmentv1alpha1.FindingStatusInfo,
+			Title:             "Custom MachineConfigs",
+			Description:       fmt.Sprintf("Found %d custom MachineConfig(s): %s", len(customMCs), strings.Join(customMCs, ", ")),
+			AffectedResources: customRefs,
+			Impact:            "Custom MachineConfigs modify node configuration and should be reviewed for supportability.",
+			Recommendation:    "Ensure custom MachineConfigs are documented and aligned with Red Hat support policies.",
 			References: []string{
 				"https://docs.openshift.com/container-platform/latest/post_installation_configuration/machine-configuration-tasks.html",
 			},
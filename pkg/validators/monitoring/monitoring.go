@@ -57,6 +57,15 @@ func (v *MonitoringValidator) Category() string {
 	return validatorCategory
 }
 
+// RequiredPermissions returns the API access this validator needs.
+func (v *MonitoringValidator) RequiredPermissions() []validator.Permission {
+	return []validator.Permission{
+		{Verb: "get", Resource: "configmaps", Namespace: "openshift-monitoring"},
+		{Verb: "get", Resource: "configmaps", Namespace: "openshift-user-workload-monitoring"},
+		{Verb: "get", Group: "config.openshift.io", Resource: "clusteroperators"},
+	}
+}
+
 // Validate performs monitoring checks.
 func (v *MonitoringValidator) Validate(ctx context.Context, c client.Client, profile profiles.Profile) ([]assessmentv1alpha1.Finding, error) {
 	var findings []assessmentv1alpha1.Finding
@@ -190,7 +199,7 @@ func (v *MonitoringValidator) checkMonitoringOperator(ctx context.Context, c cli
 			ID:          "monitoring-operator-error",
 			Validator:   validatorName,
 			Category:    validatorCategory,
-			Status:      assessmentv1alpha1.FindingStatusFail,
+			Status:      assessmentv1alpha1.FindingStatusError,
 			Title:       "Unable to Check Monitoring Operator",
 			Description: fmt.Sprintf("Failed to get monitoring ClusterOperator: %v", err),
 		}}
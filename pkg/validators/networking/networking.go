@@ -58,6 +58,15 @@ func (v *NetworkingValidator) Category() string {
 	return validatorCategory
 }
 
+// RequiredPermissions returns the API access this validator needs.
+func (v *NetworkingValidator) RequiredPermissions() []validator.Permission {
+	return []validator.Permission{
+		{Verb: "get", Group: "config.openshift.io", Resource: "networks"},
+		{Verb: "list", Group: "networking.k8s.io", Resource: "networkpolicies"},
+		{Verb: "get", Group: "config.openshift.io", Resource: "ingresses"},
+	}
+}
+
 // Validate performs networking checks.
 func (v *NetworkingValidator) Validate(ctx context.Context, c client.Client, profile profiles.Profile) ([]assessmentv1alpha1.Finding, error) {
 	var findings []assessmentv1alpha1.Finding
@@ -84,7 +93,7 @@ func (v *NetworkingValidator) checkNetworkConfig(ctx context.Context, c client.C
 			ID:          "networking-config-error",
 			Validator:   validatorName,
 			Category:    validatorCategory,
-			Status:      assessmentv1alpha1.FindingStatusFail,
+			Status:      assessmentv1alpha1.FindingStatusError,
 			Title:       "Unable to Check Network Configuration",
 			Description: fmt.Sprintf("Failed to get Network configuration: %v", err),
 		}}
@@ -182,7 +191,7 @@ func (v *NetworkingValidator) checkNetworkPolicies(ctx context.Context, c client
 			ID:          "networking-policies-error",
 			Validator:   validatorName,
 			Category:    validatorCategory,
-			Status:      assessmentv1alpha1.FindingStatusInfo,
+			Status:      assessmentv1alpha1.FindingStatusError,
 			Title:       "Unable to Check NetworkPolicies",
 			Description: fmt.Sprintf("Failed to list NetworkPolicies: %v", err),
 		}}
@@ -245,7 +254,7 @@ func (v *NetworkingValidator) checkIngressConfig(ctx context.Context, c client.C
 			ID:          "networking-ingress-error",
 			Validator:   validatorName,
 			Category:    validatorCategory,
-			Status:      assessmentv1alpha1.FindingStatusInfo,
+			Status:      assessmentv1alpha1.FindingStatusError,
 			Title:       "Unable to Check Ingress Configuration",
 			Description: fmt.Sprintf("Failed to get Ingress configuration: %v", err),
 		}}
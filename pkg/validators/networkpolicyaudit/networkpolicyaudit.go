@@ -21,11 +21,11 @@ import (
 	"fmt"
 	"strings"
 
-	corev1 "k8s.io/api/core/v1"
 	networkingv1 "k8s.io/api/networking/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	assessmentv1alpha1 "github.com/openshift-assessment/cluster-assessment-operator/api/v1alpha1"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/clusterstate"
 	"github.com/openshift-assessment/cluster-assessment-operator/pkg/profiles"
 	"github.com/openshift-assessment/cluster-assessment-operator/pkg/validator"
 )
@@ -58,47 +58,56 @@ func (v *NetworkPolicyAuditValidator) Category() string {
 	return validatorCategory
 }
 
+// RequiredPermissions returns the API access this validator needs.
+func (v *NetworkPolicyAuditValidator) RequiredPermissions() []validator.Permission {
+	return []validator.Permission{
+		{Verb: "list", Resource: "namespaces"},
+		{Verb: "list", Group: "networking.k8s.io", Resource: "networkpolicies"},
+	}
+}
+
 // Validate performs NetworkPolicy audit checks.
 func (v *NetworkPolicyAuditValidator) Validate(ctx context.Context, c client.Client, profile profiles.Profile) ([]assessmentv1alpha1.Finding, error) {
 	var findings []assessmentv1alpha1.Finding
+	state := clusterstate.FromContext(ctx, c)
 
 	// Check 1: NetworkPolicy coverage
-	findings = append(findings, v.checkNetworkPolicyCoverage(ctx, c, profile)...)
+	findings = append(findings, v.checkNetworkPolicyCoverage(ctx, state, profile)...)
 
 	// Check 2: Allow-all policies
-	findings = append(findings, v.checkAllowAllPolicies(ctx, c)...)
+	findings = append(findings, v.checkAllowAllPolicies(ctx, state)...)
 
 	// Check 3: Default deny policies
-	findings = append(findings, v.checkDefaultDenyPolicies(ctx, c)...)
+	findings = append(findings, v.checkDefaultDenyPolicies(ctx, state)...)
 
 	return findings, nil
 }
 
 // checkNetworkPolicyCoverage checks which namespaces have NetworkPolicies.
-func (v *NetworkPolicyAuditValidator) checkNetworkPolicyCoverage(ctx context.Context, c client.Client, profile profiles.Profile) []assessmentv1alpha1.Finding {
+func (v *NetworkPolicyAuditValidator) checkNetworkPolicyCoverage(ctx context.Context, state *clusterstate.State, profile profiles.Profile) []assessmentv1alpha1.Finding {
 	var findings []assessmentv1alpha1.Finding
 
 	// Get all namespaces
-	namespaces := &corev1.NamespaceList{}
-	if err := c.List(ctx, namespaces); err != nil {
+	namespaces, err := state.Namespaces(ctx)
+	if err != nil {
 		return []assessmentv1alpha1.Finding{{
 			ID:          "networkpolicyaudit-ns-error",
 			Validator:   validatorName,
 			Category:    validatorCategory,
-			Status:      assessmentv1alpha1.FindingStatusFail,
+			Status:      assessmentv1alpha1.FindingStatusError,
 			Title:       "Unable to Check Namespaces",
 			Description: fmt.Sprintf("Failed to list namespaces: %v", err),
 		}}
 	}
 
 	// Get all NetworkPolicies
-	networkPolicies := &networkingv1.NetworkPolicyList{}
-	if err := c.List(ctx, networkPolicies); err != nil {
+	networkPolicies, err := state.NetworkPolicies(ctx)
+	if err != nil {
 		return []assessmentv1alpha1.Finding{{
 			ID:          "networkpolicyaudit-list-error",
 			Validator:   validatorName,
 			Category:    validatorCategory,
-			Status:      assessmentv1alpha1.FindingStatusFail,
+			Status:      assessmentv1alpha1.FindingStatusError,
 			Title:       "Unable to Check NetworkPolicies",
 			Description: fmt.Sprintf("Failed to list NetworkPolicies: %v", err),
 		}}
@@ -106,14 +115,15 @@ func (v *NetworkPolicyAuditValidator) checkNetworkPolicyCoverage(ctx context.Con
 
 	// Build map of namespaces with policies
 	nsWithPolicy := make(map[string]int)
-	for _, np := range networkPolicies.Items {
+	for _, np := range networkPolicies {
 		nsWithPolicy[np.Namespace]++
 	}
 
 	var userNamespacesWithoutPolicy []string
 	var userNamespacesWithPolicy []string
+	var noPolicyRefs []assessmentv1alpha1.AffectedResource
 
-	for _, ns := range namespaces.Items {
+	for _, ns := range namespaces {
 		// Skip system namespaces
 		if strings.HasPrefix(ns.Name, "openshift-") || strings.HasPrefix(ns.Name, "kube-") || ns.Name == "default" {
 			continue
@@ -121,6 +131,7 @@ func (v *NetworkPolicyAuditValidator) checkNetworkPolicyCoverage(ctx context.Con
 
 		if nsWithPolicy[ns.Name] == 0 {
 			userNamespacesWithoutPolicy = append(userNamespacesWithoutPolicy, ns.Name)
+			noPolicyRefs = append(noPolicyRefs, validator.ResourceRef("Namespace", &ns))
 		} else {
 			userNamespacesWithPolicy = append(userNamespacesWithPolicy, ns.Name)
 		}
@@ -146,14 +157,15 @@ func (v *NetworkPolicyAuditValidator) checkNetworkPolicyCoverage(ctx context.Con
 		}
 
 		findings = append(findings, assessmentv1alpha1.Finding{
-			ID:             "networkpolicyaudit-coverage",
-			Validator:      validatorName,
-			Category:       validatorCategory,
-			Status:         status,
-			Title:          "NetworkPolicy Coverage",
-			Description:    fmt.Sprintf("%d%% of user namespaces have NetworkPolicies (%d/%d). Without: %s...", coveragePercent, len(userNamespacesWithPolicy), totalUserNs, strings.Join(sample, ", ")),
-			Impact:         "Namespaces without NetworkPolicies allow all pod-to-pod traffic.",
-			Recommendation: "Define NetworkPolicies for user namespaces to implement network segmentation.",
+			ID:                "networkpolicyaudit-coverage",
+			Validator:         validatorName,
+			Category:          validatorCategory,
+			Status:            status,
+			Title:             "NetworkPolicy Coverage",
+			Description:       fmt.Sprintf("%d%% of user namespaces have NetworkPolicies (%d/%d). Without: %s...", coveragePercent, len(userNamespacesWithPolicy), totalUserNs, strings.Join(sample, ", ")),
+			AffectedResources: noPolicyRefs,
+			Impact:            "Namespaces without NetworkPolicies allow all pod-to-pod traffic.",
+			Recommendation:    "Define NetworkPolicies for user namespaces to implement network segmentation.",
 			References: []string{
 				"https://kubernetes.io/docs/concepts/services-networking/network-policies/",
 			},
@@ -183,24 +195,26 @@ func (v *NetworkPolicyAuditValidator) checkNetworkPolicyCoverage(ctx context.Con
 }
 
 // checkAllowAllPolicies detects overly permissive NetworkPolicies.
-func (v *NetworkPolicyAuditValidator) checkAllowAllPolicies(ctx context.Context, c client.Client) []assessmentv1alpha1.Finding {
+func (v *NetworkPolicyAuditValidator) checkAllowAllPolicies(ctx context.Context, state *clusterstate.State) []assessmentv1alpha1.Finding {
 	var findings []assessmentv1alpha1.Finding
 
-	networkPolicies := &networkingv1.NetworkPolicyList{}
-	if err := c.List(ctx, networkPolicies); err != nil {
+	networkPolicies, err := state.NetworkPolicies(ctx)
+	if err != nil {
 		return findings
 	}
 
 	var allowAllIngress []string
 	var allowAllEgress []string
+	var allowAllIngressRefs, allowAllEgressRefs []assessmentv1alpha1.AffectedResource
 
-	for _, np := range networkPolicies.Items {
+	for _, np := range networkPolicies {
 		// Skip system namespaces
 		if strings.HasPrefix(np.Namespace, "openshift-") || strings.HasPrefix(np.Namespace, "kube-") {
 			continue
 		}
 
 		// Check for allow-all ingress
+		ingressBefore, egressBefore := len(allowAllIngress), len(allowAllEgress)
 		for _, ingress := range np.Spec.Ingress {
 			if len(ingress.From) == 0 && len(ingress.Ports) == 0 {
 				// Empty From and Ports means allow all
@@ -226,6 +240,13 @@ func (v *NetworkPolicyAuditValidator) checkAllowAllPolicies(ctx context.Context,
 				break
 			}
 		}
+
+		if len(allowAllIngress) > ingressBefore {
+			allowAllIngressRefs = append(allowAllIngressRefs, validator.ResourceRef("NetworkPolicy", &np))
+		}
+		if len(allowAllEgress) > egressBefore {
+			allowAllEgressRefs = append(allowAllEgressRefs, validator.ResourceRef("NetworkPolicy", &np))
+		}
 	}
 
 	// Report allow-all ingress policies
@@ -236,14 +257,15 @@ func (v *NetworkPolicyAuditValidator) checkAllowAllPolicies(ctx context.Context,
 		}
 
 		findings = append(findings, assessmentv1alpha1.Finding{
-			ID:             "networkpolicyaudit-allow-all-ingress",
-			Validator:      validatorName,
-			Category:       validatorCategory,
-			Status:         assessmentv1alpha1.FindingStatusWarn,
-			Title:          "Allow-All Ingress NetworkPolicies",
-			Description:    fmt.Sprintf("Found %d NetworkPolicy(ies) that allow all ingress traffic: %s", len(allowAllIngress), strings.Join(sample, ", ")),
-			Impact:         "Overly permissive policies may not provide meaningful network isolation.",
-			Recommendation: "Review and tighten NetworkPolicies to allow only necessary traffic.",
+			ID:                "networkpolicyaudit-allow-all-ingress",
+			Validator:         validatorName,
+			Category:          validatorCategory,
+			Status:            assessmentv1alpha1.FindingStatusWarn,
+			Title:             "Allow-All Ingress NetworkPolicies",
+			Description:       fmt.Sprintf("Found %d NetworkPolicy(ies) that allow all ingress traffic: %s", len(allowAllIngress), strings.Join(sample, ", ")),
+			AffectedResources: allowAllIngressRefs,
+			Impact:            "Overly permissive policies may not provide meaningful network isolation.",
+			Recommendation:    "Review and tighten NetworkPolicies to allow only necessary traffic.",
 			Remediation: &assessmentv1alpha1.RemediationGuidance{
 				Safety: assessmentv1alpha1.RemediationRequiresReview,
 				Commands: []assessmentv1alpha1.RemediationCommand{
@@ -263,14 +285,15 @@ func (v *NetworkPolicyAuditValidator) checkAllowAllPolicies(ctx context.Context,
 		}
 
 		findings = append(findings, assessmentv1alpha1.Finding{
-			ID:             "networkpolicyaudit-allow-all-egress",
-			Validator:      validatorName,
-			Category:       validatorCategory,
-			Status:         assessmentv1alpha1.FindingStatusInfo,
-			Title:          "Allow-All Egress NetworkPolicies",
-			Description:    fmt.Sprintf("Found %d NetworkPolicy(ies) that allow all egress traffic: %s", len(allowAllEgress), strings.Join(sample, ", ")),
-			Impact:         "Pods can connect to any destination, including external networks.",
-			Recommendation: "Consider restricting egress to known destinations for sensitive workloads.",
+			ID:                "networkpolicyaudit-allow-all-egress",
+			Validator:         validatorName,
+			Category:          validatorCategory,
+			Status:            assessmentv1alpha1.FindingStatusInfo,
+			Title:             "Allow-All Egress NetworkPolicies",
+			Description:       fmt.Sprintf("Found %d NetworkPolicy(ies) that allow all egress traffic: %s", len(allowAllEgress), strings.Join(sample, ", ")),
+			AffectedResources: allowAllEgressRefs,
+			Impact:            "Pods can connect to any destination, including external networks.",
+			Recommendation:    "Consider restricting egress to known destinations for sensitive workloads.",
 		})
 	}
 
@@ -278,18 +301,18 @@ func (v *NetworkPolicyAuditValidator) checkAllowAllPolicies(ctx context.Context,
 }
 
 // checkDefaultDenyPolicies checks for default deny policies.
-func (v *NetworkPolicyAuditValidator) checkDefaultDenyPolicies(ctx context.Context, c client.Client) []assessmentv1alpha1.Finding {
+func (v *NetworkPolicyAuditValidator) checkDefaultDenyPolicies(ctx context.Context, state *clusterstate.State) []assessmentv1alpha1.Finding {
 	var findings []assessmentv1alpha1.Finding
 
-	networkPolicies := &networkingv1.NetworkPolicyList{}
-	if err := c.List(ctx, networkPolicies); err != nil {
+	networkPolicies, err := state.NetworkPolicies(ctx)
+	if err != nil {
 		return findings
 	}
 
 	var namespacesWithDenyAll []string
 	seenNamespaces := make(map[string]bool)
 
-	for _, np := range networkPolicies.Items {
+	for _, np := range networkPolicies {
 		// Skip system namespaces
 		if strings.HasPrefix(np.Namespace, "openshift-") || strings.HasPrefix(np.Namespace, "kube-") {
 			continue
@@ -35,6 +35,22 @@ const (
 	validatorCategory    = "Infrastructure"
 )
 
+// Allocatable floors, as a percentage of node capacity, below which a node is reported.
+var (
+	paramMinAllocatableMemoryPercent = validator.Parameter{
+		Name:        "minAllocatableMemoryPercent",
+		Type:        validator.ParameterPercent,
+		Default:     "50",
+		Description: "Minimum allocatable memory as a percentage of node capacity.",
+	}
+	paramMinAllocatableCPUPercent = validator.Parameter{
+		Name:        "minAllocatableCPUPercent",
+		Type:        validator.ParameterPercent,
+		Default:     "50",
+		Description: "Minimum allocatable CPU as a percentage of node capacity.",
+	}
+)
+
 func init() {
 	_ = validator.Register(&NodesValidator{})
 }
@@ -42,6 +58,11 @@ func init() {
 // NodesValidator checks node configuration.
 type NodesValidator struct{}
 
+// Parameters returns the parameters this validator accepts.
+func (v *NodesValidator) Parameters() []validator.Parameter {
+	return []validator.Parameter{paramMinAllocatableMemoryPercent, paramMinAllocatableCPUPercent}
+}
+
 // Name returns the validator name.
 func (v *NodesValidator) Name() string {
 	return validatorName
@@ -57,6 +78,13 @@ func (v *NodesValidator) Category() string {
 	return validatorCategory
 }
 
+// RequiredPermissions returns the API access this validator needs.
+func (v *NodesValidator) RequiredPermissions() []validator.Permission {
+	return []validator.Permission{
+		{Verb: "list", Resource: "nodes"},
+	}
+}
+
 // Validate performs node checks.
 func (v *NodesValidator) Validate(ctx context.Context, c client.Client, profile profiles.Profile) ([]assessmentv1alpha1.Finding, error) {
 	var findings []assessmentv1alpha1.Finding
@@ -80,7 +108,7 @@ func (v *NodesValidator) Validate(ctx context.Context, c client.Client, profile
 	findings = append(findings, v.checkNodeOS(nodes)...)
 
 	// Check 5: Resource pressure
-	findings = append(findings, v.checkResourcePressure(nodes)...)
+	findings = append(findings, v.checkResourcePressure(nodes, profile)...)
 
 	return findings, nil
 }
@@ -175,13 +203,16 @@ func (v *NodesValidator) checkNodeConditions(nodes *corev1.NodeList) []assessmen
 	var findings []assessmentv1alpha1.Finding
 	var notReadyNodes []string
 	var unhealthyNodes []string
+	var notReadyRefs, pressureRefs []assessmentv1alpha1.AffectedResource
 
 	for _, node := range nodes.Items {
+		unhealthyBefore := len(unhealthyNodes)
 		for _, condition := range node.Status.Conditions {
 			switch condition.Type {
 			case corev1.NodeReady:
 				if condition.Status != corev1.ConditionTrue {
 					notReadyNodes = append(notReadyNodes, node.Name)
+					notReadyRefs = append(notReadyRefs, validator.ResourceRef("Node", &node))
 				}
 			case corev1.NodeMemoryPressure, corev1.NodeDiskPressure, corev1.NodePIDPressure:
 				if condition.Status == corev1.ConditionTrue {
@@ -189,18 +220,22 @@ func (v *NodesValidator) checkNodeConditions(nodes *corev1.NodeList) []assessmen
 				}
 			}
 		}
+		if len(unhealthyNodes) > unhealthyBefore {
+			pressureRefs = append(pressureRefs, validator.ResourceRef("Node", &node))
+		}
 	}
 
 	if len(notReadyNodes) > 0 {
 		findings = append(findings, assessmentv1alpha1.Finding{
-			ID:             "nodes-not-ready",
-			Validator:      validatorName,
-			Category:       validatorCategory,
-			Status:         assessmentv1alpha1.FindingStatusFail,
-			Title:          "Nodes Not Ready",
-			Description:    fmt.Sprintf("%d node(s) are not in Ready state: %s", len(notReadyNodes), strings.Join(notReadyNodes, ", ")),
-			Impact:         "Nodes that are not ready cannot run workloads and may indicate infrastructure issues.",
-			Recommendation: "Investigate the not-ready nodes. Check node status with 'oc describe node <node-name>' and review kubelet logs.",
+			ID:                "nodes-not-ready",
+			Validator:         validatorName,
+			Category:          validatorCategory,
+			Status:            assessmentv1alpha1.FindingStatusFail,
+			Title:             "Nodes Not Ready",
+			Description:       fmt.Sprintf("%d node(s) are not in Ready state: %s", len(notReadyNodes), strings.Join(notReadyNodes, ", ")),
+			AffectedResources: notReadyRefs,
+			Impact:            "Nodes that are not ready cannot run workloads and may indicate infrastructure issues.",
+			Recommendation:    "Investigate the not-ready nodes. Check node status with 'oc describe node <node-name>' and review kubelet logs.",
 			Remediation: &assessmentv1alpha1.RemediationGuidance{
 				Safety: assessmentv1alpha1.RemediationRequiresReview,
 				Commands: []assessmentv1alpha1.RemediationCommand{
@@ -224,14 +259,15 @@ func (v *NodesValidator) checkNodeConditions(nodes *corev1.NodeList) []assessmen
 
 	if len(unhealthyNodes) > 0 {
 		findings = append(findings, assessmentv1alpha1.Finding{
-			ID:             "nodes-pressure",
-			Validator:      validatorName,
-			Category:       validatorCategory,
-			Status:         assessmentv1alpha1.FindingStatusWarn,
-			Title:          "Nodes Under Resource Pressure",
-			Description:    fmt.Sprintf("Nodes experiencing resource pressure: %s", strings.Join(unhealthyNodes, ", ")),
-			Impact:         "Nodes under resource pressure may evict pods and degrade workload performance.",
-			Recommendation: "Review resource usage on affected nodes and consider adding capacity or rebalancing workloads.",
+			ID:                "nodes-pressure",
+			Validator:         validatorName,
+			Category:          validatorCategory,
+			Status:            assessmentv1alpha1.FindingStatusWarn,
+			Title:             "Nodes Under Resource Pressure",
+			Description:       fmt.Sprintf("Nodes experiencing resource pressure: %s", strings.Join(unhealthyNodes, ", ")),
+			AffectedResources: pressureRefs,
+			Impact:            "Nodes under resource pressure may evict pods and degrade workload performance.",
+			Recommendation:    "Review resource usage on affected nodes and consider adding capacity or rebalancing workloads.",
 			Remediation: &assessmentv1alpha1.RemediationGuidance{
 				Safety: assessmentv1alpha1.RemediationRequiresReview,
 				Commands: []assessmentv1alpha1.RemediationCommand{
@@ -251,6 +287,7 @@ func (v *NodesValidator) checkNodeRoles(nodes *corev1.NodeList) []assessmentv1al
 	var findings []assessmentv1alpha1.Finding
 	var noRoleNodes []string
 	var mixedRoleNodes []string
+	var noRoleRefs, mixedRoleRefs []assessmentv1alpha1.AffectedResource
 
 	for _, node := range nodes.Items {
 		hasControlPlane := v.hasRole(node, "master") || v.hasRole(node, "control-plane")
@@ -260,24 +297,27 @@ func (v *NodesValidator) checkNodeRoles(nodes *corev1.NodeList) []assessmentv1al
 			// Check for infra role
 			if !v.hasRole(node, "infra") {
 				noRoleNodes = append(noRoleNodes, node.Name)
+				noRoleRefs = append(noRoleRefs, validator.ResourceRef("Node", &node))
 			}
 		}
 
 		if hasControlPlane && hasWorker {
 			mixedRoleNodes = append(mixedRoleNodes, node.Name)
+			mixedRoleRefs = append(mixedRoleRefs, validator.ResourceRef("Node", &node))
 		}
 	}
 
 	if len(noRoleNodes) > 0 {
 		findings = append(findings, assessmentv1alpha1.Finding{
-			ID:             "nodes-no-role",
-			Validator:      validatorName,
-			Category:       validatorCategory,
-			Status:         assessmentv1alpha1.FindingStatusWarn,
-			Title:          "Nodes Without Recognized Role",
-			Description:    fmt.Sprintf("%d node(s) do not have a recognized role: %s", len(noRoleNodes), strings.Join(noRoleNodes, ", ")),
-			Impact:         "Nodes without proper roles may not be included in MachineConfigPools and could have inconsistent configuration.",
-			Recommendation: "Ensure nodes have appropriate role labels (worker, master, infra).",
+			ID:                "nodes-no-role",
+			Validator:         validatorName,
+			Category:          validatorCategory,
+			Status:            assessmentv1alpha1.FindingStatusWarn,
+			Title:             "Nodes Without Recognized Role",
+			Description:       fmt.Sprintf("%d node(s) do not have a recognized role: %s", len(noRoleNodes), strings.Join(noRoleNodes, ", ")),
+			AffectedResources: noRoleRefs,
+			Impact:            "Nodes without proper roles may not be included in MachineConfigPools and could have inconsistent configuration.",
+			Recommendation:    "Ensure nodes have appropriate role labels (worker, master, infra).",
 			Remediation: &assessmentv1alpha1.RemediationGuidance{
 				Safety: assessmentv1alpha1.RemediationSafeApply,
 				Commands: []assessmentv1alpha1.RemediationCommand{
@@ -290,14 +330,15 @@ func (v *NodesValidator) checkNodeRoles(nodes *corev1.NodeList) []assessmentv1al
 
 	if len(mixedRoleNodes) > 0 {
 		findings = append(findings, assessmentv1alpha1.Finding{
-			ID:             "nodes-mixed-role",
-			Validator:      validatorName,
-			Category:       validatorCategory,
-			Status:         assessmentv1alpha1.FindingStatusInfo,
-			Title:          "Nodes With Mixed Roles",
-			Description:    fmt.Sprintf("%d node(s) have both control-plane and worker roles: %s", len(mixedRoleNodes), strings.Join(mixedRoleNodes, ", ")),
-			Impact:         "Mixed-role nodes run both control plane and workloads, which is typical for compact clusters but may affect isolation.",
-			Recommendation: "For production workloads, consider using dedicated worker nodes separate from control plane.",
+			ID:                "nodes-mixed-role",
+			Validator:         validatorName,
+			Category:          validatorCategory,
+			Status:            assessmentv1alpha1.FindingStatusInfo,
+			Title:             "Nodes With Mixed Roles",
+			Description:       fmt.Sprintf("%d node(s) have both control-plane and worker roles: %s", len(mixedRoleNodes), strings.Join(mixedRoleNodes, ", ")),
+			AffectedResources: mixedRoleRefs,
+			Impact:            "Mixed-role nodes run both control plane and workloads, which is typical for compact clusters but may affect isolation.",
+			Recommendation:    "For production workloads, consider using dedicated worker nodes separate from control plane.",
 		})
 	}
 
@@ -368,10 +409,13 @@ func (v *NodesValidator) checkNodeOS(nodes *corev1.NodeList) []assessmentv1alpha
 }
 
 // checkResourcePressure checks for resource constraints.
-func (v *NodesValidator) checkResourcePressure(nodes *corev1.NodeList) []assessmentv1alpha1.Finding {
+func (v *NodesValidator) checkResourcePressure(nodes *corev1.NodeList, profile profiles.Profile) []assessmentv1alpha1.Finding {
 	var findings []assessmentv1alpha1.Finding
+	minMemory := validator.IntParameter(profile, validatorName, paramMinAllocatableMemoryPercent)
+	minCPU := validator.IntParameter(profile, validatorName, paramMinAllocatableCPUPercent)
 	var lowMemoryNodes []string
 	var lowCPUNodes []string
+	var lowMemoryRefs, lowCPURefs []assessmentv1alpha1.AffectedResource
 
 	for _, node := range nodes.Items {
 		allocatable := node.Status.Allocatable
@@ -380,43 +424,47 @@ func (v *NodesValidator) checkResourcePressure(nodes *corev1.NodeList) []assessm
 		// Check memory utilization based on allocatable vs capacity
 		if allocatable.Memory().Value() > 0 && capacity.Memory().Value() > 0 {
 			memoryRatio := float64(allocatable.Memory().Value()) / float64(capacity.Memory().Value())
-			if memoryRatio < 0.5 {
+			if memoryRatio*100 < float64(minMemory) {
 				lowMemoryNodes = append(lowMemoryNodes, node.Name)
+				lowMemoryRefs = append(lowMemoryRefs, validator.ResourceRef("Node", &node))
 			}
 		}
 
 		// Check CPU
 		if allocatable.Cpu().MilliValue() > 0 && capacity.Cpu().MilliValue() > 0 {
 			cpuRatio := float64(allocatable.Cpu().MilliValue()) / float64(capacity.Cpu().MilliValue())
-			if cpuRatio < 0.5 {
+			if cpuRatio*100 < float64(minCPU) {
 				lowCPUNodes = append(lowCPUNodes, node.Name)
+				lowCPURefs = append(lowCPURefs, validator.ResourceRef("Node", &node))
 			}
 		}
 	}
 
 	if len(lowMemoryNodes) > 0 {
 		findings = append(findings, assessmentv1alpha1.Finding{
-			ID:             "nodes-low-allocatable-memory",
-			Validator:      validatorName,
-			Category:       validatorCategory,
-			Status:         assessmentv1alpha1.FindingStatusWarn,
-			Title:          "Low Allocatable Memory",
-			Description:    fmt.Sprintf("%d node(s) have less than 50%% memory allocatable: %s", len(lowMemoryNodes), strings.Join(lowMemoryNodes, ", ")),
-			Impact:         "Nodes with low allocatable resources have limited capacity for workloads.",
-			Recommendation: "Review system reserved resources and consider if nodes need more memory.",
+			ID:                "nodes-low-allocatable-memory",
+			Validator:         validatorName,
+			Category:          validatorCategory,
+			Status:            assessmentv1alpha1.FindingStatusWarn,
+			Title:             "Low Allocatable Memory",
+			Description:       fmt.Sprintf("%d node(s) have less than %d%% memory allocatable: %s", len(lowMemoryNodes), minMemory, strings.Join(lowMemoryNodes, ", ")),
+			AffectedResources: lowMemoryRefs,
+			Impact:            "Nodes with low allocatable resources have limited capacity for workloads.",
+			Recommendation:    "Review system reserved resources and consider if nodes need more memory.",
 		})
 	}
 
 	if len(lowCPUNodes) > 0 {
 		findings = append(findings, assessmentv1alpha1.Finding{
-			ID:             "nodes-low-allocatable-cpu",
-			Validator:      validatorName,
-			Category:       validatorCategory,
-			Status:         assessmentv1alpha1.FindingStatusWarn,
-			Title:          "Low Allocatable CPU",
-			Description:    fmt.Sprintf("%d node(s) have less than 50%% CPU allocatable: %s", len(lowCPUNodes), strings.Join(lowCPUNodes, ", ")),
-			Impact:         "Nodes with low allocatable CPU have limited capacity for workloads.",
-			Recommendation: "Review system reserved resources and kubelet configuration.",
+			ID:                "nodes-low-allocatable-cpu",
+			Validator:         validatorName,
+			Category:          validatorCategory,
+			Status:            assessmentv1alpha1.FindingStatusWarn,
+			Title:             "Low Allocatable CPU",
+			Description:       fmt.Sprintf("%d node(s) have less than %d%% CPU allocatable: %s", len(lowCPUNodes), minCPU, strings.Join(lowCPUNodes, ", ")),
+			AffectedResources: lowCPURefs,
+			Impact:            "Nodes with low allocatable CPU have limited capacity for workloads.",
+			Recommendation:    "Review system reserved resources and kubelet configuration.",
 		})
 	}
 
@@ -21,6 +21,7 @@ import (
 	"testing"
 
 	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/api/resource"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
@@ -226,3 +227,35 @@ func createNodeWithCondition(name string, isMaster, isWorker bool, readyStatus c
 		},
 	}
 }
+
+func TestNodesValidator_CheckResourcePressure_Parameters(t *testing.T) {
+	node := corev1.Node{
+		ObjectMeta: metav1.ObjectMeta{Name: "worker-0"},
+		Status: corev1.NodeStatus{
+			Capacity: corev1.ResourceList{
+				corev1.ResourceMemory: resource.MustParse("16Gi"),
+				corev1.ResourceCPU:    resource.MustParse("4"),
+			},
+			Allocatable: corev1.ResourceList{
+				corev1.ResourceMemory: resource.MustParse("12Gi"),
+				corev1.ResourceCPU:    resource.MustParse("4"),
+			},
+		},
+	}
+	nodes := &corev1.NodeList{Items: []corev1.Node{node}}
+	v := &NodesValidator{}
+
+	// 75% allocatable memory is above the default 50% floor
+	profile := profiles.GetProfile("production")
+	if findings := v.checkResourcePressure(nodes, profile); len(findings) != 0 {
+		t.Fatalf("Expected no findings with default floors, got %+v", findings)
+	}
+
+	profile.Parameters = map[string]map[string]string{
+		validatorName: {"minAllocatableMemoryPercent": "80"},
+	}
+	findings := v.checkResourcePressure(nodes, profile)
+	if len(findings) != 1 || findings[0].ID != "nodes-low-allocatable-memory" {
+		t.Fatalf("Expected a low allocatable memory finding, got %+v", findings)
+	}
+}
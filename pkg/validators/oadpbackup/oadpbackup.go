@@ -36,6 +36,14 @@ const (
 	validatorCategory    = "Platform"
 )
 
+// paramMaxBackupAge is the age beyond which the latest successful backup is stale.
+var paramMaxBackupAge = validator.Parameter{
+	Name:        "maxBackupAge",
+	Type:        validator.ParameterDuration,
+	Default:     "168h",
+	Description: "Maximum age of the most recent successful backup before it is reported as stale.",
+}
+
 func init() {
 	_ = validator.Register(&OADPBackupValidator{})
 }
@@ -47,15 +55,39 @@ func (v *OADPBackupValidator) Name() string        { return validatorName }
 func (v *OADPBackupValidator) Description() string { return validatorDescription }
 func (v *OADPBackupValidator) Category() string    { return validatorCategory }
 
+// RequiredPermissions returns the API access this validator needs.
+func (v *OADPBackupValidator) RequiredPermissions() []validator.Permission {
+	return []validator.Permission{
+		{Verb: "list", Group: "velero.io", Resource: "schedules"},
+		{Verb: "list", Group: "velero.io", Resource: "backups"},
+	}
+}
+
+// Parameters returns the parameters this validator accepts.
+func (v *OADPBackupValidator) Parameters() []validator.Parameter {
+	return []validator.Parameter{paramMaxBackupAge}
+}
+
 // Validate checks for OADP/Velero backup schedules and recent backups.
 func (v *OADPBackupValidator) Validate(ctx context.Context, c client.Client, profile profiles.Profile) ([]assessmentv1alpha1.Finding, error) {
 	var findings []assessmentv1alpha1.Finding
 
 	// Check Velero Schedules
-	findings = append(findings, v.checkSchedules(ctx, c)...)
+	scheduleFindings, err := v.checkSchedules(ctx, c)
+	if validator.IsNotInstalled(err) {
+		return []assessmentv1alpha1.Finding{notInstalledFinding()}, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	findings = append(findings, scheduleFindings...)
 
 	// Check recent backups
-	findings = append(findings, v.checkRecentBackups(ctx, c)...)
+	backupFindings, err := v.checkRecentBackups(ctx, c, profile)
+	if err != nil {
+		return nil, err
+	}
+	findings = append(findings, backupFindings...)
 
 	// If no backup-related findings, add a general check
 	if len(findings) == 0 {
@@ -86,7 +118,24 @@ func (v *OADPBackupValidator) Validate(ctx context.Context, c client.Client, pro
 	return findings, nil
 }
 
-func (v *OADPBackupValidator) checkSchedules(ctx context.Context, c client.Client) []assessmentv1alpha1.Finding {
+// notInstalledFinding reports that Velero is not installed, so backup checks do
+// not apply. Clusters that need backups are covered by the etcdbackup validator.
+func notInstalledFinding() assessmentv1alpha1.Finding {
+	return assessmentv1alpha1.Finding{
+		ID:             "oadpbackup-not-installed",
+		Validator:      validatorName,
+		Category:       validatorCategory,
+		Status:         assessmentv1alpha1.FindingStatusNotApplicable,
+		Title:          "OADP Not Installed",
+		Description:    "The Velero APIs are not available, so OADP backup schedules were not checked.",
+		Recommendation: "Install OADP if application backups are in scope for this cluster.",
+		References: []string{
+			"https://docs.openshift.com/container-platform/latest/backup_and_restore/application_backup_and_restore/oadp-intro.html",
+		},
+	}
+}
+
+func (v *OADPBackupValidator) checkSchedules(ctx context.Context, c client.Client) ([]assessmentv1alpha1.Finding, error) {
 	var findings []assessmentv1alpha1.Finding
 
 	scheduleList := &unstructured.UnstructuredList{}
@@ -97,12 +146,11 @@ func (v *OADPBackupValidator) checkSchedules(ctx context.Context, c client.Clien
 	})
 
 	if err := c.List(ctx, scheduleList); err != nil {
-		// Velero not installed
-		return nil
+		return nil, fmt.Errorf("failed to list Velero schedules: %w", err)
 	}
 
 	if len(scheduleList.Items) == 0 {
-		return nil // Will be caught by parent function
+		return nil, nil // Will be caught by parent function
 	}
 
 	paused := 0
@@ -116,13 +164,14 @@ func (v *OADPBackupValidator) checkSchedules(ctx context.Context, c client.Clien
 		if p {
 			paused++
 			findings = append(findings, assessmentv1alpha1.Finding{
-				ID:             fmt.Sprintf("oadpbackup-schedule-paused-%s-%s", ns, name),
-				Validator:      validatorName,
-				Category:       validatorCategory,
-				Status:         assessmentv1alpha1.FindingStatusWarn,
-				Title:          fmt.Sprintf("Backup Schedule Paused: %s/%s", ns, name),
-				Description:    fmt.Sprintf("Velero backup schedule '%s/%s' (cron: %s) is paused and will not create backups.", ns, name, schedule),
-				Recommendation: "Review if this schedule should be unpaused.",
+				ID:                fmt.Sprintf("oadpbackup-schedule-paused-%s-%s", ns, name),
+				Validator:         validatorName,
+				Category:          validatorCategory,
+				Status:            assessmentv1alpha1.FindingStatusWarn,
+				Title:             fmt.Sprintf("Backup Schedule Paused: %s/%s", ns, name),
+				Description:       fmt.Sprintf("Velero backup schedule '%s/%s' (cron: %s) is paused and will not create backups.", ns, name, schedule),
+				AffectedResources: []assessmentv1alpha1.AffectedResource{validator.ResourceRef("Schedule", &sched)},
+				Recommendation:    "Review if this schedule should be unpaused.",
 				Remediation: &assessmentv1alpha1.RemediationGuidance{
 					Safety: assessmentv1alpha1.RemediationSafeApply,
 					Commands: []assessmentv1alpha1.RemediationCommand{
@@ -146,10 +195,10 @@ func (v *OADPBackupValidator) checkSchedules(ctx context.Context, c client.Clien
 		})
 	}
 
-	return findings
+	return findings, nil
 }
 
-func (v *OADPBackupValidator) checkRecentBackups(ctx context.Context, c client.Client) []assessmentv1alpha1.Finding {
+func (v *OADPBackupValidator) checkRecentBackups(ctx context.Context, c client.Client, profile profiles.Profile) ([]assessmentv1alpha1.Finding, error) {
 	var findings []assessmentv1alpha1.Finding
 
 	backupList := &unstructured.UnstructuredList{}
@@ -160,23 +209,24 @@ func (v *OADPBackupValidator) checkRecentBackups(ctx context.Context, c client.C
 	})
 
 	if err := c.List(ctx, backupList); err != nil {
-		return nil
+		return nil, fmt.Errorf("failed to list Velero backups: %w", err)
 	}
 
 	if len(backupList.Items) == 0 {
-		return nil
+		return nil, nil
 	}
 
 	// Find the most recent completed backup
 	var latestCompletionTime time.Time
 	var latestBackupName string
-	failedBackups := 0
+	var latestBackupRef assessmentv1alpha1.AffectedResource
+	var failedRefs []assessmentv1alpha1.AffectedResource
 
 	for _, backup := range backupList.Items {
 		phase, _, _ := unstructured.NestedString(backup.Object, "status", "phase")
 
 		if phase == "Failed" || phase == "PartiallyFailed" {
-			failedBackups++
+			failedRefs = append(failedRefs, validator.ResourceRef("Backup", &backup))
 			continue
 		}
 
@@ -197,24 +247,26 @@ func (v *OADPBackupValidator) checkRecentBackups(ctx context.Context, c client.C
 		if t.After(latestCompletionTime) {
 			latestCompletionTime = t
 			latestBackupName = fmt.Sprintf("%s/%s", backup.GetNamespace(), backup.GetName())
+			latestBackupRef = validator.ResourceRef("Backup", &backup)
 		}
 	}
 
 	// Check if recent backup is stale
 	if !latestCompletionTime.IsZero() {
 		age := time.Since(latestCompletionTime)
-		maxAge := 7 * 24 * time.Hour // 7 days default
+		maxAge := validator.DurationParameter(profile, validatorName, paramMaxBackupAge)
 
 		if age > maxAge {
 			findings = append(findings, assessmentv1alpha1.Finding{
-				ID:             "oadpbackup-stale-backup",
-				Validator:      validatorName,
-				Category:       validatorCategory,
-				Status:         assessmentv1alpha1.FindingStatusWarn,
-				Title:          "Last Successful Backup is Stale",
-				Description:    fmt.Sprintf("Most recent successful backup (%s) completed %s ago, exceeding the 7-day threshold.", latestBackupName, formatDuration(age)),
-				Impact:         "Stale backups provide inadequate protection against data loss.",
-				Recommendation: "Investigate why recent backup schedules did not produce successful backups.",
+				ID:                "oadpbackup-stale-backup",
+				Validator:         validatorName,
+				Category:          validatorCategory,
+				Status:            assessmentv1alpha1.FindingStatusWarn,
+				Title:             "Last Successful Backup is Stale",
+				Description:       fmt.Sprintf("Most recent successful backup (%s) completed %s ago, exceeding the %s threshold.", latestBackupName, formatDuration(age), formatDuration(maxAge)),
+				AffectedResources: []assessmentv1alpha1.AffectedResource{latestBackupRef},
+				Impact:            "Stale backups provide inadequate protection against data loss.",
+				Recommendation:    "Investigate why recent backup schedules did not produce successful backups.",
 			})
 		} else {
 			findings = append(findings, assessmentv1alpha1.Finding{
@@ -228,16 +280,17 @@ func (v *OADPBackupValidator) checkRecentBackups(ctx context.Context, c client.C
 		}
 	}
 
-	if failedBackups > 0 {
+	if len(failedRefs) > 0 {
 		findings = append(findings, assessmentv1alpha1.Finding{
-			ID:             "oadpbackup-failed-backups",
-			Validator:      validatorName,
-			Category:       validatorCategory,
-			Status:         assessmentv1alpha1.FindingStatusWarn,
-			Title:          "Failed Backups Detected",
-			Description:    fmt.Sprintf("%d backup(s) have Failed or PartiallyFailed status.", failedBackups),
-			Impact:         "Failed backups may indicate storage issues or misconfigured backup resources.",
-			Recommendation: "Review failed backup logs and ensure backup storage is accessible.",
+			ID:                "oadpbackup-failed-backups",
+			Validator:         validatorName,
+			Category:          validatorCategory,
+			Status:            assessmentv1alpha1.FindingStatusWarn,
+			Title:             "Failed Backups Detected",
+			Description:       fmt.Sprintf("%d backup(s) have Failed or PartiallyFailed status.", len(failedRefs)),
+			AffectedResources: failedRefs,
+			Impact:            "Failed backups may indicate storage issues or misconfigured backup resources.",
+			Recommendation:    "Review failed backup logs and ensure backup storage is accessible.",
 			Remediation: &assessmentv1alpha1.RemediationGuidance{
 				Safety: assessmentv1alpha1.RemediationSafeApply,
 				Commands: []assessmentv1alpha1.RemediationCommand{
@@ -248,7 +301,7 @@ func (v *OADPBackupValidator) checkRecentBackups(ctx context.Context, c client.C
 		})
 	}
 
-	return findings
+	return findings, nil
 }
 
 func formatDuration(d time.Duration) string {
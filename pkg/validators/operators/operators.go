@@ -57,6 +57,14 @@ func (v *OperatorsValidator) Category() string {
 	return validatorCategory
 }
 
+// RequiredPermissions returns the API access this validator needs.
+func (v *OperatorsValidator) RequiredPermissions() []validator.Permission {
+	return []validator.Permission{
+		{Verb: "list", Group: "operators.coreos.com", Resource: "clusterserviceversions"},
+		{Verb: "list", Group: "config.openshift.io", Resource: "clusteroperators"},
+	}
+}
+
 // Validate performs operator health checks.
 func (v *OperatorsValidator) Validate(ctx context.Context, c client.Client, profile profiles.Profile) ([]assessmentv1alpha1.Finding, error) {
 	var findings []assessmentv1alpha1.Finding
@@ -76,7 +84,7 @@ func (v *OperatorsValidator) Validate(ctx context.Context, c client.Client, prof
 			ID:          "operators-csv-error",
 			Validator:   validatorName,
 			Category:    validatorCategory,
-			Status:      assessmentv1alpha1.FindingStatusInfo,
+			Status:      assessmentv1alpha1.FindingStatusError,
 			Title:       "Unable to List CSVs",
 			Description: fmt.Sprintf("Failed to list ClusterServiceVersions: %v", err),
 		})
@@ -86,6 +94,7 @@ func (v *OperatorsValidator) Validate(ctx context.Context, c client.Client, prof
 	var failedCSVs []string
 	var pendingCSVs []string
 	var healthyCSVs int
+	var failedRefs, pendingRefs []assessmentv1alpha1.AffectedResource
 
 	for _, csv := range csvList.Items {
 		name, _, _ := unstructured.NestedString(csv.Object, "metadata", "name")
@@ -93,18 +102,22 @@ func (v *OperatorsValidator) Validate(ctx context.Context, c client.Client, prof
 		phase, _, _ := unstructured.NestedString(csv.Object, "status", "phase")
 
 		fullName := fmt.Sprintf("%s/%s", namespace, name)
+		ref := validator.ResourceRef("ClusterServiceVersion", &csv)
 
 		switch phase {
 		case "Succeeded":
 			healthyCSVs++
 		case "Failed":
 			failedCSVs = append(failedCSVs, fullName)
+			failedRefs = append(failedRefs, ref)
 		case "Pending", "Installing", "Replacing", "Deleting":
 			pendingCSVs = append(pendingCSVs, fullName)
+			pendingRefs = append(pendingRefs, ref)
 		default:
 			// Unknown phase, treat as pending
 			if phase != "" {
 				pendingCSVs = append(pendingCSVs, fullName)
+				pendingRefs = append(pendingRefs, ref)
 			}
 		}
 	}
@@ -112,14 +125,15 @@ func (v *OperatorsValidator) Validate(ctx context.Context, c client.Client, prof
 	// Report on failed operators
 	if len(failedCSVs) > 0 {
 		findings = append(findings, assessmentv1alpha1.Finding{
-			ID:             "operators-csv-failed",
-			Validator:      validatorName,
-			Category:       validatorCategory,
-			Status:         assessmentv1alpha1.FindingStatusFail,
-			Title:          "Failed Operators Detected",
-			Description:    fmt.Sprintf("Found %d operators in Failed state: %v", len(failedCSVs), truncateList(failedCSVs, 5)),
-			Impact:         "Failed operators may not provide expected functionality and could affect cluster operations.",
-			Recommendation: "Check the operator logs and events to diagnose the failure. Consider removing and reinstalling the operator.",
+			ID:                "operators-csv-failed",
+			Validator:         validatorName,
+			Category:          validatorCategory,
+			Status:            assessmentv1alpha1.FindingStatusFail,
+			Title:             "Failed Operators Detected",
+			Description:       fmt.Sprintf("Found %d operators in Failed state: %v", len(failedCSVs), truncateList(failedCSVs, 5)),
+			AffectedResources: failedRefs,
+			Impact:            "Failed operators may not provide expected functionality and could affect cluster operations.",
+			Recommendation:    "Check the operator logs and events to diagnose the failure. Consider removing and reinstalling the operator.",
 			Remediation: &assessmentv1alpha1.RemediationGuidance{
 				Safety: assessmentv1alpha1.RemediationRequiresReview,
 				Commands: []assessmentv1alpha1.RemediationCommand{
@@ -135,14 +149,15 @@ func (v *OperatorsValidator) Validate(ctx context.Context, c client.Client, prof
 	// Report on pending operators
 	if len(pendingCSVs) > 0 {
 		findings = append(findings, assessmentv1alpha1.Finding{
-			ID:             "operators-csv-pending",
-			Validator:      validatorName,
-			Category:       validatorCategory,
-			Status:         assessmentv1alpha1.FindingStatusWarn,
-			Title:          "Operators in Pending State",
-			Description:    fmt.Sprintf("Found %d operators pending installation: %v", len(pendingCSVs), truncateList(pendingCSVs, 5)),
-			Impact:         "Pending operators may be waiting for dependencies or experiencing installation issues.",
-			Recommendation: "Review the install plan and subscription status for blocked operators.",
+			ID:                "operators-csv-pending",
+			Validator:         validatorName,
+			Category:          validatorCategory,
+			Status:            assessmentv1alpha1.FindingStatusWarn,
+			Title:             "Operators in Pending State",
+			Description:       fmt.Sprintf("Found %d operators pending installation: %v", len(pendingCSVs), truncateList(pendingCSVs, 5)),
+			AffectedResources: pendingRefs,
+			Impact:            "Pending operators may be waiting for dependencies or experiencing installation issues.",
+			Recommendation:    "Review the install plan and subscription status for blocked operators.",
 		})
 	}
 
@@ -184,9 +199,11 @@ func (v *OperatorsValidator) checkClusterOperators(ctx context.Context, c client
 	var degradedOperators []string
 	var unavailableOperators []string
 	var progressingOperators []string
+	var degradedRefs, unavailableRefs, progressingRefs []assessmentv1alpha1.AffectedResource
 
 	for _, co := range coList.Items {
 		name, _, _ := unstructured.NestedString(co.Object, "metadata", "name")
+		ref := validator.ResourceRef("ClusterOperator", &co)
 		conditions, found, _ := unstructured.NestedSlice(co.Object, "status", "conditions")
 		if !found {
 			continue
@@ -205,14 +222,17 @@ func (v *OperatorsValidator) checkClusterOperators(ctx context.Context, c client
 			case "Degraded":
 				if condStatus == "True" {
 					degradedOperators = append(degradedOperators, name)
+					degradedRefs = append(degradedRefs, ref)
 				}
 			case "Available":
 				if condStatus == "False" {
 					unavailableOperators = append(unavailableOperators, name)
+					unavailableRefs = append(unavailableRefs, ref)
 				}
 			case "Progressing":
 				if condStatus == "True" {
 					progressingOperators = append(progressingOperators, name)
+					progressingRefs = append(progressingRefs, ref)
 				}
 			}
 		}
@@ -220,14 +240,15 @@ func (v *OperatorsValidator) checkClusterOperators(ctx context.Context, c client
 
 	if len(degradedOperators) > 0 {
 		findings = append(findings, assessmentv1alpha1.Finding{
-			ID:             "operators-cluster-degraded",
-			Validator:      validatorName,
-			Category:       validatorCategory,
-			Status:         assessmentv1alpha1.FindingStatusFail,
-			Title:          "Degraded Cluster Operators",
-			Description:    fmt.Sprintf("Found %d degraded cluster operators: %v", len(degradedOperators), degradedOperators),
-			Impact:         "Degraded operators may not be fully functional and could affect cluster stability.",
-			Recommendation: "Check the operator events and logs in the openshift-* namespaces.",
+			ID:                "operators-cluster-degraded",
+			Validator:         validatorName,
+			Category:          validatorCategory,
+			Status:            assessmentv1alpha1.FindingStatusFail,
+			Title:             "Degraded Cluster Operators",
+			Description:       fmt.Sprintf("Found %d degraded cluster operators: %v", len(degradedOperators), degradedOperators),
+			AffectedResources: degradedRefs,
+			Impact:            "Degraded operators may not be fully functional and could affect cluster stability.",
+			Recommendation:    "Check the operator events and logs in the openshift-* namespaces.",
 			Remediation: &assessmentv1alpha1.RemediationGuidance{
 				Safety: assessmentv1alpha1.RemediationRequiresReview,
 				Commands: []assessmentv1alpha1.RemediationCommand{
@@ -241,14 +262,15 @@ func (v *OperatorsValidator) checkClusterOperators(ctx context.Context, c client
 
 	if len(unavailableOperators) > 0 {
 		findings = append(findings, assessmentv1alpha1.Finding{
-			ID:             "operators-cluster-unavailable",
-			Validator:      validatorName,
-			Category:       validatorCategory,
-			Status:         assessmentv1alpha1.FindingStatusFail,
-			Title:          "Unavailable Cluster Operators",
-			Description:    fmt.Sprintf("Found %d unavailable cluster operators: %v", len(unavailableOperators), unavailableOperators),
-			Impact:         "Unavailable operators cannot perform their functions.",
-			Recommendation: "Investigate the operator status and logs immediately.",
+			ID:                "operators-cluster-unavailable",
+			Validator:         validatorName,
+			Category:          validatorCategory,
+			Status:            assessmentv1alpha1.FindingStatusFail,
+			Title:             "Unavailable Cluster Operators",
+			Description:       fmt.Sprintf("Found %d unavailable cluster operators: %v", len(unavailableOperators), unavailableOperators),
+			AffectedResources: unavailableRefs,
+			Impact:            "Unavailable operators cannot perform their functions.",
+			Recommendation:    "Investigate the operator status and logs immediately.",
 			Remediation: &assessmentv1alpha1.RemediationGuidance{
 				Safety: assessmentv1alpha1.RemediationRequiresReview,
 				Commands: []assessmentv1alpha1.RemediationCommand{
@@ -262,12 +284,13 @@ func (v *OperatorsValidator) checkClusterOperators(ctx context.Context, c client
 
 	if len(progressingOperators) > 0 {
 		findings = append(findings, assessmentv1alpha1.Finding{
-			ID:          "operators-cluster-progressing",
-			Validator:   validatorName,
-			Category:    validatorCategory,
-			Status:      assessmentv1alpha1.FindingStatusInfo,
-			Title:       "Cluster Operators Updating",
-			Description: fmt.Sprintf("Found %d cluster operators currently updating: %v", len(progressingOperators), progressingOperators),
+			ID:                "operators-cluster-progressing",
+			Validator:         validatorName,
+			Category:          validatorCategory,
+			Status:            assessmentv1alpha1.FindingStatusInfo,
+			Title:             "Cluster Operators Updating",
+			Description:       fmt.Sprintf("Found %d cluster operators currently updating: %v", len(progressingOperators), progressingOperators),
+			AffectedResources: progressingRefs,
 		})
 	}
 
@@ -21,10 +21,10 @@ import (
 	"fmt"
 	"strings"
 
-	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	assessmentv1alpha1 "github.com/openshift-assessment/cluster-assessment-operator/api/v1alpha1"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/clusterstate"
 	"github.com/openshift-assessment/cluster-assessment-operator/pkg/profiles"
 	"github.com/openshift-assessment/cluster-assessment-operator/pkg/validator"
 )
@@ -53,17 +53,25 @@ func (v *PSAValidator) Name() string        { return validatorName }
 func (v *PSAValidator) Description() string { return validatorDescription }
 func (v *PSAValidator) Category() string    { return validatorCategory }
 
+// RequiredPermissions returns the API access this validator needs.
+func (v *PSAValidator) RequiredPermissions() []validator.Permission {
+	return []validator.Permission{
+		{Verb: "list", Resource: "namespaces"},
+	}
+}
+
 // Validate checks PSA labels on all user namespaces.
 func (v *PSAValidator) Validate(ctx context.Context, c client.Client, profile profiles.Profile) ([]assessmentv1alpha1.Finding, error) {
 	var findings []assessmentv1alpha1.Finding
+	state := clusterstate.FromContext(ctx, c)
 
-	namespaces := &corev1.NamespaceList{}
-	if err := c.List(ctx, namespaces); err != nil {
+	namespaces, err := state.Namespaces(ctx)
+	if err != nil {
 		return []assessmentv1alpha1.Finding{{
 			ID:          "psa-list-error",
 			Validator:   validatorName,
 			Category:    validatorCategory,
-			Status:      assessmentv1alpha1.FindingStatusFail,
+			Status:      assessmentv1alpha1.FindingStatusError,
 			Title:       "Unable to List Namespaces",
 			Description: fmt.Sprintf("Failed to list namespaces: %v", err),
 		}}, nil
@@ -72,9 +80,10 @@ func (v *PSAValidator) Validate(ctx context.Context, c client.Client, profile pr
 	var noPSALabels []string
 	var privilegedEnforce []string
 	var restrictedEnforce []string
+	var noPSARefs, privilegedRefs []assessmentv1alpha1.AffectedResource
 	totalUser := 0
 
-	for _, ns := range namespaces.Items {
+	for _, ns := range namespaces {
 		// Skip system namespaces
 		if isSystemNamespace(ns.Name) {
 			continue
@@ -87,10 +96,12 @@ func (v *PSAValidator) Validate(ctx context.Context, c client.Client, profile pr
 
 		if enforce == "" && warn == "" && audit == "" {
 			noPSALabels = append(noPSALabels, ns.Name)
+			noPSARefs = append(noPSARefs, validator.ResourceRef("Namespace", &ns))
 		}
 
 		if enforce == "privileged" {
 			privilegedEnforce = append(privilegedEnforce, ns.Name)
+			privilegedRefs = append(privilegedRefs, validator.ResourceRef("Namespace", &ns))
 		}
 		if enforce == "restricted" {
 			restrictedEnforce = append(restrictedEnforce, ns.Name)
@@ -110,14 +121,15 @@ func (v *PSAValidator) Validate(ctx context.Context, c client.Client, profile pr
 		}
 
 		findings = append(findings, assessmentv1alpha1.Finding{
-			ID:             "psa-no-labels",
-			Validator:      validatorName,
-			Category:       validatorCategory,
-			Status:         status,
-			Title:          "Namespaces Without Pod Security Admission Labels",
-			Description:    fmt.Sprintf("%d of %d user namespaces have no PSA labels: %s", len(noPSALabels), totalUser, strings.Join(sample, ", ")),
-			Impact:         "Without PSA labels, pods in these namespaces run with the default (usually privileged) security level.",
-			Recommendation: "Add pod-security.kubernetes.io/enforce labels to configure the security level for each namespace.",
+			ID:                "psa-no-labels",
+			Validator:         validatorName,
+			Category:          validatorCategory,
+			Status:            status,
+			Title:             "Namespaces Without Pod Security Admission Labels",
+			Description:       fmt.Sprintf("%d of %d user namespaces have no PSA labels: %s", len(noPSALabels), totalUser, strings.Join(sample, ", ")),
+			AffectedResources: noPSARefs,
+			Impact:            "Without PSA labels, pods in these namespaces run with the default (usually privileged) security level.",
+			Recommendation:    "Add pod-security.kubernetes.io/enforce labels to configure the security level for each namespace.",
 			References: []string{
 				"https://kubernetes.io/docs/concepts/security/pod-security-admission/",
 				"https://docs.openshift.com/container-platform/latest/authentication/understanding-and-managing-pod-security-admission.html",
@@ -158,14 +170,15 @@ func (v *PSAValidator) Validate(ctx context.Context, c client.Client, profile pr
 		}
 
 		findings = append(findings, assessmentv1alpha1.Finding{
-			ID:             "psa-privileged-enforce",
-			Validator:      validatorName,
-			Category:       validatorCategory,
-			Status:         status,
-			Title:          "Namespaces With Privileged PSA Enforcement",
-			Description:    fmt.Sprintf("%d namespace(s) enforce the 'privileged' Pod Security level: %s", len(privilegedEnforce), strings.Join(sample, ", ")),
-			Impact:         "Privileged enforcement allows pods with any security configuration, including host access.",
-			Recommendation: "Consider using 'baseline' or 'restricted' enforcement where possible.",
+			ID:                "psa-privileged-enforce",
+			Validator:         validatorName,
+			Category:          validatorCategory,
+			Status:            status,
+			Title:             "Namespaces With Privileged PSA Enforcement",
+			Description:       fmt.Sprintf("%d namespace(s) enforce the 'privileged' Pod Security level: %s", len(privilegedEnforce), strings.Join(sample, ", ")),
+			AffectedResources: privilegedRefs,
+			Impact:            "Privileged enforcement allows pods with any security configuration, including host access.",
+			Recommendation:    "Consider using 'baseline' or 'restricted' enforcement where possible.",
 		})
 	}
 
@@ -21,10 +21,10 @@ import (
 	"fmt"
 	"strings"
 
-	rbacv1 "k8s.io/api/rbac/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	assessmentv1alpha1 "github.com/openshift-assessment/cluster-assessment-operator/api/v1alpha1"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/clusterstate"
 	"github.com/openshift-assessment/cluster-assessment-operator/pkg/profiles"
 	"github.com/openshift-assessment/cluster-assessment-operator/pkg/validator"
 )
@@ -62,41 +62,53 @@ func (v *RBACauditValidator) Name() string        { return validatorName }
 func (v *RBACauditValidator) Description() string { return validatorDescription }
 func (v *RBACauditValidator) Category() string    { return validatorCategory }
 
+// RequiredPermissions returns the API access this validator needs.
+func (v *RBACauditValidator) RequiredPermissions() []validator.Permission {
+	return []validator.Permission{
+		{Verb: "list", Group: "rbac.authorization.k8s.io", Resource: "clusterroles"},
+		{Verb: "list", Group: "rbac.authorization.k8s.io", Resource: "roles"},
+		{Verb: "list", Group: "rbac.authorization.k8s.io", Resource: "rolebindings"},
+	}
+}
+
 // Validate performs namespace-scoped RBAC auditing.
 func (v *RBACauditValidator) Validate(ctx context.Context, c client.Client, profile profiles.Profile) ([]assessmentv1alpha1.Finding, error) {
 	var findings []assessmentv1alpha1.Finding
+	state := clusterstate.FromContext(ctx, c)
 
 	// Check 1: Namespace-scoped RoleBindings to cluster-admin
-	findings = append(findings, v.checkNamespaceClusterAdminBindings(ctx, c)...)
+	findings = append(findings, v.checkNamespaceClusterAdminBindings(ctx, state)...)
 
 	// Check 2: Roles with dangerous escalation verbs
-	findings = append(findings, v.checkDangerousVerbs(ctx, c)...)
+	findings = append(findings, v.checkDangerousVerbs(ctx, state)...)
 
 	// Check 3: Roles with wildcard access to sensitive resources
-	findings = append(findings, v.checkSensitiveResourceAccess(ctx, c)...)
+	findings = append(findings, v.checkSensitiveResourceAccess(ctx, state)...)
 
 	// Check 4: RoleBindings with overly broad bindings (all ServiceAccounts)
-	findings = append(findings, v.checkBroadBindings(ctx, c)...)
+	findings = append(findings, v.checkBroadBindings(ctx, state)...)
 
 	return findings, nil
 }
 
 // checkNamespaceClusterAdminBindings checks for RoleBindings that reference cluster-admin.
-func (v *RBACauditValidator) checkNamespaceClusterAdminBindings(ctx context.Context, c client.Client) []assessmentv1alpha1.Finding {
+func (v *RBACauditValidator) checkNamespaceClusterAdminBindings(ctx context.Context, state *clusterstate.State) []assessmentv1alpha1.Finding {
 	var findings []assessmentv1alpha1.Finding
 
-	rbs := &rbacv1.RoleBindingList{}
-	if err := c.List(ctx, rbs); err != nil {
+	rbs, err := state.RoleBindings(ctx)
+	if err != nil {
 		return nil
 	}
 
 	var clusterAdminRBs []string
-	for _, rb := range rbs.Items {
+	var clusterAdminRefs []assessmentv1alpha1.AffectedResource
+	for _, rb := range rbs {
 		if isSystemNamespace(rb.Namespace) {
 			continue
 		}
 		if rb.RoleRef.Kind == "ClusterRole" && rb.RoleRef.Name == "cluster-admin" {
 			clusterAdminRBs = append(clusterAdminRBs, fmt.Sprintf("%s/%s", rb.Namespace, rb.Name))
+			clusterAdminRefs = append(clusterAdminRefs, validator.ResourceRef("RoleBinding", &rb))
 		}
 	}
 
@@ -107,14 +119,15 @@ func (v *RBACauditValidator) checkNamespaceClusterAdminBindings(ctx context.Cont
 		}
 
 		findings = append(findings, assessmentv1alpha1.Finding{
-			ID:             "rbacaudit-ns-cluster-admin",
-			Validator:      validatorName,
-			Category:       validatorCategory,
-			Status:         assessmentv1alpha1.FindingStatusWarn,
-			Title:          "Namespace RoleBindings to cluster-admin",
-			Description:    fmt.Sprintf("%d RoleBinding(s) in user namespaces grant cluster-admin access: %s", len(clusterAdminRBs), strings.Join(sample, ", ")),
-			Impact:         "Namespace-scoped cluster-admin bindings grant full cluster privileges within that namespace, defeating namespace isolation.",
-			Recommendation: "Replace cluster-admin references with more specific Roles scoped to the namespace needs.",
+			ID:                "rbacaudit-ns-cluster-admin",
+			Validator:         validatorName,
+			Category:          validatorCategory,
+			Status:            assessmentv1alpha1.FindingStatusWarn,
+			Title:             "Namespace RoleBindings to cluster-admin",
+			Description:       fmt.Sprintf("%d RoleBinding(s) in user namespaces grant cluster-admin access: %s", len(clusterAdminRBs), strings.Join(sample, ", ")),
+			AffectedResources: clusterAdminRefs,
+			Impact:            "Namespace-scoped cluster-admin bindings grant full cluster privileges within that namespace, defeating namespace isolation.",
+			Recommendation:    "Replace cluster-admin references with more specific Roles scoped to the namespace needs.",
 			Remediation: &assessmentv1alpha1.RemediationGuidance{
 				Safety: assessmentv1alpha1.RemediationRequiresReview,
 				Commands: []assessmentv1alpha1.RemediationCommand{
@@ -138,20 +151,22 @@ func (v *RBACauditValidator) checkNamespaceClusterAdminBindings(ctx context.Cont
 }
 
 // checkDangerousVerbs checks for Roles/ClusterRoles with escalation verbs.
-func (v *RBACauditValidator) checkDangerousVerbs(ctx context.Context, c client.Client) []assessmentv1alpha1.Finding {
+func (v *RBACauditValidator) checkDangerousVerbs(ctx context.Context, state *clusterstate.State) []assessmentv1alpha1.Finding {
 	var findings []assessmentv1alpha1.Finding
 
 	// Check ClusterRoles
-	clusterRoles := &rbacv1.ClusterRoleList{}
-	if err := c.List(ctx, clusterRoles); err != nil {
+	clusterRoles, err := state.ClusterRoles(ctx)
+	if err != nil {
 		return nil
 	}
 
 	var escalationRoles []string
-	for _, cr := range clusterRoles.Items {
+	var escalationRefs []assessmentv1alpha1.AffectedResource
+	for _, cr := range clusterRoles {
 		if strings.HasPrefix(cr.Name, "system:") || strings.HasPrefix(cr.Name, "openshift") {
 			continue
 		}
+		before := len(escalationRoles)
 		for _, rule := range cr.Rules {
 			for _, verb := range rule.Verbs {
 				if _, isDangerous := dangerousVerbs[verb]; isDangerous {
@@ -160,18 +175,22 @@ func (v *RBACauditValidator) checkDangerousVerbs(ctx context.Context, c client.C
 				}
 			}
 		}
+		if len(escalationRoles) > before {
+			escalationRefs = append(escalationRefs, validator.ResourceRef("ClusterRole", &cr))
+		}
 	}
 
 	// Check namespace Roles
-	roles := &rbacv1.RoleList{}
-	if err := c.List(ctx, roles); err != nil {
+	roles, err := state.Roles(ctx)
+	if err != nil {
 		return nil
 	}
 
-	for _, role := range roles.Items {
+	for _, role := range roles {
 		if isSystemNamespace(role.Namespace) {
 			continue
 		}
+		before := len(escalationRoles)
 		for _, rule := range role.Rules {
 			for _, verb := range rule.Verbs {
 				if _, isDangerous := dangerousVerbs[verb]; isDangerous {
@@ -180,6 +199,9 @@ func (v *RBACauditValidator) checkDangerousVerbs(ctx context.Context, c client.C
 				}
 			}
 		}
+		if len(escalationRoles) > before {
+			escalationRefs = append(escalationRefs, validator.ResourceRef("Role", &role))
+		}
 	}
 
 	// Deduplicate
@@ -192,14 +214,15 @@ func (v *RBACauditValidator) checkDangerousVerbs(ctx context.Context, c client.C
 		}
 
 		findings = append(findings, assessmentv1alpha1.Finding{
-			ID:             "rbacaudit-dangerous-verbs",
-			Validator:      validatorName,
-			Category:       validatorCategory,
-			Status:         assessmentv1alpha1.FindingStatusWarn,
-			Title:          "Roles With Privilege Escalation Verbs",
-			Description:    fmt.Sprintf("%d Role(s) have escalation verbs (escalate, bind, impersonate): %s", len(escalationRoles), strings.Join(sample, "; ")),
-			Impact:         "These verbs allow users to grant themselves or others additional permissions beyond what they currently have.",
-			Recommendation: "Review and restrict escalation verbs to only trusted administrative roles.",
+			ID:                "rbacaudit-dangerous-verbs",
+			Validator:         validatorName,
+			Category:          validatorCategory,
+			Status:            assessmentv1alpha1.FindingStatusWarn,
+			Title:             "Roles With Privilege Escalation Verbs",
+			Description:       fmt.Sprintf("%d Role(s) have escalation verbs (escalate, bind, impersonate): %s", len(escalationRoles), strings.Join(sample, "; ")),
+			AffectedResources: escalationRefs,
+			Impact:            "These verbs allow users to grant themselves or others additional permissions beyond what they currently have.",
+			Recommendation:    "Review and restrict escalation verbs to only trusted administrative roles.",
 			References: []string{
 				"https://kubernetes.io/docs/reference/access-authn-authz/rbac/#privilege-escalation-prevention-and-bootstrapping",
 			},
@@ -219,19 +242,21 @@ func (v *RBACauditValidator) checkDangerousVerbs(ctx context.Context, c client.C
 }
 
 // checkSensitiveResourceAccess checks for Roles with wildcard or broad access to sensitive resources.
-func (v *RBACauditValidator) checkSensitiveResourceAccess(ctx context.Context, c client.Client) []assessmentv1alpha1.Finding {
+func (v *RBACauditValidator) checkSensitiveResourceAccess(ctx context.Context, state *clusterstate.State) []assessmentv1alpha1.Finding {
 	var findings []assessmentv1alpha1.Finding
 
-	roles := &rbacv1.RoleList{}
-	if err := c.List(ctx, roles); err != nil {
+	roles, err := state.Roles(ctx)
+	if err != nil {
 		return nil
 	}
 
 	var sensitiveAccess []string
-	for _, role := range roles.Items {
+	var sensitiveRefs []assessmentv1alpha1.AffectedResource
+	for _, role := range roles {
 		if isSystemNamespace(role.Namespace) {
 			continue
 		}
+		before := len(sensitiveAccess)
 		for _, rule := range role.Rules {
 			for _, resource := range rule.Resources {
 				if sensitiveResources[resource] || resource == "*" {
@@ -244,6 +269,9 @@ func (v *RBACauditValidator) checkSensitiveResourceAccess(ctx context.Context, c
 				}
 			}
 		}
+		if len(sensitiveAccess) > before {
+			sensitiveRefs = append(sensitiveRefs, validator.ResourceRef("Role", &role))
+		}
 	}
 
 	sensitiveAccess = unique(sensitiveAccess)
@@ -255,14 +283,15 @@ func (v *RBACauditValidator) checkSensitiveResourceAccess(ctx context.Context, c
 		}
 
 		findings = append(findings, assessmentv1alpha1.Finding{
-			ID:             "rbacaudit-sensitive-access",
-			Validator:      validatorName,
-			Category:       validatorCategory,
-			Status:         assessmentv1alpha1.FindingStatusInfo,
-			Title:          "Roles With Write Access to Sensitive Resources",
-			Description:    fmt.Sprintf("%d Role(s) grant write access to sensitive resources (secrets, exec, token): %s", len(sensitiveAccess), strings.Join(sample, "; ")),
-			Impact:         "Write access to sensitive resources can be used to extract credentials or execute arbitrary commands in pods.",
-			Recommendation: "Restrict write access to sensitive resources to only the roles that strictly require it.",
+			ID:                "rbacaudit-sensitive-access",
+			Validator:         validatorName,
+			Category:          validatorCategory,
+			Status:            assessmentv1alpha1.FindingStatusInfo,
+			Title:             "Roles With Write Access to Sensitive Resources",
+			Description:       fmt.Sprintf("%d Role(s) grant write access to sensitive resources (secrets, exec, token): %s", len(sensitiveAccess), strings.Join(sample, "; ")),
+			AffectedResources: sensitiveRefs,
+			Impact:            "Write access to sensitive resources can be used to extract credentials or execute arbitrary commands in pods.",
+			Recommendation:    "Restrict write access to sensitive resources to only the roles that strictly require it.",
 		})
 	}
 
@@ -270,22 +299,24 @@ func (v *RBACauditValidator) checkSensitiveResourceAccess(ctx context.Context, c
 }
 
 // checkBroadBindings checks for RoleBindings that bind to all ServiceAccounts in a namespace.
-func (v *RBACauditValidator) checkBroadBindings(ctx context.Context, c client.Client) []assessmentv1alpha1.Finding {
+func (v *RBACauditValidator) checkBroadBindings(ctx context.Context, state *clusterstate.State) []assessmentv1alpha1.Finding {
 	var findings []assessmentv1alpha1.Finding
 
-	rbs := &rbacv1.RoleBindingList{}
-	if err := c.List(ctx, rbs); err != nil {
+	rbs, err := state.RoleBindings(ctx)
+	if err != nil {
 		return nil
 	}
 
 	var broadBindings []string
-	for _, rb := range rbs.Items {
+	var broadRefs []assessmentv1alpha1.AffectedResource
+	for _, rb := range rbs {
 		if isSystemNamespace(rb.Namespace) {
 			continue
 		}
 		for _, subject := range rb.Subjects {
 			if subject.Kind == "Group" && subject.Name == "system:serviceaccounts" {
 				broadBindings = append(broadBindings, fmt.Sprintf("%s/%s", rb.Namespace, rb.Name))
+				broadRefs = append(broadRefs, validator.ResourceRef("RoleBinding", &rb))
 			}
 		}
 	}
@@ -297,14 +328,15 @@ func (v *RBACauditValidator) checkBroadBindings(ctx context.Context, c client.Cl
 		}
 
 		findings = append(findings, assessmentv1alpha1.Finding{
-			ID:             "rbacaudit-broad-bindings",
-			Validator:      validatorName,
-			Category:       validatorCategory,
-			Status:         assessmentv1alpha1.FindingStatusWarn,
-			Title:          "RoleBindings Granting Access to All Service Accounts",
-			Description:    fmt.Sprintf("%d RoleBinding(s) bind to the system:serviceaccounts group, granting permissions to every ServiceAccount: %s", len(broadBindings), strings.Join(sample, ", ")),
-			Impact:         "Any pod in any namespace can inherit permissions from these bindings via its service account.",
-			Recommendation: "Bind to specific ServiceAccounts instead of the broad system:serviceaccounts group.",
+			ID:                "rbacaudit-broad-bindings",
+			Validator:         validatorName,
+			Category:          validatorCategory,
+			Status:            assessmentv1alpha1.FindingStatusWarn,
+			Title:             "RoleBindings Granting Access to All Service Accounts",
+			Description:       fmt.Sprintf("%d RoleBinding(s) bind to the system:serviceaccounts group, granting permissions to every ServiceAccount: %s", len(broadBindings), strings.Join(sample, ", ")),
+			AffectedResources: broadRefs,
+			Impact:            "Any pod in any namespace can inherit permissions from these bindings via its service account.",
+			Recommendation:    "Bind to specific ServiceAccounts instead of the broad system:serviceaccounts group.",
 		})
 	}
 
@@ -0,0 +1,356 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rego
+
+import (
+	"bytes"
+	"context"
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/open-policy-agent/opa/v1/ast"
+	"github.com/open-policy-agent/opa/v1/loader"
+	opa "github.com/open-policy-agent/opa/v1/rego"
+	"github.com/open-policy-agent/opa/v1/storage"
+	"github.com/open-policy-agent/opa/v1/storage/inmem"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+
+	assessmentv1alpha1 "github.com/openshift-assessment/cluster-assessment-operator/api/v1alpha1"
+)
+
+const (
+	// DenyRule and WarnRule are the rules evaluated in each policy package,
+	// as written for Conftest. Their results are the messages of objects that
+	// fail or warn; the input is the object.
+	DenyRule = "deny"
+	WarnRule = "warn"
+
+	// ViolationRule is the rule of Gatekeeper constraint templates. Its
+	// results are the messages of objects that fail; the input is a
+	// Gatekeeper review of the object with the policy's parameters.
+	ViolationRule = "violation"
+)
+
+// rules are the rules a policy may define, in evaluation order.
+var rules = []string{DenyRule, ViolationRule, WarnRule}
+
+// policySet is a compiled set of Rego modules, with the data documents of
+// the bundles they were loaded from.
+type policySet struct {
+	compiler *ast.Compiler
+	store    storage.Store
+	policies []*policy
+}
+
+// policy is a module whose package metadata declares a finding. Modules
+// without one are libraries, available to policies through imports.
+type policy struct {
+	source   string
+	pkg      ast.Ref
+	rules    []string
+	metadata metadata
+	invalid  error
+}
+
+// metadata is read from the package's METADATA annotation: the title,
+// description and related resources of the annotation, and these fields of
+// its custom section. Parameters are passed to the violation rule, like the
+// parameters of a Gatekeeper constraint.
+type metadata struct {
+	Title       string                                 `json:"-"`
+	Description string                                 `json:"-"`
+	References  []string                               `json:"-"`
+	ID          string                                 `json:"id"`
+	Severity    assessmentv1alpha1.FindingSeverity     `json:"severity,omitempty"`
+	Category    string                                 `json:"category,omitempty"`
+	Remediation string                                 `json:"remediation,omitempty"`
+	Targets     []assessmentv1alpha1.CustomCheckTarget `json:"targets"`
+	Parameters  map[string]any                         `json:"parameters,omitempty"`
+}
+
+// sources collects the Rego modules and bundles to compile.
+type sources struct {
+	modules map[string]*ast.Module
+	data    map[string]any
+}
+
+func newSources() *sources {
+	return &sources{modules: make(map[string]*ast.Module), data: make(map[string]any)}
+}
+
+// addModule parses a single module. Modules are parsed as Rego v1 and, when
+// that fails, as v0, so policies written for older Gatekeeper and Conftest
+// releases keep working.
+func (s *sources) addModule(name string, src string) error {
+	opts := ast.ParserOptions{ProcessAnnotation: true, RegoVersion: ast.RegoV1}
+	module, err := ast.ParseModuleWithOpts(name, src, opts)
+	if err != nil {
+		opts.RegoVersion = ast.RegoV0
+		legacy, legacyErr := ast.ParseModuleWithOpts(name, src, opts)
+		if legacyErr != nil {
+			return err
+		}
+		module = legacy
+	}
+	s.modules[name] = module
+	return nil
+}
+
+// addBundle loads a bundle tarball, such as one built by "opa build" or
+// pulled from an OCI registry, or an unpacked bundle directory.
+func (s *sources) addBundle(name string, tarball []byte) error {
+	l := loader.NewFileLoader().WithProcessAnnotation(true)
+	if tarball != nil {
+		l = l.WithReader(bytes.NewReader(tarball))
+	}
+	b, err := l.AsBundle(name)
+	if err != nil {
+		return err
+	}
+	for _, m := range b.Modules {
+		s.modules[m.URL] = m.Parsed
+	}
+	return mergeData(s.data, b.Data, "data")
+}
+
+// compile compiles the modules together, so policies can import libraries
+// from other modules, and collects the policies they declare. Policies
+// cannot reach the network: http.send and DNS lookups are denied.
+func (s *sources) compile() (*policySet, error) {
+	capabilities := ast.CapabilitiesForThisVersion()
+	capabilities.AllowNet = []string{}
+
+	compiler := ast.NewCompiler().WithCapabilities(capabilities)
+	if compiler.Compile(s.modules); compiler.Failed() {
+		return nil, compiler.Errors
+	}
+
+	var policies []*policy
+	for name, module := range s.modules {
+		if p := newPolicy(name, module); p != nil {
+			policies = append(policies, p)
+		}
+	}
+	sort.Slice(policies, func(i, j int) bool {
+		if policies[i].metadata.ID != policies[j].metadata.ID {
+			return policies[i].metadata.ID < policies[j].metadata.ID
+		}
+		return policies[i].source < policies[j].source
+	})
+
+	// Policies declaring the same ID are reported once, as invalid.
+	set := &policySet{compiler: compiler, store: inmem.NewFromObject(s.data)}
+	for i := 0; i < len(policies); {
+		j := i + 1
+		for j < len(policies) && policies[j].metadata.ID == policies[i].metadata.ID {
+			j++
+		}
+		if j-i > 1 {
+			sources := make([]string, 0, j-i)
+			for _, p := range policies[i:j] {
+				sources = append(sources, p.source)
+			}
+			policies[i].invalid = fmt.Errorf("custom.id is declared by more than one module: %s", strings.Join(sources, ", "))
+		}
+		set.policies = append(set.policies, policies[i])
+		i = j
+	}
+	return set, nil
+}
+
+// newPolicy returns the policy declared by module, or nil for a library.
+// Invalid metadata is recorded on the policy so it is reported as ERROR.
+func newPolicy(source string, module *ast.Module) *policy {
+	var annotation *ast.Annotations
+	for _, a := range module.Annotations {
+		if a.Scope == "package" {
+			annotation = a
+		}
+	}
+	if annotation == nil || annotation.Custom["id"] == nil {
+		return nil
+	}
+
+	p := &policy{source: source, pkg: module.Package.Path}
+	raw, err := json.Marshal(annotation.Custom)
+	if err == nil {
+		err = json.Unmarshal(raw, &p.metadata)
+	}
+	p.metadata.Title = annotation.Title
+	p.metadata.Description = annotation.Description
+	for _, r := range annotation.RelatedResources {
+		p.metadata.References = append(p.metadata.References, r.Ref.String())
+	}
+	if !strings.HasPrefix(p.metadata.ID, IDPrefix) {
+		p.metadata.ID = IDPrefix + p.metadata.ID
+	}
+	if p.metadata.Title == "" {
+		p.metadata.Title = p.metadata.ID
+	}
+
+	for _, rule := range rules {
+		for _, r := range module.Rules {
+			if r.Head.Ref().String() == rule {
+				p.rules = append(p.rules, rule)
+				break
+			}
+		}
+	}
+
+	if err != nil {
+		p.invalid = fmt.Errorf("invalid custom metadata: %w", err)
+	} else {
+		p.invalid = p.check()
+	}
+	return p
+}
+
+// check reports the first problem with the policy's metadata or rules.
+func (p *policy) check() error {
+	if p.metadata.ID == IDPrefix {
+		return fmt.Errorf("custom.id must not be empty")
+	}
+	if len(p.metadata.Targets) == 0 {
+		return fmt.Errorf("custom.targets must list at least one apiVersion and kind")
+	}
+	for _, t := range p.metadata.Targets {
+		if t.APIVersion == "" || t.Kind == "" {
+			return fmt.Errorf("every entry of custom.targets needs an apiVersion and a kind")
+		}
+	}
+	if !validSeverity(p.metadata.Severity) {
+		return fmt.Errorf("custom.severity must be low, medium, high or critical, not %q", p.metadata.Severity)
+	}
+	if len(p.rules) == 0 {
+		return fmt.Errorf("package %s defines none of the %s rules", p.pkg, strings.Join(rules, ", "))
+	}
+	return nil
+}
+
+// prepare compiles the query of each rule the policy defines.
+func (s *policySet) prepare(ctx context.Context, p *policy) (map[string]opa.PreparedEvalQuery, error) {
+	queries := make(map[string]opa.PreparedEvalQuery, len(p.rules))
+	for _, rule := range p.rules {
+		query, err := opa.New(
+			opa.Compiler(s.compiler),
+			opa.Store(s.store),
+			opa.Query(p.pkg.Append(ast.StringTerm(rule)).String()),
+		).PrepareForEval(ctx)
+		if err != nil {
+			return nil, err
+		}
+		queries[rule] = query
+	}
+	return queries, nil
+}
+
+// evaluate runs query against a single input and returns the messages it
+// produced. A rule may be a set of strings, a set of objects with a "msg"
+// field as written for Gatekeeper and Conftest, or a boolean.
+func evaluate(ctx context.Context, query opa.PreparedEvalQuery, input map[string]any) ([]string, error) {
+	results, err := query.Eval(ctx, opa.EvalInput(input))
+	if err != nil {
+		return nil, err
+	}
+	var messages []string
+	for _, result := range results {
+		for _, expr := range result.Expressions {
+			switch value := expr.Value.(type) {
+			case []any:
+				for _, v := range value {
+					messages = append(messages, message(v))
+				}
+			case bool:
+				if value {
+					messages = append(messages, "")
+				}
+			default:
+				messages = append(messages, message(value))
+			}
+		}
+	}
+	return messages, nil
+}
+
+// input returns the input of rule for obj, of kind gvk: the object itself,
+// or for the violation rule the review Gatekeeper's audit passes to
+// constraint templates.
+func input(rule string, obj map[string]any, gvk schema.GroupVersionKind, parameters map[string]any) map[string]any {
+	if rule != ViolationRule {
+		return obj
+	}
+	u := unstructured.Unstructured{Object: obj}
+	if parameters == nil {
+		parameters = map[string]any{}
+	}
+	return map[string]any{
+		"review": map[string]any{
+			"kind":      map[string]any{"group": gvk.Group, "version": gvk.Version, "kind": gvk.Kind},
+			"name":      u.GetName(),
+			"namespace": u.GetNamespace(),
+			"object":    obj,
+		},
+		"parameters": parameters,
+	}
+}
+
+// message returns the text of a single rule result.
+func message(v any) string {
+	switch value := v.(type) {
+	case string:
+		return value
+	case map[string]any:
+		if msg, ok := value["msg"].(string); ok {
+			return msg
+		}
+	}
+	raw, _ := json.Marshal(v)
+	return string(raw)
+}
+
+// mergeData merges the data document src into dst, failing when two bundles
+// set the same value.
+func mergeData(dst, src map[string]any, path string) error {
+	for k, v := range src {
+		existing, ok := dst[k]
+		if !ok {
+			dst[k] = v
+			continue
+		}
+		a, aok := existing.(map[string]any)
+		b, bok := v.(map[string]any)
+		if !aok || !bok {
+			return fmt.Errorf("%s.%s is set by more than one bundle", path, k)
+		}
+		if err := mergeData(a, b, path+"."+k); err != nil {
+			return err
+		}
+	}
+	return nil
+}
+
+func validSeverity(s assessmentv1alpha1.FindingSeverity) bool {
+	switch s {
+	case "", assessmentv1alpha1.SeverityLow, assessmentv1alpha1.SeverityMedium,
+		assessmentv1alpha1.SeverityHigh, assessmentv1alpha1.SeverityCritical:
+		return true
+	}
+	return false
+}
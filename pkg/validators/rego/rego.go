@@ -0,0 +1,355 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package rego evaluates Rego policies, such as those maintained for
+// Gatekeeper or Conftest, against the objects their metadata targets. Each
+// policy reports a single finding: FAIL when its deny or violation rule
+// matches any object, WARN when only its warn rule does, and PASS otherwise.
+package rego
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"os"
+	"slices"
+	"strings"
+
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+
+	assessmentv1alpha1 "github.com/openshift-assessment/cluster-assessment-operator/api/v1alpha1"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/profiles"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/validator"
+)
+
+const (
+	validatorName        = "rego"
+	validatorDescription = "Evaluates Rego policies loaded from ConfigMaps and policy bundles"
+	validatorCategory    = "Policy"
+
+	// IDPrefix is prepended to the ID declared in a policy's metadata, so
+	// policy findings never collide with those of built-in validators.
+	IDPrefix = "rego-"
+
+	// PolicyLabel marks the ConfigMaps in the operator namespace that hold
+	// policies: Rego modules under keys ending in ".rego", and bundle
+	// tarballs under binary keys ending in ".tar.gz".
+	PolicyLabel = "assessment.openshift.io/rego-policy"
+
+	// BundleDirEnv names the environment variable holding the path of an
+	// unpacked policy bundle mounted into the operator pod.
+	BundleDirEnv = "REGO_BUNDLE_DIR"
+
+	// maxMessages bounds the rule messages quoted in a finding description.
+	maxMessages = 5
+)
+
+func init() {
+	_ = validator.Register(&RegoValidator{})
+}
+
+// RegoValidator evaluates the policies found in labeled ConfigMaps and in
+// the bundle directory.
+type RegoValidator struct {
+	// Namespace holds the policy ConfigMaps. Defaults to the operator namespace.
+	Namespace string
+
+	// BundleDir is an unpacked bundle to load. Defaults to $REGO_BUNDLE_DIR.
+	BundleDir string
+}
+
+func (v *RegoValidator) Name() string        { return validatorName }
+func (v *RegoValidator) Description() string { return validatorDescription }
+func (v *RegoValidator) Category() string    { return validatorCategory }
+
+// RequiredPermissions returns the API access this validator needs. Reading the
+// kinds targeted by policies needs further grants, which depend on the
+// policies; a policy whose kind cannot be listed reports ERROR.
+func (v *RegoValidator) RequiredPermissions() []validator.Permission {
+	return []validator.Permission{
+		{Verb: "list", Group: "", Resource: "configmaps"},
+	}
+}
+
+// Validate compiles every policy module together and evaluates each policy,
+// in ID order. A module that does not parse or compile fails the validator,
+// as the policies importing it could not be evaluated faithfully.
+func (v *RegoValidator) Validate(ctx context.Context, c client.Client, profile profiles.Profile) ([]assessmentv1alpha1.Finding, error) {
+	src, err := v.load(ctx, c)
+	if err != nil {
+		return nil, err
+	}
+	if len(src.modules) == 0 {
+		return nil, nil
+	}
+	set, err := src.compile()
+	if err != nil {
+		return nil, fmt.Errorf("failed to compile Rego policies: %w", err)
+	}
+
+	findings := make([]assessmentv1alpha1.Finding, 0, len(set.policies))
+	for _, p := range set.policies {
+		findings = append(findings, evaluatePolicy(ctx, c, set, p))
+	}
+	return findings, nil
+}
+
+// load reads the modules and bundles of the policy ConfigMaps and the bundle
+// directory.
+func (v *RegoValidator) load(ctx context.Context, c client.Client) (*sources, error) {
+	namespace := v.Namespace
+	if namespace == "" {
+		namespace = os.Getenv("OPERATOR_NAMESPACE")
+	}
+	if namespace == "" {
+		namespace = "cluster-assessment-operator"
+	}
+
+	list := &corev1.ConfigMapList{}
+	if err := c.List(ctx, list, client.InNamespace(namespace), client.MatchingLabels{PolicyLabel: "true"}); err != nil {
+		return nil, fmt.Errorf("failed to list policy ConfigMaps: %w", err)
+	}
+
+	src := newSources()
+	for _, cm := range list.Items {
+		for _, key := range slices.Sorted(maps.Keys(cm.Data)) {
+			if !strings.HasSuffix(key, ".rego") {
+				continue
+			}
+			if err := src.addModule(cm.Name+"/"+key, cm.Data[key]); err != nil {
+				return nil, fmt.Errorf("failed to parse policy %s/%s: %w", cm.Name, key, err)
+			}
+		}
+		for _, key := range slices.Sorted(maps.Keys(cm.BinaryData)) {
+			if !strings.HasSuffix(key, ".tar.gz") {
+				continue
+			}
+			if err := src.addBundle(cm.Name+"/"+key, cm.BinaryData[key]); err != nil {
+				return nil, fmt.Errorf("failed to load policy bundle %s/%s: %w", cm.Name, key, err)
+			}
+		}
+	}
+
+	dir := v.BundleDir
+	if dir == "" {
+		dir = os.Getenv(BundleDirEnv)
+	}
+	if dir != "" {
+		if err := src.addBundle(dir, nil); err != nil {
+			return nil, fmt.Errorf("failed to load policy bundle %s: %w", dir, err)
+		}
+	}
+	return src, nil
+}
+
+// ruleResult collects the objects matched by one rule of a policy.
+type ruleResult struct {
+	resources []assessmentv1alpha1.AffectedResource
+	messages  []string
+}
+
+// evaluatePolicy lists the objects targeted by p and evaluates its rules
+// against each of them.
+func evaluatePolicy(ctx context.Context, c client.Client, set *policySet, p *policy) assessmentv1alpha1.Finding {
+	meta := p.metadata
+	if p.invalid != nil {
+		return inconclusive(p, fmt.Sprintf("Rego policy %s in %s is invalid: %v.", meta.ID, p.source, p.invalid))
+	}
+	queries, err := set.prepare(ctx, p)
+	if err != nil {
+		return inconclusive(p, fmt.Sprintf("Rego policy %s in %s could not be prepared: %v.", meta.ID, p.source, err))
+	}
+
+	// Violations fail objects like denials
+	results := map[string]*ruleResult{DenyRule: {}, WarnRule: {}}
+	results[ViolationRule] = results[DenyRule]
+
+	var evalErrors []string
+	var matched, served int
+	for _, target := range meta.Targets {
+		list, err := listTargets(ctx, c, target)
+		if err != nil {
+			if validator.IsNotInstalled(err) {
+				continue
+			}
+			return inconclusive(p, fmt.Sprintf("Failed to list %s objects for Rego policy %s: %v.", target.Kind, meta.ID, err))
+		}
+		served++
+		matched += len(list.Items)
+		gvk := schema.FromAPIVersionAndKind(target.APIVersion, target.Kind)
+
+		for i := range list.Items {
+			obj := &list.Items[i]
+			matches := make(map[*ruleResult][]string)
+			for _, rule := range p.rules {
+				messages, err := evaluate(ctx, queries[rule], input(rule, obj.Object, gvk, meta.Parameters))
+				if err != nil {
+					evalErrors = append(evalErrors, fmt.Sprintf("%s: %v", objectName(obj), err))
+					break
+				}
+				if len(messages) > 0 {
+					matches[results[rule]] = append(matches[results[rule]], messages...)
+				}
+			}
+			for r, messages := range matches {
+				r.resources = append(r.resources, validator.ResourceRef(target.Kind, obj))
+				for _, m := range messages {
+					r.messages = append(r.messages, joinMessage(objectName(obj), m))
+				}
+			}
+		}
+	}
+
+	if served == 0 {
+		f := newFinding(p, assessmentv1alpha1.FindingStatusNotApplicable)
+		f.Description = fmt.Sprintf("The cluster serves none of the kinds targeted by Rego policy %s, so it has nothing to evaluate.", meta.ID)
+		f.Recommendation = ""
+		return f
+	}
+
+	deny, warn := results[DenyRule], results[WarnRule]
+	if len(deny.resources) == 0 && len(warn.resources) == 0 && len(evalErrors) > 0 {
+		return inconclusive(p, fmt.Sprintf("Rego policy %s could not be evaluated for %d objects: %s.",
+			meta.ID, len(evalErrors), summarize(evalErrors)))
+	}
+
+	var f assessmentv1alpha1.Finding
+	var summary string
+	switch {
+	case len(deny.resources) > 0:
+		f = newFinding(p, assessmentv1alpha1.FindingStatusFail)
+		f.AffectedResources = deny.resources
+		summary = fmt.Sprintf("%d of %d matching objects are denied by the policy: %s.", len(deny.resources), matched, summarize(deny.messages))
+		if len(warn.resources) > 0 {
+			summary += fmt.Sprintf(" %d objects trigger warnings.", len(warn.resources))
+		}
+	case len(warn.resources) > 0:
+		f = newFinding(p, assessmentv1alpha1.FindingStatusWarn)
+		f.AffectedResources = warn.resources
+		summary = fmt.Sprintf("%d of %d matching objects trigger warnings: %s.", len(warn.resources), matched, summarize(warn.messages))
+	default:
+		f = newFinding(p, assessmentv1alpha1.FindingStatusPass)
+		f.Description = joinSentences(meta.Description, fmt.Sprintf("All %d matching objects satisfy the policy.", matched))
+		f.Recommendation = ""
+		return f
+	}
+	if len(evalErrors) > 0 {
+		summary += fmt.Sprintf(" The policy could not be evaluated for %d other objects.", len(evalErrors))
+	}
+	f.Description = joinSentences(meta.Description, summary)
+	return f
+}
+
+// listTargets lists the objects of target, restricted to its namespace and
+// label selector.
+func listTargets(ctx context.Context, c client.Client, target assessmentv1alpha1.CustomCheckTarget) (*unstructured.UnstructuredList, error) {
+	gv, err := schema.ParseGroupVersion(target.APIVersion)
+	if err != nil {
+		return nil, err
+	}
+	var opts []client.ListOption
+	if target.Namespace != "" {
+		opts = append(opts, client.InNamespace(target.Namespace))
+	}
+	if target.LabelSelector != nil {
+		selector, err := metav1.LabelSelectorAsSelector(target.LabelSelector)
+		if err != nil {
+			return nil, fmt.Errorf("invalid label selector: %w", err)
+		}
+		opts = append(opts, client.MatchingLabelsSelector{Selector: selector})
+	}
+
+	list := &unstructured.UnstructuredList{}
+	list.SetGroupVersionKind(gv.WithKind(target.Kind + "List"))
+	if err := c.List(ctx, list, opts...); err != nil {
+		return nil, err
+	}
+	return list, nil
+}
+
+// newFinding builds the finding for p with the fields its metadata declares.
+func newFinding(p *policy, status assessmentv1alpha1.FindingStatus) assessmentv1alpha1.Finding {
+	meta := p.metadata
+
+	category := meta.Category
+	if category == "" {
+		category = validatorCategory
+	}
+	severity := meta.Severity
+	if severity == "" || status == assessmentv1alpha1.FindingStatusError || status == assessmentv1alpha1.FindingStatusNotApplicable {
+		severity = validator.DefaultSeverity(status)
+	}
+
+	return assessmentv1alpha1.Finding{
+		ID:             meta.ID,
+		Validator:      validatorName,
+		Category:       category,
+		Status:         status,
+		Severity:       severity,
+		Title:          meta.Title,
+		Description:    meta.Description,
+		Recommendation: meta.Remediation,
+		References:     meta.References,
+	}
+}
+
+// inconclusive builds the ERROR finding for a policy that could not be evaluated.
+func inconclusive(p *policy, description string) assessmentv1alpha1.Finding {
+	f := newFinding(p, assessmentv1alpha1.FindingStatusError)
+	f.Description = description
+	f.Recommendation = fmt.Sprintf("Fix the policy in %s, or grant the operator permission to list its target kinds.", p.source)
+	return f
+}
+
+// objectName returns the namespace/name of obj.
+func objectName(obj *unstructured.Unstructured) string {
+	if obj.GetNamespace() == "" {
+		return obj.GetName()
+	}
+	return obj.GetNamespace() + "/" + obj.GetName()
+}
+
+// joinMessage prefixes a rule message with the object it is about.
+func joinMessage(object, message string) string {
+	message = strings.TrimSuffix(strings.TrimSpace(message), ".")
+	if message == "" {
+		return object
+	}
+	return object + ": " + message
+}
+
+// summarize joins the first few messages, counting the rest.
+func summarize(messages []string) string {
+	if len(messages) <= maxMessages {
+		return strings.Join(messages, "; ")
+	}
+	return fmt.Sprintf("%s; and %d more", strings.Join(messages[:maxMessages], "; "), len(messages)-maxMessages)
+}
+
+// joinSentences joins the non-empty sentences with a space.
+func joinSentences(sentences ...string) string {
+	var parts []string
+	for _, s := range sentences {
+		if s = strings.TrimSpace(s); s != "" {
+			parts = append(parts, s)
+		}
+	}
+	return strings.Join(parts, " ")
+}
@@ -0,0 +1,411 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package rego
+
+import (
+	"archive/tar"
+	"bytes"
+	"compress/gzip"
+	"context"
+	"os"
+	"path/filepath"
+	"slices"
+	"strings"
+	"testing"
+
+	corev1 "k8s.io/api/core/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/api/meta"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/apis/meta/v1/unstructured"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	clientgoscheme "k8s.io/client-go/kubernetes/scheme"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+
+	assessmentv1alpha1 "github.com/openshift-assessment/cluster-assessment-operator/api/v1alpha1"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/profiles"
+)
+
+const testNamespace = "cluster-assessment-operator"
+
+const costCenterPolicy = `# METADATA
+# title: Namespaces Without Cost Center
+# description: Every namespace must be labeled with its cost center.
+# related_resources:
+#   - https://example.com/policies/cost-center
+# custom:
+#   id: cost-center
+#   severity: high
+#   remediation: Add a cost-center label.
+#   targets:
+#     - apiVersion: v1
+#       kind: Namespace
+package assessment.costcenter
+
+deny contains msg if {
+	not input.metadata.labels["cost-center"]
+	msg := sprintf("namespace %s has no cost-center label.", [input.metadata.name])
+}
+
+warn contains msg if {
+	not input.metadata.labels.owner
+	msg := "no owner label"
+}
+`
+
+// legacyPolicy is written in Rego v0, as for older Gatekeeper releases, and
+// uses a library module without metadata.
+const legacyPolicy = `# METADATA
+# title: Unowned Namespaces
+# custom:
+#   id: rego-owner
+#   targets:
+#     - apiVersion: v1
+#       kind: Namespace
+package assessment.owner
+
+import data.lib.labels
+
+warn[{"msg": msg}] {
+	not labels.has(input, "owner")
+	msg := "no owner label"
+}
+`
+
+// requiredLabelsTemplate is the Rego of the k8srequiredlabels constraint
+// template of the Gatekeeper policy library, with the metadata declaring its
+// targets and the parameters of its constraint.
+const requiredLabelsTemplate = `# METADATA
+# title: Required Labels
+# custom:
+#   id: required-labels
+#   targets:
+#     - apiVersion: v1
+#       kind: Namespace
+#   parameters:
+#     labels:
+#       - key: owner
+#       - key: env
+#         allowedRegex: "^(prod|dev)$"
+package k8srequiredlabels
+
+get_message(parameters, _default) := _default {
+  not parameters.message
+}
+
+get_message(parameters, _) := parameters.message
+
+violation[{"msg": msg, "details": {"missing_labels": missing}}] {
+  provided := {label | input.review.object.metadata.labels[label]}
+  required := {label | label := input.parameters.labels[_].key}
+  missing := required - provided
+  count(missing) > 0
+  def_msg := sprintf("you must provide labels: %v", [missing])
+  msg := get_message(input.parameters, def_msg)
+}
+
+violation[{"msg": msg}] {
+  value := input.review.object.metadata.labels[key]
+  expected := input.parameters.labels[_]
+  expected.key == key
+  # do not match if allowedRegex is not defined, or is an empty string
+  expected.allowedRegex != ""
+  not regex.match(expected.allowedRegex, value)
+  def_msg := sprintf("Label <%v: %v> does not satisfy allowed regex: %v", [key, value, expected.allowedRegex])
+  msg := get_message(input.parameters, def_msg)
+}
+`
+
+const labelsLibrary = `package lib.labels
+
+has(obj, key) if obj.metadata.labels[key]
+`
+
+func policyConfigMap(name string, modules map[string]string) *corev1.ConfigMap {
+	return &corev1.ConfigMap{
+		ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: testNamespace, Labels: map[string]string{PolicyLabel: "true"}},
+		Data:       modules,
+	}
+}
+
+func namespace(name string, labels map[string]string) *corev1.Namespace {
+	return &corev1.Namespace{ObjectMeta: metav1.ObjectMeta{Name: name, Labels: labels}}
+}
+
+func validate(t *testing.T, v *RegoValidator, c client.Client) []assessmentv1alpha1.Finding {
+	t.Helper()
+	findings, err := v.Validate(context.Background(), c, profiles.GetProfile("production"))
+	if err != nil {
+		t.Fatalf("Validate returned error: %v", err)
+	}
+	return findings
+}
+
+func TestValidate_DenyAndWarn(t *testing.T) {
+	c := fake.NewClientBuilder().WithScheme(clientgoscheme.Scheme).WithObjects(
+		policyConfigMap("policies", map[string]string{"costcenter.rego": costCenterPolicy, "README": "not a module"}),
+		namespace("labelled", map[string]string{"cost-center": "42", "owner": "a"}),
+		namespace("unlabelled", map[string]string{"owner": "a"}),
+		namespace("unowned", map[string]string{"cost-center": "42"}),
+	).Build()
+
+	findings := validate(t, &RegoValidator{Namespace: testNamespace}, c)
+	if len(findings) != 1 {
+		t.Fatalf("Expected 1 finding, got %+v", findings)
+	}
+	f := findings[0]
+	if f.ID != "rego-cost-center" || f.Status != assessmentv1alpha1.FindingStatusFail || f.Category != "Policy" {
+		t.Errorf("Unexpected finding %+v", f)
+	}
+	if f.Title != "Namespaces Without Cost Center" || f.Severity != assessmentv1alpha1.SeverityHigh || f.Recommendation != "Add a cost-center label." {
+		t.Errorf("Expected the finding to carry the policy metadata, got %+v", f)
+	}
+	if len(f.References) != 1 || f.References[0] != "https://example.com/policies/cost-center" {
+		t.Errorf("Expected the related resources as references, got %v", f.References)
+	}
+	if len(f.AffectedResources) != 1 || f.AffectedResources[0].Name != "unlabelled" || f.AffectedResources[0].Kind != "Namespace" {
+		t.Errorf("Expected only the unlabelled namespace to be affected, got %+v", f.AffectedResources)
+	}
+	for _, want := range []string{"1 of 3", "unlabelled: namespace unlabelled has no cost-center label", "1 objects trigger warnings"} {
+		if !strings.Contains(f.Description, want) {
+			t.Errorf("Expected the description to contain %q, got %q", want, f.Description)
+		}
+	}
+}
+
+func TestValidate_LegacyPolicyWithLibrary(t *testing.T) {
+	c := fake.NewClientBuilder().WithScheme(clientgoscheme.Scheme).WithObjects(
+		policyConfigMap("policies", map[string]string{"owner.rego": legacyPolicy}),
+		policyConfigMap("libraries", map[string]string{"labels.rego": labelsLibrary}),
+		namespace("owned", map[string]string{"owner": "a"}),
+		namespace("unowned", nil),
+	).Build()
+
+	findings := validate(t, &RegoValidator{Namespace: testNamespace}, c)
+	if len(findings) != 1 {
+		t.Fatalf("Expected only the policy to report a finding, got %+v", findings)
+	}
+	f := findings[0]
+	if f.ID != "rego-owner" || f.Status != assessmentv1alpha1.FindingStatusWarn || f.Severity != assessmentv1alpha1.SeverityMedium {
+		t.Errorf("Unexpected finding %+v", f)
+	}
+	if len(f.AffectedResources) != 1 || f.AffectedResources[0].Name != "unowned" {
+		t.Errorf("Expected only the unowned namespace to be affected, got %+v", f.AffectedResources)
+	}
+	if !strings.Contains(f.Description, "unowned: no owner label") {
+		t.Errorf("Expected the description to quote the message, got %q", f.Description)
+	}
+}
+
+func TestValidate_GatekeeperTemplate(t *testing.T) {
+	c := fake.NewClientBuilder().WithScheme(clientgoscheme.Scheme).WithObjects(
+		policyConfigMap("policies", map[string]string{"k8srequiredlabels.rego": requiredLabelsTemplate}),
+		namespace("labelled", map[string]string{"owner": "a", "env": "prod"}),
+		namespace("unowned", map[string]string{"env": "dev"}),
+		namespace("staging", map[string]string{"owner": "a", "env": "staging"}),
+	).Build()
+
+	findings := validate(t, &RegoValidator{Namespace: testNamespace}, c)
+	if len(findings) != 1 {
+		t.Fatalf("Expected 1 finding, got %+v", findings)
+	}
+	f := findings[0]
+	if f.ID != "rego-required-labels" || f.Status != assessmentv1alpha1.FindingStatusFail {
+		t.Fatalf("Expected the violations to fail the policy, got %+v", f)
+	}
+	var affected []string
+	for _, r := range f.AffectedResources {
+		affected = append(affected, r.Name)
+	}
+	if len(affected) != 2 || !slices.Contains(affected, "unowned") || !slices.Contains(affected, "staging") {
+		t.Errorf("Expected the unowned and staging namespaces to be affected, got %v", affected)
+	}
+	for _, want := range []string{
+		"2 of 3",
+		`unowned: you must provide labels: {"owner"}`,
+		"staging: Label <env: staging> does not satisfy allowed regex: ^(prod|dev)$",
+	} {
+		if !strings.Contains(f.Description, want) {
+			t.Errorf("Expected the description to contain %q, got %q", want, f.Description)
+		}
+	}
+}
+
+func TestValidate_DuplicateIDs(t *testing.T) {
+	c := fake.NewClientBuilder().WithScheme(clientgoscheme.Scheme).WithObjects(
+		policyConfigMap("team-a", map[string]string{"costcenter.rego": costCenterPolicy}),
+		policyConfigMap("team-b", map[string]string{"costcenter.rego": strings.Replace(costCenterPolicy,
+			"package assessment.costcenter", "package teamb.costcenter", 1)}),
+		namespace("labelled", map[string]string{"cost-center": "42", "owner": "a"}),
+	).Build()
+
+	findings := validate(t, &RegoValidator{Namespace: testNamespace}, c)
+	if len(findings) != 1 {
+		t.Fatalf("Expected one finding for the duplicated ID, got %+v", findings)
+	}
+	f := findings[0]
+	if f.ID != "rego-cost-center" || f.Status != assessmentv1alpha1.FindingStatusError {
+		t.Errorf("Expected the duplicated ID to be reported as ERROR, got %+v", f)
+	}
+	if !strings.Contains(f.Description, "team-a/costcenter.rego, team-b/costcenter.rego") {
+		t.Errorf("Expected the description to name both modules, got %q", f.Description)
+	}
+}
+
+func TestValidate_Pass(t *testing.T) {
+	c := fake.NewClientBuilder().WithScheme(clientgoscheme.Scheme).WithObjects(
+		policyConfigMap("policies", map[string]string{"costcenter.rego": costCenterPolicy}),
+		namespace("labelled", map[string]string{"cost-center": "42", "owner": "a"}),
+	).Build()
+
+	findings := validate(t, &RegoValidator{Namespace: testNamespace}, c)
+	if len(findings) != 1 || findings[0].Status != assessmentv1alpha1.FindingStatusPass {
+		t.Fatalf("Expected a single PASS finding, got %+v", findings)
+	}
+	if findings[0].Recommendation != "" || len(findings[0].AffectedResources) != 0 {
+		t.Errorf("Expected a PASS finding without recommendation or resources, got %+v", findings[0])
+	}
+}
+
+func TestValidate_InconclusiveAndNotApplicable(t *testing.T) {
+	policy := func(id, apiVersion, kind string) string {
+		return strings.NewReplacer("cost-center\n", id+"\n", "apiVersion: v1", "apiVersion: "+apiVersion, "kind: Namespace", "kind: "+kind,
+			"package assessment.costcenter", "package assessment."+strings.ReplaceAll(id, "-", "")).Replace(costCenterPolicy)
+	}
+	c := fake.NewClientBuilder().WithScheme(clientgoscheme.Scheme).WithObjects(
+		policyConfigMap("policies", map[string]string{
+			"a.rego": policy("a-routes", "route.openshift.io/v1", "Route"),
+			"b.rego": policy("b-secrets", "v1", "Secret"),
+			"c.rego": "# METADATA\n# custom:\n#   id: c-no-targets\npackage assessment.notargets\n\ndeny contains \"x\" if false\n",
+			"d.rego": "# METADATA\n# custom:\n#   id: d-no-rules\n#   targets:\n#     - apiVersion: v1\n#       kind: Namespace\npackage assessment.norules\n\nallow := true\n",
+		}),
+		namespace("default", nil),
+	).WithInterceptorFuncs(interceptor.Funcs{
+		List: func(ctx context.Context, c client.WithWatch, list client.ObjectList, opts ...client.ListOption) error {
+			if u, ok := list.(*unstructured.UnstructuredList); ok {
+				switch u.GetKind() {
+				case "RouteList":
+					return &meta.NoKindMatchError{GroupKind: schema.GroupKind{Group: "route.openshift.io", Kind: "Route"}}
+				case "SecretList":
+					return apierrors.NewForbidden(schema.GroupResource{Resource: "secrets"}, "", nil)
+				}
+			}
+			return c.List(ctx, list, opts...)
+		},
+	}).Build()
+
+	findings := validate(t, &RegoValidator{Namespace: testNamespace}, c)
+	want := []struct {
+		id     string
+		status assessmentv1alpha1.FindingStatus
+	}{
+		{"rego-a-routes", assessmentv1alpha1.FindingStatusNotApplicable},
+		{"rego-b-secrets", assessmentv1alpha1.FindingStatusError},
+		{"rego-c-no-targets", assessmentv1alpha1.FindingStatusError},
+		{"rego-d-no-rules", assessmentv1alpha1.FindingStatusError},
+	}
+	if len(findings) != len(want) {
+		t.Fatalf("Expected %d findings, got %+v", len(want), findings)
+	}
+	for i, w := range want {
+		if findings[i].ID != w.id || findings[i].Status != w.status {
+			t.Errorf("Expected %s to be %s, got %s %s: %s", w.id, w.status, findings[i].ID, findings[i].Status, findings[i].Description)
+		}
+	}
+}
+
+func TestValidate_InvalidModule(t *testing.T) {
+	c := fake.NewClientBuilder().WithScheme(clientgoscheme.Scheme).WithObjects(
+		policyConfigMap("policies", map[string]string{"broken.rego": "package broken\n\ndeny contains msg if {"}),
+	).Build()
+
+	_, err := (&RegoValidator{Namespace: testNamespace}).Validate(context.Background(), c, profiles.GetProfile("production"))
+	if err == nil || !strings.Contains(err.Error(), "policies/broken.rego") {
+		t.Errorf("Expected the parse error to name the module, got %v", err)
+	}
+}
+
+func TestValidate_Bundles(t *testing.T) {
+	bundlePolicy := strings.NewReplacer(
+		"id: cost-center", "id: bundle-cost-center",
+		"package assessment.costcenter", "package assessment.bundle",
+		`not input.metadata.labels["cost-center"]`, `not input.metadata.labels["cost-center"] in data.costcenters`,
+	).Replace(costCenterPolicy)
+
+	dir := t.TempDir()
+	if err := os.WriteFile(filepath.Join(dir, "policy.rego"), []byte(bundlePolicy), 0o600); err != nil {
+		t.Fatal(err)
+	}
+	if err := os.WriteFile(filepath.Join(dir, "data.json"), []byte(`{"costcenters": ["42"]}`), 0o600); err != nil {
+		t.Fatal(err)
+	}
+
+	tarball := policyConfigMap("bundle", nil)
+	tarball.BinaryData = map[string][]byte{"bundle.tar.gz": tarGz(t, map[string]string{"/costcenter.rego": costCenterPolicy})}
+
+	c := fake.NewClientBuilder().WithScheme(clientgoscheme.Scheme).WithObjects(
+		tarball,
+		namespace("known", map[string]string{"cost-center": "42", "owner": "a"}),
+		namespace("unknown", map[string]string{"cost-center": "7", "owner": "a"}),
+	).Build()
+
+	findings := validate(t, &RegoValidator{Namespace: testNamespace, BundleDir: dir}, c)
+	if len(findings) != 2 {
+		t.Fatalf("Expected a finding per bundle policy, got %+v", findings)
+	}
+	if f := findings[0]; f.ID != "rego-bundle-cost-center" || f.Status != assessmentv1alpha1.FindingStatusFail ||
+		len(f.AffectedResources) != 1 || f.AffectedResources[0].Name != "unknown" {
+		t.Errorf("Expected the directory bundle to deny the unknown cost center, got %+v", f)
+	}
+	if f := findings[1]; f.ID != "rego-cost-center" || f.Status != assessmentv1alpha1.FindingStatusPass {
+		t.Errorf("Expected the tarball bundle policy to pass, got %+v", f)
+	}
+}
+
+func TestValidate_NoPolicies(t *testing.T) {
+	c := fake.NewClientBuilder().WithScheme(clientgoscheme.Scheme).WithObjects(
+		&corev1.ConfigMap{ObjectMeta: metav1.ObjectMeta{Name: "unlabelled", Namespace: testNamespace}, Data: map[string]string{"p.rego": costCenterPolicy}},
+	).Build()
+	if findings := validate(t, &RegoValidator{Namespace: testNamespace}, c); len(findings) != 0 {
+		t.Errorf("Expected no findings without policy ConfigMaps, got %+v", findings)
+	}
+}
+
+// tarGz builds a bundle tarball holding files.
+func tarGz(t *testing.T, files map[string]string) []byte {
+	t.Helper()
+	var buf bytes.Buffer
+	gz := gzip.NewWriter(&buf)
+	tw := tar.NewWriter(gz)
+	for name, content := range files {
+		if err := tw.WriteHeader(&tar.Header{Name: name, Mode: 0o600, Size: int64(len(content))}); err != nil {
+			t.Fatal(err)
+		}
+		if _, err := tw.Write([]byte(content)); err != nil {
+			t.Fatal(err)
+		}
+	}
+	if err := tw.Close(); err != nil {
+		t.Fatal(err)
+	}
+	if err := gz.Close(); err != nil {
+		t.Fatal(err)
+	}
+	return buf.Bytes()
+}
@@ -23,11 +23,10 @@ import (
 
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/resource"
-	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
-	"k8s.io/apimachinery/pkg/runtime/schema"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	assessmentv1alpha1 "github.com/openshift-assessment/cluster-assessment-operator/api/v1alpha1"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/clusterstate"
 	"github.com/openshift-assessment/cluster-assessment-operator/pkg/profiles"
 	"github.com/openshift-assessment/cluster-assessment-operator/pkg/validator"
 )
@@ -41,6 +40,14 @@ const (
 	validatorCategory    = "Governance"
 )
 
+// paramNearLimitPercent is the utilization at which a quota is reported as near its limit.
+var paramNearLimitPercent = validator.Parameter{
+	Name:        "nearLimitPercent",
+	Type:        validator.ParameterPercent,
+	Default:     "80",
+	Description: "Quota utilization percentage at or above which a ResourceQuota is reported as near its limit.",
+}
+
 func init() {
 	_ = validator.Register(&ResourceQuotasValidator{})
 }
@@ -48,6 +55,11 @@ func init() {
 // ResourceQuotasValidator checks resource quota and limit range configuration.
 type ResourceQuotasValidator struct{}
 
+// Parameters returns the parameters this validator accepts.
+func (v *ResourceQuotasValidator) Parameters() []validator.Parameter {
+	return []validator.Parameter{paramNearLimitPercent}
+}
+
 // Name returns the validator name.
 func (v *ResourceQuotasValidator) Name() string {
 	return validatorName
@@ -63,31 +75,34 @@ func (v *ResourceQuotasValidator) Category() string {
 	return validatorCategory
 }
 
+// RequiredPermissions returns the API access this validator needs.
+func (v *ResourceQuotasValidator) RequiredPermissions() []validator.Permission {
+	return []validator.Permission{
+		{Verb: "list", Resource: "namespaces"},
+		{Verb: "list", Resource: "resourcequotas"},
+		{Verb: "list", Resource: "limitranges"},
+	}
+}
+
 // Validate performs resource governance checks.
 func (v *ResourceQuotasValidator) Validate(ctx context.Context, c client.Client, profile profiles.Profile) ([]assessmentv1alpha1.Finding, error) {
 	var findings []assessmentv1alpha1.Finding
 
-	// Optimized: List namespaces once using PartialObjectMetadataList
-	nsList := &metav1.PartialObjectMetadataList{}
-	nsList.SetGroupVersionKind(schema.GroupVersionKind{
-		Group:   "",
-		Version: "v1",
-		Kind:    "NamespaceList",
-	})
-
-	if err := c.List(ctx, nsList); err != nil {
+	// Namespaces come from the run's shared cluster state, listed once for all validators
+	namespaces, err := clusterstate.FromContext(ctx, c).Namespaces(ctx)
+	if err != nil {
 		return []assessmentv1alpha1.Finding{{
 			ID:          "resourcequotas-ns-error",
 			Validator:   validatorName,
 			Category:    validatorCategory,
-			Status:      assessmentv1alpha1.FindingStatusFail,
+			Status:      assessmentv1alpha1.FindingStatusError,
 			Title:       "Unable to Check Namespaces",
 			Description: fmt.Sprintf("Failed to list namespaces: %v", err),
 		}}, nil
 	}
 
 	var userNamespaces []string
-	for _, ns := range nsList.Items {
+	for _, ns := range namespaces {
 		// Skip system namespaces
 		if strings.HasPrefix(ns.Name, "openshift-") || strings.HasPrefix(ns.Name, "kube-") || ns.Name == "default" {
 			continue
@@ -115,7 +130,7 @@ func (v *ResourceQuotasValidator) checkResourceQuotas(ctx context.Context, c cli
 			ID:          "resourcequotas-list-error",
 			Validator:   validatorName,
 			Category:    validatorCategory,
-			Status:      assessmentv1alpha1.FindingStatusFail,
+			Status:      assessmentv1alpha1.FindingStatusError,
 			Title:       "Unable to Check ResourceQuotas",
 			Description: fmt.Sprintf("Failed to list ResourceQuotas: %v", err),
 		}}
@@ -129,14 +144,18 @@ func (v *ResourceQuotasValidator) checkResourceQuotas(ctx context.Context, c cli
 
 	var userNamespacesWithoutQuota []string
 	var nearLimitQuotas []string
+	var noQuotaRefs, nearLimitRefs []assessmentv1alpha1.AffectedResource
+	nearLimit := validator.IntParameter(profile, validatorName, paramNearLimitPercent)
 
 	for _, nsName := range userNamespaces {
 		quotasInNs, hasQuota := nsWithQuota[nsName]
 		if !hasQuota {
 			userNamespacesWithoutQuota = append(userNamespacesWithoutQuota, nsName)
+			noQuotaRefs = append(noQuotaRefs, assessmentv1alpha1.AffectedResource{Kind: "Namespace", Name: nsName})
 		} else {
 			// Check quota utilization
 			for _, quota := range quotasInNs {
+				before := len(nearLimitQuotas)
 				for resourceName, hard := range quota.Status.Hard {
 					used, ok := quota.Status.Used[resourceName]
 					if !ok {
@@ -146,12 +165,15 @@ func (v *ResourceQuotasValidator) checkResourceQuotas(ctx context.Context, c cli
 					// Calculate utilization percentage
 					if hard.Value() > 0 {
 						utilization := float64(used.Value()) / float64(hard.Value()) * 100
-						if utilization >= 80 {
+						if utilization >= float64(nearLimit) {
 							nearLimitQuotas = append(nearLimitQuotas,
 								fmt.Sprintf("%s/%s (%s: %.0f%%)", nsName, quota.Name, resourceName, utilization))
 						}
 					}
 				}
+				if len(nearLimitQuotas) > before {
+					nearLimitRefs = append(nearLimitRefs, validator.ResourceRef("ResourceQuota", &quota))
+				}
 			}
 		}
 	}
@@ -170,14 +192,15 @@ func (v *ResourceQuotasValidator) checkResourceQuotas(ctx context.Context, c cli
 		}
 
 		findings = append(findings, assessmentv1alpha1.Finding{
-			ID:             "resourcequotas-coverage",
-			Validator:      validatorName,
-			Category:       validatorCategory,
-			Status:         status,
-			Title:          "Namespaces Without ResourceQuotas",
-			Description:    fmt.Sprintf("%d of %d user namespace(s) have no ResourceQuota: %s...", len(userNamespacesWithoutQuota), totalUserNs, strings.Join(sample, ", ")),
-			Impact:         "Namespaces without quotas can consume unbounded cluster resources.",
-			Recommendation: "Define ResourceQuotas for user namespaces to prevent resource exhaustion.",
+			ID:                "resourcequotas-coverage",
+			Validator:         validatorName,
+			Category:          validatorCategory,
+			Status:            status,
+			Title:             "Namespaces Without ResourceQuotas",
+			Description:       fmt.Sprintf("%d of %d user namespace(s) have no ResourceQuota: %s...", len(userNamespacesWithoutQuota), totalUserNs, strings.Join(sample, ", ")),
+			AffectedResources: noQuotaRefs,
+			Impact:            "Namespaces without quotas can consume unbounded cluster resources.",
+			Recommendation:    "Define ResourceQuotas for user namespaces to prevent resource exhaustion.",
 			References: []string{
 				"https://kubernetes.io/docs/concepts/policy/resource-quotas/",
 			},
@@ -210,14 +233,15 @@ func (v *ResourceQuotasValidator) checkResourceQuotas(ctx context.Context, c cli
 		}
 
 		findings = append(findings, assessmentv1alpha1.Finding{
-			ID:             "resourcequotas-near-limit",
-			Validator:      validatorName,
-			Category:       validatorCategory,
-			Status:         assessmentv1alpha1.FindingStatusWarn,
-			Title:          "ResourceQuotas Near Limit",
-			Description:    fmt.Sprintf("%d ResourceQuota(s) are at or above 80%% utilization: %s", len(nearLimitQuotas), strings.Join(sample, ", ")),
-			Impact:         "Workloads may be unable to scale or deploy new pods.",
-			Recommendation: "Review and increase quota limits or optimize resource usage.",
+			ID:                "resourcequotas-near-limit",
+			Validator:         validatorName,
+			Category:          validatorCategory,
+			Status:            assessmentv1alpha1.FindingStatusWarn,
+			Title:             "ResourceQuotas Near Limit",
+			Description:       fmt.Sprintf("%d ResourceQuota(s) are at or above %d%% utilization: %s", len(nearLimitQuotas), nearLimit, strings.Join(sample, ", ")),
+			AffectedResources: nearLimitRefs,
+			Impact:            "Workloads may be unable to scale or deploy new pods.",
+			Recommendation:    "Review and increase quota limits or optimize resource usage.",
 		})
 	}
 
@@ -242,10 +266,12 @@ func (v *ResourceQuotasValidator) checkLimitRanges(ctx context.Context, c client
 
 	var userNamespacesWithoutLR []string
 	var veryHighDefaultLimits []string
+	var noLimitRangeRefs, highDefaultRefs []assessmentv1alpha1.AffectedResource
 
 	for _, nsName := range userNamespaces {
 		if !nsWithLimitRange[nsName] {
 			userNamespacesWithoutLR = append(userNamespacesWithoutLR, nsName)
+			noLimitRangeRefs = append(noLimitRangeRefs, assessmentv1alpha1.AffectedResource{Kind: "Namespace", Name: nsName})
 		}
 	}
 
@@ -258,6 +284,7 @@ func (v *ResourceQuotasValidator) checkLimitRanges(ctx context.Context, c client
 					if defaultMem.Cmp(eightGi) > 0 {
 						veryHighDefaultLimits = append(veryHighDefaultLimits,
 							fmt.Sprintf("%s/%s (default memory: %s)", lr.Namespace, lr.Name, defaultMem.String()))
+						highDefaultRefs = append(highDefaultRefs, validator.ResourceRef("LimitRange", &lr))
 					}
 				}
 			}
@@ -278,14 +305,15 @@ func (v *ResourceQuotasValidator) checkLimitRanges(ctx context.Context, c client
 		}
 
 		findings = append(findings, assessmentv1alpha1.Finding{
-			ID:             "resourcequotas-limitrange-missing",
-			Validator:      validatorName,
-			Category:       validatorCategory,
-			Status:         status,
-			Title:          "Namespaces Without LimitRanges",
-			Description:    fmt.Sprintf("%d of %d user namespace(s) have no LimitRange: %s...", len(userNamespacesWithoutLR), totalUserNs, strings.Join(sample, ", ")),
-			Impact:         "Containers without limits may consume all available node resources.",
-			Recommendation: "Define LimitRanges to set default CPU/memory limits for containers.",
+			ID:                "resourcequotas-limitrange-missing",
+			Validator:         validatorName,
+			Category:          validatorCategory,
+			Status:            status,
+			Title:             "Namespaces Without LimitRanges",
+			Description:       fmt.Sprintf("%d of %d user namespace(s) have no LimitRange: %s...", len(userNamespacesWithoutLR), totalUserNs, strings.Join(sample, ", ")),
+			AffectedResources: noLimitRangeRefs,
+			Impact:            "Containers without limits may consume all available node resources.",
+			Recommendation:    "Define LimitRanges to set default CPU/memory limits for containers.",
 			References: []string{
 				"https://kubernetes.io/docs/concepts/policy/limit-range/",
 			},
@@ -312,14 +340,15 @@ func (v *ResourceQuotasValidator) checkLimitRanges(ctx context.Context, c client
 	// Report very high default limits
 	if len(veryHighDefaultLimits) > 0 {
 		findings = append(findings, assessmentv1alpha1.Finding{
-			ID:             "resourcequotas-high-defaults",
-			Validator:      validatorName,
-			Category:       validatorCategory,
-			Status:         assessmentv1alpha1.FindingStatusInfo,
-			Title:          "LimitRanges with Very High Defaults",
-			Description:    fmt.Sprintf("%d LimitRange(s) have default memory > 8Gi: %s", len(veryHighDefaultLimits), strings.Join(veryHighDefaultLimits, ", ")),
-			Impact:         "High default limits may lead to inefficient resource allocation.",
-			Recommendation: "Review default limits to ensure they match expected workload requirements.",
+			ID:                "resourcequotas-high-defaults",
+			Validator:         validatorName,
+			Category:          validatorCategory,
+			Status:            assessmentv1alpha1.FindingStatusInfo,
+			Title:             "LimitRanges with Very High Defaults",
+			Description:       fmt.Sprintf("%d LimitRange(s) have default memory > 8Gi: %s", len(veryHighDefaultLimits), strings.Join(veryHighDefaultLimits, ", ")),
+			AffectedResources: highDefaultRefs,
+			Impact:            "High default limits may lead to inefficient resource allocation.",
+			Recommendation:    "Review default limits to ensure they match expected workload requirements.",
 		})
 	}
 
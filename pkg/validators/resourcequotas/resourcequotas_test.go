@@ -4,6 +4,7 @@ import (
 	"context"
 	"testing"
 
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/clusterstate"
 	"github.com/openshift-assessment/cluster-assessment-operator/pkg/profiles"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -81,3 +82,20 @@ func TestValidate_Optimization(t *testing.T) {
 		t.Errorf("Expected 0 NamespaceList calls, got %d", c.listNamespaceCalls)
 	}
 }
+
+func TestValidate_SharedNamespaces(t *testing.T) {
+	c := &mockClient{}
+	v := &ResourceQuotasValidator{}
+
+	// Namespaces are listed once per run, however many validators use them
+	ctx := clusterstate.NewContext(context.Background(), clusterstate.New(c))
+	_, _ = v.Validate(ctx, c, profiles.Profile{})
+	_, _ = v.Validate(ctx, c, profiles.Profile{})
+
+	if c.listPartialCalls != 1 {
+		t.Errorf("Expected 1 PartialObjectMetadataList call per run, got %d", c.listPartialCalls)
+	}
+	if c.listNamespaceCalls != 0 {
+		t.Errorf("Expected 0 NamespaceList calls, got %d", c.listNamespaceCalls)
+	}
+}
@@ -22,10 +22,10 @@ import (
 	"strings"
 
 	corev1 "k8s.io/api/core/v1"
-	rbacv1 "k8s.io/api/rbac/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	assessmentv1alpha1 "github.com/openshift-assessment/cluster-assessment-operator/api/v1alpha1"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/clusterstate"
 	"github.com/openshift-assessment/cluster-assessment-operator/pkg/profiles"
 	"github.com/openshift-assessment/cluster-assessment-operator/pkg/validator"
 )
@@ -86,37 +86,49 @@ func (v *SecurityValidator) Category() string {
 	return validatorCategory
 }
 
+// RequiredPermissions returns the API access this validator needs.
+func (v *SecurityValidator) RequiredPermissions() []validator.Permission {
+	return []validator.Permission{
+		{Verb: "list", Resource: "namespaces"},
+		{Verb: "list", Resource: "pods"},
+		{Verb: "get", Resource: "serviceaccounts"},
+		{Verb: "list", Group: "rbac.authorization.k8s.io", Resource: "clusterroles"},
+		{Verb: "list", Group: "rbac.authorization.k8s.io", Resource: "clusterrolebindings"},
+	}
+}
+
 // Validate performs security checks.
 func (v *SecurityValidator) Validate(ctx context.Context, c client.Client, profile profiles.Profile) ([]assessmentv1alpha1.Finding, error) {
 	var findings []assessmentv1alpha1.Finding
+	state := clusterstate.FromContext(ctx, c)
 
 	// Check 1: Cluster-admin bindings
-	findings = append(findings, v.checkClusterAdminBindings(ctx, c, profile)...)
+	findings = append(findings, v.checkClusterAdminBindings(ctx, state, profile)...)
 
 	// Check 2: Privileged pods
-	findings = append(findings, v.checkPrivilegedPods(ctx, c, profile)...)
+	findings = append(findings, v.checkPrivilegedPods(ctx, state, profile)...)
 
 	// Check 3: Service account token automation
-	findings = append(findings, v.checkServiceAccountTokenAutomation(ctx, c)...)
+	findings = append(findings, v.checkServiceAccountTokenAutomation(ctx, c, state)...)
 
 	// Check 4: Risky RBAC patterns
-	findings = append(findings, v.checkRiskyRBACPatterns(ctx, c)...)
+	findings = append(findings, v.checkRiskyRBACPatterns(ctx, state)...)
 
 	return findings, nil
 }
 
 // checkClusterAdminBindings checks for excessive cluster-admin usage.
-func (v *SecurityValidator) checkClusterAdminBindings(ctx context.Context, c client.Client, profile profiles.Profile) []assessmentv1alpha1.Finding {
+func (v *SecurityValidator) checkClusterAdminBindings(ctx context.Context, state *clusterstate.State, profile profiles.Profile) []assessmentv1alpha1.Finding {
 	var findings []assessmentv1alpha1.Finding
 
 	// Get ClusterRoleBindings
-	crbs := &rbacv1.ClusterRoleBindingList{}
-	if err := c.List(ctx, crbs); err != nil {
+	crbs, err := state.ClusterRoleBindings(ctx)
+	if err != nil {
 		return []assessmentv1alpha1.Finding{{
 			ID:          "security-crb-error",
 			Validator:   validatorName,
 			Category:    validatorCategory,
-			Status:      assessmentv1alpha1.FindingStatusFail,
+			Status:      assessmentv1alpha1.FindingStatusError,
 			Title:       "Unable to Check ClusterRoleBindings",
 			Description: fmt.Sprintf("Failed to list ClusterRoleBindings: %v", err),
 		}}
@@ -124,27 +136,34 @@ func (v *SecurityValidator) checkClusterAdminBindings(ctx context.Context, c cli
 
 	var clusterAdminBindings []string
 	var nonSystemClusterAdminBindings []string
+	var nonSystemClusterAdminRefs []assessmentv1alpha1.AffectedResource
 
-	for _, crb := range crbs.Items {
+	for _, crb := range crbs {
 		if crb.RoleRef.Name == "cluster-admin" {
 			clusterAdminBindings = append(clusterAdminBindings, crb.Name)
 
 			// Check if it's binding to non-system subjects
+			nonSystem := false
 			for _, subject := range crb.Subjects {
 				switch subject.Kind {
 				case "ServiceAccount":
 					if !systemNamespaces[subject.Namespace] {
 						nonSystemClusterAdminBindings = append(nonSystemClusterAdminBindings,
 							fmt.Sprintf("%s (SA: %s/%s)", crb.Name, subject.Namespace, subject.Name))
+						nonSystem = true
 					}
 				case "User", "Group":
 					// Users and groups with cluster-admin
 					if !strings.HasPrefix(subject.Name, "system:") {
 						nonSystemClusterAdminBindings = append(nonSystemClusterAdminBindings,
 							fmt.Sprintf("%s (%s: %s)", crb.Name, subject.Kind, subject.Name))
+						nonSystem = true
 					}
 				}
 			}
+			if nonSystem {
+				nonSystemClusterAdminRefs = append(nonSystemClusterAdminRefs, validator.ResourceRef("ClusterRoleBinding", &crb))
+			}
 		}
 	}
 
@@ -161,14 +180,15 @@ func (v *SecurityValidator) checkClusterAdminBindings(ctx context.Context, c cli
 	// Check non-system cluster-admin bindings
 	if len(nonSystemClusterAdminBindings) > profile.Thresholds.MaxClusterAdminBindings {
 		findings = append(findings, assessmentv1alpha1.Finding{
-			ID:             "security-cluster-admin-excessive",
-			Validator:      validatorName,
-			Category:       validatorCategory,
-			Status:         assessmentv1alpha1.FindingStatusWarn,
-			Title:          "Excessive Non-System Cluster-Admin Bindings",
-			Description:    fmt.Sprintf("Found %d non-system cluster-admin bindings (threshold: %d): %s", len(nonSystemClusterAdminBindings), profile.Thresholds.MaxClusterAdminBindings, strings.Join(nonSystemClusterAdminBindings, ", ")),
-			Impact:         "Excessive cluster-admin permissions increase the attack surface and risk of privilege escalation.",
-			Recommendation: "Review cluster-admin bindings and apply least privilege principle. Consider using more specific ClusterRoles.",
+			ID:                "security-cluster-admin-excessive",
+			Validator:         validatorName,
+			Category:          validatorCategory,
+			Status:            assessmentv1alpha1.FindingStatusWarn,
+			Title:             "Excessive Non-System Cluster-Admin Bindings",
+			Description:       fmt.Sprintf("Found %d non-system cluster-admin bindings (threshold: %d): %s", len(nonSystemClusterAdminBindings), profile.Thresholds.MaxClusterAdminBindings, strings.Join(nonSystemClusterAdminBindings, ", ")),
+			AffectedResources: nonSystemClusterAdminRefs,
+			Impact:            "Excessive cluster-admin permissions increase the attack surface and risk of privilege escalation.",
+			Recommendation:    "Review cluster-admin bindings and apply least privilege principle. Consider using more specific ClusterRoles.",
 			References: []string{
 				"https://docs.openshift.com/container-platform/latest/authentication/using-rbac.html",
 			},
@@ -186,12 +206,13 @@ func (v *SecurityValidator) checkClusterAdminBindings(ctx context.Context, c cli
 		})
 	} else if len(nonSystemClusterAdminBindings) > 0 {
 		findings = append(findings, assessmentv1alpha1.Finding{
-			ID:          "security-cluster-admin-found",
-			Validator:   validatorName,
-			Category:    validatorCategory,
-			Status:      assessmentv1alpha1.FindingStatusInfo,
-			Title:       "Non-System Cluster-Admin Bindings",
-			Description: fmt.Sprintf("Found %d non-system cluster-admin bindings: %s", len(nonSystemClusterAdminBindings), strings.Join(nonSystemClusterAdminBindings, ", ")),
+			ID:                "security-cluster-admin-found",
+			Validator:         validatorName,
+			Category:          validatorCategory,
+			Status:            assessmentv1alpha1.FindingStatusInfo,
+			Title:             "Non-System Cluster-Admin Bindings",
+			Description:       fmt.Sprintf("Found %d non-system cluster-admin bindings: %s", len(nonSystemClusterAdminBindings), strings.Join(nonSystemClusterAdminBindings, ", ")),
+			AffectedResources: nonSystemClusterAdminRefs,
 		})
 	} else {
 		findings = append(findings, assessmentv1alpha1.Finding{
@@ -208,16 +229,16 @@ func (v *SecurityValidator) checkClusterAdminBindings(ctx context.Context, c cli
 }
 
 // checkPrivilegedPods checks for privileged containers.
-func (v *SecurityValidator) checkPrivilegedPods(ctx context.Context, c client.Client, profile profiles.Profile) []assessmentv1alpha1.Finding {
+func (v *SecurityValidator) checkPrivilegedPods(ctx context.Context, state *clusterstate.State, profile profiles.Profile) []assessmentv1alpha1.Finding {
 	var findings []assessmentv1alpha1.Finding
 
-	pods := &corev1.PodList{}
-	if err := c.List(ctx, pods); err != nil {
+	pods, err := state.Pods(ctx)
+	if err != nil {
 		return []assessmentv1alpha1.Finding{{
 			ID:          "security-pods-error",
 			Validator:   validatorName,
 			Category:    validatorCategory,
-			Status:      assessmentv1alpha1.FindingStatusFail,
+			Status:      assessmentv1alpha1.FindingStatusError,
 			Title:       "Unable to Check Pods",
 			Description: fmt.Sprintf("Failed to list pods: %v", err),
 		}}
@@ -226,8 +247,9 @@ func (v *SecurityValidator) checkPrivilegedPods(ctx context.Context, c client.Cl
 	var privilegedPods []string
 	var hostNetworkPods []string
 	var hostPIDPods []string
+	var privilegedRefs, hostNetworkRefs, hostPIDRefs []assessmentv1alpha1.AffectedResource
 
-	for _, pod := range pods.Items {
+	for _, pod := range pods {
 		// Skip system namespaces
 		if systemNamespaces[pod.Namespace] || strings.HasPrefix(pod.Namespace, "openshift-") {
 			continue
@@ -243,16 +265,19 @@ func (v *SecurityValidator) checkPrivilegedPods(ctx context.Context, c client.Cl
 		}
 		if isPrivileged {
 			privilegedPods = append(privilegedPods, fmt.Sprintf("%s/%s", pod.Namespace, pod.Name))
+			privilegedRefs = append(privilegedRefs, validator.ResourceRef("Pod", &pod))
 		}
 
 		// Check for host network
 		if pod.Spec.HostNetwork {
 			hostNetworkPods = append(hostNetworkPods, fmt.Sprintf("%s/%s", pod.Namespace, pod.Name))
+			hostNetworkRefs = append(hostNetworkRefs, validator.ResourceRef("Pod", &pod))
 		}
 
 		// Check for host PID
 		if pod.Spec.HostPID {
 			hostPIDPods = append(hostPIDPods, fmt.Sprintf("%s/%s", pod.Namespace, pod.Name))
+			hostPIDRefs = append(hostPIDRefs, validator.ResourceRef("Pod", &pod))
 		}
 	}
 
@@ -269,14 +294,15 @@ func (v *SecurityValidator) checkPrivilegedPods(ctx context.Context, c client.Cl
 		}
 
 		findings = append(findings, assessmentv1alpha1.Finding{
-			ID:             "security-privileged-pods",
-			Validator:      validatorName,
-			Category:       validatorCategory,
-			Status:         status,
-			Title:          "Privileged Containers in User Namespaces",
-			Description:    fmt.Sprintf("Found %d pod(s) with privileged containers in user namespaces: %s...", len(privilegedPods), strings.Join(sample, ", ")),
-			Impact:         "Privileged containers have elevated access to the host and bypass many security controls.",
-			Recommendation: "Review if privileged access is necessary. Consider using specific capabilities instead of full privileged mode.",
+			ID:                "security-privileged-pods",
+			Validator:         validatorName,
+			Category:          validatorCategory,
+			Status:            status,
+			Title:             "Privileged Containers in User Namespaces",
+			Description:       fmt.Sprintf("Found %d pod(s) with privileged containers in user namespaces: %s...", len(privilegedPods), strings.Join(sample, ", ")),
+			AffectedResources: privilegedRefs,
+			Impact:            "Privileged containers have elevated access to the host and bypass many security controls.",
+			Recommendation:    "Review if privileged access is necessary. Consider using specific capabilities instead of full privileged mode.",
 			Remediation: &assessmentv1alpha1.RemediationGuidance{
 				Safety: assessmentv1alpha1.RemediationRequiresReview,
 				Commands: []assessmentv1alpha1.RemediationCommand{
@@ -305,14 +331,15 @@ func (v *SecurityValidator) checkPrivilegedPods(ctx context.Context, c client.Cl
 			sample = sample[:5]
 		}
 		findings = append(findings, assessmentv1alpha1.Finding{
-			ID:             "security-host-network",
-			Validator:      validatorName,
-			Category:       validatorCategory,
-			Status:         assessmentv1alpha1.FindingStatusWarn,
-			Title:          "Pods Using Host Network",
-			Description:    fmt.Sprintf("Found %d pod(s) using host network in user namespaces: %s...", len(hostNetworkPods), strings.Join(sample, ", ")),
-			Impact:         "Pods with host network access can see all network traffic on the node.",
-			Recommendation: "Review if host network access is necessary. Use CNI networking when possible.",
+			ID:                "security-host-network",
+			Validator:         validatorName,
+			Category:          validatorCategory,
+			Status:            assessmentv1alpha1.FindingStatusWarn,
+			Title:             "Pods Using Host Network",
+			Description:       fmt.Sprintf("Found %d pod(s) using host network in user namespaces: %s...", len(hostNetworkPods), strings.Join(sample, ", ")),
+			AffectedResources: hostNetworkRefs,
+			Impact:            "Pods with host network access can see all network traffic on the node.",
+			Recommendation:    "Review if host network access is necessary. Use CNI networking when possible.",
 			Remediation: &assessmentv1alpha1.RemediationGuidance{
 				Safety: assessmentv1alpha1.RemediationRequiresReview,
 				Commands: []assessmentv1alpha1.RemediationCommand{
@@ -330,14 +357,15 @@ func (v *SecurityValidator) checkPrivilegedPods(ctx context.Context, c client.Cl
 			sample = sample[:5]
 		}
 		findings = append(findings, assessmentv1alpha1.Finding{
-			ID:             "security-host-pid",
-			Validator:      validatorName,
-			Category:       validatorCategory,
-			Status:         assessmentv1alpha1.FindingStatusWarn,
-			Title:          "Pods Using Host PID",
-			Description:    fmt.Sprintf("Found %d pod(s) using host PID namespace in user namespaces: %s...", len(hostPIDPods), strings.Join(sample, ", ")),
-			Impact:         "Pods with host PID access can see and potentially interact with all processes on the node.",
-			Recommendation: "Review if host PID namespace access is necessary.",
+			ID:                "security-host-pid",
+			Validator:         validatorName,
+			Category:          validatorCategory,
+			Status:            assessmentv1alpha1.FindingStatusWarn,
+			Title:             "Pods Using Host PID",
+			Description:       fmt.Sprintf("Found %d pod(s) using host PID namespace in user namespaces: %s...", len(hostPIDPods), strings.Join(sample, ", ")),
+			AffectedResources: hostPIDRefs,
+			Impact:            "Pods with host PID access can see and potentially interact with all processes on the node.",
+			Recommendation:    "Review if host PID namespace access is necessary.",
 			Remediation: &assessmentv1alpha1.RemediationGuidance{
 				Safety: assessmentv1alpha1.RemediationRequiresReview,
 				Commands: []assessmentv1alpha1.RemediationCommand{
@@ -352,18 +380,19 @@ func (v *SecurityValidator) checkPrivilegedPods(ctx context.Context, c client.Cl
 }
 
 // checkServiceAccountTokenAutomation checks for service account token mount settings.
-func (v *SecurityValidator) checkServiceAccountTokenAutomation(ctx context.Context, c client.Client) []assessmentv1alpha1.Finding {
+func (v *SecurityValidator) checkServiceAccountTokenAutomation(ctx context.Context, c client.Client, state *clusterstate.State) []assessmentv1alpha1.Finding {
 	var findings []assessmentv1alpha1.Finding
 
 	// Check if default service accounts have automount disabled
-	namespaces := &corev1.NamespaceList{}
-	if err := c.List(ctx, namespaces); err != nil {
+	namespaces, err := state.Namespaces(ctx)
+	if err != nil {
 		return findings
 	}
 
 	var automountEnabledNamespaces []string
+	var automountRefs []assessmentv1alpha1.AffectedResource
 
-	for _, ns := range namespaces.Items {
+	for _, ns := range namespaces {
 		// Skip system namespaces
 		if systemNamespaces[ns.Name] || strings.HasPrefix(ns.Name, "openshift-") || strings.HasPrefix(ns.Name, "kube-") {
 			continue
@@ -378,19 +407,21 @@ func (v *SecurityValidator) checkServiceAccountTokenAutomation(ctx context.Conte
 		// Check if automount is not explicitly disabled
 		if sa.AutomountServiceAccountToken == nil || *sa.AutomountServiceAccountToken {
 			automountEnabledNamespaces = append(automountEnabledNamespaces, ns.Name)
+			automountRefs = append(automountRefs, validator.ResourceRef("ServiceAccount", sa))
 		}
 	}
 
 	if len(automountEnabledNamespaces) > 0 {
 		findings = append(findings, assessmentv1alpha1.Finding{
-			ID:             "security-sa-automount",
-			Validator:      validatorName,
-			Category:       validatorCategory,
-			Status:         assessmentv1alpha1.FindingStatusInfo,
-			Title:          "Service Account Token Automount Enabled",
-			Description:    fmt.Sprintf("%d user namespace(s) have default service accounts with token automount enabled.", len(automountEnabledNamespaces)),
-			Impact:         "Pods automatically receive service account tokens which may not always be necessary.",
-			Recommendation: "Consider disabling automountServiceAccountToken on default service accounts where not needed.",
+			ID:                "security-sa-automount",
+			Validator:         validatorName,
+			Category:          validatorCategory,
+			Status:            assessmentv1alpha1.FindingStatusInfo,
+			Title:             "Service Account Token Automount Enabled",
+			Description:       fmt.Sprintf("%d user namespace(s) have default service accounts with token automount enabled.", len(automountEnabledNamespaces)),
+			AffectedResources: automountRefs,
+			Impact:            "Pods automatically receive service account tokens which may not always be necessary.",
+			Recommendation:    "Consider disabling automountServiceAccountToken on default service accounts where not needed.",
 			References: []string{
 				"https://kubernetes.io/docs/tasks/configure-pod-container/configure-service-account/",
 			},
@@ -401,19 +432,19 @@ func (v *SecurityValidator) checkServiceAccountTokenAutomation(ctx context.Conte
 }
 
 // checkRiskyRBACPatterns checks for risky RBAC configurations.
-func (v *SecurityValidator) checkRiskyRBACPatterns(ctx context.Context, c client.Client) []assessmentv1alpha1.Finding {
+func (v *SecurityValidator) checkRiskyRBACPatterns(ctx context.Context, state *clusterstate.State) []assessmentv1alpha1.Finding {
 	var findings []assessmentv1alpha1.Finding
 
 	// Get ClusterRoles
-	clusterRoles := &rbacv1.ClusterRoleList{}
-	if err := c.List(ctx, clusterRoles); err != nil {
+	clusterRoles, err := state.ClusterRoles(ctx)
+	if err != nil {
 		return findings
 	}
 
 	var wildcardRoles []string
 	var secretsAccessRoles []string
 
-	for _, cr := range clusterRoles.Items {
+	for _, cr := range clusterRoles {
 		// Skip system roles
 		if strings.HasPrefix(cr.Name, "system:") || strings.HasPrefix(cr.Name, "openshift") {
 			continue
@@ -452,14 +483,15 @@ func (v *SecurityValidator) checkRiskyRBACPatterns(ctx context.Context, c client
 
 	if len(wildcardRoles) > 0 {
 		findings = append(findings, assessmentv1alpha1.Finding{
-			ID:             "security-rbac-wildcard",
-			Validator:      validatorName,
-			Category:       validatorCategory,
-			Status:         assessmentv1alpha1.FindingStatusWarn,
-			Title:          "ClusterRoles with Wildcard Permissions",
-			Description:    fmt.Sprintf("Found %d custom ClusterRole(s) with wildcard (*) permissions: %s", len(wildcardRoles), strings.Join(wildcardRoles, ", ")),
-			Impact:         "Wildcard permissions grant excessive access and violate the principle of least privilege.",
-			Recommendation: "Refine ClusterRoles to specify only the necessary resources and verbs.",
+			ID:                "security-rbac-wildcard",
+			Validator:         validatorName,
+			Category:          validatorCategory,
+			Status:            assessmentv1alpha1.FindingStatusWarn,
+			Title:             "ClusterRoles with Wildcard Permissions",
+			Description:       fmt.Sprintf("Found %d custom ClusterRole(s) with wildcard (*) permissions: %s", len(wildcardRoles), strings.Join(wildcardRoles, ", ")),
+			AffectedResources: clusterRoleRefs(wildcardRoles),
+			Impact:            "Wildcard permissions grant excessive access and violate the principle of least privilege.",
+			Recommendation:    "Refine ClusterRoles to specify only the necessary resources and verbs.",
 			Remediation: &assessmentv1alpha1.RemediationGuidance{
 				Safety: assessmentv1alpha1.RemediationRequiresReview,
 				Commands: []assessmentv1alpha1.RemediationCommand{
@@ -473,20 +505,30 @@ func (v *SecurityValidator) checkRiskyRBACPatterns(ctx context.Context, c client
 
 	if len(secretsAccessRoles) > 0 {
 		findings = append(findings, assessmentv1alpha1.Finding{
-			ID:             "security-rbac-secrets",
-			Validator:      validatorName,
-			Category:       validatorCategory,
-			Status:         assessmentv1alpha1.FindingStatusInfo,
-			Title:          "ClusterRoles with Secrets Access",
-			Description:    fmt.Sprintf("Found %d custom ClusterRole(s) with secrets access: %s", len(secretsAccessRoles), strings.Join(secretsAccessRoles, ", ")),
-			Impact:         "Access to secrets allows reading sensitive data including credentials and tokens.",
-			Recommendation: "Review if secrets access is necessary and limit to specific namespaces if possible.",
+			ID:                "security-rbac-secrets",
+			Validator:         validatorName,
+			Category:          validatorCategory,
+			Status:            assessmentv1alpha1.FindingStatusInfo,
+			Title:             "ClusterRoles with Secrets Access",
+			Description:       fmt.Sprintf("Found %d custom ClusterRole(s) with secrets access: %s", len(secretsAccessRoles), strings.Join(secretsAccessRoles, ", ")),
+			AffectedResources: clusterRoleRefs(secretsAccessRoles),
+			Impact:            "Access to secrets allows reading sensitive data including credentials and tokens.",
+			Recommendation:    "Review if secrets access is necessary and limit to specific namespaces if possible.",
 		})
 	}
 
 	return findings
 }
 
+// clusterRoleRefs converts ClusterRole names to affected resources.
+func clusterRoleRefs(names []string) []assessmentv1alpha1.AffectedResource {
+	refs := make([]assessmentv1alpha1.AffectedResource, 0, len(names))
+	for _, name := range names {
+		refs = append(refs, assessmentv1alpha1.AffectedResource{Kind: "ClusterRole", Name: name})
+	}
+	return refs
+}
+
 // unique removes duplicates from a string slice.
 func unique(slice []string) []string {
 	seen := make(map[string]bool)
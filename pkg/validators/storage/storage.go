@@ -77,6 +77,14 @@ func (v *StorageValidator) Category() string {
 	return validatorCategory
 }
 
+// RequiredPermissions returns the API access this validator needs.
+func (v *StorageValidator) RequiredPermissions() []validator.Permission {
+	return []validator.Permission{
+		{Verb: "list", Group: "storage.k8s.io", Resource: "storageclasses"},
+		{Verb: "list", Group: "storage.k8s.io", Resource: "csidrivers"},
+	}
+}
+
 // Validate performs storage checks.
 func (v *StorageValidator) Validate(ctx context.Context, c client.Client, profile profiles.Profile) ([]assessmentv1alpha1.Finding, error) {
 	var findings []assessmentv1alpha1.Finding
@@ -100,7 +108,7 @@ func (v *StorageValidator) checkStorageClasses(ctx context.Context, c client.Cli
 			ID:          "storage-sc-error",
 			Validator:   validatorName,
 			Category:    validatorCategory,
-			Status:      assessmentv1alpha1.FindingStatusFail,
+			Status:      assessmentv1alpha1.FindingStatusError,
 			Title:       "Unable to Check StorageClasses",
 			Description: fmt.Sprintf("Failed to list StorageClasses: %v", err),
 		}}
@@ -131,11 +139,13 @@ func (v *StorageValidator) checkStorageClasses(ctx context.Context, c client.Cli
 	// Check for default StorageClass
 	var defaultSC *storagev1.StorageClass
 	var defaultSCCount int
+	var defaultRefs []assessmentv1alpha1.AffectedResource
 	for i := range scs.Items {
 		sc := &scs.Items[i]
 		if sc.Annotations["storageclass.kubernetes.io/is-default-class"] == "true" {
 			defaultSC = sc
 			defaultSCCount++
+			defaultRefs = append(defaultRefs, validator.ResourceRef("StorageClass", sc))
 		}
 	}
 
@@ -160,14 +170,15 @@ func (v *StorageValidator) checkStorageClasses(ctx context.Context, c client.Cli
 		})
 	} else if defaultSCCount > 1 {
 		findings = append(findings, assessmentv1alpha1.Finding{
-			ID:             "storage-multiple-default-sc",
-			Validator:      validatorName,
-			Category:       validatorCategory,
-			Status:         assessmentv1alpha1.FindingStatusWarn,
-			Title:          "Multiple Default StorageClasses",
-			Description:    fmt.Sprintf("%d StorageClasses are marked as default.", defaultSCCount),
-			Impact:         "Having multiple default StorageClasses can cause unpredictable behavior.",
-			Recommendation: "Ensure only one StorageClass is marked as default.",
+			ID:                "storage-multiple-default-sc",
+			Validator:         validatorName,
+			Category:          validatorCategory,
+			Status:            assessmentv1alpha1.FindingStatusWarn,
+			Title:             "Multiple Default StorageClasses",
+			Description:       fmt.Sprintf("%d StorageClasses are marked as default.", defaultSCCount),
+			AffectedResources: defaultRefs,
+			Impact:            "Having multiple default StorageClasses can cause unpredictable behavior.",
+			Recommendation:    "Ensure only one StorageClass is marked as default.",
 		})
 	} else if defaultSC != nil {
 		findings = append(findings, assessmentv1alpha1.Finding{
@@ -196,20 +207,23 @@ func (v *StorageValidator) checkStorageClasses(ctx context.Context, c client.Cli
 
 	// Check for volume expansion support
 	var noExpansion []string
+	var noExpansionRefs []assessmentv1alpha1.AffectedResource
 	for _, sc := range scs.Items {
 		if sc.AllowVolumeExpansion == nil || !*sc.AllowVolumeExpansion {
 			noExpansion = append(noExpansion, sc.Name)
+			noExpansionRefs = append(noExpansionRefs, validator.ResourceRef("StorageClass", &sc))
 		}
 	}
 	if len(noExpansion) > 0 {
 		findings = append(findings, assessmentv1alpha1.Finding{
-			ID:             "storage-no-expansion",
-			Validator:      validatorName,
-			Category:       validatorCategory,
-			Status:         assessmentv1alpha1.FindingStatusInfo,
-			Title:          "StorageClasses Without Volume Expansion",
-			Description:    fmt.Sprintf("%d StorageClass(es) do not support volume expansion: %s", len(noExpansion), strings.Join(noExpansion, ", ")),
-			Recommendation: "Consider enabling volume expansion for StorageClasses if supported by the provisioner.",
+			ID:                "storage-no-expansion",
+			Validator:         validatorName,
+			Category:          validatorCategory,
+			Status:            assessmentv1alpha1.FindingStatusInfo,
+			Title:             "StorageClasses Without Volume Expansion",
+			Description:       fmt.Sprintf("%d StorageClass(es) do not support volume expansion: %s", len(noExpansion), strings.Join(noExpansion, ", ")),
+			AffectedResources: noExpansionRefs,
+			Recommendation:    "Consider enabling volume expansion for StorageClasses if supported by the provisioner.",
 		})
 	}
 
@@ -226,7 +240,7 @@ func (v *StorageValidator) checkCSIDrivers(ctx context.Context, c client.Client)
 			ID:          "storage-csi-error",
 			Validator:   validatorName,
 			Category:    validatorCategory,
-			Status:      assessmentv1alpha1.FindingStatusInfo,
+			Status:      assessmentv1alpha1.FindingStatusError,
 			Title:       "Unable to Check CSI Drivers",
 			Description: fmt.Sprintf("Failed to list CSI drivers: %v", err),
 		}}
@@ -246,6 +260,7 @@ func (v *StorageValidator) checkCSIDrivers(ctx context.Context, c client.Client)
 	// Categorize drivers
 	var supportedDrivers []string
 	var unknownDrivers []string
+	var unknownRefs []assessmentv1alpha1.AffectedResource
 
 	for _, driver := range drivers.Items {
 		if supportedCSIDrivers[driver.Name] {
@@ -256,6 +271,7 @@ func (v *StorageValidator) checkCSIDrivers(ctx context.Context, c client.Client)
 				supportedDrivers = append(supportedDrivers, driver.Name)
 			} else {
 				unknownDrivers = append(unknownDrivers, driver.Name)
+				unknownRefs = append(unknownRefs, validator.ResourceRef("CSIDriver", &driver))
 			}
 		}
 	}
@@ -282,14 +298,15 @@ func (v *StorageValidator) checkCSIDrivers(ctx context.Context, c client.Client)
 
 	if len(unknownDrivers) > 0 {
 		findings = append(findings, assessmentv1alpha1.Finding{
-			ID:             "storage-csi-unknown",
-			Validator:      validatorName,
-			Category:       validatorCategory,
-			Status:         assessmentv1alpha1.FindingStatusInfo,
-			Title:          "Third-Party CSI Drivers",
-			Description:    fmt.Sprintf("Found %d third-party CSI driver(s): %s", len(unknownDrivers), strings.Join(unknownDrivers, ", ")),
-			Impact:         "Third-party CSI drivers may have different support levels and update schedules.",
-			Recommendation: "Ensure third-party CSI drivers are maintained and compatible with your OpenShift version.",
+			ID:                "storage-csi-unknown",
+			Validator:         validatorName,
+			Category:          validatorCategory,
+			Status:            assessmentv1alpha1.FindingStatusInfo,
+			Title:             "Third-Party CSI Drivers",
+			Description:       fmt.Sprintf("Found %d third-party CSI driver(s): %s", len(unknownDrivers), strings.Join(unknownDrivers, ", ")),
+			AffectedResources: unknownRefs,
+			Impact:            "Third-party CSI drivers may have different support levels and update schedules.",
+			Recommendation:    "Ensure third-party CSI drivers are maintained and compatible with your OpenShift version.",
 		})
 	}
 
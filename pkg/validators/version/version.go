@@ -58,6 +58,13 @@ func (v *VersionValidator) Category() string {
 	return validatorCategory
 }
 
+// RequiredPermissions returns the API access this validator needs.
+func (v *VersionValidator) RequiredPermissions() []validator.Permission {
+	return []validator.Permission{
+		{Verb: "get", Group: "config.openshift.io", Resource: "clusterversions"},
+	}
+}
+
 // Validate performs version checks.
 func (v *VersionValidator) Validate(ctx context.Context, c client.Client, profile profiles.Profile) ([]assessmentv1alpha1.Finding, error) {
 	var findings []assessmentv1alpha1.Finding
@@ -0,0 +1,137 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"context"
+	"fmt"
+	"sort"
+	"strings"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	assessmentv1alpha1 "github.com/openshift-assessment/cluster-assessment-operator/api/v1alpha1"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/profiles"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/validator"
+)
+
+// AssessmentProfileWebhook defaults and validates AssessmentProfiles.
+type AssessmentProfileWebhook struct {
+	// Client lists the ClusterAssessments using a profile being deleted.
+	Client client.Reader
+
+	// Registry holds the validators, check catalog and parameter schemas
+	// profiles are checked against.
+	Registry *validator.Registry
+}
+
+// +kubebuilder:webhook:path=/mutate-assessment-openshift-io-v1alpha1-assessmentprofile,mutating=true,failurePolicy=fail,sideEffects=None,groups=assessment.openshift.io,resources=assessmentprofiles,verbs=create;update,versions=v1alpha1,name=massessmentprofile.assessment.openshift.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-assessment-openshift-io-v1alpha1-assessmentprofile,mutating=false,failurePolicy=fail,sideEffects=None,groups=assessment.openshift.io,resources=assessmentprofiles,verbs=create;update;delete,versions=v1alpha1,name=vassessmentprofile.assessment.openshift.io,admissionReviewVersions=v1
+
+var (
+	_ webhook.CustomDefaulter = &AssessmentProfileWebhook{}
+	_ webhook.CustomValidator = &AssessmentProfileWebhook{}
+)
+
+// SetupWebhookWithManager registers the webhooks with the manager's webhook server.
+func (w *AssessmentProfileWebhook) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&assessmentv1alpha1.AssessmentProfile{}).
+		WithDefaulter(w).
+		WithValidator(w).
+		Complete()
+}
+
+// Default implements webhook.CustomDefaulter.
+func (w *AssessmentProfileWebhook) Default(_ context.Context, obj runtime.Object) error {
+	profile, ok := obj.(*assessmentv1alpha1.AssessmentProfile)
+	if !ok {
+		return fmt.Errorf("expected an AssessmentProfile but got %T", obj)
+	}
+	if profile.Spec.BasedOn == "" {
+		profile.Spec.BasedOn = string(profiles.ProfileProduction)
+	}
+	return nil
+}
+
+// ValidateCreate implements webhook.CustomValidator.
+func (w *AssessmentProfileWebhook) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	profile, ok := obj.(*assessmentv1alpha1.AssessmentProfile)
+	if !ok {
+		return nil, fmt.Errorf("expected an AssessmentProfile but got %T", obj)
+	}
+	return nil, w.validate(profile)
+}
+
+// ValidateUpdate implements webhook.CustomValidator. Updates that leave the
+// spec unchanged are always allowed.
+func (w *AssessmentProfileWebhook) ValidateUpdate(_ context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	profile, ok := newObj.(*assessmentv1alpha1.AssessmentProfile)
+	if !ok {
+		return nil, fmt.Errorf("expected an AssessmentProfile but got %T", newObj)
+	}
+	old, ok := oldObj.(*assessmentv1alpha1.AssessmentProfile)
+	if !ok {
+		return nil, fmt.Errorf("expected an AssessmentProfile but got %T", oldObj)
+	}
+	if !profile.DeletionTimestamp.IsZero() || equality.Semantic.DeepEqual(old.Spec, profile.Spec) {
+		return nil, nil
+	}
+	return nil, w.validate(profile)
+}
+
+// ValidateDelete implements webhook.CustomValidator. Deleting a profile that
+// assessments still use is allowed, with a warning naming them.
+func (w *AssessmentProfileWebhook) ValidateDelete(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	profile, ok := obj.(*assessmentv1alpha1.AssessmentProfile)
+	if !ok {
+		return nil, fmt.Errorf("expected an AssessmentProfile but got %T", obj)
+	}
+
+	assessments := &assessmentv1alpha1.ClusterAssessmentList{}
+	if err := w.Client.List(ctx, assessments); err != nil {
+		return admission.Warnings{fmt.Sprintf("could not check which ClusterAssessments use the profile: %v", err)}, nil
+	}
+	var users []string
+	for _, a := range assessments.Items {
+		if a.Spec.Profile == profile.Name {
+			users = append(users, a.Name)
+		}
+	}
+	if len(users) == 0 {
+		return nil, nil
+	}
+	sort.Strings(users)
+	return admission.Warnings{fmt.Sprintf("ClusterAssessments %s use profile %s; their runs will fail until it is recreated",
+		strings.Join(users, ", "), profile.Name)}, nil
+}
+
+// validate checks the profile against the registry.
+func (w *AssessmentProfileWebhook) validate(profile *assessmentv1alpha1.AssessmentProfile) error {
+	if err := w.Registry.ValidateProfile(profile); err != nil {
+		return apierrors.NewInvalid(assessmentv1alpha1.GroupVersion.WithKind("AssessmentProfile").GroupKind(), profile.Name,
+			field.ErrorList{field.Invalid(field.NewPath("spec"), field.OmitValueType{}, err.Error())})
+	}
+	return nil
+}
@@ -0,0 +1,114 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	assessmentv1alpha1 "github.com/openshift-assessment/cluster-assessment-operator/api/v1alpha1"
+)
+
+func TestAssessmentProfileWebhook_Default(t *testing.T) {
+	w := &AssessmentProfileWebhook{}
+	profile := &assessmentv1alpha1.AssessmentProfile{}
+	if err := w.Default(context.Background(), profile); err != nil {
+		t.Fatalf("Default returned error: %v", err)
+	}
+	if profile.Spec.BasedOn != "production" {
+		t.Errorf("Expected basedOn to default to production, got %q", profile.Spec.BasedOn)
+	}
+}
+
+func TestAssessmentProfileWebhook_Validate(t *testing.T) {
+	w := &AssessmentProfileWebhook{Client: newTestClient(), Registry: newTestRegistry()}
+
+	tests := []struct {
+		name    string
+		spec    assessmentv1alpha1.AssessmentProfileSpec
+		wantErr string
+	}{
+		{
+			name: "valid",
+			spec: assessmentv1alpha1.AssessmentProfileSpec{
+				BasedOn:            "development",
+				DisabledValidators: []string{"nodes"},
+				DisabledChecks:     []string{"security-privileged-default"},
+			},
+		},
+		{
+			name:    "unknown validator",
+			spec:    assessmentv1alpha1.AssessmentProfileSpec{EnabledValidators: []string{"sekurity"}},
+			wantErr: `unknown validator(s) "sekurity" in enabledValidators`,
+		},
+		{
+			name:    "unknown check",
+			spec:    assessmentv1alpha1.AssessmentProfileSpec{DisabledChecks: []string{"security-unknown"}},
+			wantErr: `unknown check ID "security-unknown"`,
+		},
+		{
+			name:    "invalid basedOn",
+			spec:    assessmentv1alpha1.AssessmentProfileSpec{BasedOn: "staging"},
+			wantErr: "invalid basedOn",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			profile := &assessmentv1alpha1.AssessmentProfile{ObjectMeta: metav1.ObjectMeta{Name: "team-a"}, Spec: tt.spec}
+			_, err := w.ValidateCreate(context.Background(), profile)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("Expected no error, got %v", err)
+				}
+				return
+			}
+			if !apierrors.IsInvalid(err) || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Expected an Invalid error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestAssessmentProfileWebhook_ValidateDelete(t *testing.T) {
+	profile := &assessmentv1alpha1.AssessmentProfile{ObjectMeta: metav1.ObjectMeta{Name: "team-a"}}
+	w := &AssessmentProfileWebhook{
+		Client: newTestClient(
+			&assessmentv1alpha1.ClusterAssessment{
+				ObjectMeta: metav1.ObjectMeta{Name: "nightly"},
+				Spec:       assessmentv1alpha1.ClusterAssessmentSpec{Profile: "team-a"},
+			},
+			&assessmentv1alpha1.ClusterAssessment{
+				ObjectMeta: metav1.ObjectMeta{Name: "weekly"},
+				Spec:       assessmentv1alpha1.ClusterAssessmentSpec{Profile: "production"},
+			},
+		),
+		Registry: newTestRegistry(),
+	}
+
+	warnings, err := w.ValidateDelete(context.Background(), profile)
+	if err != nil {
+		t.Fatalf("Expected deletion to be allowed, got %v", err)
+	}
+	if len(warnings) != 1 || !strings.Contains(warnings[0], "nightly") || strings.Contains(warnings[0], "weekly") {
+		t.Errorf("Expected a warning naming the assessment using the profile, got %v", warnings)
+	}
+}
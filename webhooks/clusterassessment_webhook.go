@@ -0,0 +1,273 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+// Package webhooks implements the defaulting and validating admission
+// webhooks of the assessment CRDs, so invalid input is rejected when it is
+// applied instead of failing a later run.
+package webhooks
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	assessmentv1alpha1 "github.com/openshift-assessment/cluster-assessment-operator/api/v1alpha1"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/profiles"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/schedule"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/suppression"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/validator"
+)
+
+// ClusterAssessmentWebhook defaults and validates ClusterAssessments.
+type ClusterAssessmentWebhook struct {
+	// Client looks up the AssessmentProfiles and ValidatorPlugins referenced
+	// by assessments.
+	Client client.Reader
+
+	// Registry holds the validators and check catalog names are checked against.
+	Registry *validator.Registry
+}
+
+// +kubebuilder:webhook:path=/mutate-assessment-openshift-io-v1alpha1-clusterassessment,mutating=true,failurePolicy=fail,sideEffects=None,groups=assessment.openshift.io,resources=clusterassessments,verbs=create;update,versions=v1alpha1,name=mclusterassessment.assessment.openshift.io,admissionReviewVersions=v1
+// +kubebuilder:webhook:path=/validate-assessment-openshift-io-v1alpha1-clusterassessment,mutating=false,failurePolicy=fail,sideEffects=None,groups=assessment.openshift.io,resources=clusterassessments,verbs=create;update,versions=v1alpha1,name=vclusterassessment.assessment.openshift.io,admissionReviewVersions=v1
+
+var (
+	_ webhook.CustomDefaulter = &ClusterAssessmentWebhook{}
+	_ webhook.CustomValidator = &ClusterAssessmentWebhook{}
+)
+
+// SetupWebhookWithManager registers the webhooks with the manager's webhook server.
+func (w *ClusterAssessmentWebhook) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&assessmentv1alpha1.ClusterAssessment{}).
+		WithDefaulter(w).
+		WithValidator(w).
+		Complete()
+}
+
+// Default implements webhook.CustomDefaulter. It records the defaults the
+// controller would otherwise apply implicitly.
+func (w *ClusterAssessmentWebhook) Default(_ context.Context, obj runtime.Object) error {
+	assessment, ok := obj.(*assessmentv1alpha1.ClusterAssessment)
+	if !ok {
+		return fmt.Errorf("expected a ClusterAssessment but got %T", obj)
+	}
+
+	spec := &assessment.Spec
+	if spec.Profile == "" {
+		spec.Profile = string(profiles.ProfileProduction)
+	}
+	if spec.DeletionPolicy == "" {
+		spec.DeletionPolicy = assessmentv1alpha1.DeletionPolicyDelete
+	}
+	if cm := spec.ReportStorage.ConfigMap; cm != nil && cm.Format == "" {
+		cm.Format = "json"
+	}
+	if git := spec.ReportStorage.Git; git != nil && git.Branch == "" {
+		git.Branch = "main"
+	}
+	if fs := spec.FindingStorage; fs != nil && fs.Mode == "" {
+		fs.Mode = assessmentv1alpha1.FindingStorageStatus
+	}
+	return nil
+}
+
+// ValidateCreate implements webhook.CustomValidator.
+func (w *ClusterAssessmentWebhook) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	assessment, ok := obj.(*assessmentv1alpha1.ClusterAssessment)
+	if !ok {
+		return nil, fmt.Errorf("expected a ClusterAssessment but got %T", obj)
+	}
+	return w.validate(ctx, assessment)
+}
+
+// ValidateUpdate implements webhook.CustomValidator. Updates that leave the
+// spec unchanged, such as the controller adding or removing its finalizer,
+// are always allowed, so an assessment that became invalid can be deleted.
+func (w *ClusterAssessmentWebhook) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	assessment, ok := newObj.(*assessmentv1alpha1.ClusterAssessment)
+	if !ok {
+		return nil, fmt.Errorf("expected a ClusterAssessment but got %T", newObj)
+	}
+	old, ok := oldObj.(*assessmentv1alpha1.ClusterAssessment)
+	if !ok {
+		return nil, fmt.Errorf("expected a ClusterAssessment but got %T", oldObj)
+	}
+	if !assessment.DeletionTimestamp.IsZero() || equality.Semantic.DeepEqual(old.Spec, assessment.Spec) {
+		return nil, nil
+	}
+	return w.validate(ctx, assessment)
+}
+
+// ValidateDelete implements webhook.CustomValidator.
+func (w *ClusterAssessmentWebhook) ValidateDelete(context.Context, runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validate returns every problem of the assessment's spec as one Invalid
+// error, and warnings for suppressions that have expired.
+func (w *ClusterAssessmentWebhook) validate(ctx context.Context, assessment *assessmentv1alpha1.ClusterAssessment) (admission.Warnings, error) {
+	spec := assessment.Spec
+	specPath := field.NewPath("spec")
+	var errs field.ErrorList
+	var warnings admission.Warnings
+
+	if spec.Schedule != "" {
+		if _, err := schedule.New(assessment); err != nil {
+			errs = append(errs, field.Invalid(specPath.Child("schedule"), spec.Schedule, err.Error()))
+		}
+	}
+
+	errs = append(errs, w.validateProfileRef(ctx, specPath.Child("profile"), spec.Profile)...)
+	validatorErrs, validatorWarnings := w.validateValidators(ctx, specPath.Child("validators"), spec.Validators)
+	errs = append(errs, validatorErrs...)
+	warnings = append(warnings, validatorWarnings...)
+
+	if spec.Triggers != nil {
+		for i, event := range spec.Triggers.Events {
+			validatorErrs, validatorWarnings := w.validateValidators(ctx, specPath.Child("triggers", "events").Index(i).Child("validators"), event.Validators)
+			errs = append(errs, validatorErrs...)
+			warnings = append(warnings, validatorWarnings...)
+		}
+	}
+
+	now := time.Now()
+	for i, rule := range spec.Suppressions {
+		rulePath := specPath.Child("suppressions").Index(i)
+		if err := suppression.Validate(rule); err != nil {
+			errs = append(errs, field.Invalid(rulePath, rule.FindingID, err.Error()))
+		}
+		if rule.FindingID != "" && !w.Registry.KnownCheckID(rule.FindingID) {
+			plugin, err := w.pluginOfCheck(ctx, rule.FindingID)
+			switch {
+			case err != nil:
+				errs = append(errs, field.InternalError(rulePath.Child("findingID"), err))
+			case plugin == "":
+				errs = append(errs, field.Invalid(rulePath.Child("findingID"), rule.FindingID, "does not match any check in the validator catalog"))
+			default:
+				warnings = append(warnings, fmt.Sprintf("%s: validator plugin %q is not ready, so %q cannot be checked against its catalog",
+					rulePath.Child("findingID"), plugin, rule.FindingID))
+			}
+		}
+		if rule.Validator != "" {
+			warning, validatorErr := w.validateValidator(ctx, rulePath.Child("validator"), rule.Validator)
+			if validatorErr != nil {
+				errs = append(errs, validatorErr)
+			}
+			if warning != "" {
+				warnings = append(warnings, warning)
+			}
+		}
+		if rule.ExpiresAt != nil && !rule.ExpiresAt.After(now) {
+			warnings = append(warnings, fmt.Sprintf("%s expired on %s and no longer suppresses findings",
+				rulePath, rule.ExpiresAt.UTC().Format(time.RFC3339)))
+		}
+	}
+
+	if git := spec.ReportStorage.Git; git != nil && git.Enabled {
+		gitPath := specPath.Child("reportStorage", "git")
+		if git.URL == "" {
+			errs = append(errs, field.Required(gitPath.Child("url"), "required when Git export is enabled"))
+		}
+		if git.SecretRef != "" && git.SecretNamespace == "" {
+			errs = append(errs, field.Required(gitPath.Child("secretNamespace"), "required when secretRef is set"))
+		}
+	}
+
+	if len(errs) > 0 {
+		return warnings, apierrors.NewInvalid(assessmentv1alpha1.GroupVersion.WithKind("ClusterAssessment").GroupKind(), assessment.Name, errs)
+	}
+	return warnings, nil
+}
+
+// validateProfileRef checks that the profile is built in or exists as an
+// AssessmentProfile.
+func (w *ClusterAssessmentWebhook) validateProfileRef(ctx context.Context, path *field.Path, name string) field.ErrorList {
+	if name == "" || name == string(profiles.ProfileProduction) || name == string(profiles.ProfileDevelopment) {
+		return nil
+	}
+	err := w.Client.Get(ctx, client.ObjectKey{Name: name}, &assessmentv1alpha1.AssessmentProfile{})
+	switch {
+	case apierrors.IsNotFound(err):
+		return field.ErrorList{field.NotFound(path, name)}
+	case err != nil:
+		return field.ErrorList{field.InternalError(path, fmt.Errorf("failed to get AssessmentProfile: %w", err))}
+	}
+	return nil
+}
+
+// validateValidators checks that every name is a registered validator or a
+// ValidatorPlugin.
+func (w *ClusterAssessmentWebhook) validateValidators(ctx context.Context, path *field.Path, names []string) (field.ErrorList, admission.Warnings) {
+	var errs field.ErrorList
+	var warnings admission.Warnings
+	for i, name := range names {
+		warning, validatorErr := w.validateValidator(ctx, path.Index(i), name)
+		if validatorErr != nil {
+			errs = append(errs, validatorErr)
+		}
+		if warning != "" {
+			warnings = append(warnings, warning)
+		}
+	}
+	return errs, warnings
+}
+
+// validateValidator checks that name is a registered validator or a
+// ValidatorPlugin. Plugins are only registered once they are ready, so the
+// others are accepted with a warning rather than rejected.
+func (w *ClusterAssessmentWebhook) validateValidator(ctx context.Context, path *field.Path, name string) (string, *field.Error) {
+	if _, ok := w.Registry.Get(name); ok {
+		return "", nil
+	}
+	err := w.Client.Get(ctx, client.ObjectKey{Name: name}, &assessmentv1alpha1.ValidatorPlugin{})
+	switch {
+	case apierrors.IsNotFound(err) || validator.IsNotInstalled(err):
+		return "", field.Invalid(path, name, "unknown validator")
+	case err != nil:
+		return "", field.InternalError(path, fmt.Errorf("failed to get ValidatorPlugin: %w", err))
+	}
+	return fmt.Sprintf("%s: validator plugin %q is not ready; it is skipped until it is", path, name), nil
+}
+
+// pluginOfCheck returns the name of the ValidatorPlugin whose check IDs id is
+// namespaced by, or "" if there is none. Plugin check IDs start with
+// "<plugin>-".
+func (w *ClusterAssessmentWebhook) pluginOfCheck(ctx context.Context, id string) (string, error) {
+	list := &assessmentv1alpha1.ValidatorPluginList{}
+	if err := w.Client.List(ctx, list); err != nil {
+		if validator.IsNotInstalled(err) {
+			return "", nil
+		}
+		return "", fmt.Errorf("failed to list ValidatorPlugins: %w", err)
+	}
+	for _, plugin := range list.Items {
+		if strings.HasPrefix(id, plugin.Name+"-") {
+			return plugin.Name, nil
+		}
+	}
+	return "", nil
+}
@@ -0,0 +1,274 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"context"
+	"strings"
+	"testing"
+	"time"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	assessmentv1alpha1 "github.com/openshift-assessment/cluster-assessment-operator/api/v1alpha1"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/profiles"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/validator"
+)
+
+type testValidator struct {
+	name string
+}
+
+func (v *testValidator) Name() string        { return v.name }
+func (v *testValidator) Description() string { return "test validator" }
+func (v *testValidator) Category() string    { return "Test" }
+func (v *testValidator) Validate(_ context.Context, _ client.Client, _ profiles.Profile) ([]assessmentv1alpha1.Finding, error) {
+	return nil, nil
+}
+func (v *testValidator) Checks() []validator.Check {
+	return []validator.Check{{ID: v.name + "-privileged-*", Title: "Privileged workloads"}}
+}
+
+func newTestClient(objs ...client.Object) client.Client {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(assessmentv1alpha1.AddToScheme(scheme))
+	return fake.NewClientBuilder().WithScheme(scheme).WithObjects(objs...).Build()
+}
+
+func newTestRegistry() *validator.Registry {
+	reg := validator.NewRegistry()
+	_ = reg.Register(&testValidator{name: "security"})
+	_ = reg.Register(&testValidator{name: "nodes"})
+	return reg
+}
+
+func validAssessment() *assessmentv1alpha1.ClusterAssessment {
+	return &assessmentv1alpha1.ClusterAssessment{
+		ObjectMeta: metav1.ObjectMeta{Name: "nightly"},
+		Spec: assessmentv1alpha1.ClusterAssessmentSpec{
+			Profile:    "team-a",
+			Schedule:   "0 2 * * *",
+			Validators: []string{"security", "nodes"},
+			Suppressions: []assessmentv1alpha1.SuppressionRule{
+				{FindingID: "security-privileged-*", Reason: "Accepted"},
+			},
+		},
+	}
+}
+
+func TestClusterAssessmentWebhook_Default(t *testing.T) {
+	w := &ClusterAssessmentWebhook{}
+	assessment := &assessmentv1alpha1.ClusterAssessment{}
+	assessment.Spec.ReportStorage = assessmentv1alpha1.ReportStorageSpec{
+		ConfigMap: &assessmentv1alpha1.ConfigMapStorageSpec{Enabled: true},
+		Git:       &assessmentv1alpha1.GitStorageSpec{Enabled: true},
+	}
+	assessment.Spec.FindingStorage = &assessmentv1alpha1.FindingStorageSpec{}
+
+	if err := w.Default(context.Background(), assessment); err != nil {
+		t.Fatalf("Default returned error: %v", err)
+	}
+	spec := assessment.Spec
+	if spec.Profile != "production" || spec.DeletionPolicy != assessmentv1alpha1.DeletionPolicyDelete {
+		t.Errorf("Expected profile and deletion policy defaults, got %q and %q", spec.Profile, spec.DeletionPolicy)
+	}
+	if spec.ReportStorage.ConfigMap.Format != "json" || spec.ReportStorage.Git.Branch != "main" {
+		t.Errorf("Expected report storage defaults, got %+v and %+v", spec.ReportStorage.ConfigMap, spec.ReportStorage.Git)
+	}
+	if spec.FindingStorage.Mode != assessmentv1alpha1.FindingStorageStatus {
+		t.Errorf("Expected finding storage mode Status, got %q", spec.FindingStorage.Mode)
+	}
+}
+
+func TestClusterAssessmentWebhook_ValidateCreate(t *testing.T) {
+	profile := &assessmentv1alpha1.AssessmentProfile{ObjectMeta: metav1.ObjectMeta{Name: "team-a"}}
+
+	tests := []struct {
+		name    string
+		mutate  func(*assessmentv1alpha1.ClusterAssessment)
+		wantErr []string
+	}{
+		{
+			name:   "valid",
+			mutate: func(*assessmentv1alpha1.ClusterAssessment) {},
+		},
+		{
+			name:   "built-in profile",
+			mutate: func(a *assessmentv1alpha1.ClusterAssessment) { a.Spec.Profile = "development" },
+		},
+		{
+			name:    "invalid schedule",
+			mutate:  func(a *assessmentv1alpha1.ClusterAssessment) { a.Spec.Schedule = "every night" },
+			wantErr: []string{"spec.schedule"},
+		},
+		{
+			name:    "invalid time zone",
+			mutate:  func(a *assessmentv1alpha1.ClusterAssessment) { a.Spec.TimeZone = "Mars/Olympus" },
+			wantErr: []string{"spec.schedule", "invalid time zone"},
+		},
+		{
+			name:    "missing profile",
+			mutate:  func(a *assessmentv1alpha1.ClusterAssessment) { a.Spec.Profile = "team-b" },
+			wantErr: []string{"spec.profile", "team-b"},
+		},
+		{
+			name:    "unknown validator",
+			mutate:  func(a *assessmentv1alpha1.ClusterAssessment) { a.Spec.Validators = []string{"security", "sekurity"} },
+			wantErr: []string{"spec.validators[1]", "sekurity"},
+		},
+		{
+			name: "unknown trigger validator",
+			mutate: func(a *assessmentv1alpha1.ClusterAssessment) {
+				a.Spec.Triggers = &assessmentv1alpha1.TriggerSpec{Events: []assessmentv1alpha1.EventTrigger{
+					{Type: assessmentv1alpha1.TriggerNamespaceCreated, Validators: []string{"quotas"}},
+				}}
+			},
+			wantErr: []string{"spec.triggers.events[0].validators[0]"},
+		},
+		{
+			name: "unknown check ID",
+			mutate: func(a *assessmentv1alpha1.ClusterAssessment) {
+				a.Spec.Suppressions[0].FindingID = "security-unknown"
+			},
+			wantErr: []string{"spec.suppressions[0].findingID", "does not match any check"},
+		},
+		{
+			name: "unknown suppression validator",
+			mutate: func(a *assessmentv1alpha1.ClusterAssessment) {
+				a.Spec.Suppressions[0] = assessmentv1alpha1.SuppressionRule{Validator: "sekurity"}
+			},
+			wantErr: []string{"spec.suppressions[0].validator"},
+		},
+		{
+			name: "suppression without selector",
+			mutate: func(a *assessmentv1alpha1.ClusterAssessment) {
+				a.Spec.Suppressions[0] = assessmentv1alpha1.SuppressionRule{Reason: "everything"}
+			},
+			wantErr: []string{"spec.suppressions[0]", "one of findingID, validator or category is required"},
+		},
+		{
+			name: "incomplete Git settings",
+			mutate: func(a *assessmentv1alpha1.ClusterAssessment) {
+				a.Spec.ReportStorage.Git = &assessmentv1alpha1.GitStorageSpec{Enabled: true, SecretRef: "git-credentials"}
+			},
+			wantErr: []string{"spec.reportStorage.git.url", "spec.reportStorage.git.secretNamespace"},
+		},
+		{
+			name: "disabled Git export",
+			mutate: func(a *assessmentv1alpha1.ClusterAssessment) {
+				a.Spec.ReportStorage.Git = &assessmentv1alpha1.GitStorageSpec{SecretRef: "git-credentials"}
+			},
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			w := &ClusterAssessmentWebhook{Client: newTestClient(profile), Registry: newTestRegistry()}
+			assessment := validAssessment()
+			tt.mutate(assessment)
+
+			_, err := w.ValidateCreate(context.Background(), assessment)
+			if len(tt.wantErr) == 0 {
+				if err != nil {
+					t.Fatalf("Expected no error, got %v", err)
+				}
+				return
+			}
+			if !apierrors.IsInvalid(err) {
+				t.Fatalf("Expected an Invalid error, got %v", err)
+			}
+			for _, want := range tt.wantErr {
+				if !strings.Contains(err.Error(), want) {
+					t.Errorf("Expected error to contain %q, got %v", want, err)
+				}
+			}
+		})
+	}
+}
+
+func TestClusterAssessmentWebhook_ExpiredSuppressionWarning(t *testing.T) {
+	w := &ClusterAssessmentWebhook{Client: newTestClient(), Registry: newTestRegistry()}
+	assessment := validAssessment()
+	assessment.Spec.Profile = "production"
+	expired := metav1.NewTime(time.Now().Add(-time.Hour))
+	future := metav1.NewTime(time.Now().Add(24 * time.Hour))
+	assessment.Spec.Suppressions = []assessmentv1alpha1.SuppressionRule{
+		{FindingID: "security-privileged-*", ExpiresAt: &future},
+		{FindingID: "nodes-error", ExpiresAt: &expired},
+	}
+
+	warnings, err := w.ValidateCreate(context.Background(), assessment)
+	if err != nil {
+		t.Fatalf("Expected expired suppressions to be allowed, got %v", err)
+	}
+	if len(warnings) != 1 || !strings.Contains(warnings[0], "spec.suppressions[1] expired") {
+		t.Errorf("Expected one warning for the expired suppression, got %v", warnings)
+	}
+}
+
+func TestClusterAssessmentWebhook_PluginNotReady(t *testing.T) {
+	// Plugins are registered once ready; this one is not yet
+	plugin := &assessmentv1alpha1.ValidatorPlugin{ObjectMeta: metav1.ObjectMeta{Name: "acme-scanner"}}
+	w := &ClusterAssessmentWebhook{Client: newTestClient(plugin), Registry: newTestRegistry()}
+	assessment := validAssessment()
+	assessment.Spec.Profile = "production"
+	assessment.Spec.Validators = []string{"security", "acme-scanner"}
+	assessment.Spec.Suppressions = []assessmentv1alpha1.SuppressionRule{
+		{FindingID: "acme-scanner-cve-*", Reason: "Tracked upstream"},
+		{Validator: "acme-scanner", Reason: "Noisy"},
+	}
+
+	warnings, err := w.ValidateCreate(context.Background(), assessment)
+	if err != nil {
+		t.Fatalf("Expected names of a plugin that is not ready to be allowed, got %v", err)
+	}
+	if len(warnings) != 3 {
+		t.Fatalf("Expected a warning per plugin reference, got %v", warnings)
+	}
+	for i, want := range []string{"spec.validators[1]", "spec.suppressions[0].findingID", "spec.suppressions[1].validator"} {
+		if !strings.Contains(warnings[i], want) || !strings.Contains(warnings[i], "not ready") {
+			t.Errorf("Expected warning %d to mention %s not being ready, got %q", i, want, warnings[i])
+		}
+	}
+
+	assessment.Spec.Suppressions = []assessmentv1alpha1.SuppressionRule{{FindingID: "other-scanner-cve-*"}}
+	if _, err := w.ValidateCreate(context.Background(), assessment); !apierrors.IsInvalid(err) {
+		t.Errorf("Expected check IDs of no plugin to be rejected, got %v", err)
+	}
+}
+
+func TestClusterAssessmentWebhook_ValidateUpdate(t *testing.T) {
+	w := &ClusterAssessmentWebhook{Client: newTestClient(), Registry: newTestRegistry()}
+
+	// The profile was deleted after the assessment was created
+	old := validAssessment()
+	updated := old.DeepCopy()
+	updated.Finalizers = []string{"assessment.openshift.io/cleanup"}
+	if _, err := w.ValidateUpdate(context.Background(), old, updated); err != nil {
+		t.Errorf("Expected a metadata-only update to be allowed, got %v", err)
+	}
+
+	updated.Spec.Schedule = "0 3 * * *"
+	if _, err := w.ValidateUpdate(context.Background(), old, updated); !apierrors.IsInvalid(err) {
+		t.Errorf("Expected a spec update to be validated, got %v", err)
+	}
+}
@@ -0,0 +1,124 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/runtime/schema"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	assessmentv1alpha1 "github.com/openshift-assessment/cluster-assessment-operator/api/v1alpha1"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/validators/customchecks"
+)
+
+// CustomCheckWebhook validates CustomChecks, so that broken expressions are
+// rejected on admission instead of surfacing as ERROR findings on the next run.
+type CustomCheckWebhook struct {
+	// Client lists the other CustomChecks to keep finding IDs unique.
+	Client client.Reader
+}
+
+// +kubebuilder:webhook:path=/validate-assessment-openshift-io-v1alpha1-customcheck,mutating=false,failurePolicy=fail,sideEffects=None,groups=assessment.openshift.io,resources=customchecks,verbs=create;update,versions=v1alpha1,name=vcustomcheck.assessment.openshift.io,admissionReviewVersions=v1
+
+var _ webhook.CustomValidator = &CustomCheckWebhook{}
+
+// SetupWebhookWithManager registers the webhook with the manager's webhook server.
+func (w *CustomCheckWebhook) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&assessmentv1alpha1.CustomCheck{}).
+		WithValidator(w).
+		Complete()
+}
+
+// ValidateCreate implements webhook.CustomValidator.
+func (w *CustomCheckWebhook) ValidateCreate(ctx context.Context, obj runtime.Object) (admission.Warnings, error) {
+	check, ok := obj.(*assessmentv1alpha1.CustomCheck)
+	if !ok {
+		return nil, fmt.Errorf("expected a CustomCheck but got %T", obj)
+	}
+	return nil, w.validate(ctx, check)
+}
+
+// ValidateUpdate implements webhook.CustomValidator. Updates that leave the
+// spec unchanged are always allowed.
+func (w *CustomCheckWebhook) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	check, ok := newObj.(*assessmentv1alpha1.CustomCheck)
+	if !ok {
+		return nil, fmt.Errorf("expected a CustomCheck but got %T", newObj)
+	}
+	old, ok := oldObj.(*assessmentv1alpha1.CustomCheck)
+	if !ok {
+		return nil, fmt.Errorf("expected a CustomCheck but got %T", oldObj)
+	}
+	if !check.DeletionTimestamp.IsZero() || equality.Semantic.DeepEqual(old.Spec, check.Spec) {
+		return nil, nil
+	}
+	return nil, w.validate(ctx, check)
+}
+
+// ValidateDelete implements webhook.CustomValidator.
+func (w *CustomCheckWebhook) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validate checks that the expression compiles, the target is well formed and
+// no other CustomCheck reports the same finding ID.
+func (w *CustomCheckWebhook) validate(ctx context.Context, check *assessmentv1alpha1.CustomCheck) error {
+	spec := field.NewPath("spec")
+	var errs field.ErrorList
+
+	if _, err := customchecks.Compile(check.Spec.Expression); err != nil {
+		errs = append(errs, field.Invalid(spec.Child("expression"), check.Spec.Expression, err.Error()))
+	}
+
+	target := spec.Child("target")
+	if _, err := schema.ParseGroupVersion(check.Spec.Target.APIVersion); err != nil {
+		errs = append(errs, field.Invalid(target.Child("apiVersion"), check.Spec.Target.APIVersion, err.Error()))
+	}
+	if check.Spec.Target.LabelSelector != nil {
+		if _, err := metav1.LabelSelectorAsSelector(check.Spec.Target.LabelSelector); err != nil {
+			errs = append(errs, field.Invalid(target.Child("labelSelector"), field.OmitValueType{}, err.Error()))
+		}
+	}
+
+	id := spec.Child("finding", "id")
+	others := &assessmentv1alpha1.CustomCheckList{}
+	if err := w.Client.List(ctx, others); err != nil {
+		errs = append(errs, field.InternalError(id, fmt.Errorf("failed to list CustomChecks: %w", err)))
+	}
+	for _, other := range others.Items {
+		if other.Name != check.Name && other.Spec.Finding.ID == check.Spec.Finding.ID {
+			errs = append(errs, field.Duplicate(id, check.Spec.Finding.ID))
+			break
+		}
+	}
+
+	if len(errs) > 0 {
+		return apierrors.NewInvalid(assessmentv1alpha1.GroupVersion.WithKind("CustomCheck").GroupKind(), check.Name, errs)
+	}
+	return nil
+}
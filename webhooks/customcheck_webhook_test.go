@@ -0,0 +1,115 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+
+	assessmentv1alpha1 "github.com/openshift-assessment/cluster-assessment-operator/api/v1alpha1"
+)
+
+func validCustomCheck(name, id string) *assessmentv1alpha1.CustomCheck {
+	return &assessmentv1alpha1.CustomCheck{
+		ObjectMeta: metav1.ObjectMeta{Name: name},
+		Spec: assessmentv1alpha1.CustomCheckSpec{
+			Target:     assessmentv1alpha1.CustomCheckTarget{APIVersion: "route.openshift.io/v1", Kind: "Route"},
+			Expression: "!has(object.spec.tls) || object.spec.tls.insecureEdgeTerminationPolicy != 'Allow'",
+			Finding: assessmentv1alpha1.CustomCheckFinding{
+				ID:    id,
+				Title: "Routes Allowing Insecure Traffic",
+			},
+		},
+	}
+}
+
+func TestCustomCheckWebhook_Validate(t *testing.T) {
+	w := &CustomCheckWebhook{Client: newTestClient(validCustomCheck("existing", "custom-insecure-routes"))}
+
+	tests := []struct {
+		name    string
+		mutate  func(*assessmentv1alpha1.CustomCheck)
+		wantErr string
+	}{
+		{
+			name: "valid",
+		},
+		{
+			name:    "invalid expression",
+			mutate:  func(c *assessmentv1alpha1.CustomCheck) { c.Spec.Expression = "object.spec.(" },
+			wantErr: "spec.expression",
+		},
+		{
+			name:    "non-bool expression",
+			mutate:  func(c *assessmentv1alpha1.CustomCheck) { c.Spec.Expression = "size(object.metadata.name)" },
+			wantErr: "must evaluate to bool",
+		},
+		{
+			name:    "invalid apiVersion",
+			mutate:  func(c *assessmentv1alpha1.CustomCheck) { c.Spec.Target.APIVersion = "a/b/c" },
+			wantErr: "spec.target.apiVersion",
+		},
+		{
+			name: "invalid label selector",
+			mutate: func(c *assessmentv1alpha1.CustomCheck) {
+				c.Spec.Target.LabelSelector = &metav1.LabelSelector{MatchExpressions: []metav1.LabelSelectorRequirement{
+					{Key: "team", Operator: "Contains"},
+				}}
+			},
+			wantErr: "spec.target.labelSelector",
+		},
+		{
+			name:    "duplicate finding ID",
+			mutate:  func(c *assessmentv1alpha1.CustomCheck) { c.Spec.Finding.ID = "custom-insecure-routes" },
+			wantErr: "spec.finding.id: Duplicate value",
+		},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			check := validCustomCheck("new", "custom-edge-routes")
+			if tt.mutate != nil {
+				tt.mutate(check)
+			}
+			_, err := w.ValidateCreate(context.Background(), check)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("Expected no error, got %v", err)
+				}
+				return
+			}
+			if !apierrors.IsInvalid(err) || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Errorf("Expected an Invalid error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestCustomCheckWebhook_ValidateUpdateKeepsOwnID(t *testing.T) {
+	existing := validCustomCheck("existing", "custom-insecure-routes")
+	w := &CustomCheckWebhook{Client: newTestClient(existing)}
+
+	updated := existing.DeepCopy()
+	updated.Spec.Finding.Title = "Insecure Routes"
+	if _, err := w.ValidateUpdate(context.Background(), existing, updated); err != nil {
+		t.Errorf("Expected an update keeping the check's own ID to be allowed, got %v", err)
+	}
+}
@@ -0,0 +1,181 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"context"
+	"fmt"
+
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	"k8s.io/apimachinery/pkg/api/equality"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/util/validation/field"
+	ctrl "sigs.k8s.io/controller-runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/webhook"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	assessmentv1alpha1 "github.com/openshift-assessment/cluster-assessment-operator/api/v1alpha1"
+	"github.com/openshift-assessment/cluster-assessment-operator/pkg/suppression"
+)
+
+// ApproveVerb is the RBAC verb on findingexceptions that allows a user to
+// approve or revoke FindingExceptions.
+const ApproveVerb = "approve"
+
+// FindingExceptionWebhook validates FindingExceptions, and only accepts
+// approvals in status.approval from users allowed to approve them.
+type FindingExceptionWebhook struct {
+	// Client creates the SubjectAccessReviews that check the approver.
+	Client client.Client
+}
+
+// +kubebuilder:webhook:path=/validate-assessment-openshift-io-v1alpha1-findingexception,mutating=false,failurePolicy=fail,sideEffects=None,groups=assessment.openshift.io,resources=findingexceptions;findingexceptions/status,verbs=create;update,versions=v1alpha1,name=vfindingexception.assessment.openshift.io,admissionReviewVersions=v1
+// +kubebuilder:rbac:groups=authorization.k8s.io,resources=subjectaccessreviews,verbs=create
+
+var _ webhook.CustomValidator = &FindingExceptionWebhook{}
+
+// SetupWebhookWithManager registers the webhook with the manager's webhook server.
+func (w *FindingExceptionWebhook) SetupWebhookWithManager(mgr ctrl.Manager) error {
+	return ctrl.NewWebhookManagedBy(mgr).
+		For(&assessmentv1alpha1.FindingException{}).
+		WithValidator(w).
+		Complete()
+}
+
+// ValidateCreate implements webhook.CustomValidator. The API server drops the
+// status of new objects, so an exception cannot be created approved.
+func (w *FindingExceptionWebhook) ValidateCreate(_ context.Context, obj runtime.Object) (admission.Warnings, error) {
+	exception, ok := obj.(*assessmentv1alpha1.FindingException)
+	if !ok {
+		return nil, fmt.Errorf("expected a FindingException but got %T", obj)
+	}
+	return nil, w.validateSpec(exception)
+}
+
+// ValidateUpdate implements webhook.CustomValidator. Spec changes are
+// validated, and a changed status.approval must be written by an approver
+// under their own user name, for the current generation.
+func (w *FindingExceptionWebhook) ValidateUpdate(ctx context.Context, oldObj, newObj runtime.Object) (admission.Warnings, error) {
+	exception, ok := newObj.(*assessmentv1alpha1.FindingException)
+	if !ok {
+		return nil, fmt.Errorf("expected a FindingException but got %T", newObj)
+	}
+	old, ok := oldObj.(*assessmentv1alpha1.FindingException)
+	if !ok {
+		return nil, fmt.Errorf("expected a FindingException but got %T", oldObj)
+	}
+	if !exception.DeletionTimestamp.IsZero() {
+		return nil, nil
+	}
+	if !equality.Semantic.DeepEqual(old.Spec, exception.Spec) {
+		if err := w.validateSpec(exception); err != nil {
+			return nil, err
+		}
+	}
+	if equality.Semantic.DeepEqual(old.Status.Approval, exception.Status.Approval) {
+		return nil, nil
+	}
+	return nil, w.validateApproval(ctx, exception)
+}
+
+// ValidateDelete implements webhook.CustomValidator.
+func (w *FindingExceptionWebhook) ValidateDelete(_ context.Context, _ runtime.Object) (admission.Warnings, error) {
+	return nil, nil
+}
+
+// validateSpec checks the exception's suppression rule.
+func (w *FindingExceptionWebhook) validateSpec(exception *assessmentv1alpha1.FindingException) error {
+	spec := field.NewPath("spec")
+	var errs field.ErrorList
+	if exception.Spec.ApprovedBy != "" {
+		errs = append(errs, field.Forbidden(spec.Child("approvedBy"), "approve the exception through status.approval"))
+	}
+	if err := suppression.Validate(exception.Spec.SuppressionRule); err != nil {
+		errs = append(errs, field.Invalid(spec, exception.Spec.FindingID, err.Error()))
+	}
+	if len(errs) > 0 {
+		return apierrors.NewInvalid(assessmentv1alpha1.GroupVersion.WithKind("FindingException").GroupKind(), exception.Name, errs)
+	}
+	return nil
+}
+
+// validateApproval checks that the requesting user may approve the exception
+// and that the approval names them and the exception's current generation.
+func (w *FindingExceptionWebhook) validateApproval(ctx context.Context, exception *assessmentv1alpha1.FindingException) error {
+	req, err := admission.RequestFromContext(ctx)
+	if err != nil {
+		return apierrors.NewInternalError(err)
+	}
+	user := req.UserInfo
+
+	allowed, err := w.canApprove(ctx, user, exception.Name)
+	if err != nil {
+		return apierrors.NewInternalError(fmt.Errorf("failed to review approval permission: %w", err))
+	}
+	if !allowed {
+		return apierrors.NewForbidden(assessmentv1alpha1.GroupVersion.WithResource("findingexceptions").GroupResource(), exception.Name,
+			fmt.Errorf("user %q may not %s findingexceptions", user.Username, ApproveVerb))
+	}
+
+	approval := exception.Status.Approval
+	if approval == nil {
+		return nil
+	}
+	path := field.NewPath("status", "approval")
+	var errs field.ErrorList
+	if approval.ApprovedBy != user.Username {
+		errs = append(errs, field.Invalid(path.Child("approvedBy"), approval.ApprovedBy,
+			fmt.Sprintf("must be the approving user %q", user.Username)))
+	}
+	if approval.Generation != exception.Generation {
+		errs = append(errs, field.Invalid(path.Child("generation"), approval.Generation,
+			fmt.Sprintf("must be the current generation %d", exception.Generation)))
+	}
+	if len(errs) > 0 {
+		return apierrors.NewInvalid(assessmentv1alpha1.GroupVersion.WithKind("FindingException").GroupKind(), exception.Name, errs)
+	}
+	return nil
+}
+
+// canApprove reviews whether user holds the approve verb on the exception.
+func (w *FindingExceptionWebhook) canApprove(ctx context.Context, user authenticationv1.UserInfo, name string) (bool, error) {
+	extra := make(map[string]authorizationv1.ExtraValue, len(user.Extra))
+	for k, v := range user.Extra {
+		extra[k] = authorizationv1.ExtraValue(v)
+	}
+	review := &authorizationv1.SubjectAccessReview{
+		Spec: authorizationv1.SubjectAccessReviewSpec{
+			User:   user.Username,
+			UID:    user.UID,
+			Groups: user.Groups,
+			Extra:  extra,
+			ResourceAttributes: &authorizationv1.ResourceAttributes{
+				Verb:     ApproveVerb,
+				Group:    assessmentv1alpha1.GroupVersion.Group,
+				Resource: "findingexceptions",
+				Name:     name,
+			},
+		},
+	}
+	if err := w.Client.Create(ctx, review); err != nil {
+		return false, err
+	}
+	return review.Status.Allowed, nil
+}
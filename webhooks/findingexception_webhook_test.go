@@ -0,0 +1,167 @@
+/*
+Copyright 2024.
+
+Licensed under the Apache License, Version 2.0 (the "License");
+you may not use this file except in compliance with the License.
+You may obtain a copy of the License at
+
+    http://www.apache.org/licenses/LICENSE-2.0
+
+Unless required by applicable law or agreed to in writing, software
+distributed under the License is distributed on an "AS IS" BASIS,
+WITHOUT WARRANTIES OR CONDITIONS OF ANY KIND, either express or implied.
+See the License for the specific language governing permissions and
+limitations under the License.
+*/
+
+package webhooks
+
+import (
+	"context"
+	"strings"
+	"testing"
+
+	admissionv1 "k8s.io/api/admission/v1"
+	authenticationv1 "k8s.io/api/authentication/v1"
+	authorizationv1 "k8s.io/api/authorization/v1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	utilruntime "k8s.io/apimachinery/pkg/util/runtime"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/client/interceptor"
+	"sigs.k8s.io/controller-runtime/pkg/webhook/admission"
+
+	assessmentv1alpha1 "github.com/openshift-assessment/cluster-assessment-operator/api/v1alpha1"
+)
+
+// newApproverClient answers SubjectAccessReviews, allowing only the given
+// users to approve FindingExceptions.
+func newApproverClient(approvers ...string) client.Client {
+	scheme := runtime.NewScheme()
+	utilruntime.Must(assessmentv1alpha1.AddToScheme(scheme))
+	utilruntime.Must(authorizationv1.AddToScheme(scheme))
+	return fake.NewClientBuilder().WithScheme(scheme).WithInterceptorFuncs(interceptor.Funcs{
+		Create: func(_ context.Context, _ client.WithWatch, obj client.Object, _ ...client.CreateOption) error {
+			review, ok := obj.(*authorizationv1.SubjectAccessReview)
+			if !ok {
+				return nil
+			}
+			attrs := review.Spec.ResourceAttributes
+			for _, a := range approvers {
+				if review.Spec.User == a && attrs.Verb == ApproveVerb && attrs.Resource == "findingexceptions" {
+					review.Status.Allowed = true
+				}
+			}
+			return nil
+		},
+	}).Build()
+}
+
+func asUser(name string) context.Context {
+	return admission.NewContextWithRequest(context.Background(), admission.Request{
+		AdmissionRequest: admissionv1.AdmissionRequest{UserInfo: authenticationv1.UserInfo{Username: name}},
+	})
+}
+
+func testException() *assessmentv1alpha1.FindingException {
+	return &assessmentv1alpha1.FindingException{
+		ObjectMeta: metav1.ObjectMeta{Name: "team-a-debug-pods", Generation: 2},
+		Spec: assessmentv1alpha1.FindingExceptionSpec{
+			SuppressionRule: assessmentv1alpha1.SuppressionRule{
+				FindingID:  "security-privileged-pods",
+				Namespaces: []string{"team-a"},
+				Reason:     "debug pods",
+			},
+		},
+	}
+}
+
+func TestFindingExceptionWebhook_Approval(t *testing.T) {
+	w := &FindingExceptionWebhook{Client: newApproverClient("alice")}
+
+	tests := []struct {
+		name     string
+		user     string
+		approval *assessmentv1alpha1.ExceptionApproval
+		wantErr  string
+	}{
+		{
+			name:     "approver approves current generation",
+			user:     "alice",
+			approval: &assessmentv1alpha1.ExceptionApproval{ApprovedBy: "alice", Generation: 2},
+		},
+		{
+			name:     "requester approves own exception",
+			user:     "mallory",
+			approval: &assessmentv1alpha1.ExceptionApproval{ApprovedBy: "mallory", Generation: 2},
+			wantErr:  "may not approve",
+		},
+		{
+			name:     "approval in another user's name",
+			user:     "alice",
+			approval: &assessmentv1alpha1.ExceptionApproval{ApprovedBy: "bob", Generation: 2},
+			wantErr:  "status.approval.approvedBy",
+		},
+		{
+			name:     "approval of an older generation",
+			user:     "alice",
+			approval: &assessmentv1alpha1.ExceptionApproval{ApprovedBy: "alice", Generation: 1},
+			wantErr:  "status.approval.generation",
+		},
+	}
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			updated := testException()
+			updated.Status.Approval = tt.approval
+
+			_, err := w.ValidateUpdate(asUser(tt.user), testException(), updated)
+			if tt.wantErr == "" {
+				if err != nil {
+					t.Fatalf("Expected no error, got %v", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tt.wantErr) {
+				t.Fatalf("Expected error containing %q, got %v", tt.wantErr, err)
+			}
+		})
+	}
+}
+
+func TestFindingExceptionWebhook_RevokeRequiresApprover(t *testing.T) {
+	w := &FindingExceptionWebhook{Client: newApproverClient("alice")}
+	approved := testException()
+	approved.Status.Approval = &assessmentv1alpha1.ExceptionApproval{ApprovedBy: "alice", Generation: 2}
+
+	if _, err := w.ValidateUpdate(asUser("mallory"), approved, testException()); !apierrors.IsForbidden(err) {
+		t.Errorf("Expected forbidden revocation, got %v", err)
+	}
+	if _, err := w.ValidateUpdate(asUser("alice"), approved, testException()); err != nil {
+		t.Errorf("Expected approver to revoke, got %v", err)
+	}
+}
+
+func TestFindingExceptionWebhook_StatusUpdatesKeepingApproval(t *testing.T) {
+	w := &FindingExceptionWebhook{Client: newApproverClient()}
+	old := testException()
+	old.Status.Approval = &assessmentv1alpha1.ExceptionApproval{ApprovedBy: "alice", Generation: 2}
+	updated := old.DeepCopy()
+	updated.Status.Assessments = []assessmentv1alpha1.ExceptionAssessmentStatus{{Name: "prod", State: assessmentv1alpha1.SuppressionActive}}
+
+	if _, err := w.ValidateUpdate(asUser("system:serviceaccount:operator"), old, updated); err != nil {
+		t.Errorf("Expected operator status update to be allowed, got %v", err)
+	}
+}
+
+func TestFindingExceptionWebhook_RejectsSpecApproval(t *testing.T) {
+	w := &FindingExceptionWebhook{Client: newApproverClient()}
+	e := testException()
+	e.Spec.ApprovedBy = "mallory"
+
+	_, err := w.ValidateCreate(asUser("mallory"), e)
+	if err == nil || !strings.Contains(err.Error(), "spec.approvedBy") {
+		t.Errorf("Expected spec.approvedBy to be rejected, got %v", err)
+	}
+}